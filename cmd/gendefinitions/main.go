@@ -0,0 +1,71 @@
+// Command gendefinitions generates structs mapping the metaobjects and metafields of a shop from dumps of its
+// metaobject and metafield definitions.
+//
+// Usage:
+//
+//	go run ./cmd/gendefinitions -out custom/definitions_gen.go [-package custom] dump.json...
+//
+// Each dump is the JSON response of a query selecting metaobjectDefinitions and/or metafieldDefinitions, e.g.
+//
+//	{
+//	  metaobjectDefinitions(first: 250) {
+//	    nodes { type name description fieldDefinitions { key name description required type { name } } }
+//	  }
+//	  metafieldDefinitions(first: 250, ownerType: PRODUCT) {
+//	    nodes { namespace key name description ownerType type { name } }
+//	  }
+//	}
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+func main() {
+	out := flag.String("out", "", "path of the generated file")
+	pkg := flag.String("package", "", "package of the generated file, defaults to the name of its directory")
+	flag.Parse()
+
+	if *out == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: gendefinitions -out file [-package name] dump.json...")
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		abs, err := filepath.Abs(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to resolve output", err.Error())
+			os.Exit(2)
+		}
+		*pkg = filepath.Base(filepath.Dir(abs))
+	}
+
+	defs := &codegen.Definitions{}
+	for _, path := range flag.Args() {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to read dump", err.Error())
+			os.Exit(2)
+		}
+		d, err := codegen.ParseDefinitions(b)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, path, err.Error())
+			os.Exit(1)
+		}
+		defs.Merge(d)
+	}
+
+	src, err := codegen.GenerateDefinitions(defs, *pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(3)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write", *out, err.Error())
+		os.Exit(3)
+	}
+}
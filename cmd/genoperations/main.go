@@ -0,0 +1,78 @@
+// Command genoperations generates operation-specific response types from GraphQL operation documents.
+//
+// Usage:
+//
+//	go run ./cmd/genoperations [-schema schema.graphql] -out queries/operations_gen.go [-package queries] path...
+//
+// Each path is either a document or a directory that is searched for *.graphql and *.gql documents.
+// The documents are validated like with cmd/validate before generating.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+	"github.com/gempages/go-shopify-graphql-model/graph/schema"
+)
+
+func main() {
+	schemaFile := flag.String("schema", "schema.graphql", "path of the schema file")
+	out := flag.String("out", "", "path of the generated file")
+	pkg := flag.String("package", "", "package of the generated file, defaults to the name of its directory")
+	flag.Parse()
+
+	if *out == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: genoperations [-schema schema.graphql] -out file [-package name] path...")
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		abs, err := filepath.Abs(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to resolve output", err.Error())
+			os.Exit(2)
+		}
+		*pkg = filepath.Base(filepath.Dir(abs))
+	}
+
+	s, err := schema.Load(*schemaFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to load schema", err.Error())
+		os.Exit(2)
+	}
+
+	files, err := schema.QueryFiles(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to list documents", err.Error())
+		os.Exit(2)
+	}
+	sources := make([]*ast.Source, 0, len(files))
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to read document", err.Error())
+			os.Exit(2)
+		}
+		sources = append(sources, &ast.Source{Name: file, Input: string(b)})
+	}
+
+	doc, err := schema.LoadQuery(s, sources...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	src, err := codegen.GenerateOperations(s, doc, *pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(3)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write", *out, err.Error())
+		os.Exit(3)
+	}
+}
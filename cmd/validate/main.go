@@ -0,0 +1,49 @@
+// Command validate checks GraphQL operation documents against schema.graphql.
+//
+// Usage:
+//
+//	go run ./cmd/validate [-schema schema.graphql] path...
+//
+// Each path is either a document or a directory that is searched for *.graphql and *.gql documents.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/schema"
+)
+
+func main() {
+	schemaFile := flag.String("schema", "schema.graphql", "path of the schema file")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: validate [-schema schema.graphql] path...")
+		os.Exit(2)
+	}
+
+	s, err := schema.Load(*schemaFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to load schema", err.Error())
+		os.Exit(2)
+	}
+
+	files, err := schema.QueryFiles(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to list documents", err.Error())
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, file := range files {
+		if err = schema.ValidateQueryFile(s, file); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
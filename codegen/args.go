@@ -0,0 +1,76 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// GenerateArgs returns the source of a file in package pkg declaring an arguments struct for every field of
+// the query and mutation roots and every connection field that accepts arguments.
+// The structs are named after the parent type and the field, e.g. QueryRootProductsArgs or ProductMediaArgs,
+// and marshal to the JSON variables of the field.
+func GenerateArgs(schema *ast.Schema, pkg string) ([]byte, error) {
+	f := newFile(pkg)
+
+	roots := rootTypes(schema)
+	for _, root := range roots {
+		for _, field := range publicFields(root) {
+			if len(field.Arguments) > 0 {
+				writeArgs(f, schema, root, field)
+			}
+		}
+	}
+
+	for _, def := range sortedDefinitions(schema) {
+		if isRoot(roots, def) || (def.Kind != ast.Object && def.Kind != ast.Interface) {
+			continue
+		}
+		for _, field := range def.Fields {
+			if len(field.Arguments) > 0 && isConnection(field.Type) {
+				writeArgs(f, schema, def, field)
+			}
+		}
+	}
+
+	src, err := f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate args: %w", err)
+	}
+	return src, nil
+}
+
+// argsName returns the name of the arguments struct generated for field of def.
+func argsName(def *ast.Definition, field *ast.FieldDefinition) string {
+	return templates.ToGo(def.Name) + templates.ToGo(field.Name) + "Args"
+}
+
+func writeArgs(f *file, schema *ast.Schema, def *ast.Definition, field *ast.FieldDefinition) {
+	name := argsName(def, field)
+	f.printf("// %s holds the arguments of `%s.%s`.\n", name, def.Name, field.Name)
+	f.printf("type %s struct {\n", name)
+	for _, arg := range field.Arguments {
+		f.comment("\t", arg.Description)
+		if arg.DefaultValue != nil {
+			f.printf("\t// Defaults to `%s` when omitted.\n", arg.DefaultValue.String())
+		}
+		f.printf("\t%s %s %s\n", templates.ToGo(arg.Name), f.typeRef(schema, arg.Type), jsonTag(arg.Name, arg.Type))
+	}
+	f.printf("}\n\n")
+}
+
+func isRoot(roots []*ast.Definition, def *ast.Definition) bool {
+	for _, root := range roots {
+		if root == def {
+			return true
+		}
+	}
+	return false
+}
+
+// isConnection reports whether t is a Relay connection type.
+func isConnection(t *ast.Type) bool {
+	return t.Elem == nil && strings.HasSuffix(t.NamedType, "Connection")
+}
@@ -0,0 +1,122 @@
+package codegen_test
+
+import (
+	"go/parser"
+	"go/token"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateArgs", func() {
+	var (
+		src []byte
+		err error
+	)
+
+	BeforeEach(func() {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: argsSchema})
+		src, err = codegen.GenerateArgs(schema, "model")
+	})
+
+	It("generates a valid Go file", func() {
+		Expect(err).NotTo(HaveOccurred())
+		_, err = parser.ParseFile(token.NewFileSet(), "args_gen.go", src, parser.AllErrors)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(HavePrefix("// Code generated by"))
+		Expect(string(src)).To(ContainSubstring(`"time"`))
+	})
+
+	It("compiles against the models", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(typeCheckModel("args_gen.go", src)).To(Succeed())
+	})
+
+	It("generates args for query root fields", func() {
+		Expect(string(src)).To(ContainSubstring("type QueryRootProductsArgs struct {"))
+		Expect(string(src)).To(MatchRegexp("First +\\*int +`json:\"first,omitempty\"`"))
+		Expect(string(src)).To(MatchRegexp("After +\\*string +`json:\"after,omitempty\"`"))
+		Expect(string(src)).To(ContainSubstring("SortKey *ProductSortKeys `json:\"sortKey,omitempty\"`"))
+		Expect(string(src)).To(ContainSubstring("// Defaults to `false` when omitted.\n\tReverse *bool `json:\"reverse,omitempty\"`"))
+		Expect(string(src)).To(ContainSubstring("type QueryRootProductArgs struct {\n\t// The ID of the product.\n\tID string `json:\"id\"`"))
+	})
+
+	It("skips fields without arguments", func() {
+		Expect(string(src)).NotTo(ContainSubstring("QueryRootShopArgs"))
+	})
+
+	It("generates args for mutation fields", func() {
+		Expect(string(src)).To(ContainSubstring("type MutationProductSetArgs struct {"))
+		Expect(string(src)).To(ContainSubstring("Input *ProductSetInput `json:\"input\"`"))
+		Expect(string(src)).To(ContainSubstring("Synchronous *bool `json:\"synchronous,omitempty\"`"))
+		Expect(string(src)).To(MatchRegexp("Ids +\\[\\]string +`json:\"ids\"`"))
+		Expect(string(src)).To(MatchRegexp("Since +\\*time.Time +`json:\"since,omitempty\"`"))
+	})
+
+	It("generates args for connection fields with arguments only", func() {
+		Expect(string(src)).To(ContainSubstring("type ProductMediaArgs struct {"))
+		Expect(string(src)).NotTo(ContainSubstring("ProductTagsArgs"))
+		Expect(string(src)).NotTo(ContainSubstring("ProductOptionsArgs"))
+	})
+})
+
+const argsSchema = `
+type QueryRoot {
+  "Returns a Product resource by ID."
+  product(
+    "The ID of the product."
+    id: ID!
+  ): Product
+  products(
+    first: Int
+    after: String
+    query: String
+    sortKey: ProductSortKeys = ID
+    reverse: Boolean = false
+  ): ProductConnection!
+  shop: Shop!
+}
+
+type Mutation {
+  productSet(input: ProductSetInput!, synchronous: Boolean = true): ProductSetPayload
+  productsDelete(ids: [ID!]!, since: DateTime): ProductSetPayload
+}
+
+scalar DateTime
+
+enum ProductSortKeys {
+  ID
+  TITLE
+}
+
+type Shop {
+  name: String!
+}
+
+type Product {
+  id: ID!
+  tags(first: Int): [String!]!
+  options(first: Int): [String!]!
+  media(first: Int, after: String): MediaConnection!
+}
+
+type ProductConnection {
+  nodes: [Product!]!
+}
+
+type MediaConnection {
+  nodes: [Shop!]!
+}
+
+input ProductSetInput {
+  title: String
+}
+
+type ProductSetPayload {
+  product: Product
+}
+`
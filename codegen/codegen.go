@@ -0,0 +1,200 @@
+// Package codegen contains the generators that run after gqlgen and emit additional code into the model package.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const header = "// Code generated by github.com/gempages/go-shopify-graphql-model, DO NOT EDIT.\n\n"
+
+// ModelImportPath is the import path of the package the models are generated into.
+const ModelImportPath = "github.com/gempages/go-shopify-graphql-model/graph/model"
+
+// goType is a Go type referenced by generated code.
+type goType struct {
+	Name       string
+	ImportPath string
+}
+
+// scalars maps custom scalars to their Go types, mirroring the `models` section of gqlgen.yml.
+// Scalars that are not listed here are represented as strings.
+var scalars = map[string]goType{
+	"String":   {Name: "string"},
+	"ID":       {Name: "string"},
+	"Int":      {Name: "int"},
+	"Float":    {Name: "float64"},
+	"Boolean":  {Name: "bool"},
+	"Money":    {Name: "decimal.Decimal", ImportPath: "github.com/shopspring/decimal"},
+	"Decimal":  {Name: "decimal.Decimal", ImportPath: "github.com/shopspring/decimal"},
+	"DateTime": {Name: "time.Time", ImportPath: "time"},
+}
+
+// file accumulates the body and imports of a generated Go file.
+type file struct {
+	pkg     string
+	imports map[string]struct{}
+	body    bytes.Buffer
+	// external is true when the file is generated outside the model package.
+	external bool
+}
+
+func newFile(pkg string) *file {
+	return &file{pkg: pkg, imports: map[string]struct{}{}}
+}
+
+func (f *file) printf(format string, args ...any) {
+	fmt.Fprintf(&f.body, format, args...)
+}
+
+func (f *file) use(importPath string) {
+	if importPath != "" {
+		f.imports[importPath] = struct{}{}
+	}
+}
+
+// comment writes text as a Go comment with the given indentation.
+func (f *file) comment(indent, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		f.printf("%s// %s\n", indent, strings.TrimRight(line, " "))
+	}
+}
+
+// source returns the gofmt-ed source of the file.
+func (f *file) source() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %s\n\n", f.pkg)
+	if len(f.imports) > 0 {
+		var std, others []string
+		for p := range f.imports {
+			if strings.Contains(strings.Split(p, "/")[0], ".") {
+				others = append(others, p)
+			} else {
+				std = append(std, p)
+			}
+		}
+		sort.Strings(std)
+		sort.Strings(others)
+		buf.WriteString("import (\n")
+		for _, p := range std {
+			fmt.Fprintf(&buf, "\t%q\n", p)
+		}
+		if len(std) > 0 && len(others) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, p := range others {
+			fmt.Fprintf(&buf, "\t%q\n", p)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(f.body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format source: %w", err)
+	}
+	return src, nil
+}
+
+// typeRef returns the Go type of a GraphQL input value of type t, following the conventions of the generated models:
+// nullable scalars and enums become pointers, input objects are always pointers and list elements are never pointers.
+func (f *file) typeRef(schema *ast.Schema, t *ast.Type) string {
+	if t.Elem != nil {
+		return "[]" + f.elemRef(schema, t.Elem)
+	}
+	def := schema.Types[t.NamedType]
+	if !t.NonNull || (def != nil && def.Kind == ast.InputObject) {
+		return "*" + f.elemRef(schema, t)
+	}
+	return f.elemRef(schema, t)
+}
+
+// elemRef returns the Go type of a list element or a non-null value of type t.
+func (f *file) elemRef(schema *ast.Schema, t *ast.Type) string {
+	if t.Elem != nil {
+		return "[]" + f.elemRef(schema, t.Elem)
+	}
+	def := schema.Types[t.NamedType]
+	if def == nil || def.Kind == ast.Scalar {
+		gt, ok := scalars[t.NamedType]
+		if !ok {
+			return "string"
+		}
+		f.use(gt.ImportPath)
+		return gt.Name
+	}
+	return f.modelRef(t.NamedType)
+}
+
+// modelRef returns the Go name of the model type typeName.
+func (f *file) modelRef(typeName string) string {
+	if !f.external {
+		return templates.ToGo(typeName)
+	}
+	f.use(ModelImportPath)
+	return "model." + templates.ToGo(typeName)
+}
+
+// jsonTag returns the struct tag of a field named name. Nullable values are omitted when unset so that
+// Shopify applies its own defaults.
+func jsonTag(name string, t *ast.Type) string {
+	if t.NonNull {
+		return fmt.Sprintf("`json:\"%s\"`", name)
+	}
+	return fmt.Sprintf("`json:\"%s,omitempty\"`", name)
+}
+
+// rootTypes returns the query and mutation types of the schema. The `schema` declaration is removed from
+// schema.graphql before generating, so the Shopify root type names are used as a fallback.
+func rootTypes(schema *ast.Schema) []*ast.Definition {
+	query, mutation := schema.Query, schema.Mutation
+	if query == nil {
+		query = schema.Types["QueryRoot"]
+	}
+	if mutation == nil {
+		mutation = schema.Types["Mutation"]
+	}
+
+	var roots []*ast.Definition
+	for _, def := range []*ast.Definition{query, mutation} {
+		if def != nil {
+			roots = append(roots, def)
+		}
+	}
+	return roots
+}
+
+// sortedDefinitions returns the non built-in definitions of the schema sorted by name.
+func sortedDefinitions(schema *ast.Schema) []*ast.Definition {
+	defs := make([]*ast.Definition, 0, len(schema.Types))
+	for _, def := range schema.Types {
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") {
+			continue
+		}
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// publicFields returns the fields of def, without the introspection fields.
+func publicFields(def *ast.Definition) ast.FieldList {
+	fields := make(ast.FieldList, 0, len(def.Fields))
+	for _, field := range def.Fields {
+		if !strings.HasPrefix(field.Name, "__") {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,62 @@
+package codegen_test
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// typeCheckModel type-checks the generated file name with the source src together with the other sources of the
+// model package, failing when src does not compile against the generated models.
+func typeCheckModel(name string, src []byte) error {
+	const dir = "../graph/model"
+	fset := token.NewFileSet()
+	files, err := parseModelFiles(fset, dir, name)
+	if err != nil {
+		return err
+	}
+	generated, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		return err
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("github.com/gempages/go-shopify-graphql-model/graph/model", fset, append(files, generated), nil)
+	return err
+}
+
+// typeCheck type-checks the generated file name with the source src as a package of its own.
+func typeCheck(name string, src []byte) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		return err
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check(f.Name.Name, fset, []*ast.File{f}, nil)
+	return err
+}
+
+// parseModelFiles parses the sources of the package in dir, except its tests and the file skip.
+func parseModelFiles(fset *token.FileSet, dir, skip string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") || e.Name() == skip {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
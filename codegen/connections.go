@@ -0,0 +1,78 @@
+package codegen
+
+import "fmt"
+
+// GenerateConnections returns the source of a file in the models package declaring, for every connection, the
+// following methods, which all accept a nil receiver, e.g. for a connection field that is null:
+//   - the GetNodes and GetPageInfo methods, which implement model.Connection,
+//   - the GetEdges method returning the cursors and nodes of the edges,
+//   - the Merge method concatenating two pages.
+func GenerateConnections(m *Models) ([]byte, error) {
+	f := newFile(m.Package)
+
+	for _, c := range m.Connections {
+		f.printf("// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.\n")
+		f.printf("func (c *%s) GetNodes() []%s {\n", c.Name, c.Node)
+		f.printf("\tif c == nil {\n\t\treturn nil\n\t}\n")
+		if c.HasNodes {
+			f.printf("\tif len(c.Nodes) > 0 || len(c.Edges) == 0 {\n\t\treturn c.Nodes\n\t}\n")
+		}
+		writeEdgeNodes(f, c)
+		f.printf("\treturn nodes\n}\n\n")
+
+		f.printf("// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.\n")
+		f.printf("func (c *%s) GetEdges() []Edge[%s] {\n", c.Name, c.Node)
+		f.printf("\tif c == nil {\n\t\treturn nil\n\t}\n")
+		f.printf("\tedges := make([]Edge[%s], 0, len(c.Edges))\n", c.Node)
+		f.printf("\tfor _, edge := range c.Edges {\n")
+		if c.Nilable {
+			f.printf("\t\tif edge.Node == nil {\n\t\t\tcontinue\n\t\t}\n")
+		}
+		f.printf("\t\tedges = append(edges, Edge[%s]{Cursor: edge.Cursor, Node: %s})\n\t}\n", c.Node,
+			edgeNode(c, "edge.Node"))
+		f.printf("\treturn edges\n}\n\n")
+
+		f.printf("// GetPageInfo returns the page info of the connection.\n")
+		f.printf("func (c *%s) GetPageInfo() *PageInfo {\n", c.Name)
+		f.printf("\tif c == nil {\n\t\treturn nil\n\t}\n")
+		f.printf("\treturn c.PageInfo\n}\n\n")
+
+		f.printf("// Merge returns a connection holding the edges and nodes of c followed by the ones of next.\n")
+		f.printf("// The page info spans both pages, the other fields are the ones of c.\n")
+		f.printf("func (c *%s) Merge(next *%s) *%s {\n", c.Name, c.Name, c.Name)
+		f.printf("\tif c == nil {\n\t\tc = &%s{}\n\t}\n", c.Name)
+		f.printf("\tmerged := *c\n")
+		f.printf("\tif next == nil {\n\t\treturn &merged\n\t}\n")
+		f.use("slices")
+		f.printf("\tmerged.Edges = slices.Concat(c.Edges, next.Edges)\n")
+		if c.HasNodes {
+			f.printf("\tmerged.Nodes = slices.Concat(c.Nodes, next.Nodes)\n")
+		}
+		f.printf("\tmerged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)\n")
+		f.printf("\treturn &merged\n}\n\n")
+	}
+
+	src, err := f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate connections: %w", err)
+	}
+	return src, nil
+}
+
+// writeEdgeNodes writes the statements collecting the nodes of the edges of c into nodes.
+func writeEdgeNodes(f *file, c *Connection) {
+	f.printf("\tnodes := make([]%s, 0, len(c.Edges))\n", c.Node)
+	f.printf("\tfor _, edge := range c.Edges {\n")
+	if c.Nilable {
+		f.printf("\t\tif edge.Node == nil {\n\t\t\tcontinue\n\t\t}\n")
+	}
+	f.printf("\t\tnodes = append(nodes, %s)\n\t}\n", edgeNode(c, "edge.Node"))
+}
+
+// edgeNode returns the expression of the node of type c.Node held by the edge node expr.
+func edgeNode(c *Connection, expr string) string {
+	if c.EdgeNode != c.Node {
+		return "*" + expr
+	}
+	return expr
+}
@@ -0,0 +1,145 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateConnections", func() {
+	var models *codegen.Models
+
+	BeforeEach(func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "models_gen.go")
+		Expect(os.WriteFile(filename, []byte(connectionModels), 0o644)).To(Succeed())
+
+		var err error
+		models, err = codegen.ParseModels(filename)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("parses the connections of the models", func() {
+		Expect(models.Connections).To(Equal([]*codegen.Connection{
+			{Name: "MediaConnection", Node: "Media", HasNodes: true, Edge: "MediaEdge", EdgeNode: "Media", Nilable: true},
+			{Name: "ProductConnection", Node: "Product", HasNodes: true, Edge: "ProductEdge", EdgeNode: "*Product",
+				Nilable: true},
+			{Name: "StringConnection", Node: "string", Edge: "StringEdge", EdgeNode: "string"},
+		}))
+	})
+
+	It("generates the Connection methods", func() {
+		src, err := codegen.GenerateConnections(models)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring(`func (c *ProductConnection) GetNodes() []Product {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Product, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (c *ProductConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (c *StringConnection) GetNodes() []string {
+	if c == nil {
+		return nil
+	}
+	nodes := make([]string, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}`))
+	})
+
+	It("generates the normalization methods", func() {
+		src, err := codegen.GenerateConnections(models)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring(`func (c *MediaConnection) GetEdges() []Edge[Media] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Media], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Media]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}`))
+		Expect(string(src)).To(ContainSubstring(`	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)`))
+		Expect(string(src)).To(ContainSubstring(`	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)`))
+	})
+})
+
+const connectionModels = `package model
+
+type Media interface {
+	IsMedia()
+}
+
+type PageInfo struct {
+	EndCursor   *string
+	HasNextPage bool
+}
+
+type Product struct {
+	ID string
+}
+
+type ProductConnection struct {
+	Edges    []ProductEdge
+	Nodes    []Product
+	PageInfo *PageInfo
+}
+
+type ProductEdge struct {
+	Cursor string
+	Node   *Product
+}
+
+type MediaConnection struct {
+	Edges    []MediaEdge
+	Nodes    []Media
+	PageInfo *PageInfo
+}
+
+type MediaEdge struct {
+	Cursor string
+	Node   Media
+}
+
+type StringConnection struct {
+	Edges    []StringEdge
+	PageInfo *PageInfo
+}
+
+type StringEdge struct {
+	Cursor string
+	Node   string
+}
+
+type ProductConnectionInput struct {
+	First *int
+}
+`
@@ -0,0 +1,299 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// MetaobjectImportPath is the import path of the package mapping metaobjects to structs.
+const MetaobjectImportPath = "github.com/gempages/go-shopify-graphql-model/graph/metaobject"
+
+// Definitions are the metaobject and metafield definitions of a shop, as selected by the metaobjectDefinitions and
+// metafieldDefinitions queries.
+type Definitions struct {
+	Metaobjects []MetaobjectDefinition
+	Metafields  []MetafieldDefinition
+}
+
+// MetaobjectDefinition is the selection of a MetaobjectDefinition used by GenerateDefinitions.
+type MetaobjectDefinition struct {
+	Type             string            `json:"type"`
+	Name             string            `json:"name"`
+	Description      *string           `json:"description"`
+	FieldDefinitions []FieldDefinition `json:"fieldDefinitions"`
+}
+
+// FieldDefinition is the selection of a MetaobjectFieldDefinition used by GenerateDefinitions.
+type FieldDefinition struct {
+	Key         string         `json:"key"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description"`
+	Required    bool           `json:"required"`
+	Type        DefinitionType `json:"type"`
+}
+
+// MetafieldDefinition is the selection of a MetafieldDefinition used by GenerateDefinitions.
+type MetafieldDefinition struct {
+	Namespace   string         `json:"namespace"`
+	Key         string         `json:"key"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description"`
+	OwnerType   string         `json:"ownerType"`
+	Type        DefinitionType `json:"type"`
+}
+
+// DefinitionType is the selection of a MetafieldDefinitionType, e.g. {"name": "list.product_reference"}.
+type DefinitionType struct {
+	Name string `json:"name"`
+}
+
+// definitionConnection is a connection of definitions, selected with nodes or edges.
+type definitionConnection[T any] struct {
+	Nodes []T `json:"nodes"`
+	Edges []struct {
+		Node T `json:"node"`
+	} `json:"edges"`
+}
+
+func (c *definitionConnection[T]) nodes() []T {
+	if c == nil {
+		return nil
+	}
+	if c.Nodes != nil {
+		return c.Nodes
+	}
+	nodes := make([]T, len(c.Edges))
+	for i, e := range c.Edges {
+		nodes[i] = e.Node
+	}
+	return nodes
+}
+
+// ParseDefinitions parses the JSON response of a query selecting metaobjectDefinitions and/or metafieldDefinitions,
+// with or without its data envelope. The connections can be selected with nodes or edges.
+func ParseDefinitions(data []byte) (*Definitions, error) {
+	type result struct {
+		MetaobjectDefinitions *definitionConnection[MetaobjectDefinition] `json:"metaobjectDefinitions"`
+		MetafieldDefinitions  *definitionConnection[MetafieldDefinition]  `json:"metafieldDefinitions"`
+	}
+	var r struct {
+		result
+		Data *result `json:"data"`
+	}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parse definitions: %w", err)
+	}
+	if r.Data != nil {
+		r.result = *r.Data
+	}
+	return &Definitions{
+		Metaobjects: r.MetaobjectDefinitions.nodes(),
+		Metafields:  r.MetafieldDefinitions.nodes(),
+	}, nil
+}
+
+// Merge appends the definitions of other to d.
+func (d *Definitions) Merge(other *Definitions) {
+	d.Metaobjects = append(d.Metaobjects, other.Metaobjects...)
+	d.Metafields = append(d.Metafields, other.Metafields...)
+}
+
+// definitionTypes maps the metafield types to their Go types. The types that are not listed here, e.g. references,
+// are represented as strings holding their raw values.
+var definitionTypes = map[string]goType{
+	"boolean":         {Name: "bool"},
+	"number_integer":  {Name: "int"},
+	"number_decimal":  {Name: "decimal.Decimal", ImportPath: "github.com/shopspring/decimal"},
+	"date":            {Name: "time.Time", ImportPath: "time"},
+	"date_time":       {Name: "time.Time", ImportPath: "time"},
+	"money":           {Name: "model.MoneyV2", ImportPath: ModelImportPath},
+	"rich_text_field": {Name: "metaobject.RichText", ImportPath: MetaobjectImportPath},
+	"json":            {Name: "json.RawMessage", ImportPath: "encoding/json"},
+	"dimension":       {Name: "json.RawMessage", ImportPath: "encoding/json"},
+	"volume":          {Name: "json.RawMessage", ImportPath: "encoding/json"},
+	"weight":          {Name: "json.RawMessage", ImportPath: "encoding/json"},
+	"rating":          {Name: "json.RawMessage", ImportPath: "encoding/json"},
+	"link":            {Name: "json.RawMessage", ImportPath: "encoding/json"},
+}
+
+// GenerateDefinitions generates a struct per metaobject definition and per metafield owner type, with the methods
+// mapping them to metaobjects and metafields through the metaobject package.
+func GenerateDefinitions(d *Definitions, pkg string) ([]byte, error) {
+	f := newFile(pkg)
+	f.external = true
+
+	metaobjects := append([]MetaobjectDefinition(nil), d.Metaobjects...)
+	sort.Slice(metaobjects, func(i, j int) bool { return metaobjects[i].Type < metaobjects[j].Type })
+	// names maps the declared Go names to the metaobject or owner types declaring them.
+	names := map[string]string{}
+	for _, def := range metaobjects {
+		name := definitionName(def.Type)
+		// Each metaobject declares its struct and the const of its type.
+		for _, declared := range []string{name, name + "Type"} {
+			if other, ok := names[declared]; ok {
+				return nil, fmt.Errorf("metaobject types %s and %s both generate %s", other, def.Type, declared)
+			}
+		}
+		names[name] = def.Type
+		names[name+"Type"] = def.Type
+		writeMetaobject(f, name, &def)
+	}
+
+	owners := map[string][]MetafieldDefinition{}
+	for _, def := range d.Metafields {
+		owners[def.OwnerType] = append(owners[def.OwnerType], def)
+	}
+	ownerTypes := make([]string, 0, len(owners))
+	for owner := range owners {
+		ownerTypes = append(ownerTypes, owner)
+	}
+	sort.Strings(ownerTypes)
+	for _, owner := range ownerTypes {
+		name := ownerTypeName(owner) + "Metafields"
+		if other, ok := names[name]; ok {
+			return nil, fmt.Errorf("metaobject type %s and owner type %s both generate %s", other, owner, name)
+		}
+		names[name] = owner
+		defs := owners[owner]
+		sort.Slice(defs, func(i, j int) bool {
+			return defs[i].Namespace+"."+defs[i].Key < defs[j].Namespace+"."+defs[j].Key
+		})
+		writeMetafields(f, name, owner, defs)
+	}
+	return f.source()
+}
+
+// ownerTypeNames maps the MetafieldOwnerType values whose words are not separated to the names of their types.
+var ownerTypeNames = map[string]string{
+	"CARTTRANSFORM":  "CartTransform",
+	"DRAFTORDER":     "DraftOrder",
+	"PRODUCTVARIANT": "ProductVariant",
+}
+
+// ownerTypeName returns the Go name of the type of the MetafieldOwnerType owner, e.g. ProductVariant for
+// PRODUCTVARIANT and CompanyLocation for COMPANY_LOCATION.
+func ownerTypeName(owner string) string {
+	if name, ok := ownerTypeNames[owner]; ok {
+		return name
+	}
+	return templates.ToGo(strings.ToLower(owner))
+}
+
+// definitionName returns the Go name of the metaobject type typ, e.g. Banner for $app:banner.
+func definitionName(typ string) string {
+	if i := strings.LastIndex(typ, ":"); i >= 0 {
+		typ = typ[i+1:]
+	}
+	return templates.ToGo(typ)
+}
+
+// metaobjectMethods and metafieldsMethods are the methods of the metaobject and metafield structs, which their
+// fields cannot be named after.
+var (
+	metaobjectMethods = []string{"UnmarshalMetaobject", "MetaobjectFields", "CreateInput"}
+	metafieldsMethods = []string{"UnmarshalMetafields", "MetafieldInputs"}
+)
+
+func writeMetaobject(f *file, name string, def *MetaobjectDefinition) {
+	f.use(ModelImportPath)
+	f.use(MetaobjectImportPath)
+	f.comment("", fmt.Sprintf("%s is a %s metaobject (%s).", name, def.Type, def.Name))
+	if def.Description != nil {
+		f.comment("", *def.Description)
+	}
+	f.printf("type %s struct {\n", name)
+	fields := reservedNames(metaobjectMethods)
+	for _, field := range def.FieldDefinitions {
+		goName := uniqueName(fields, templates.ToGo(field.Key), field.Key)
+		writeDefinitionField(f, goName, field.Key, field.Type.Name, field.Required, field.Name, field.Description)
+	}
+	f.printf("}\n\n")
+
+	f.printf("// %sType is the type of %s metaobjects.\n", name, name)
+	f.printf("const %sType = %q\n\n", name, def.Type)
+	f.printf("// UnmarshalMetaobject sets the fields of o from the fields of m.\n")
+	f.printf("func (o *%s) UnmarshalMetaobject(m *model.Metaobject) error {\n", name)
+	f.printf("\treturn metaobject.Unmarshal(m, o)\n}\n\n")
+	f.printf("// MetaobjectFields returns the inputs of the fields of o.\n")
+	f.printf("func (o *%s) MetaobjectFields() ([]model.MetaobjectFieldInput, error) {\n", name)
+	f.printf("\treturn metaobject.Fields(o)\n}\n\n")
+	f.printf("// CreateInput returns the input creating o. An empty handle lets Shopify generate one.\n")
+	f.printf("func (o *%s) CreateInput(handle string) (*model.MetaobjectCreateInput, error) {\n", name)
+	f.printf("\treturn metaobject.CreateInput(%sType, handle, o)\n}\n\n", name)
+}
+
+func writeMetafields(f *file, name, owner string, defs []MetafieldDefinition) {
+	f.use(ModelImportPath)
+	f.use(MetaobjectImportPath)
+	f.comment("", fmt.Sprintf("%s holds the defined metafields of the %s owner type.", name, owner))
+	f.printf("type %s struct {\n", name)
+	fields := reservedNames(metafieldsMethods)
+	for _, def := range defs {
+		key := def.Namespace + "." + def.Key
+		goName := uniqueName(fields, templates.ToGo(strings.TrimPrefix(def.Namespace, "$")+"_"+def.Key), key)
+		writeDefinitionField(f, goName, key, def.Type.Name, false, def.Name, def.Description)
+	}
+	f.printf("}\n\n")
+
+	f.printf("// UnmarshalMetafields sets the fields of o from metafields.\n")
+	f.printf("func (o *%s) UnmarshalMetafields(metafields []model.Metafield) error {\n", name)
+	f.printf("\treturn metaobject.UnmarshalMetafields(metafields, o)\n}\n\n")
+	f.printf("// MetafieldInputs returns the inputs setting the metafields of the owner ownerID from o.\n")
+	f.printf("func (o *%s) MetafieldInputs(ownerID string) ([]model.MetafieldsSetInput, error) {\n", name)
+	f.printf("\treturn metaobject.MetafieldInputs(ownerID, o)\n}\n\n")
+}
+
+// reservedNames returns the names used by the methods of a struct, to be passed to uniqueName.
+func reservedNames(methods []string) map[string]string {
+	used := make(map[string]string, len(methods))
+	for _, method := range methods {
+		used[method] = "method " + method
+	}
+	return used
+}
+
+// uniqueName returns goName, suffixed with a number if it is already used by another key or a method.
+func uniqueName(used map[string]string, goName, key string) string {
+	name := goName
+	for i := 2; used[name] != ""; i++ {
+		name = fmt.Sprintf("%s%d", goName, i)
+	}
+	used[name] = key
+	return name
+}
+
+// writeDefinitionField writes the struct field of a metaobject field or metafield with the given key and type.
+// Optional values are pointers, except lists and raw JSON, and are omitted from the inputs when unset.
+func writeDefinitionField(f *file, goName, key, typ string, required bool, name string, description *string) {
+	doc := name
+	if description != nil && *description != "" {
+		doc += ": " + *description
+	}
+	f.comment("\t", doc)
+
+	base, isList := strings.CutPrefix(typ, "list.")
+	goType := "string"
+	if t, ok := definitionTypes[base]; ok {
+		f.use(t.ImportPath)
+		goType = t.Name
+	}
+	switch {
+	case isList:
+		goType = "[]" + goType
+	case !required && goType != "json.RawMessage":
+		goType = "*" + goType
+	}
+
+	tag := key
+	if !required {
+		tag += ",omitempty"
+	}
+	if base == "date" {
+		tag += ",date"
+	}
+	f.printf("\t%s %s `shopify:%q`\n", goName, goType, tag)
+}
@@ -0,0 +1,147 @@
+package codegen_test
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateDefinitions", func() {
+	It("parses definition dumps", func() {
+		defs, err := codegen.ParseDefinitions([]byte(definitionDump))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(defs.Metaobjects).To(HaveLen(1))
+		Expect(defs.Metaobjects[0].Type).To(Equal("$app:banner"))
+		Expect(defs.Metaobjects[0].FieldDefinitions).To(HaveLen(6))
+		Expect(defs.Metafields).To(HaveLen(2))
+		Expect(defs.Metafields[0].OwnerType).To(Equal("PRODUCT"))
+
+		edges, err := codegen.ParseDefinitions([]byte(`{"metafieldDefinitions": {"edges": [
+			{"node": {"namespace": "custom", "key": "a", "ownerType": "SHOP", "type": {"name": "boolean"}}}
+		]}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(edges.Metafields).To(HaveLen(1))
+		Expect(edges.Metafields[0].Key).To(Equal("a"))
+
+		_, err = codegen.ParseDefinitions([]byte("{"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("generates metaobject and metafield structs", func() {
+		defs, err := codegen.ParseDefinitions([]byte(definitionDump))
+		Expect(err).NotTo(HaveOccurred())
+		src, err := codegen.GenerateDefinitions(defs, "custom")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(string(src)).To(ContainSubstring("package custom"))
+		Expect(string(src)).To(ContainSubstring(`"github.com/gempages/go-shopify-graphql-model/graph/metaobject"`))
+		Expect(string(src)).To(ContainSubstring(`// Banner is a $app:banner metaobject (Banner).
+// A promotional banner.
+type Banner struct {
+	// Title
+	Title string ` + "`" + `shopify:"title"` + "`" + `
+	// Body: The text of the banner.
+	Body *metaobject.RichText ` + "`" + `shopify:"body,omitempty"` + "`" + `
+	// Starts on
+	StartsOn *time.Time ` + "`" + `shopify:"starts_on,omitempty,date"` + "`" + `
+	// Price
+	Price model.MoneyV2 ` + "`" + `shopify:"price"` + "`" + `
+	// Products
+	Products []string ` + "`" + `shopify:"products,omitempty"` + "`" + `
+	// Settings
+	Settings json.RawMessage ` + "`" + `shopify:"settings,omitempty"` + "`" + `
+}`))
+		Expect(string(src)).To(ContainSubstring(`const BannerType = "$app:banner"`))
+		Expect(string(src)).To(ContainSubstring(`func (o *Banner) CreateInput(handle string) (*model.MetaobjectCreateInput, error) {
+	return metaobject.CreateInput(BannerType, handle, o)
+}`))
+		Expect(string(src)).To(ContainSubstring(`type ProductMetafields struct {
+	// Care guide
+	CustomCareGuide *string ` + "`" + `shopify:"custom.care_guide,omitempty"` + "`" + `
+	// Sizes
+	SpecsSizes []int ` + "`" + `shopify:"specs.sizes,omitempty"` + "`" + `
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (o *ProductMetafields) MetafieldInputs(ownerID string) ([]model.MetafieldsSetInput, error) {`))
+	})
+
+	It("fails on conflicting type names", func() {
+		_, err := codegen.GenerateDefinitions(&codegen.Definitions{Metaobjects: []codegen.MetaobjectDefinition{
+			{Type: "banner"}, {Type: "$app:banner"},
+		}}, "custom")
+		Expect(err).To(MatchError(ContainSubstring("both generate Banner")))
+
+		_, err = codegen.GenerateDefinitions(&codegen.Definitions{Metaobjects: []codegen.MetaobjectDefinition{
+			{Type: "banner"}, {Type: "banner_type"},
+		}}, "custom")
+		Expect(err).To(MatchError("metaobject types banner and banner_type both generate BannerType"))
+	})
+
+	It("does not name fields after the methods", func() {
+		src, err := codegen.GenerateDefinitions(&codegen.Definitions{
+			Metaobjects: []codegen.MetaobjectDefinition{{Type: "banner", FieldDefinitions: []codegen.FieldDefinition{
+				{Key: "create_input", Type: codegen.DefinitionType{Name: "boolean"}},
+				{Key: "metaobject_fields", Type: codegen.DefinitionType{Name: "boolean"}},
+				{Key: "unmarshal_metaobject", Type: codegen.DefinitionType{Name: "boolean"}},
+			}}},
+			Metafields: []codegen.MetafieldDefinition{
+				{Namespace: "metafield", Key: "inputs", OwnerType: "PRODUCT", Type: codegen.DefinitionType{Name: "boolean"}},
+				{Namespace: "unmarshal", Key: "metafields", OwnerType: "PRODUCT", Type: codegen.DefinitionType{Name: "boolean"}},
+			},
+		}, "custom")
+		Expect(err).NotTo(HaveOccurred())
+		for field, key := range map[string]string{
+			"CreateInput2":         "create_input",
+			"MetaobjectFields2":    "metaobject_fields",
+			"UnmarshalMetaobject2": "unmarshal_metaobject",
+			"MetafieldInputs2":     "metafield.inputs",
+			"UnmarshalMetafields2": "unmarshal.metafields",
+		} {
+			Expect(string(src)).To(MatchRegexp(`\t%s +\*bool `+"`"+`shopify:"%s,omitempty"`, field, regexp.QuoteMeta(key)))
+		}
+		Expect(typeCheck("definitions_gen.go", src)).To(Succeed())
+	})
+
+	It("names the metafield structs after the owner types", func() {
+		src, err := codegen.GenerateDefinitions(&codegen.Definitions{Metafields: []codegen.MetafieldDefinition{
+			{Namespace: "custom", Key: "a", OwnerType: "PRODUCTVARIANT", Type: codegen.DefinitionType{Name: "boolean"}},
+			{Namespace: "custom", Key: "b", OwnerType: "COMPANY_LOCATION", Type: codegen.DefinitionType{Name: "boolean"}},
+		}}, "custom")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring("type ProductVariantMetafields struct {"))
+		Expect(string(src)).To(ContainSubstring("type CompanyLocationMetafields struct {"))
+	})
+})
+
+const definitionDump = `{
+  "data": {
+    "metaobjectDefinitions": {
+      "nodes": [
+        {
+          "type": "$app:banner",
+          "name": "Banner",
+          "description": "A promotional banner.",
+          "fieldDefinitions": [
+            {"key": "title", "name": "Title", "required": true, "type": {"name": "single_line_text_field"}},
+            {"key": "body", "name": "Body", "description": "The text of the banner.", "required": false,
+             "type": {"name": "rich_text_field"}},
+            {"key": "starts_on", "name": "Starts on", "required": false, "type": {"name": "date"}},
+            {"key": "price", "name": "Price", "required": true, "type": {"name": "money"}},
+            {"key": "products", "name": "Products", "required": false, "type": {"name": "list.product_reference"}},
+            {"key": "settings", "name": "Settings", "required": false, "type": {"name": "json"}}
+          ]
+        }
+      ]
+    },
+    "metafieldDefinitions": {
+      "nodes": [
+        {"namespace": "specs", "key": "sizes", "name": "Sizes", "ownerType": "PRODUCT",
+         "type": {"name": "list.number_integer"}},
+        {"namespace": "custom", "key": "care_guide", "name": "Care guide", "ownerType": "PRODUCT",
+         "type": {"name": "multi_line_text_field"}}
+      ]
+    }
+  }
+}`
@@ -0,0 +1,71 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GenerateEnums returns the source of a file in the models package declaring, for every enum:
+//   - the UnmarshalJSON and UnmarshalText methods, which check the decoded value according to the policy set by
+//     model.SetEnumPolicy,
+//   - the MarshalText, IsKnown and GoString methods, which look the value up in All<Enum>,
+//   - a Lookup<Enum> function returning the value of All<Enum> with the given name,
+//
+// and the Enums variable listing the known values of every enum.
+func GenerateEnums(m *Models) ([]byte, error) {
+	f := newFile(m.Package)
+	f.use("slices")
+
+	f.printf("// Enums maps the name of every enum to its known values.\n")
+	f.printf("var Enums = map[string][]Enum{\n")
+	for _, e := range m.Enums {
+		f.printf("\t%q: enumValues(All%s),\n", e.Name, e.Name)
+	}
+	f.printf("}\n\n")
+
+	// gqlgen names the constants after the enum and the value, and lists them in All<Enum> in declaration order.
+	f.printf("// enumConstants maps the name of every enum to the names of its constants without the name of the\n")
+	f.printf("// enum, in the order of All<Enum>.\n")
+	f.printf("var enumConstants = map[string][]string{\n")
+	for _, e := range m.Enums {
+		suffixes := make([]string, len(e.Values))
+		for i, v := range e.Values {
+			suffix, ok := strings.CutPrefix(v, e.Name)
+			if !ok {
+				return nil, fmt.Errorf("generate enums: constant %s is not prefixed by its enum %s", v, e.Name)
+			}
+			suffixes[i] = strconv.Quote(suffix)
+		}
+		f.printf("\t%q: {%s},\n", e.Name, strings.Join(suffixes, ", "))
+	}
+	f.printf("}\n\n")
+
+	for _, e := range m.Enums {
+		f.printf("// Lookup%s returns the value of All%s named name.\n", e.Name, e.Name)
+		f.printf("func Lookup%s(name string) (%s, bool) {\n", e.Name, e.Name)
+		f.printf("\treturn lookupEnum(All%s, name)\n}\n\n", e.Name)
+
+		f.printf("// IsKnown reports whether e is one of All%s, the values declared by the schema the models were\n", e.Name)
+		f.printf("// generated from.\n")
+		f.printf("func (e %s) IsKnown() bool {\n", e.Name)
+		f.printf("\treturn slices.Contains(All%s, e)\n}\n\n", e.Name)
+
+		f.printf("func (e %s) GoString() string {\n", e.Name)
+		f.printf("\treturn enumGoString(All%s, enumConstants[%q], e, %q)\n}\n\n", e.Name, e.Name, m.Package+"."+e.Name)
+
+		f.printf("func (e %s) MarshalText() ([]byte, error) {\n", e.Name)
+		f.printf("\treturn []byte(e), nil\n}\n\n")
+
+		f.printf("func (e *%s) UnmarshalJSON(b []byte) error {\n", e.Name)
+		f.printf("\treturn unmarshalEnumJSON(e, b, %q)\n}\n\n", e.Name)
+		f.printf("func (e *%s) UnmarshalText(text []byte) error {\n", e.Name)
+		f.printf("\treturn unmarshalEnumText(e, text, %q)\n}\n\n", e.Name)
+	}
+
+	src, err := f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate enums: %w", err)
+	}
+	return src, nil
+}
@@ -0,0 +1,89 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateEnums", func() {
+	var models *codegen.Models
+
+	BeforeEach(func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "models_gen.go")
+		Expect(os.WriteFile(filename, []byte(enumModels), 0o644)).To(Succeed())
+
+		var err error
+		models, err = codegen.ParseModels(filename)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("parses the enums of the models", func() {
+		Expect(models.Package).To(Equal("model"))
+		Expect(models.Enums).To(HaveLen(2))
+		Expect(models.Enums[0].Name).To(Equal("CurrencyCode"))
+		Expect(models.Enums[0].Values).To(Equal([]string{"CurrencyCodeUsd", "CurrencyCodeEur"}))
+		Expect(models.Enums[1].Name).To(Equal("ProductStatus"))
+	})
+
+	It("rejects constants not prefixed by their enum", func() {
+		models.Enums[0].Values[1] = "EuroCode"
+		_, err := codegen.GenerateEnums(models)
+		Expect(err).To(MatchError(ContainSubstring("constant EuroCode is not prefixed by its enum CurrencyCode")))
+	})
+
+	It("skips string types without IsValid", func() {
+		for _, e := range models.Enums {
+			Expect(e.Name).NotTo(Equal("Handle"))
+		}
+	})
+
+	It("generates the unmarshal methods", func() {
+		src, err := codegen.GenerateEnums(models)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring("package model"))
+		Expect(string(src)).To(ContainSubstring(`func (e *CurrencyCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CurrencyCode")
+}`))
+		Expect(string(src)).To(MatchRegexp(`"CurrencyCode":\s+enumValues\(AllCurrencyCode\),`))
+		Expect(string(src)).To(ContainSubstring(`func LookupCurrencyCode(name string) (CurrencyCode, bool) {
+	return lookupEnum(AllCurrencyCode, name)
+}`))
+		Expect(string(src)).To(ContainSubstring(`"CurrencyCode":  {"Usd", "Eur"},`))
+		Expect(string(src)).To(ContainSubstring(`func (e CurrencyCode) IsKnown() bool {
+	return slices.Contains(AllCurrencyCode, e)
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (e CurrencyCode) GoString() string {
+	return enumGoString(AllCurrencyCode, enumConstants["CurrencyCode"], e, "model.CurrencyCode")
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (e *ProductStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductStatus")
+}`))
+	})
+})
+
+const enumModels = `package model
+
+type Handle string
+
+type ProductStatus string
+
+const (
+	ProductStatusActive ProductStatus = "ACTIVE"
+)
+
+func (e ProductStatus) IsValid() bool { return e == ProductStatusActive }
+
+type CurrencyCode string
+
+const (
+	CurrencyCodeUsd CurrencyCode = "USD"
+	CurrencyCodeEur CurrencyCode = "EUR"
+)
+
+func (e CurrencyCode) IsValid() bool { return e == CurrencyCodeUsd || e == CurrencyCodeEur }
+`
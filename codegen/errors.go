@@ -0,0 +1,49 @@
+package codegen
+
+import "fmt"
+
+// GenerateErrors returns the source of a file in the models package declaring the Error method of every
+// implementation of DisplayableError and of their code enums. The implementations with a code also declare an Is
+// method matching their code, so that errors.Is(err, ProductSetUserErrorCodeInvalidVariant) reports whether err
+// holds a ProductSetUserError with the code INVALID_VARIANT.
+func GenerateErrors(m *Models) ([]byte, error) {
+	f := newFile(m.Package)
+	codes := map[string]bool{}
+
+	for _, e := range m.DisplayableErrors {
+		code := `""`
+		switch {
+		case e.Code != "" && e.CodePointer:
+			code = "codeString(e.Code)"
+		case e.Code != "":
+			code = "string(e.Code)"
+		}
+		f.printf("func (e %s) Error() string {\n", e.Name)
+		f.printf("\treturn userErrorString(e.Field, e.Message, %s)\n}\n\n", code)
+
+		if e.Code == "" {
+			continue
+		}
+		codes[e.Code] = true
+		f.printf("// Is reports whether target is the code of e.\n")
+		f.printf("func (e %s) Is(target error) bool {\n", e.Name)
+		f.printf("\tcode, ok := target.(%s)\n", e.Code)
+		if e.CodePointer {
+			f.printf("\treturn ok && e.Code != nil && *e.Code == code\n}\n\n")
+		} else {
+			f.printf("\treturn ok && e.Code == code\n}\n\n")
+		}
+	}
+
+	for _, e := range m.Enums {
+		if codes[e.Name] {
+			f.printf("func (e %s) Error() string {\n\treturn string(e)\n}\n\n", e.Name)
+		}
+	}
+
+	src, err := f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate errors: %w", err)
+	}
+	return src, nil
+}
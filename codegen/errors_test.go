@@ -0,0 +1,78 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateErrors", func() {
+	var models *codegen.Models
+
+	BeforeEach(func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "models_gen.go")
+		Expect(os.WriteFile(filename, []byte(errorModels), 0o644)).To(Succeed())
+
+		var err error
+		models, err = codegen.ParseModels(filename)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("parses the implementations of DisplayableError", func() {
+		Expect(models.DisplayableErrors).To(Equal([]*codegen.DisplayableError{
+			{Name: "ProductSetUserError", Code: "ProductSetUserErrorCode", CodePointer: true},
+			{Name: "UserError"},
+		}))
+	})
+
+	It("generates the Error and Is methods", func() {
+		src, err := codegen.GenerateErrors(models)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring(`func (e ProductSetUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (e ProductSetUserError) Is(target error) bool {
+	code, ok := target.(ProductSetUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (e UserError) Error() string {
+	return userErrorString(e.Field, e.Message, "")
+}`))
+		Expect(string(src)).To(ContainSubstring(`func (e ProductSetUserErrorCode) Error() string {
+	return string(e)
+}`))
+		Expect(string(src)).NotTo(ContainSubstring("func (e UserError) Is"))
+	})
+})
+
+const errorModels = `package model
+
+type DisplayableError interface {
+	IsDisplayableError()
+}
+
+type UserError struct {
+	Field   []string
+	Message string
+}
+
+func (UserError) IsDisplayableError() {}
+
+type ProductSetUserError struct {
+	Code    *ProductSetUserErrorCode
+	Field   []string
+	Message string
+}
+
+func (ProductSetUserError) IsDisplayableError() {}
+
+type ProductSetUserErrorCode string
+
+const ProductSetUserErrorCodeBlank ProductSetUserErrorCode = "BLANK"
+
+func (e ProductSetUserErrorCode) IsValid() bool { return e == ProductSetUserErrorCodeBlank }
+`
@@ -0,0 +1,56 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// GenerateFields returns the source of a file in package pkg declaring a <Type>Fields variable of
+// operation.FieldDescriptor values for every object and interface type of the schema.
+// The file is meant to be generated into the graph/operation package.
+func GenerateFields(schema *ast.Schema, pkg string) ([]byte, error) {
+	f := newFile(pkg)
+
+	for _, def := range sortedDefinitions(schema) {
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+		fields := publicFields(def)
+		if len(fields) == 0 {
+			continue
+		}
+		name := templates.ToGo(def.Name) + "Fields"
+		f.printf("// %s describes the fields of the `%s` %s.\n", name, def.Name, kindName(def.Kind))
+		f.printf("var %s = struct {\n", name)
+		for _, field := range fields {
+			f.printf("\t%s FieldDescriptor\n", templates.ToGo(field.Name))
+		}
+		f.printf("}{\n")
+		for _, field := range fields {
+			f.printf("\t%s: FieldDescriptor{Parent: %q, Name: %q, Type: %q, Abstract: %t},\n",
+				templates.ToGo(field.Name), def.Name, field.Name, field.Type.String(), isAbstract(schema, field.Type))
+		}
+		f.printf("}\n\n")
+	}
+
+	src, err := f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate fields: %w", err)
+	}
+	return src, nil
+}
+
+// isAbstract reports whether the named type of t is an interface or a union.
+func isAbstract(schema *ast.Schema, t *ast.Type) bool {
+	def := schema.Types[t.Name()]
+	return def != nil && def.IsAbstractType()
+}
+
+func kindName(kind ast.DefinitionKind) string {
+	if kind == ast.Interface {
+		return "interface"
+	}
+	return "type"
+}
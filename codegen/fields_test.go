@@ -0,0 +1,84 @@
+package codegen_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateFields", func() {
+	var (
+		src []byte
+		err error
+	)
+
+	BeforeEach(func() {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: fieldsSchema})
+		src, err = codegen.GenerateFields(schema, "operation")
+	})
+
+	It("generates descriptors for object types", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring("var ProductFields = struct {"))
+		Expect(string(src)).To(MatchRegexp(
+			`ID:\s+FieldDescriptor\{Parent: "Product", Name: "id", Type: "ID!", Abstract: false\},`))
+		Expect(string(src)).To(MatchRegexp(
+			`DescriptionHTML:\s+FieldDescriptor\{Parent: "Product", Name: "descriptionHtml", Type: "String", Abstract: false\},`))
+	})
+
+	It("marks fields of interface and union types as abstract", func() {
+		Expect(string(src)).To(MatchRegexp(
+			`Media:\s+FieldDescriptor\{Parent: "Product", Name: "media", Type: "\[Media!\]!", Abstract: true\},`))
+		Expect(string(src)).To(MatchRegexp(
+			`Owner:\s+FieldDescriptor\{Parent: "Metafield", Name: "owner", Type: "MetafieldOwner", Abstract: true\},`))
+	})
+
+	It("generates descriptors for interfaces", func() {
+		Expect(string(src)).To(ContainSubstring("// MediaFields describes the fields of the `Media` interface."))
+	})
+
+	It("skips enums and inputs", func() {
+		Expect(string(src)).NotTo(ContainSubstring("MediaContentTypeFields"))
+		Expect(string(src)).NotTo(ContainSubstring("ProductInputFields"))
+	})
+})
+
+const fieldsSchema = `
+interface Media {
+  alt: String
+  mediaContentType: MediaContentType!
+}
+
+enum MediaContentType {
+  IMAGE
+  VIDEO
+}
+
+type MediaImage implements Media {
+  alt: String
+  mediaContentType: MediaContentType!
+}
+
+type Product {
+  id: ID!
+  descriptionHtml: String
+  media: [Media!]!
+}
+
+type Collection {
+  id: ID!
+}
+
+union MetafieldOwner = Product | Collection
+
+type Metafield {
+  owner: MetafieldOwner
+}
+
+input ProductInput {
+  title: String
+}
+`
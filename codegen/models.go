@@ -0,0 +1,266 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Models describes the types gqlgen generated into models_gen.go. The generators working on the Go models rather
+// than on the schema use it, so that their output always matches the generated types.
+type Models struct {
+	Package           string
+	Enums             []*Enum
+	Connections       []*Connection
+	DisplayableErrors []*DisplayableError
+	OneOfInputs       []*OneOfInput
+}
+
+// Enum is a generated enum type.
+type Enum struct {
+	Name string
+	// Values holds the names of the constants of the enum, in declaration order.
+	Values []string
+}
+
+// Connection is a generated Relay connection type, e.g. ProductConnection.
+type Connection struct {
+	Name string
+	// Node is the Go type of the nodes, e.g. Product or Media.
+	Node string
+	// HasNodes reports whether the connection has a Nodes field. All connections have an Edges field.
+	HasNodes bool
+	// Edge is the name of the edge type, e.g. ProductEdge.
+	Edge string
+	// EdgeNode is the Go type of the Node field of the edge, e.g. *Product.
+	EdgeNode string
+	// Nilable reports whether the Node field of the edge can be nil.
+	Nilable bool
+}
+
+// DisplayableError is a generated implementation of the DisplayableError interface, e.g. ProductSetUserError.
+type DisplayableError struct {
+	Name string
+	// Code is the enum type of the Code field, e.g. ProductSetUserErrorCode, or empty.
+	Code string
+	// CodePointer reports whether the Code field is a pointer.
+	CodePointer bool
+}
+
+// OneOfInput is a generated input object taking exactly one of its fields: declared with the @oneOf directive,
+// documented as such or listed in OneOfOverrides.
+type OneOfInput struct {
+	Name   string
+	Fields []OneOfField
+}
+
+// OneOfField is a field of a OneOfInput.
+type OneOfField struct {
+	Name string
+	// JSON is the name of the field in JSON, e.g. appUsagePricingDetails.
+	JSON string
+	// List reports whether the field is a slice rather than a pointer.
+	List bool
+}
+
+// ParseModels parses the models file at filename, e.g. graph/model/models_gen.go. oneOf names the input objects
+// declaring the @oneOf directive in the schema, see OneOfDirectives.
+func ParseModels(filename string, oneOf ...string) (*Models, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.SkipObjectResolution|parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parse models: %w", err)
+	}
+
+	m := &Models{Package: file.Name.Name}
+	enums := map[string]*Enum{}
+	validated := map[string]bool{}
+	displayable := map[string]bool{}
+	structs := map[string]*ast.StructType{}
+	docs := map[string]string{}
+	interfaces := map[string]bool{}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					switch t := spec.Type.(type) {
+					case *ast.Ident:
+						if t.Name == "string" {
+							enums[spec.Name.Name] = &Enum{Name: spec.Name.Name}
+						}
+					case *ast.StructType:
+						structs[spec.Name.Name] = t
+						docs[spec.Name.Name] = decl.Doc.Text()
+					case *ast.InterfaceType:
+						interfaces[spec.Name.Name] = true
+					}
+				case *ast.ValueSpec:
+					ident, ok := spec.Type.(*ast.Ident)
+					if !ok || decl.Tok != token.CONST {
+						continue
+					}
+					if e := enums[ident.Name]; e != nil {
+						for _, name := range spec.Names {
+							e.Values = append(e.Values, name.Name)
+						}
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			// gqlgen declares IsValid on every enum.
+			if decl.Recv != nil && decl.Name.Name == "IsValid" {
+				validated[receiverName(decl.Recv)] = true
+			}
+			if decl.Recv != nil && decl.Name.Name == "IsDisplayableError" {
+				displayable[receiverName(decl.Recv)] = true
+			}
+		}
+	}
+
+	for name, e := range enums {
+		if validated[name] {
+			m.Enums = append(m.Enums, e)
+		}
+	}
+	sort.Slice(m.Enums, func(i, j int) bool { return m.Enums[i].Name < m.Enums[j].Name })
+
+	for name, st := range structs {
+		if c := parseConnection(name, st, structs, interfaces); c != nil {
+			m.Connections = append(m.Connections, c)
+		}
+	}
+	sort.Slice(m.Connections, func(i, j int) bool { return m.Connections[i].Name < m.Connections[j].Name })
+
+	for name := range displayable {
+		if structs[name] == nil {
+			continue
+		}
+		e := &DisplayableError{Name: name}
+		code := structField(structs[name], "Code")
+		star, pointer := code.(*ast.StarExpr)
+		if pointer {
+			code = star.X
+		}
+		if ident, ok := code.(*ast.Ident); ok && validated[ident.Name] {
+			e.Code = ident.Name
+			e.CodePointer = pointer
+		}
+		m.DisplayableErrors = append(m.DisplayableErrors, e)
+	}
+	sort.Slice(m.DisplayableErrors, func(i, j int) bool {
+		return m.DisplayableErrors[i].Name < m.DisplayableErrors[j].Name
+	})
+
+	directives := map[string]bool{}
+	for _, name := range oneOf {
+		directives[name] = true
+	}
+	for name, st := range structs {
+		if !isOneOf(name, docs[name], directives) {
+			continue
+		}
+		input, err := parseOneOfInput(name, st)
+		if err != nil {
+			return nil, fmt.Errorf("parse models: %w", err)
+		}
+		m.OneOfInputs = append(m.OneOfInputs, input)
+	}
+	sort.Slice(m.OneOfInputs, func(i, j int) bool { return m.OneOfInputs[i].Name < m.OneOfInputs[j].Name })
+	return m, nil
+}
+
+// parseOneOfInput returns the input declared by the struct st named name, whose fields must be nullable.
+func parseOneOfInput(name string, st *ast.StructType) (*OneOfInput, error) {
+	input := &OneOfInput{Name: name}
+	for _, field := range st.Fields.List {
+		var list bool
+		switch field.Type.(type) {
+		case *ast.StarExpr:
+		case *ast.ArrayType:
+			list = true
+		default:
+			return nil, fmt.Errorf("field %s.%s of a one-of input is not nullable", name, field.Names[0].Name)
+		}
+		var jsonName string
+		if field.Tag != nil {
+			tag, _ := strconv.Unquote(field.Tag.Value)
+			jsonName, _, _ = strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+		}
+		for _, n := range field.Names {
+			input.Fields = append(input.Fields, OneOfField{Name: n.Name, JSON: jsonName, List: list})
+		}
+	}
+	return input, nil
+}
+
+// parseConnection returns the connection declared by the struct st named name, or nil if it is not a connection.
+func parseConnection(
+	name string, st *ast.StructType, structs map[string]*ast.StructType, interfaces map[string]bool,
+) *Connection {
+	if !strings.HasSuffix(name, "Connection") || structField(st, "PageInfo") == nil {
+		return nil
+	}
+	edges, ok := structField(st, "Edges").(*ast.ArrayType)
+	if !ok {
+		return nil
+	}
+	edgeName, ok := edges.Elt.(*ast.Ident)
+	if !ok || structs[edgeName.Name] == nil {
+		return nil
+	}
+	edgeNode := structField(structs[edgeName.Name], "Node")
+	if edgeNode == nil {
+		return nil
+	}
+
+	c := &Connection{Name: name, Edge: edgeName.Name, EdgeNode: types.ExprString(edgeNode)}
+	switch t := edgeNode.(type) {
+	case *ast.StarExpr:
+		c.Node = types.ExprString(t.X)
+		c.Nilable = true
+	case *ast.Ident:
+		c.Node = t.Name
+		c.Nilable = interfaces[t.Name]
+	default:
+		return nil
+	}
+	if nodes, ok := structField(st, "Nodes").(*ast.ArrayType); ok {
+		if types.ExprString(nodes.Elt) != c.Node {
+			return nil
+		}
+		c.HasNodes = true
+	}
+	return c
+}
+
+// structField returns the type of the field of st named name, or nil.
+func structField(st *ast.StructType, name string) ast.Expr {
+	for _, field := range st.Fields.List {
+		for _, n := range field.Names {
+			if n.Name == name {
+				return field.Type
+			}
+		}
+	}
+	return nil
+}
+
+// receiverName returns the name of the type of a method receiver.
+func receiverName(recv *ast.FieldList) string {
+	t := recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if ident, ok := t.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package codegen
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// oneOfMarker matches the documentation of the input objects that take exactly one of their fields, e.g.
+// "Exactly one field of input is required." or "This is an input union: one, and only one, field can be provided.".
+var oneOfMarker = regexp.MustCompile(`(?i)\bexactly one (input )?(field|option)\b|\bone, and only one, field\b`)
+
+// OneOfOverrides decides whether the input objects it lists take exactly one of their fields, overriding the
+// @oneOf directive and the documentation: true adds the input, false removes it. It lists the inputs whose
+// documentation describes the constraint in its own words, e.g. AppPlanInput: "The pricing model input can be
+// either appRecurringPricingDetails or appUsagePricingDetails".
+var OneOfOverrides = map[string]bool{
+	"AppPlanInput":                      true,
+	"AppSubscriptionDiscountValueInput": true,
+	"CustomerPaymentMethodRemoteInput":  true,
+	"OrderCreateDiscountCodeInput":      true,
+	"PurchasingEntityInput":             true,
+}
+
+// OneOfDirectives returns the names of the input objects of schema declaring the @oneOf directive, to be passed to
+// ParseModels.
+func OneOfDirectives(schema *ast.Schema) []string {
+	var names []string
+	for _, def := range sortedDefinitions(schema) {
+		if def.Kind == ast.InputObject && def.Directives.ForName("oneOf") != nil {
+			names = append(names, def.Name)
+		}
+	}
+	return names
+}
+
+// isOneOf reports whether the input object name with the documentation doc takes exactly one of its fields.
+func isOneOf(name, doc string, directives map[string]bool) bool {
+	if override, ok := OneOfOverrides[name]; ok {
+		return override
+	}
+	return directives[name] || oneOfMarker.MatchString(doc)
+}
+
+// GenerateOneOf returns the source of a file in the models package declaring the Validate and MarshalJSON methods
+// of the one-of inputs of m. Validate returns a *OneOfError unless exactly one field of the input is set, and
+// MarshalJSON fails with that error, so that invalid inputs are not sent to Shopify.
+func GenerateOneOf(m *Models) ([]byte, error) {
+	f := newFile(m.Package)
+
+	for _, input := range m.OneOfInputs {
+		writeOneOf(f, input)
+	}
+
+	src, err := f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate one of: %w", err)
+	}
+	return src, nil
+}
+
+func writeOneOf(f *file, input *OneOfInput) {
+	f.printf("// Validate checks that exactly one field of %s is set.\n", input.Name)
+	f.printf("func (i %s) Validate() error {\n", input.Name)
+	f.printf("\tvar set []string\n")
+	for _, field := range input.Fields {
+		// Lists are nil or empty when unset, as they are omitted from the JSON when empty.
+		if field.List {
+			f.printf("\tif len(i.%s) > 0 {\n", field.Name)
+		} else {
+			f.printf("\tif i.%s != nil {\n", field.Name)
+		}
+		f.printf("\t\tset = append(set, %q)\n\t}\n", field.JSON)
+	}
+	f.printf("\tif len(set) != 1 {\n")
+	f.printf("\t\treturn &OneOfError{Input: %q, Set: set}\n\t}\n", input.Name)
+	f.printf("\treturn nil\n}\n\n")
+
+	f.use("encoding/json")
+	f.printf("// MarshalJSON encodes i, failing when Validate does.\n")
+	f.printf("func (i %s) MarshalJSON() ([]byte, error) {\n", input.Name)
+	f.printf("\tif err := i.Validate(); err != nil {\n\t\treturn nil, err\n\t}\n")
+	f.printf("\ttype plain %s\n", input.Name)
+	f.printf("\treturn json.Marshal(plain(i))\n}\n\n")
+}
@@ -0,0 +1,144 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+)
+
+var _ = Describe("GenerateOneOf", func() {
+	parse := func(src string) (*codegen.Models, error) {
+		filename := filepath.Join(GinkgoT().TempDir(), "models_gen.go")
+		Expect(os.WriteFile(filename, []byte(src), 0o644)).To(Succeed())
+		return codegen.ParseModels(filename)
+	}
+
+	It("parses the overridden inputs", func() {
+		models, err := parse(oneOfModels)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(models.OneOfInputs).To(ContainElement(&codegen.OneOfInput{
+			Name: "AppPlanInput",
+			Fields: []codegen.OneOfField{
+				{Name: "AppUsagePricingDetails", JSON: "appUsagePricingDetails"},
+				{Name: "AppRecurringPricingDetails", JSON: "appRecurringPricingDetails"},
+				{Name: "Tags", JSON: "tags", List: true},
+			},
+		}))
+	})
+
+	It("parses the inputs documented as one-of", func() {
+		models, err := parse(oneOfModels)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oneOfNames(models)).To(Equal([]string{"AppPlanInput", "MetaobjectBulkDeleteWhereCondition"}))
+	})
+
+	It("parses the inputs declaring the directive", func() {
+		schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: `
+directive @oneOf on INPUT_OBJECT
+
+type QueryRoot {
+	shop: String
+}
+
+input ProductSetInput @oneOf {
+	title: String
+}
+
+input ProductInput {
+	title: String
+}
+`})
+		Expect(codegen.OneOfDirectives(schema)).To(Equal([]string{"ProductSetInput"}))
+
+		filename := filepath.Join(GinkgoT().TempDir(), "models_gen.go")
+		Expect(os.WriteFile(filename, []byte(oneOfModels), 0o644)).To(Succeed())
+		models, err := codegen.ParseModels(filename, codegen.OneOfDirectives(schema)...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oneOfNames(models)).To(Equal([]string{
+			"AppPlanInput", "MetaobjectBulkDeleteWhereCondition", "ProductSetInput",
+		}))
+	})
+
+	It("lets overrides remove inputs", func() {
+		codegen.OneOfOverrides["MetaobjectBulkDeleteWhereCondition"] = false
+		DeferCleanup(func() { delete(codegen.OneOfOverrides, "MetaobjectBulkDeleteWhereCondition") })
+
+		models, err := parse(oneOfModels)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oneOfNames(models)).To(Equal([]string{"AppPlanInput"}))
+	})
+
+	It("generates Validate and MarshalJSON", func() {
+		models, err := parse(oneOfModels)
+		Expect(err).NotTo(HaveOccurred())
+		src, err := codegen.GenerateOneOf(models)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(HavePrefix("// Code generated by"))
+		Expect(string(src)).To(ContainSubstring("func (i AppPlanInput) Validate() error {"))
+		Expect(string(src)).To(ContainSubstring(
+			"\tif i.AppRecurringPricingDetails != nil {\n\t\tset = append(set, \"appRecurringPricingDetails\")\n\t}"))
+		Expect(string(src)).To(ContainSubstring("\tif len(i.Tags) > 0 {\n\t\tset = append(set, \"tags\")\n\t}"))
+		Expect(string(src)).To(ContainSubstring("return &OneOfError{Input: \"AppPlanInput\", Set: set}"))
+		Expect(string(src)).To(ContainSubstring("func (i AppPlanInput) MarshalJSON() ([]byte, error) {"))
+		Expect(string(src)).To(ContainSubstring("\ttype plain AppPlanInput\n\treturn json.Marshal(plain(i))"))
+		Expect(string(src)).NotTo(ContainSubstring("ProductSetInput"))
+	})
+
+	It("rejects non-null fields", func() {
+		_, err := parse(`package model
+
+type AppPlanInput struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+`)
+		Expect(err).To(MatchError("parse models: field AppPlanInput.Name of a one-of input is not nullable"))
+	})
+
+	It("compiles against the models", func() {
+		models, err := codegen.ParseModels("../graph/model/models_gen.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oneOfNames(models)).To(ContainElements("AppPlanInput", "MetaobjectBulkDeleteWhereCondition"))
+		src, err := codegen.GenerateOneOf(models)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(typeCheckModel("oneof_gen.go", src)).To(Succeed())
+	})
+})
+
+// oneOfNames returns the names of the one-of inputs of m.
+func oneOfNames(m *codegen.Models) []string {
+	var names []string
+	for _, input := range m.OneOfInputs {
+		names = append(names, input.Name)
+	}
+	return names
+}
+
+const oneOfModels = `package model
+
+type AppPlanInput struct {
+	AppUsagePricingDetails     *AppUsagePricingInput     ` + "`json:\"appUsagePricingDetails,omitempty\"`" + `
+	AppRecurringPricingDetails *AppRecurringPricingInput ` + "`json:\"appRecurringPricingDetails,omitempty\"`" + `
+	Tags                       []string                  ` + "`json:\"tags,omitempty\"`" + `
+}
+
+// Exactly one field of input is required.
+type MetaobjectBulkDeleteWhereCondition struct {
+	Type *string  ` + "`json:\"type,omitempty\"`" + `
+	Ids  []string ` + "`json:\"ids,omitempty\"`" + `
+}
+
+// The input fields for creating a market region with exactly one required option.
+type MarketRegionCreateInput struct {
+	CountryCode string ` + "`json:\"countryCode\"`" + `
+}
+
+type ProductSetInput struct {
+	Title *string ` + "`json:\"title,omitempty\"`" + `
+}
+`
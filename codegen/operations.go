@@ -0,0 +1,300 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// GenerateOperations returns the source of a file in package pkg declaring, for every operation of doc:
+//   - a <Operation>Document constant holding the operation and the fragments it uses,
+//   - a <Operation>Variables struct when the operation declares variables,
+//   - a <Operation>Response struct holding only the selected fields.
+//
+// Named fragments on object types are generated as <Fragment>Fragment structs, embedded where they are spread.
+// Fields of interface and union types reference the interfaces of the model package and are decoded into the
+// concrete models by model.Decode using __typename.
+// doc must have been validated against schema, e.g. by schema.LoadQuery.
+func GenerateOperations(schema *ast.Schema, doc *ast.QueryDocument, pkg string) ([]byte, error) {
+	g := &operationGen{
+		f:         newFile(pkg),
+		schema:    schema,
+		doc:       doc,
+		fragments: map[string]bool{},
+	}
+	g.f.external = true
+
+	for _, op := range doc.Operations {
+		if err := g.writeOperation(op); err != nil {
+			return nil, fmt.Errorf("generate operation %s: %w", op.Name, err)
+		}
+	}
+
+	src, err := g.f.source()
+	if err != nil {
+		return nil, fmt.Errorf("generate operations: %w", err)
+	}
+	return src, nil
+}
+
+type operationGen struct {
+	f      *file
+	schema *ast.Schema
+	doc    *ast.QueryDocument
+	// fragments holds the names of the fragments whose struct has been generated.
+	fragments map[string]bool
+	// pending holds the structs referenced by the struct being written.
+	pending []pendingStruct
+}
+
+type pendingStruct struct {
+	name    string
+	comment string
+	def     *ast.Definition
+	set     ast.SelectionSet
+}
+
+// selection is the result of merging the fields of a selection set by response key.
+type selection struct {
+	keys   []string
+	fields map[string][]*ast.Field
+	embeds []string
+}
+
+func (g *operationGen) writeOperation(op *ast.OperationDefinition) error {
+	if op.Name == "" {
+		return fmt.Errorf("operations must be named")
+	}
+
+	var root *ast.Definition
+	switch op.Operation {
+	case ast.Query:
+		root = g.schema.Query
+	case ast.Mutation:
+		root = g.schema.Mutation
+	}
+	if root == nil {
+		return fmt.Errorf("schema does not support %s operations", op.Operation)
+	}
+
+	name := templates.ToGo(op.Name)
+	g.writeDocument(name, op)
+	g.writeVariables(name, op)
+
+	g.pending = append(g.pending, pendingStruct{
+		name:    name + "Response",
+		comment: fmt.Sprintf("%sResponse is the response of the %s %s.", name, op.Name, op.Operation),
+		def:     root,
+		set:     op.SelectionSet,
+	})
+	g.flush()
+
+	g.f.printf("func (r *%sResponse) UnmarshalJSON(b []byte) error {\n", name)
+	g.f.use("encoding/json")
+	g.f.use("fmt")
+	g.f.use(ModelImportPath)
+	g.f.printf("\tvar m map[string]any\n")
+	g.f.printf("\tif err := json.Unmarshal(b, &m); err != nil {\n\t\treturn err\n\t}\n")
+	g.f.printf("\tif _, err := model.Decode(m, r); err != nil {\n")
+	g.f.printf("\t\treturn fmt.Errorf(\"decode %sResponse: %%w\", err)\n\t}\n", name)
+	g.f.printf("\treturn nil\n}\n\n")
+	return nil
+}
+
+func (g *operationGen) writeDocument(name string, op *ast.OperationDefinition) {
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf, formatter.WithIndent("  ")).FormatQueryDocument(&ast.QueryDocument{
+		Operations: ast.OperationList{op},
+		Fragments:  g.usedFragments(op.SelectionSet, nil),
+	})
+	document := strings.TrimSpace(buf.String())
+
+	g.f.printf("// %sDocument is the document of the %s %s.\n", name, op.Name, op.Operation)
+	if strings.Contains(document, "`") {
+		g.f.printf("const %sDocument = %s\n\n", name, strconv.Quote(document))
+		return
+	}
+	g.f.printf("const %sDocument = `%s\n`\n\n", name, document)
+}
+
+func (g *operationGen) writeVariables(name string, op *ast.OperationDefinition) {
+	if len(op.VariableDefinitions) == 0 {
+		return
+	}
+	g.f.printf("// %sVariables holds the variables of the %s %s.\n", name, op.Name, op.Operation)
+	g.f.printf("type %sVariables struct {\n", name)
+	for _, v := range op.VariableDefinitions {
+		goType := g.f.typeRef(g.schema, v.Type)
+		g.f.printf("\t%s %s %s\n", templates.ToGo(v.Variable), goType, jsonTag(v.Variable, v.Type))
+	}
+	g.f.printf("}\n\n")
+}
+
+// usedFragments returns the fragments spread in set, directly or through other fragments.
+func (g *operationGen) usedFragments(
+	set ast.SelectionSet, used ast.FragmentDefinitionList,
+) ast.FragmentDefinitionList {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			used = g.usedFragments(sel.SelectionSet, used)
+		case *ast.InlineFragment:
+			used = g.usedFragments(sel.SelectionSet, used)
+		case *ast.FragmentSpread:
+			if used.ForName(sel.Name) != nil {
+				continue
+			}
+			def := g.doc.Fragments.ForName(sel.Name)
+			used = append(used, def)
+			used = g.usedFragments(def.SelectionSet, used)
+		}
+	}
+	return used
+}
+
+// flush writes the pending structs, including the ones they reference in turn.
+func (g *operationGen) flush() {
+	for len(g.pending) > 0 {
+		s := g.pending[0]
+		g.pending = g.pending[1:]
+		g.writeStruct(s)
+	}
+}
+
+func (g *operationGen) writeStruct(s pendingStruct) {
+	sel := &selection{fields: map[string][]*ast.Field{}}
+	g.collect(s.def, s.set, sel)
+
+	g.f.comment("", s.comment)
+	g.f.printf("type %s struct {\n", s.name)
+	for _, fragment := range sel.embeds {
+		g.f.printf("\t%sFragment `json:\",squash\"`\n", templates.ToGo(fragment))
+	}
+	for _, key := range sel.keys {
+		fields := sel.fields[key]
+		field := fields[0]
+		if field.Name == types.GqlTypeNameKey {
+			g.f.printf("\t%s string `json:\"%s\"`\n", templates.ToGo(key), key)
+			continue
+		}
+
+		var set ast.SelectionSet
+		for _, f := range fields {
+			set = append(set, f.SelectionSet...)
+		}
+		def := field.Definition
+		g.f.comment("\t", def.Description)
+		g.f.printf("\t%s %s %s\n", templates.ToGo(key), g.outputRef(s.name+templates.ToGo(key), def.Type, set, true),
+			outputTag(key, def.Type))
+	}
+	g.f.printf("}\n\n")
+}
+
+// collect merges the fields selected by set on an object of type def into sel.
+func (g *operationGen) collect(def *ast.Definition, set ast.SelectionSet, sel *selection) {
+	for _, s := range set {
+		switch s := s.(type) {
+		case *ast.Field:
+			if _, ok := sel.fields[s.Alias]; !ok {
+				sel.keys = append(sel.keys, s.Alias)
+			}
+			sel.fields[s.Alias] = append(sel.fields[s.Alias], s)
+		case *ast.InlineFragment:
+			if g.applies(def, s.TypeCondition) {
+				g.collect(def, s.SelectionSet, sel)
+			}
+		case *ast.FragmentSpread:
+			fragment := g.doc.Fragments.ForName(s.Name)
+			switch {
+			case fragment.TypeCondition == def.Name && def.Kind == ast.Object:
+				g.embed(def, fragment, sel)
+			case g.applies(def, fragment.TypeCondition):
+				g.collect(def, fragment.SelectionSet, sel)
+			}
+		}
+	}
+}
+
+// embed adds fragment to the embedded structs of sel, generating its struct on first use.
+func (g *operationGen) embed(def *ast.Definition, fragment *ast.FragmentDefinition, sel *selection) {
+	for _, name := range sel.embeds {
+		if name == fragment.Name {
+			return
+		}
+	}
+	sel.embeds = append(sel.embeds, fragment.Name)
+
+	if g.fragments[fragment.Name] {
+		return
+	}
+	g.fragments[fragment.Name] = true
+	name := templates.ToGo(fragment.Name) + "Fragment"
+	g.pending = append(g.pending, pendingStruct{
+		name:    name,
+		comment: fmt.Sprintf("%s holds the fields of the %s fragment on `%s`.", name, fragment.Name, def.Name),
+		def:     def,
+		set:     fragment.SelectionSet,
+	})
+}
+
+// applies reports whether a fragment with the type condition typeCondition applies to objects of type def.
+func (g *operationGen) applies(def *ast.Definition, typeCondition string) bool {
+	if typeCondition == "" || typeCondition == def.Name {
+		return true
+	}
+	condition := g.schema.Types[typeCondition]
+	if condition == nil || !condition.IsAbstractType() {
+		return false
+	}
+	for _, possible := range g.schema.GetPossibleTypes(condition) {
+		if possible.Name == def.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// outputRef returns the Go type of a field of type t selecting set. Objects are generated as a struct named
+// structName, interfaces and unions reference the model interfaces. Values outside of lists are pointers when
+// nullable, and objects are always pointers, following the conventions of the generated models.
+func (g *operationGen) outputRef(structName string, t *ast.Type, set ast.SelectionSet, top bool) string {
+	if t.Elem != nil {
+		return "[]" + g.outputRef(structName, t.Elem, set, false)
+	}
+
+	def := g.schema.Types[t.NamedType]
+	switch {
+	case def.Kind == ast.Object:
+		g.pending = append(g.pending, pendingStruct{
+			name:    structName,
+			comment: fmt.Sprintf("%s holds the selected fields of `%s`.", structName, def.Name),
+			def:     def,
+			set:     set,
+		})
+		if top {
+			return "*" + structName
+		}
+		return structName
+	case def.IsAbstractType():
+		return g.f.modelRef(def.Name)
+	case top && !t.NonNull:
+		return "*" + g.f.elemRef(g.schema, t)
+	default:
+		return g.f.elemRef(g.schema, t)
+	}
+}
+
+// outputTag returns the struct tag of a selected field with the response key key.
+func outputTag(key string, t *ast.Type) string {
+	if t.NonNull && t.Elem == nil {
+		return fmt.Sprintf("`json:\"%s\"`", key)
+	}
+	return fmt.Sprintf("`json:\"%s,omitempty\"`", key)
+}
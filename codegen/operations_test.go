@@ -0,0 +1,134 @@
+package codegen_test
+
+import (
+	"go/parser"
+	"go/token"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
+	"github.com/gempages/go-shopify-graphql-model/graph/schema"
+)
+
+var _ = Describe("GenerateOperations", func() {
+	var (
+		src []byte
+		err error
+	)
+
+	BeforeEach(func() {
+		s, err := schema.LoadString("schema.graphql", operationsSchema)
+		Expect(err).NotTo(HaveOccurred())
+		doc, err := schema.LoadQuery(s, &ast.Source{Name: "product.graphql", Input: operationsQuery})
+		Expect(err).NotTo(HaveOccurred())
+		src, err = codegen.GenerateOperations(s, doc, "queries")
+	})
+
+	It("generates a valid Go file", func() {
+		Expect(err).NotTo(HaveOccurred())
+		_, err = parser.ParseFile(token.NewFileSet(), "operations_gen.go", src, parser.AllErrors)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(src)).To(ContainSubstring(`"github.com/gempages/go-shopify-graphql-model/graph/model"`))
+	})
+
+	It("generates the document with the used fragments", func() {
+		Expect(string(src)).To(ContainSubstring("const GetProductDocument = `query GetProduct ($id: ID!) {"))
+		Expect(string(src)).To(ContainSubstring("fragment ProductBasics on Product {"))
+		Expect(string(src)).To(ContainSubstring("const GetProductTitleDocument = `query GetProductTitle ($id: ID!) {\n" +
+			"  product(id: $id) {\n    title\n  }\n}\n`"))
+	})
+
+	It("generates the variables", func() {
+		Expect(string(src)).To(MatchRegexp("type GetProductVariables struct {\n\tID +string +`json:\"id\"`"))
+	})
+
+	It("generates structs holding the selected fields only", func() {
+		Expect(string(src)).To(ContainSubstring("type GetProductResponse struct {"))
+		Expect(string(src)).To(MatchRegexp("Product +\\*GetProductResponseProduct +`json:\"product,omitempty\"`"))
+		Expect(string(src)).To(ContainSubstring("type GetProductResponseProduct struct {"))
+		Expect(string(src)).To(MatchRegexp("Name +string +`json:\"name\"`"))
+		Expect(string(src)).To(MatchRegexp("Status +\\*model.ProductStatus +`json:\"status,omitempty\"`"))
+		Expect(string(src)).To(MatchRegexp("UpdatedAt +time.Time +`json:\"updatedAt\"`"))
+		Expect(string(src)).NotTo(MatchRegexp("Vendor +"))
+	})
+
+	It("embeds fragments on object types", func() {
+		Expect(string(src)).To(ContainSubstring("ProductBasicsFragment `json:\",squash\"`"))
+		Expect(string(src)).To(ContainSubstring("type ProductBasicsFragment struct {"))
+	})
+
+	It("references model interfaces for abstract fields", func() {
+		Expect(string(src)).To(MatchRegexp("Media +\\[\\]model.Media +`json:\"media,omitempty\"`"))
+	})
+
+	It("decodes the response with model.Decode", func() {
+		Expect(string(src)).To(ContainSubstring("func (r *GetProductResponse) UnmarshalJSON(b []byte) error {"))
+		Expect(string(src)).To(ContainSubstring("model.Decode(m, r)"))
+	})
+
+	It("requires named operations", func() {
+		s, err := schema.LoadString("schema.graphql", operationsSchema)
+		Expect(err).NotTo(HaveOccurred())
+		doc, err := schema.LoadQuery(s, &ast.Source{Input: `query { product(id: "1") { id } }`})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = codegen.GenerateOperations(s, doc, "queries")
+		Expect(err).To(MatchError(ContainSubstring("operations must be named")))
+	})
+})
+
+const operationsQuery = `
+query GetProduct($id: ID!) {
+  product(id: $id) {
+    ...ProductBasics
+    name: title
+    status
+    media {
+      __typename
+      alt
+    }
+  }
+}
+
+fragment ProductBasics on Product {
+  id
+  updatedAt
+}
+
+query GetProductTitle($id: ID!) {
+  product(id: $id) {
+    title
+  }
+}
+`
+
+const operationsSchema = `
+scalar DateTime
+
+type QueryRoot {
+  product(id: ID!): Product
+}
+
+enum ProductStatus {
+  ACTIVE
+  DRAFT
+}
+
+type Product {
+  id: ID!
+  title: String!
+  vendor: String!
+  status: ProductStatus
+  updatedAt: DateTime!
+  media: [Media!]!
+}
+
+interface Media {
+  alt: String
+}
+
+type MediaImage implements Media {
+  alt: String
+}
+`
@@ -17,6 +17,7 @@ async function main() {
     const result = await response.json()
     if (result.errors) {
         console.error(result.errors)
+        process.exitCode = 1
         return
     }
 
@@ -24,7 +25,10 @@ async function main() {
 
     const outputFile = "./schema.graphql"
 
-    await promises.writeFile(outputFile, printSchema(schema))
+    // gqlgen cannot generate models while the schema declaration names QueryRoot as the query type
+    const sdl = printSchema(schema).replace(/^schema \{[^}]*\}\n\n/, "")
+
+    await promises.writeFile(outputFile, sdl)
     await promises.writeFile(`./${process.env.API_VERSION}.json`, JSON.stringify(result)) // Use this schema file for GemPages v6
 }
 
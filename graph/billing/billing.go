@@ -0,0 +1,303 @@
+// Package billing computes the prices, trials, discounts, usage balances and charges of app subscriptions from
+// their models, and builds the inputs creating subscriptions and usage records.
+package billing
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// day is the length of the days of trials and 30 day intervals.
+const day = 24 * time.Hour
+
+// currencyDecimals maps the currencies whose amounts do not have two decimal places to their number of decimal
+// places, as in ISO 4217.
+var currencyDecimals = map[model.CurrencyCode]int32{
+	model.CurrencyCodeBif: 0,
+	model.CurrencyCodeClp: 0,
+	model.CurrencyCodeDjf: 0,
+	model.CurrencyCodeGnf: 0,
+	model.CurrencyCodeIsk: 0,
+	model.CurrencyCodeJpy: 0,
+	model.CurrencyCodeKmf: 0,
+	model.CurrencyCodeKrw: 0,
+	model.CurrencyCodePyg: 0,
+	model.CurrencyCodeRwf: 0,
+	model.CurrencyCodeUgx: 0,
+	model.CurrencyCodeVnd: 0,
+	model.CurrencyCodeVuv: 0,
+	model.CurrencyCodeXaf: 0,
+	model.CurrencyCodeXof: 0,
+	model.CurrencyCodeXpf: 0,
+	model.CurrencyCodeBhd: 3,
+	model.CurrencyCodeIqd: 3,
+	model.CurrencyCodeJod: 3,
+	model.CurrencyCodeKwd: 3,
+	model.CurrencyCodeLyd: 3,
+	model.CurrencyCodeOmr: 3,
+	model.CurrencyCodeTnd: 3,
+}
+
+// round rounds amount to the smallest unit of currency, e.g. cents for USD, yen for JPY and fils for KWD.
+func round(amount decimal.Decimal, currency model.CurrencyCode) decimal.Decimal {
+	places, ok := currencyDecimals[currency]
+	if !ok {
+		places = 2
+	}
+	return amount.Round(places)
+}
+
+// NextInterval returns the start of the billing interval following the one starting at start.
+func NextInterval(start time.Time, interval model.AppPricingInterval) time.Time {
+	if interval == model.AppPricingIntervalAnnual {
+		return start.AddDate(1, 0, 0)
+	}
+	return start.Add(30 * day)
+}
+
+// RecurringPricing returns the recurring pricing of the subscription, or nil if it has none.
+func RecurringPricing(s *model.AppSubscription) *model.AppRecurringPricing {
+	for _, item := range s.LineItems {
+		if item.Plan == nil {
+			continue
+		}
+		switch p := item.Plan.PricingDetails.(type) {
+		case *model.AppRecurringPricing:
+			return p
+		case model.AppRecurringPricing:
+			return &p
+		}
+	}
+	return nil
+}
+
+// UsagePricing returns the usage pricing of the subscription, or nil if it has none.
+func UsagePricing(s *model.AppSubscription) *model.AppUsagePricing {
+	for _, item := range s.LineItems {
+		if item.Plan == nil {
+			continue
+		}
+		switch p := item.Plan.PricingDetails.(type) {
+		case *model.AppUsagePricing:
+			return p
+		case model.AppUsagePricing:
+			return &p
+		}
+	}
+	return nil
+}
+
+// TrialEnd returns the end of the trial of the subscription, counted from its creation. It is the creation time
+// when the subscription has no trial.
+func TrialEnd(s *model.AppSubscription) time.Time {
+	return s.CreatedAt.Add(time.Duration(s.TrialDays) * day)
+}
+
+// TrialDaysRemaining returns the number of days of the trial left at now, counting the current day.
+func TrialDaysRemaining(s *model.AppSubscription, now time.Time) int {
+	left := TrialEnd(s).Sub(now)
+	if left <= 0 {
+		return 0
+	}
+	return int((left + day - 1) / day)
+}
+
+// DiscountActive reports whether the discount of the pricing applies to its next charge. It is false for a nil
+// pricing.
+func DiscountActive(p *model.AppRecurringPricing) bool {
+	if p == nil {
+		return false
+	}
+	d := p.Discount
+	return d != nil && (d.RemainingDurationInIntervals == nil || *d.RemainingDurationInIntervals > 0)
+}
+
+// DiscountIntervalsRemaining returns the number of charges the discount of the pricing still applies to, or false
+// if it applies indefinitely.
+func DiscountIntervalsRemaining(p *model.AppRecurringPricing) (int, bool) {
+	switch {
+	case p == nil || p.Discount == nil:
+		return 0, true
+	case p.Discount.RemainingDurationInIntervals != nil:
+		return *p.Discount.RemainingDurationInIntervals, true
+	}
+	return 0, false
+}
+
+// EffectivePrice returns the price charged per interval while the discount of the pricing is active, e.g. 8.00
+// for a price of 10.00 with a 20% discount. Percentage discounts are fractions, e.g. 0.2 for 20%, and the
+// discounted price is rounded to the smallest unit of the currency of the price. It is zero for a nil pricing,
+// e.g. the RecurringPricing of a subscription without one.
+func EffectivePrice(p *model.AppRecurringPricing) decimal.Decimal {
+	var price decimal.Decimal
+	if p == nil {
+		return price
+	}
+	var currency model.CurrencyCode
+	if p.Price != nil {
+		price = p.Price.Amount
+		currency = p.Price.CurrencyCode
+	}
+	if !DiscountActive(p) {
+		return price
+	}
+	if p.Discount.PriceAfterDiscount != nil {
+		return p.Discount.PriceAfterDiscount.Amount
+	}
+
+	var discounted decimal.Decimal
+	switch v := p.Discount.Value.(type) {
+	case *model.AppSubscriptionDiscountAmount:
+		discounted = subtractAmount(price, v)
+	case model.AppSubscriptionDiscountAmount:
+		discounted = subtractAmount(price, &v)
+	case *model.AppSubscriptionDiscountPercentage:
+		discounted = applyPercentage(price, currency, v.Percentage)
+	case model.AppSubscriptionDiscountPercentage:
+		discounted = applyPercentage(price, currency, v.Percentage)
+	default:
+		return price
+	}
+	if discounted.IsNegative() {
+		return decimal.Zero
+	}
+	return discounted
+}
+
+func subtractAmount(price decimal.Decimal, d *model.AppSubscriptionDiscountAmount) decimal.Decimal {
+	if d.Amount == nil {
+		return price
+	}
+	return price.Sub(d.Amount.Amount)
+}
+
+func applyPercentage(price decimal.Decimal, currency model.CurrencyCode, percentage float64) decimal.Decimal {
+	return round(price.Mul(decimal.NewFromInt(1).Sub(decimal.NewFromFloat(percentage))), currency)
+}
+
+// UsageBalance is the usage charged in the current interval of a usage pricing against its capped amount.
+type UsageBalance struct {
+	Used         decimal.Decimal
+	Capped       decimal.Decimal
+	CurrencyCode model.CurrencyCode
+}
+
+// Usage returns the usage balance of the pricing. It is the zero balance, which cannot be charged, for a nil
+// pricing, e.g. the UsagePricing of a subscription without one.
+func Usage(p *model.AppUsagePricing) UsageBalance {
+	var b UsageBalance
+	if p == nil {
+		return b
+	}
+	if p.BalanceUsed != nil {
+		b.Used = p.BalanceUsed.Amount
+		b.CurrencyCode = p.BalanceUsed.CurrencyCode
+	}
+	if p.CappedAmount != nil {
+		b.Capped = p.CappedAmount.Amount
+		b.CurrencyCode = p.CappedAmount.CurrencyCode
+	}
+	return b
+}
+
+// Remaining returns the amount that can still be charged in the interval.
+func (b UsageBalance) Remaining() decimal.Decimal {
+	if b.Used.GreaterThanOrEqual(b.Capped) {
+		return decimal.Zero
+	}
+	return b.Capped.Sub(b.Used)
+}
+
+// Fraction returns the fraction of the capped amount used, between 0 and 1.
+func (b UsageBalance) Fraction() float64 {
+	if !b.Capped.IsPositive() {
+		return 1
+	}
+	f, _ := b.Used.Div(b.Capped).Float64()
+	return min(f, 1)
+}
+
+// CanCharge reports whether a usage record of amount fits under the capped amount.
+func (b UsageBalance) CanCharge(amount decimal.Decimal) bool {
+	return b.Used.Add(amount).LessThanOrEqual(b.Capped)
+}
+
+// Charge is a projected recurring charge of a subscription.
+type Charge struct {
+	Date   time.Time
+	Amount model.MoneyV2
+	// Discounted reports whether the discount of the pricing applies to the charge.
+	Discounted bool
+}
+
+// NextCharges projects the next n recurring charges of the subscription from now. The first charge is at the end
+// of the current period, or of the trial when the period is unknown, moved forward by intervals until it is after
+// now, as a period end read before now may be over. The discount is taken to have applied to the intervals
+// skipped from the period end. Usage charges are not projected. It returns nil for subscriptions that are not
+// active or have no recurring pricing, and when n is not positive.
+func NextCharges(s *model.AppSubscription, now time.Time, n int) []Charge {
+	p := RecurringPricing(s)
+	if p == nil || s.Status != model.AppSubscriptionStatusActive || n <= 0 {
+		return nil
+	}
+
+	date := TrialEnd(s)
+	if s.CurrentPeriodEnd != nil {
+		date = *s.CurrentPeriodEnd
+	}
+	skipped := 0
+	for !date.After(now) {
+		date = NextInterval(date, p.Interval)
+		if s.CurrentPeriodEnd != nil {
+			skipped++
+		}
+	}
+	var price model.MoneyV2
+	if p.Price != nil {
+		price = *p.Price
+	}
+	discounted := EffectivePrice(p)
+	remaining, limited := DiscountIntervalsRemaining(p)
+
+	charges := make([]Charge, n)
+	for i := range charges {
+		c := Charge{Date: date, Amount: price}
+		if DiscountActive(p) && (!limited || skipped+i < remaining) {
+			c.Amount.Amount = discounted
+			c.Discounted = true
+		}
+		charges[i] = c
+		date = NextInterval(date, p.Interval)
+	}
+	return charges
+}
+
+// ProratedCredit returns the share of the current interval's charge that is left unused at now, as credited when
+// the subscription is replaced, rounded to the smallest unit of the currency of the price. It is zero when the
+// current period is unknown or over.
+func ProratedCredit(s *model.AppSubscription, now time.Time) decimal.Decimal {
+	p := RecurringPricing(s)
+	if p == nil || s.CurrentPeriodEnd == nil || !s.CurrentPeriodEnd.After(now) {
+		return decimal.Zero
+	}
+	end := *s.CurrentPeriodEnd
+	var start time.Time
+	if p.Interval == model.AppPricingIntervalAnnual {
+		start = end.AddDate(-1, 0, 0)
+	} else {
+		start = end.Add(-30 * day)
+	}
+	left := decimal.NewFromInt(int64(end.Sub(now)))
+	length := decimal.NewFromInt(int64(end.Sub(start)))
+	if left.GreaterThan(length) {
+		left = length
+	}
+	var currency model.CurrencyCode
+	if p.Price != nil {
+		currency = p.Price.CurrencyCode
+	}
+	return round(EffectivePrice(p).Mul(left).Div(length), currency)
+}
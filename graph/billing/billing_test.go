@@ -0,0 +1,180 @@
+package billing_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/billing"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+func usd(amount string) *model.MoneyV2 {
+	return &model.MoneyV2{Amount: decimal.RequireFromString(amount), CurrencyCode: model.CurrencyCodeUsd}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+var _ = Describe("Billing", func() {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var recurring *model.AppRecurringPricing
+	var usage *model.AppUsagePricing
+	var subscription *model.AppSubscription
+
+	BeforeEach(func() {
+		recurring = &model.AppRecurringPricing{Interval: model.AppPricingIntervalEvery30Days, Price: usd("10.00")}
+		usage = &model.AppUsagePricing{
+			Interval:     model.AppPricingIntervalEvery30Days,
+			BalanceUsed:  usd("30"),
+			CappedAmount: usd("100"),
+		}
+		subscription = &model.AppSubscription{
+			CreatedAt: created,
+			Status:    model.AppSubscriptionStatusActive,
+			TrialDays: 7,
+			LineItems: []model.AppSubscriptionLineItem{
+				{Plan: &model.AppPlanV2{PricingDetails: usage}},
+				{Plan: &model.AppPlanV2{PricingDetails: recurring}},
+			},
+		}
+	})
+
+	It("finds the pricings of the subscription", func() {
+		Expect(billing.RecurringPricing(subscription)).To(BeIdenticalTo(recurring))
+		Expect(billing.UsagePricing(subscription)).To(BeIdenticalTo(usage))
+		Expect(billing.RecurringPricing(&model.AppSubscription{})).To(BeNil())
+	})
+
+	It("computes the trial", func() {
+		Expect(billing.TrialEnd(subscription)).To(Equal(created.AddDate(0, 0, 7)))
+		Expect(billing.TrialDaysRemaining(subscription, created)).To(Equal(7))
+		Expect(billing.TrialDaysRemaining(subscription, created.Add(36*time.Hour))).To(Equal(6))
+		Expect(billing.TrialDaysRemaining(subscription, created.AddDate(0, 0, 8))).To(Equal(0))
+	})
+
+	It("applies discounts", func() {
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("10"))
+
+		recurring.Discount = &model.AppSubscriptionDiscount{
+			Value:                        &model.AppSubscriptionDiscountPercentage{Percentage: 0.25},
+			RemainingDurationInIntervals: intPtr(2),
+		}
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("7.5"))
+		remaining, limited := billing.DiscountIntervalsRemaining(recurring)
+		Expect(remaining).To(Equal(2))
+		Expect(limited).To(BeTrue())
+
+		recurring.Discount.Value = model.AppSubscriptionDiscountAmount{Amount: usd("15")}
+		Expect(billing.EffectivePrice(recurring).IsZero()).To(BeTrue())
+
+		recurring.Discount.PriceAfterDiscount = usd("4")
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("4"))
+
+		recurring.Discount.RemainingDurationInIntervals = intPtr(0)
+		Expect(billing.DiscountActive(recurring)).To(BeFalse())
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("10"))
+
+		recurring.Discount.RemainingDurationInIntervals = nil
+		_, limited = billing.DiscountIntervalsRemaining(recurring)
+		Expect(limited).To(BeFalse())
+	})
+
+	It("computes usage balances", func() {
+		b := billing.Usage(usage)
+		Expect(b.Remaining().String()).To(Equal("70"))
+		Expect(b.Fraction()).To(BeNumerically("~", 0.3))
+		Expect(b.CanCharge(decimal.NewFromInt(70))).To(BeTrue())
+		Expect(b.CanCharge(decimal.NewFromInt(71))).To(BeFalse())
+		Expect(b.CurrencyCode).To(Equal(model.CurrencyCodeUsd))
+
+		usage.BalanceUsed = usd("120")
+		Expect(billing.Usage(usage).Remaining().IsZero()).To(BeTrue())
+		Expect(billing.Usage(usage).Fraction()).To(Equal(1.0))
+	})
+
+	It("projects the next charges", func() {
+		periodEnd := created.AddDate(0, 0, 37)
+		subscription.CurrentPeriodEnd = &periodEnd
+		recurring.Discount = &model.AppSubscriptionDiscount{
+			Value:                        &model.AppSubscriptionDiscountPercentage{Percentage: 0.5},
+			RemainingDurationInIntervals: intPtr(1),
+		}
+
+		charges := billing.NextCharges(subscription, created.AddDate(0, 0, 10), 3)
+		Expect(charges).To(HaveLen(3))
+		Expect(charges[0].Date).To(Equal(periodEnd))
+		Expect(charges[0].Amount.Amount.String()).To(Equal("5"))
+		Expect(charges[0].Discounted).To(BeTrue())
+		Expect(charges[1].Date).To(Equal(periodEnd.AddDate(0, 0, 30)))
+		Expect(charges[1].Amount.Amount.String()).To(Equal("10"))
+		Expect(charges[1].Amount.CurrencyCode).To(Equal(model.CurrencyCodeUsd))
+		Expect(charges[2].Discounted).To(BeFalse())
+
+		Expect(billing.NextCharges(subscription, created, 0)).To(BeNil())
+		Expect(billing.NextCharges(subscription, created, -1)).To(BeNil())
+
+		subscription.Status = model.AppSubscriptionStatusCancelled
+		Expect(billing.NextCharges(subscription, created, 3)).To(BeNil())
+	})
+
+	It("moves a past period end forward", func() {
+		periodEnd := created.AddDate(0, 0, 37)
+		subscription.CurrentPeriodEnd = &periodEnd
+		recurring.Discount = &model.AppSubscriptionDiscount{
+			Value:                        &model.AppSubscriptionDiscountPercentage{Percentage: 0.5},
+			RemainingDurationInIntervals: intPtr(2),
+		}
+
+		charges := billing.NextCharges(subscription, periodEnd.AddDate(0, 0, 1), 2)
+		Expect(charges).To(HaveLen(2))
+		Expect(charges[0].Date).To(Equal(periodEnd.AddDate(0, 0, 30)))
+		Expect(charges[0].Discounted).To(BeTrue())
+		Expect(charges[1].Date).To(Equal(periodEnd.AddDate(0, 0, 60)))
+		Expect(charges[1].Discounted).To(BeFalse())
+	})
+
+	It("accepts missing pricings", func() {
+		Expect(billing.EffectivePrice(nil).IsZero()).To(BeTrue())
+		Expect(billing.DiscountActive(nil)).To(BeFalse())
+		b := billing.Usage(nil)
+		Expect(b.Remaining().IsZero()).To(BeTrue())
+		Expect(b.CanCharge(decimal.NewFromInt(1))).To(BeFalse())
+	})
+
+	It("projects charges from the trial end without a current period", func() {
+		recurring.Interval = model.AppPricingIntervalAnnual
+		charges := billing.NextCharges(subscription, created.AddDate(0, 2, 0), 2)
+		Expect(charges[0].Date).To(Equal(created.AddDate(1, 0, 7)))
+		Expect(charges[1].Date).To(Equal(created.AddDate(2, 0, 7)))
+	})
+
+	It("prorates the current interval", func() {
+		periodEnd := created.AddDate(0, 0, 30)
+		subscription.CurrentPeriodEnd = &periodEnd
+		Expect(billing.ProratedCredit(subscription, created.AddDate(0, 0, 20)).String()).To(Equal("3.33"))
+		Expect(billing.ProratedCredit(subscription, periodEnd).IsZero()).To(BeTrue())
+	})
+
+	It("rounds to the smallest unit of the currency", func() {
+		periodEnd := created.AddDate(0, 0, 30)
+		subscription.CurrentPeriodEnd = &periodEnd
+		recurring.Discount = &model.AppSubscriptionDiscount{
+			Value: &model.AppSubscriptionDiscountPercentage{Percentage: 0.15},
+		}
+
+		recurring.Price = &model.MoneyV2{Amount: decimal.NewFromInt(999), CurrencyCode: model.CurrencyCodeJpy}
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("849"))
+		Expect(billing.ProratedCredit(subscription, created.AddDate(0, 0, 20)).String()).To(Equal("283"))
+
+		recurring.Price = &model.MoneyV2{Amount: decimal.RequireFromString("9.999"), CurrencyCode: model.CurrencyCodeKwd}
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("8.499"))
+		Expect(billing.ProratedCredit(subscription, created.AddDate(0, 0, 20)).String()).To(Equal("2.833"))
+
+		recurring.Price = usd("9.99")
+		Expect(billing.EffectivePrice(recurring).String()).To(Equal("8.49"))
+	})
+})
@@ -0,0 +1,256 @@
+package billing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// MaxIdempotencyKeyLength is the maximum length of the idempotency keys of usage records.
+const MaxIdempotencyKeyLength = 255
+
+// AppSubscriptionCreateVariables are the variables of the appSubscriptionCreate mutation.
+type AppSubscriptionCreateVariables struct {
+	Name                string                                    `json:"name"`
+	LineItems           []model.AppSubscriptionLineItemInput      `json:"lineItems"`
+	Test                *bool                                     `json:"test,omitempty"`
+	TrialDays           *int                                      `json:"trialDays,omitempty"`
+	ReturnURL           string                                    `json:"returnUrl"`
+	ReplacementBehavior *model.AppSubscriptionReplacementBehavior `json:"replacementBehavior,omitempty"`
+}
+
+// AppUsageRecordCreateVariables are the variables of the appUsageRecordCreate mutation.
+type AppUsageRecordCreateVariables struct {
+	SubscriptionLineItemID string           `json:"subscriptionLineItemId"`
+	Price                  model.MoneyInput `json:"price"`
+	Description            string           `json:"description"`
+	IdempotencyKey         *string          `json:"idempotencyKey,omitempty"`
+}
+
+// SubscriptionBuilder builds the variables of an appSubscriptionCreate mutation with at most one recurring and
+// one usage line item. The rules are checked by Variables, which reports all the violations at once:
+//
+//	vars, err := billing.NewSubscription("Pro", returnURL).
+//		TrialDays(7).
+//		Recurring(model.MoneyInput{Amount: decimal.NewFromInt(10), CurrencyCode: model.CurrencyCodeUsd},
+//			model.AppPricingIntervalEvery30Days).
+//		PercentageDiscount(0.5, 3).
+//		Usage(model.MoneyInput{Amount: decimal.NewFromInt(100), CurrencyCode: model.CurrencyCodeUsd}, "$1 per order").
+//		Variables()
+type SubscriptionBuilder struct {
+	vars      AppSubscriptionCreateVariables
+	recurring *model.AppRecurringPricingInput
+	usage     *model.AppUsagePricingInput
+	discount  *model.AppSubscriptionDiscountInput
+	errs      []error
+}
+
+// NewSubscription returns a builder of a subscription named name, redirecting the merchant to returnURL once they
+// approved it.
+func NewSubscription(name, returnURL string) *SubscriptionBuilder {
+	return &SubscriptionBuilder{vars: AppSubscriptionCreateVariables{Name: name, ReturnURL: returnURL}}
+}
+
+// Test marks the subscription as a test, which is not charged.
+func (b *SubscriptionBuilder) Test(test bool) *SubscriptionBuilder {
+	b.vars.Test = &test
+	return b
+}
+
+// TrialDays sets the number of days of the trial.
+func (b *SubscriptionBuilder) TrialDays(days int) *SubscriptionBuilder {
+	b.vars.TrialDays = &days
+	return b
+}
+
+// ReplacementBehavior sets how the subscription replaces the current one of the shop.
+func (b *SubscriptionBuilder) ReplacementBehavior(r model.AppSubscriptionReplacementBehavior) *SubscriptionBuilder {
+	b.vars.ReplacementBehavior = &r
+	return b
+}
+
+// Recurring adds the recurring line item charging price every interval.
+func (b *SubscriptionBuilder) Recurring(price model.MoneyInput, interval model.AppPricingInterval) *SubscriptionBuilder {
+	if b.recurring != nil {
+		b.errs = append(b.errs, errors.New("recurring line item already set"))
+		return b
+	}
+	b.recurring = &model.AppRecurringPricingInput{Interval: &interval, Price: &price}
+	return b
+}
+
+// Usage adds the usage line item, charging up to cappedAmount per 30 day interval according to terms.
+func (b *SubscriptionBuilder) Usage(cappedAmount model.MoneyInput, terms string) *SubscriptionBuilder {
+	if b.usage != nil {
+		b.errs = append(b.errs, errors.New("usage line item already set"))
+		return b
+	}
+	b.usage = &model.AppUsagePricingInput{CappedAmount: &cappedAmount, Terms: terms}
+	return b
+}
+
+// PercentageDiscount discounts the recurring line item by percentage, a fraction such as 0.2 for 20%, for the
+// given number of intervals, or indefinitely if intervals is 0.
+func (b *SubscriptionBuilder) PercentageDiscount(percentage float64, intervals int) *SubscriptionBuilder {
+	if percentage <= 0 || percentage > 1 {
+		b.errs = append(b.errs, fmt.Errorf("discount percentage %v is not in (0, 1]", percentage))
+	}
+	return b.setDiscount(&model.AppSubscriptionDiscountValueInput{Percentage: &percentage}, intervals)
+}
+
+// AmountDiscount discounts the recurring line item by amount, in the currency of its price, for the given number
+// of intervals, or indefinitely if intervals is 0.
+func (b *SubscriptionBuilder) AmountDiscount(amount decimal.Decimal, intervals int) *SubscriptionBuilder {
+	if !amount.IsPositive() {
+		b.errs = append(b.errs, fmt.Errorf("discount amount %s is not positive", amount))
+	}
+	return b.setDiscount(&model.AppSubscriptionDiscountValueInput{Amount: &amount}, intervals)
+}
+
+func (b *SubscriptionBuilder) setDiscount(value *model.AppSubscriptionDiscountValueInput, intervals int) *SubscriptionBuilder {
+	if b.discount != nil {
+		b.errs = append(b.errs, errors.New("discount already set"))
+		return b
+	}
+	if intervals < 0 {
+		b.errs = append(b.errs, fmt.Errorf("discount intervals %d is negative", intervals))
+	}
+	b.discount = &model.AppSubscriptionDiscountInput{Value: value}
+	if intervals > 0 {
+		b.discount.DurationLimitInIntervals = &intervals
+	}
+	return b
+}
+
+// Variables checks the subscription and returns the variables of its appSubscriptionCreate mutation.
+func (b *SubscriptionBuilder) Variables() (*AppSubscriptionCreateVariables, error) {
+	errs := append([]error(nil), b.errs...)
+	if b.vars.Name == "" {
+		errs = append(errs, errors.New("name is blank"))
+	}
+	if u, err := url.Parse(b.vars.ReturnURL); err != nil || !u.IsAbs() {
+		errs = append(errs, fmt.Errorf("return URL %q is not absolute", b.vars.ReturnURL))
+	}
+	if b.vars.TrialDays != nil && *b.vars.TrialDays < 0 {
+		errs = append(errs, fmt.Errorf("trial days %d is negative", *b.vars.TrialDays))
+	}
+	if b.recurring == nil && b.usage == nil {
+		errs = append(errs, errors.New("no line items"))
+	}
+
+	vars := b.vars
+	vars.LineItems = nil
+	if b.recurring != nil {
+		recurring := *b.recurring
+		if !recurring.Price.Amount.IsPositive() {
+			errs = append(errs, fmt.Errorf("recurring price %s is not positive", recurring.Price.Amount))
+		}
+		if b.discount != nil {
+			if a := b.discount.Value.Amount; a != nil && a.GreaterThan(recurring.Price.Amount) {
+				errs = append(errs, fmt.Errorf("discount amount %s exceeds the price %s", a, recurring.Price.Amount))
+			}
+			recurring.Discount = b.discount
+		}
+		vars.LineItems = append(vars.LineItems, model.AppSubscriptionLineItemInput{
+			Plan: &model.AppPlanInput{AppRecurringPricingDetails: &recurring},
+		})
+	} else if b.discount != nil {
+		errs = append(errs, errors.New("discount without a recurring line item"))
+	}
+	if b.usage != nil {
+		usage := *b.usage
+		if !usage.CappedAmount.Amount.IsPositive() {
+			errs = append(errs, fmt.Errorf("capped amount %s is not positive", usage.CappedAmount.Amount))
+		}
+		if strings.TrimSpace(usage.Terms) == "" {
+			errs = append(errs, errors.New("usage terms are blank"))
+		}
+		if b.recurring != nil {
+			if *b.recurring.Interval == model.AppPricingIntervalAnnual {
+				errs = append(errs, errors.New("usage line item with an annual recurring line item"))
+			}
+			if price, capped := b.recurring.Price.CurrencyCode, usage.CappedAmount.CurrencyCode; price != capped {
+				errs = append(errs, fmt.Errorf("capped amount currency %s differs from price currency %s", capped, price))
+			}
+		}
+		vars.LineItems = append(vars.LineItems, model.AppSubscriptionLineItemInput{
+			Plan: &model.AppPlanInput{AppUsagePricingDetails: &usage},
+		})
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid app subscription: %w", err)
+	}
+	return &vars, nil
+}
+
+// UsageRecordBuilder builds the variables of an appUsageRecordCreate mutation.
+type UsageRecordBuilder struct {
+	vars AppUsageRecordCreateVariables
+}
+
+// NewUsageRecord returns a builder of a usage record charging price on the usage line item lineItemID.
+func NewUsageRecord(lineItemID string, price model.MoneyInput, description string) *UsageRecordBuilder {
+	return &UsageRecordBuilder{vars: AppUsageRecordCreateVariables{
+		SubscriptionLineItemID: lineItemID,
+		Price:                  price,
+		Description:            description,
+	}}
+}
+
+// IdempotencyKey sets the key preventing the record from being created twice, see IdempotencyKey.
+func (b *UsageRecordBuilder) IdempotencyKey(key string) *UsageRecordBuilder {
+	b.vars.IdempotencyKey = &key
+	return b
+}
+
+// Variables checks the usage record and returns the variables of its appUsageRecordCreate mutation. With the usage
+// balance of the line item, it also checks that the record fits under the capped amount in the same currency.
+func (b *UsageRecordBuilder) Variables(balance ...UsageBalance) (*AppUsageRecordCreateVariables, error) {
+	var errs []error
+	if !strings.HasPrefix(b.vars.SubscriptionLineItemID, "gid://shopify/AppSubscriptionLineItem/") {
+		errs = append(errs, fmt.Errorf("%q is not a subscription line item ID", b.vars.SubscriptionLineItemID))
+	}
+	if !b.vars.Price.Amount.IsPositive() {
+		errs = append(errs, fmt.Errorf("price %s is not positive", b.vars.Price.Amount))
+	}
+	if strings.TrimSpace(b.vars.Description) == "" {
+		errs = append(errs, errors.New("description is blank"))
+	}
+	if key := b.vars.IdempotencyKey; key != nil && (*key == "" || len(*key) > MaxIdempotencyKeyLength) {
+		errs = append(errs, fmt.Errorf("idempotency key length %d is not in [1, %d]", len(*key), MaxIdempotencyKeyLength))
+	}
+	for _, bal := range balance {
+		if bal.CurrencyCode != b.vars.Price.CurrencyCode {
+			errs = append(errs, fmt.Errorf("price currency %s differs from capped amount currency %s",
+				b.vars.Price.CurrencyCode, bal.CurrencyCode))
+		} else if !bal.CanCharge(b.vars.Price.Amount) {
+			errs = append(errs, fmt.Errorf("price %s exceeds the remaining balance %s",
+				b.vars.Price.Amount, bal.Remaining()))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid app usage record: %w", err)
+	}
+	vars := b.vars
+	return &vars, nil
+}
+
+// IdempotencyKey returns a key identifying a usage charge by parts, e.g. the ID of the shop and of the order being
+// charged for, so that retries of the same charge share it.
+func IdempotencyKey(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
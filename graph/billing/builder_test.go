@@ -0,0 +1,165 @@
+package billing_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/billing"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+func money(amount string, currency model.CurrencyCode) model.MoneyInput {
+	return model.MoneyInput{Amount: decimal.RequireFromString(amount), CurrencyCode: currency}
+}
+
+var _ = Describe("SubscriptionBuilder", func() {
+	const returnURL = "https://app.example.com/billing"
+
+	It("builds the variables of a recurring and usage subscription", func() {
+		vars, err := billing.NewSubscription("Pro", returnURL).
+			Test(true).
+			TrialDays(7).
+			ReplacementBehavior(model.AppSubscriptionReplacementBehaviorApplyImmediately).
+			Recurring(money("10", model.CurrencyCodeUsd), model.AppPricingIntervalEvery30Days).
+			PercentageDiscount(0.5, 3).
+			Usage(money("100", model.CurrencyCodeUsd), "$1 per order").
+			Variables()
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := json.Marshal(vars)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"name": "Pro",
+			"returnUrl": "https://app.example.com/billing",
+			"test": true,
+			"trialDays": 7,
+			"replacementBehavior": "APPLY_IMMEDIATELY",
+			"lineItems": [
+				{"plan": {"appRecurringPricingDetails": {
+					"interval": "EVERY_30_DAYS",
+					"price": {"amount": "10", "currencyCode": "USD"},
+					"discount": {"value": {"percentage": 0.5}, "durationLimitInIntervals": 3}
+				}}},
+				{"plan": {"appUsagePricingDetails": {
+					"cappedAmount": {"amount": "100", "currencyCode": "USD"},
+					"terms": "$1 per order"
+				}}}
+			]
+		}`))
+	})
+
+	It("leaves indefinite discounts unlimited", func() {
+		vars, err := billing.NewSubscription("Pro", returnURL).
+			Recurring(money("10", model.CurrencyCodeUsd), model.AppPricingIntervalAnnual).
+			AmountDiscount(decimal.NewFromInt(2), 0).
+			Variables()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vars.LineItems).To(HaveLen(1))
+		discount := vars.LineItems[0].Plan.AppRecurringPricingDetails.Discount
+		Expect(discount.DurationLimitInIntervals).To(BeNil())
+		Expect(discount.Value.Percentage).To(BeNil())
+		Expect(discount.Value.Amount.String()).To(Equal("2"))
+	})
+
+	It("rejects a second line item or discount", func() {
+		_, err := billing.NewSubscription("Pro", returnURL).
+			Recurring(money("10", model.CurrencyCodeUsd), model.AppPricingIntervalEvery30Days).
+			Recurring(money("20", model.CurrencyCodeUsd), model.AppPricingIntervalEvery30Days).
+			Usage(money("100", model.CurrencyCodeUsd), "terms").
+			Usage(money("200", model.CurrencyCodeUsd), "terms").
+			PercentageDiscount(0.5, 1).
+			AmountDiscount(decimal.NewFromInt(1), 1).
+			Variables()
+		Expect(err).To(MatchError(ContainSubstring("recurring line item already set")))
+		Expect(err).To(MatchError(ContainSubstring("usage line item already set")))
+		Expect(err).To(MatchError(ContainSubstring("discount already set")))
+	})
+
+	It("rejects mismatched currencies", func() {
+		_, err := billing.NewSubscription("Pro", returnURL).
+			Recurring(money("10", model.CurrencyCodeUsd), model.AppPricingIntervalEvery30Days).
+			Usage(money("100", model.CurrencyCodeEur), "terms").
+			Variables()
+		Expect(err).To(MatchError(ContainSubstring("capped amount currency EUR differs from price currency USD")))
+	})
+
+	It("rejects invalid subscriptions", func() {
+		_, err := billing.NewSubscription("", "/billing").TrialDays(-1).PercentageDiscount(20, -1).Variables()
+		Expect(err).To(MatchError(ContainSubstring("name is blank")))
+		Expect(err).To(MatchError(ContainSubstring(`return URL "/billing" is not absolute`)))
+		Expect(err).To(MatchError(ContainSubstring("trial days -1 is negative")))
+		Expect(err).To(MatchError(ContainSubstring("no line items")))
+		Expect(err).To(MatchError(ContainSubstring("discount percentage 20 is not in (0, 1]")))
+		Expect(err).To(MatchError(ContainSubstring("discount intervals -1 is negative")))
+		Expect(err).To(MatchError(ContainSubstring("discount without a recurring line item")))
+
+		_, err = billing.NewSubscription("Pro", returnURL).
+			Recurring(money("0", model.CurrencyCodeUsd), model.AppPricingIntervalAnnual).
+			AmountDiscount(decimal.NewFromInt(5), 1).
+			Usage(money("0", model.CurrencyCodeUsd), " ").
+			Variables()
+		Expect(err).To(MatchError(ContainSubstring("recurring price 0 is not positive")))
+		Expect(err).To(MatchError(ContainSubstring("discount amount 5 exceeds the price 0")))
+		Expect(err).To(MatchError(ContainSubstring("capped amount 0 is not positive")))
+		Expect(err).To(MatchError(ContainSubstring("usage terms are blank")))
+		Expect(err).To(MatchError(ContainSubstring("usage line item with an annual recurring line item")))
+	})
+})
+
+var _ = Describe("UsageRecordBuilder", func() {
+	const lineItemID = "gid://shopify/AppSubscriptionLineItem/1?v=1&index=1"
+
+	It("builds the variables of a usage record", func() {
+		key := billing.IdempotencyKey("shop.myshopify.com", "gid://shopify/Order/1")
+		vars, err := billing.NewUsageRecord(lineItemID, money("1.50", model.CurrencyCodeUsd), "1 order").
+			IdempotencyKey(key).
+			Variables()
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := json.Marshal(vars)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{
+			"subscriptionLineItemId": "gid://shopify/AppSubscriptionLineItem/1?v=1&index=1",
+			"price": {"amount": "1.5", "currencyCode": "USD"},
+			"description": "1 order",
+			"idempotencyKey": "` + key + `"
+		}`))
+	})
+
+	It("derives stable idempotency keys", func() {
+		key := billing.IdempotencyKey("a", "b")
+		Expect(key).To(HaveLen(64))
+		Expect(billing.IdempotencyKey("a", "b")).To(Equal(key))
+		Expect(billing.IdempotencyKey("ab")).NotTo(Equal(key))
+		Expect(billing.IdempotencyKey("a", "c")).NotTo(Equal(key))
+	})
+
+	It("checks the record against the usage balance", func() {
+		balance := billing.UsageBalance{
+			Used:         decimal.NewFromInt(99),
+			Capped:       decimal.NewFromInt(100),
+			CurrencyCode: model.CurrencyCodeUsd,
+		}
+		_, err := billing.NewUsageRecord(lineItemID, money("1", model.CurrencyCodeUsd), "1 order").Variables(balance)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = billing.NewUsageRecord(lineItemID, money("2", model.CurrencyCodeUsd), "2 orders").Variables(balance)
+		Expect(err).To(MatchError(ContainSubstring("price 2 exceeds the remaining balance 1")))
+
+		_, err = billing.NewUsageRecord(lineItemID, money("1", model.CurrencyCodeEur), "1 order").Variables(balance)
+		Expect(err).To(MatchError(ContainSubstring("price currency EUR differs from capped amount currency USD")))
+	})
+
+	It("rejects invalid records", func() {
+		_, err := billing.NewUsageRecord("gid://shopify/Order/1", money("-1", model.CurrencyCodeUsd), "").
+			IdempotencyKey("").
+			Variables()
+		Expect(err).To(MatchError(ContainSubstring(`"gid://shopify/Order/1" is not a subscription line item ID`)))
+		Expect(err).To(MatchError(ContainSubstring("price -1 is not positive")))
+		Expect(err).To(MatchError(ContainSubstring("description is blank")))
+		Expect(err).To(MatchError(ContainSubstring("idempotency key length 0 is not in [1, 255]")))
+	})
+})
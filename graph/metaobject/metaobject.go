@@ -0,0 +1,417 @@
+// Package metaobject maps metaobjects to Go structs whose fields are tagged with the keys of the metaobject fields,
+// e.g. `shopify:"title"`. The options of the tag follow the key: omitempty skips zero values when encoding, and
+// date encodes times as dates rather than date times.
+package metaobject
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// TagName is the name of the struct tag holding the metaobject field key.
+const TagName = "shopify"
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	decimalType   = reflect.TypeOf(decimal.Decimal{})
+	moneyType     = reflect.TypeOf(model.MoneyV2{})
+	referenceType = reflect.TypeOf((*model.MetafieldReference)(nil)).Elem()
+	rawType       = reflect.TypeOf(json.RawMessage{})
+)
+
+// RichText is a node of the JSON tree of rich_text_field values.
+type RichText struct {
+	Type     string     `json:"type"`
+	Children []RichText `json:"children,omitempty"`
+	Value    string     `json:"value,omitempty"`
+	Level    int        `json:"level,omitempty"`
+	ListType string     `json:"listType,omitempty"`
+	URL      string     `json:"url,omitempty"`
+	Title    string     `json:"title,omitempty"`
+	Target   string     `json:"target,omitempty"`
+	Bold     bool       `json:"bold,omitempty"`
+	Italic   bool       `json:"italic,omitempty"`
+}
+
+// Text returns the text of the node and its children, without formatting.
+func (r *RichText) Text() string {
+	var b strings.Builder
+	var walk func(*RichText)
+	walk = func(n *RichText) {
+		b.WriteString(n.Value)
+		for i := range n.Children {
+			walk(&n.Children[i])
+		}
+	}
+	walk(r)
+	return b.String()
+}
+
+// money is the JSON representation of money values.
+type money struct {
+	Amount       decimal.Decimal    `json:"amount"`
+	CurrencyCode model.CurrencyCode `json:"currency_code"`
+}
+
+// structField is a struct field tagged with a metaobject field key.
+type structField struct {
+	index     int
+	key       string
+	omitEmpty bool
+	date      bool
+}
+
+// structFields returns the tagged fields of the struct type t.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := t.Field(i).Tag.Lookup(TagName)
+		if !ok || tag == "-" || !t.Field(i).IsExported() {
+			continue
+		}
+		key, opts, _ := strings.Cut(tag, ",")
+		f := structField{index: i, key: key}
+		for _, opt := range strings.Split(opts, ",") {
+			switch opt {
+			case "omitempty":
+				f.omitEmpty = true
+			case "date":
+				f.date = true
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// structValue returns the struct v points to.
+func structValue(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("metaobject: %T is not a pointer to a struct", v)
+	}
+	return rv.Elem(), nil
+}
+
+// Unmarshal sets the tagged fields of the struct v points to from the fields of m, parsing the values according to
+// the types of the metaobject fields. Fields missing from m or without a value are left untouched. Reference fields
+// can be strings, set to the referenced IDs, or types implementing model.MetafieldReference, set to the selected
+// references when their type matches.
+func Unmarshal(m *model.Metaobject, v any) error {
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+	byKey := make(map[string]*model.MetaobjectField, len(m.Fields))
+	for i := range m.Fields {
+		byKey[m.Fields[i].Key] = &m.Fields[i]
+	}
+	for _, f := range structFields(rv.Type()) {
+		field := byKey[f.key]
+		if field == nil || field.Value == nil {
+			continue
+		}
+		if err := decodeField(rv.Field(f.index), field); err != nil {
+			return fmt.Errorf("metaobject field %s: %w", f.key, err)
+		}
+	}
+	return nil
+}
+
+// decodeField sets v from the metaobject field.
+func decodeField(v reflect.Value, field *model.MetaobjectField) error {
+	base, isList := strings.CutPrefix(field.Type, "list.")
+	if !isList {
+		return decodeValue(v, base, *field.Value, field.Reference)
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal([]byte(*field.Value), &items); err != nil {
+		return fmt.Errorf("decode %s: %w", field.Type, err)
+	}
+	t := v.Type()
+	if t.Kind() != reflect.Slice {
+		return fmt.Errorf("cannot decode %s into %s", field.Type, t)
+	}
+	// The references are matched to the items by ID, as the connection may not hold all of them, e.g. when it is
+	// paginated or a referenced object was deleted.
+	refs := map[string]model.MetafieldReference{}
+	for _, ref := range field.References.GetNodes() {
+		if id, err := referenceID(reflect.ValueOf(ref)); err == nil && id != "" {
+			refs[id] = ref
+		}
+	}
+	list := reflect.MakeSlice(t, len(items), len(items))
+	for i, item := range items {
+		var s string
+		if err := json.Unmarshal(item, &s); err != nil {
+			s = string(item)
+		}
+		if err := decodeValue(list.Index(i), base, s, refs[s]); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	v.Set(list)
+	return nil
+}
+
+// decodeValue sets v from the value s of a metaobject field of type typ, e.g. date or product_reference.
+func decodeValue(v reflect.Value, typ string, s string, ref model.MetafieldReference) error {
+	t := v.Type()
+	if t.Implements(referenceType) {
+		if ref == nil {
+			return nil
+		}
+		if !reflect.TypeOf(ref).AssignableTo(t) {
+			return fmt.Errorf("cannot set %T reference to %s", ref, t)
+		}
+		v.Set(reflect.ValueOf(ref))
+		return nil
+	}
+	if t.Kind() == reflect.Pointer {
+		p := reflect.New(t.Elem())
+		if err := decodeValue(p.Elem(), typ, s, ref); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+
+	var err error
+	switch t {
+	case timeType:
+		var tm time.Time
+		tm, err = parseTime(s)
+		v.Set(reflect.ValueOf(tm))
+	case decimalType:
+		var d decimal.Decimal
+		d, err = decimal.NewFromString(s)
+		v.Set(reflect.ValueOf(d))
+	case moneyType:
+		var m money
+		err = json.Unmarshal([]byte(s), &m)
+		v.Set(reflect.ValueOf(model.MoneyV2{Amount: m.Amount, CurrencyCode: m.CurrencyCode}))
+	default:
+		switch t.Kind() {
+		case reflect.String:
+			v.SetString(s)
+		case reflect.Bool:
+			var b bool
+			b, err = strconv.ParseBool(s)
+			v.SetBool(b)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var i int64
+			i, err = strconv.ParseInt(s, 10, t.Bits())
+			v.SetInt(i)
+		case reflect.Float32, reflect.Float64:
+			var f float64
+			f, err = strconv.ParseFloat(s, t.Bits())
+			v.SetFloat(f)
+		default:
+			err = json.Unmarshal([]byte(s), v.Addr().Interface())
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("decode %s into %s: %w", typ, t, err)
+	}
+	return nil
+}
+
+// parseTime parses the values of date and date_time fields. Date times may lack a time zone, in which case they
+// are in UTC.
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// Fields returns the inputs of the tagged fields of the struct v points to, in declaration order. Lists are
+// encoded as JSON arrays, money as JSON objects and references as the IDs of the referenced objects. Nil pointers
+// and slices are encoded as empty values, clearing the fields.
+func Fields(v any) ([]model.MetaobjectFieldInput, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	var inputs []model.MetaobjectFieldInput
+	for _, f := range structFields(rv.Type()) {
+		fv := rv.Field(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		value, err := encodeField(fv, f.date)
+		if err != nil {
+			return nil, fmt.Errorf("metaobject field %s: %w", f.key, err)
+		}
+		inputs = append(inputs, model.MetaobjectFieldInput{Key: f.key, Value: value})
+	}
+	return inputs, nil
+}
+
+// encodeField returns the value of the field v.
+func encodeField(v reflect.Value, date bool) (string, error) {
+	if v.Kind() == reflect.Pointer && !v.Type().Implements(referenceType) {
+		if v.IsNil() {
+			return "", nil
+		}
+		return encodeField(v.Elem(), date)
+	}
+	if v.Kind() != reflect.Slice || v.Type() == rawType {
+		return encodeValue(v, date)
+	}
+
+	if v.IsNil() {
+		return "", nil
+	}
+	items := make([]json.RawMessage, v.Len())
+	for i := range items {
+		item := v.Index(i)
+		if item.Kind() == reflect.Pointer && !item.Type().Implements(referenceType) && !item.IsNil() {
+			item = item.Elem()
+		}
+		s, err := encodeValue(item, date)
+		if err != nil {
+			return "", fmt.Errorf("item %d: %w", i, err)
+		}
+		if rawItem(item.Type()) {
+			items[i] = json.RawMessage(s)
+		} else {
+			items[i], _ = json.Marshal(s)
+		}
+	}
+	b, err := json.Marshal(items)
+	return string(b), err
+}
+
+// rawItem reports whether the list items of type t are encoded as JSON numbers, booleans or objects rather than
+// strings.
+func rawItem(t reflect.Type) bool {
+	if t.Implements(referenceType) || t == timeType || t == decimalType {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32,
+		reflect.Float64, reflect.Struct, reflect.Map:
+		return true
+	}
+	return false
+}
+
+// encodeValue returns the value of a single metaobject field value v.
+func encodeValue(v reflect.Value, date bool) (string, error) {
+	if v.Type().Implements(referenceType) {
+		return referenceID(v)
+	}
+	switch v.Type() {
+	case timeType:
+		if date {
+			return v.Interface().(time.Time).Format(time.DateOnly), nil
+		}
+		return v.Interface().(time.Time).Format(time.RFC3339), nil
+	case decimalType:
+		return v.Interface().(decimal.Decimal).String(), nil
+	case moneyType:
+		m := v.Interface().(model.MoneyV2)
+		b, err := json.Marshal(money{Amount: m.Amount, CurrencyCode: m.CurrencyCode})
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+	if v.Type() == rawType {
+		return string(v.Bytes()), nil
+	}
+	b, err := json.Marshal(v.Interface())
+	return string(b), err
+}
+
+// referenceID returns the ID of the reference v.
+func referenceID(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	id := v.FieldByName("ID")
+	if !id.IsValid() || id.Kind() != reflect.String {
+		return "", fmt.Errorf("reference %s has no ID", v.Type())
+	}
+	return id.String(), nil
+}
+
+// CreateInput returns the input creating a metaobject of type typ with the fields of the struct v points to. An
+// empty handle lets Shopify generate one.
+func CreateInput(typ, handle string, v any) (*model.MetaobjectCreateInput, error) {
+	fields, err := Fields(v)
+	if err != nil {
+		return nil, err
+	}
+	input := &model.MetaobjectCreateInput{Type: typ, Fields: fields}
+	if handle != "" {
+		input.Handle = &handle
+	}
+	return input, nil
+}
+
+// UpsertInput returns the input upserting a metaobject with the fields of the struct v points to.
+func UpsertInput(v any) (*model.MetaobjectUpsertInput, error) {
+	fields, err := Fields(v)
+	if err != nil {
+		return nil, err
+	}
+	return &model.MetaobjectUpsertInput{Fields: fields}, nil
+}
+
+// UnmarshalMetafields sets the tagged fields of the struct v points to from metafields, like Unmarshal. The fields
+// are tagged with the namespaces and keys of the metafields, e.g. `shopify:"custom.care_guide"`.
+func UnmarshalMetafields(metafields []model.Metafield, v any) error {
+	m := &model.Metaobject{Fields: make([]model.MetaobjectField, len(metafields))}
+	for i, mf := range metafields {
+		m.Fields[i] = model.MetaobjectField{
+			Key:        mf.Namespace + "." + mf.Key,
+			Type:       mf.Type,
+			Value:      &mf.Value,
+			Reference:  mf.Reference,
+			References: mf.References,
+		}
+	}
+	return Unmarshal(m, v)
+}
+
+// MetafieldInputs returns the inputs setting the metafields of the owner ownerID from the fields of the struct v
+// points to, tagged like for UnmarshalMetafields. The inputs have no type, so the metafields must have definitions.
+func MetafieldInputs(ownerID string, v any) ([]model.MetafieldsSetInput, error) {
+	fields, err := Fields(v)
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]model.MetafieldsSetInput, len(fields))
+	for i, f := range fields {
+		dot := strings.LastIndex(f.Key, ".")
+		if dot < 0 {
+			return nil, fmt.Errorf("metafield %s has no namespace", f.Key)
+		}
+		namespace := f.Key[:dot]
+		inputs[i] = model.MetafieldsSetInput{OwnerID: ownerID, Namespace: &namespace, Key: f.Key[dot+1:], Value: f.Value}
+	}
+	return inputs, nil
+}
@@ -0,0 +1,13 @@
+package metaobject_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMetaobject(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Metaobject Suite")
+}
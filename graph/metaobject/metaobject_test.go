@@ -0,0 +1,217 @@
+package metaobject_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/metaobject"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+type banner struct {
+	Title     string                   `shopify:"title"`
+	Body      metaobject.RichText      `shopify:"body"`
+	Price     model.MoneyV2            `shopify:"price"`
+	Discount  decimal.Decimal          `shopify:"discount,omitempty"`
+	Priority  int                      `shopify:"priority"`
+	Visible   bool                     `shopify:"visible"`
+	StartsOn  time.Time                `shopify:"starts_on,date"`
+	Tags      []string                 `shopify:"tags"`
+	Sizes     []int                    `shopify:"sizes,omitempty"`
+	Product   *model.Product           `shopify:"product"`
+	Products  []string                 `shopify:"products"`
+	Reference model.MetafieldReference `shopify:"reference,omitempty"`
+	Note      *string                  `shopify:"note"`
+	Settings  json.RawMessage          `shopify:"settings,omitempty"`
+	Ignored   string
+}
+
+func field(key, typ, value string) model.MetaobjectField {
+	return model.MetaobjectField{Key: key, Type: typ, Value: &value}
+}
+
+var _ = Describe("Unmarshal", func() {
+	It("parses the values according to their types", func() {
+		product := &model.Product{ID: "gid://shopify/Product/1", Title: "Shirt"}
+		productField := field("product", "product_reference", product.ID)
+		productField.Reference = product
+
+		m := &model.Metaobject{Fields: []model.MetaobjectField{
+			field("title", "single_line_text_field", "Summer sale"),
+			field("body", "rich_text_field",
+				`{"type":"root","children":[{"type":"paragraph","children":[{"type":"text","value":"Hot deals","bold":true}]}]}`),
+			field("price", "money", `{"amount":"5.99","currency_code":"CAD"}`),
+			field("discount", "number_decimal", "0.15"),
+			field("priority", "number_integer", "3"),
+			field("visible", "boolean", "true"),
+			field("starts_on", "date", "2024-06-01"),
+			field("tags", "list.single_line_text_field", `["a","b"]`),
+			field("sizes", "list.number_integer", `[1,2]`),
+			productField,
+			field("products", "list.product_reference", `["gid://shopify/Product/1","gid://shopify/Product/2"]`),
+			{Key: "note", Type: "multi_line_text_field"},
+			field("settings", "json", `{"dark": true}`),
+			field("unknown", "single_line_text_field", "x"),
+		}}
+
+		var b banner
+		Expect(metaobject.Unmarshal(m, &b)).To(Succeed())
+		Expect(b.Title).To(Equal("Summer sale"))
+		Expect(b.Body.Text()).To(Equal("Hot deals"))
+		Expect(b.Body.Children[0].Children[0].Bold).To(BeTrue())
+		Expect(b.Price.Amount.Equal(decimal.RequireFromString("5.99"))).To(BeTrue())
+		Expect(b.Price.CurrencyCode).To(Equal(model.CurrencyCodeCad))
+		Expect(b.Discount.Equal(decimal.RequireFromString("0.15"))).To(BeTrue())
+		Expect(b.Priority).To(Equal(3))
+		Expect(b.Visible).To(BeTrue())
+		Expect(b.StartsOn).To(Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(b.Tags).To(Equal([]string{"a", "b"}))
+		Expect(b.Sizes).To(Equal([]int{1, 2}))
+		Expect(b.Product).To(BeIdenticalTo(product))
+		Expect(b.Products).To(Equal([]string{"gid://shopify/Product/1", "gid://shopify/Product/2"}))
+		Expect(b.Reference).To(BeNil())
+		Expect(b.Note).To(BeNil())
+		Expect(b.Settings).To(MatchJSON(`{"dark":true}`))
+	})
+
+	It("matches list references by ID", func() {
+		var v struct {
+			Products []*model.Product `shopify:"products"`
+		}
+		products := field("products", "list.product_reference",
+			`["gid://shopify/Product/1","gid://shopify/Product/2","gid://shopify/Product/3"]`)
+		// The connection misses the deleted product 2 and lists the others in another order.
+		products.References = &model.MetafieldReferenceConnection{Nodes: []model.MetafieldReference{
+			&model.Product{ID: "gid://shopify/Product/3"},
+			&model.Product{ID: "gid://shopify/Product/1"},
+		}}
+		Expect(metaobject.Unmarshal(&model.Metaobject{Fields: []model.MetaobjectField{products}}, &v)).To(Succeed())
+		Expect(v.Products).To(HaveLen(3))
+		Expect(v.Products[0].ID).To(Equal("gid://shopify/Product/1"))
+		Expect(v.Products[1]).To(BeNil())
+		Expect(v.Products[2].ID).To(Equal("gid://shopify/Product/3"))
+	})
+
+	It("parses date times without time zone", func() {
+		var v struct {
+			EndsAt time.Time `shopify:"ends_at"`
+		}
+		m := &model.Metaobject{Fields: []model.MetaobjectField{field("ends_at", "date_time", "2024-06-01T12:30:00")}}
+		Expect(metaobject.Unmarshal(m, &v)).To(Succeed())
+		Expect(v.EndsAt).To(Equal(time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)))
+	})
+
+	It("fails on invalid values", func() {
+		m := &model.Metaobject{Fields: []model.MetaobjectField{field("priority", "number_integer", "high")}}
+		Expect(metaobject.Unmarshal(m, &banner{})).To(MatchError(ContainSubstring("metaobject field priority")))
+
+		m = &model.Metaobject{Fields: []model.MetaobjectField{field("title", "list.single_line_text_field", `["a"]`)}}
+		Expect(metaobject.Unmarshal(m, &banner{})).To(MatchError(ContainSubstring("cannot decode")))
+
+		Expect(metaobject.Unmarshal(m, banner{})).To(MatchError(ContainSubstring("not a pointer to a struct")))
+	})
+})
+
+var _ = Describe("Fields", func() {
+	It("encodes the values of the fields", func() {
+		note := "Limited"
+		b := &banner{
+			Title:    "Summer sale",
+			Body:     metaobject.RichText{Type: "root", Children: []metaobject.RichText{{Type: "text", Value: "Hi"}}},
+			Price:    model.MoneyV2{Amount: decimal.RequireFromString("5.99"), CurrencyCode: model.CurrencyCodeCad},
+			Priority: 3,
+			StartsOn: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+			Tags:     []string{"a", "b"},
+			Sizes:    []int{1, 2},
+			Product:  &model.Product{ID: "gid://shopify/Product/1"},
+			Note:     &note,
+			Settings: json.RawMessage(`{"dark":true}`),
+		}
+
+		input, err := metaobject.CreateInput("banner", "summer", b)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input.Type).To(Equal("banner"))
+		Expect(*input.Handle).To(Equal("summer"))
+		Expect(input.Fields).To(Equal([]model.MetaobjectFieldInput{
+			{Key: "title", Value: "Summer sale"},
+			{Key: "body", Value: `{"type":"root","children":[{"type":"text","value":"Hi"}]}`},
+			{Key: "price", Value: `{"amount":"5.99","currency_code":"CAD"}`},
+			{Key: "priority", Value: "3"},
+			{Key: "visible", Value: "false"},
+			{Key: "starts_on", Value: "2024-06-01"},
+			{Key: "tags", Value: `["a","b"]`},
+			{Key: "sizes", Value: `[1,2]`},
+			{Key: "product", Value: "gid://shopify/Product/1"},
+			{Key: "products", Value: ""},
+			{Key: "note", Value: "Limited"},
+			{Key: "settings", Value: `{"dark":true}`},
+		}))
+
+		upsert, err := metaobject.UpsertInput(b)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upsert.Handle).To(BeNil())
+		Expect(upsert.Fields).To(Equal(input.Fields))
+	})
+
+	It("round trips through Unmarshal", func() {
+		b := &banner{Title: "Sale", Sizes: []int{4}, Discount: decimal.RequireFromString("0.5"), Products: []string{"x"}}
+		inputs, err := metaobject.Fields(b)
+		Expect(err).NotTo(HaveOccurred())
+
+		types := map[string]string{"sizes": "list.number_integer", "products": "list.product_reference",
+			"starts_on": "date", "price": "money", "body": "json", "discount": "number_decimal"}
+		m := &model.Metaobject{}
+		for _, in := range inputs {
+			if in.Value == "" {
+				continue
+			}
+			typ := types[in.Key]
+			if typ == "" {
+				typ = "single_line_text_field"
+			}
+			m.Fields = append(m.Fields, field(in.Key, typ, in.Value))
+		}
+		var decoded banner
+		Expect(metaobject.Unmarshal(m, &decoded)).To(Succeed())
+		Expect(decoded.Title).To(Equal(b.Title))
+		Expect(decoded.Sizes).To(Equal(b.Sizes))
+		Expect(decoded.Products).To(Equal(b.Products))
+		Expect(decoded.Discount.Equal(b.Discount)).To(BeTrue())
+	})
+})
+
+type productMetafields struct {
+	CareGuide *string `shopify:"custom.care_guide,omitempty"`
+	Sizes     []int   `shopify:"specs.sizes,omitempty"`
+}
+
+var _ = Describe("Metafields", func() {
+	It("maps metafields by namespace and key", func() {
+		var p productMetafields
+		Expect(metaobject.UnmarshalMetafields([]model.Metafield{
+			{Namespace: "custom", Key: "care_guide", Type: "multi_line_text_field", Value: "Wash cold"},
+			{Namespace: "specs", Key: "sizes", Type: "list.number_integer", Value: "[38,40]"},
+		}, &p)).To(Succeed())
+		Expect(*p.CareGuide).To(Equal("Wash cold"))
+		Expect(p.Sizes).To(Equal([]int{38, 40}))
+
+		inputs, err := metaobject.MetafieldInputs("gid://shopify/Product/1", &p)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inputs).To(HaveLen(2))
+		Expect(inputs[0].OwnerID).To(Equal("gid://shopify/Product/1"))
+		Expect(*inputs[0].Namespace).To(Equal("custom"))
+		Expect(inputs[0].Key).To(Equal("care_guide"))
+		Expect(inputs[0].Value).To(Equal("Wash cold"))
+		Expect(inputs[1].Value).To(Equal("[38,40]"))
+		Expect(inputs[1].Type).To(BeNil())
+	})
+
+	It("requires namespaces", func() {
+		_, err := metaobject.MetafieldInputs("gid://shopify/Product/1", &banner{})
+		Expect(err).To(MatchError(ContainSubstring("has no namespace")))
+	})
+})
@@ -0,0 +1,333 @@
+package metaobject
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// referenceTypes maps the reference metafield types to the types of the objects they can reference.
+var referenceTypes = map[string][]string{
+	"collection_reference":             {"Collection"},
+	"company_reference":                {"Company"},
+	"customer_reference":               {"Customer"},
+	"file_reference":                   {"GenericFile", "MediaImage", "Video", "Model3d"},
+	"metaobject_reference":             {"Metaobject"},
+	"mixed_reference":                  {"Metaobject"},
+	"page_reference":                   {"Page"},
+	"product_reference":                {"Product"},
+	"product_taxonomy_value_reference": {"TaxonomyValue"},
+	"variant_reference":                {"ProductVariant"},
+}
+
+// fileTypes maps the options of the file_type_options validation to the types of the files they allow. The other
+// options allow generic files.
+var fileTypes = map[string]string{
+	"Image": "MediaImage",
+	"Video": "Video",
+	"Model": "Model3d",
+}
+
+// checkedValidations maps the types to the validations checked on their values. The other validations, e.g. the
+// bounds of ratings and measurements or metaobject_definition_id, are reported as unchecked.
+var checkedValidations = map[string][]string{
+	"single_line_text_field": {"min", "max", "regex", "choices"},
+	"multi_line_text_field":  {"min", "max", "regex", "choices"},
+	"number_integer":         {"min", "max"},
+	"number_decimal":         {"min", "max", "max_precision"},
+	"date":                   {"min", "max"},
+	"date_time":              {"min", "max"},
+	"url":                    {"allowed_domains"},
+	"file_reference":         {"file_type_options"},
+}
+
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// violation is a rule violated by a value.
+type violation struct {
+	code    model.MetafieldsSetUserErrorCode
+	message string
+}
+
+func violationf(code model.MetafieldsSetUserErrorCode, format string, args ...any) *violation {
+	return &violation{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+// Validate checks value against the type and the validations of the metafield definition, and returns the
+// violations like the metafieldsSet mutation would, on the value field. The violations of list items have their
+// ElementIndex set. The checks only use the value, so references are checked against the types of the referenced
+// objects, but not against the metaobject definitions they belong to.
+//
+// The error reports every validation that cannot be checked: a regex using lookaheads, which Go does not support,
+// metaobject_definition_id, the bounds of ratings and measurements, and validations unknown to this package. The
+// violations of the other validations are returned with it.
+func Validate(def *model.MetafieldDefinition, value string) ([]model.MetafieldsSetUserError, error) {
+	if def.Type == nil {
+		return nil, nil
+	}
+	errs, unchecked := validateValue(def.Type.Name, def.Validations, value, []string{"value"})
+	return errs, errors.Join(unchecked...)
+}
+
+// ValidateInputs checks the inputs of a metafieldsSet mutation against the definitions of their metafields, see
+// Validate. The violations are on the fields of the inputs, e.g. metafields.0.value. Inputs without a definition are
+// not checked. The error reports the validations that cannot be checked, by input.
+func ValidateInputs(
+	defs []model.MetafieldDefinition, inputs []model.MetafieldsSetInput,
+) ([]model.MetafieldsSetUserError, error) {
+	var errs []model.MetafieldsSetUserError
+	var unchecked []error
+	for i, in := range inputs {
+		var namespace string
+		if in.Namespace != nil {
+			namespace = *in.Namespace
+		}
+		j := slices.IndexFunc(defs, func(d model.MetafieldDefinition) bool {
+			return d.Namespace == namespace && d.Key == in.Key
+		})
+		if j < 0 || defs[j].Type == nil {
+			continue
+		}
+		field := []string{"metafields", strconv.Itoa(i), "value"}
+		violations, errUnchecked := validateValue(defs[j].Type.Name, defs[j].Validations, in.Value, field)
+		errs = append(errs, violations...)
+		for _, err := range errUnchecked {
+			unchecked = append(unchecked, fmt.Errorf("metafields.%d: %w", i, err))
+		}
+	}
+	return errs, errors.Join(unchecked...)
+}
+
+// validateValue checks the value of type typ against validations, reporting the violations on field. It returns
+// an error for every validation that cannot be checked.
+func validateValue(
+	typ string, validations []model.MetafieldDefinitionValidation, value string, field []string,
+) ([]model.MetafieldsSetUserError, []error) {
+	base, isList := strings.CutPrefix(typ, "list.")
+	rules := make(map[string]string, len(validations))
+	var unchecked []error
+	for _, v := range validations {
+		if v.Value == nil {
+			continue
+		}
+		switch {
+		case isList && (v.Name == "list.min" || v.Name == "list.max"):
+		case !slices.Contains(checkedValidations[base], v.Name):
+			unchecked = append(unchecked, fmt.Errorf("%s validation %q of %s cannot be checked", v.Name, *v.Value, typ))
+			continue
+		case v.Name == "regex":
+			if _, err := regexp.Compile(*v.Value); err != nil {
+				unchecked = append(unchecked, fmt.Errorf("regex validation %q cannot be checked: %w", *v.Value, err))
+				continue
+			}
+		}
+		rules[v.Name] = *v.Value
+	}
+
+	userError := func(v *violation, index *int) model.MetafieldsSetUserError {
+		return model.MetafieldsSetUserError{Code: &v.code, ElementIndex: index, Field: field, Message: v.message}
+	}
+
+	if !isList {
+		var errs []model.MetafieldsSetUserError
+		for _, v := range validateItem(base, rules, value) {
+			errs = append(errs, userError(v, nil))
+		}
+		return errs, unchecked
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &items); err != nil {
+		return []model.MetafieldsSetUserError{
+			userError(violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be a JSON array"), nil),
+		}, unchecked
+	}
+	var errs []model.MetafieldsSetUserError
+	if n, err := strconv.Atoi(rules["list.min"]); err == nil && len(items) < n {
+		errs = append(errs, userError(violationf(model.MetafieldsSetUserErrorCodeTooShort,
+			"Value must have at least %d items", n), nil))
+	}
+	if n, err := strconv.Atoi(rules["list.max"]); err == nil && len(items) > n {
+		errs = append(errs, userError(violationf(model.MetafieldsSetUserErrorCodeTooLong,
+			"Value must have at most %d items", n), nil))
+	}
+	for i, item := range items {
+		var s string
+		if err := json.Unmarshal(item, &s); err != nil {
+			s = string(item)
+		}
+		for _, v := range validateItem(base, rules, s) {
+			errs = append(errs, userError(v, &i))
+		}
+	}
+	return errs, unchecked
+}
+
+// validateItem checks a single value s of type typ against the rules.
+func validateItem(typ string, rules map[string]string, s string) []*violation {
+	if s == "" {
+		return []*violation{violationf(model.MetafieldsSetUserErrorCodeBlank, "Value can't be blank")}
+	}
+	if refTypes, ok := referenceTypes[typ]; ok {
+		return validateReference(typ, refTypes, rules, s)
+	}
+
+	switch typ {
+	case "single_line_text_field", "multi_line_text_field":
+		return validateText(rules, s)
+	case "number_integer":
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be an integer")}
+		}
+		return validateRange(rules, decimal.NewFromInt(n), decimal.NewFromString)
+	case "number_decimal":
+		d, err := decimal.NewFromString(s)
+		if err != nil {
+			return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be a decimal")}
+		}
+		violations := validateRange(rules, d, decimal.NewFromString)
+		if p, err := strconv.Atoi(rules["max_precision"]); err == nil && -d.Exponent() > int32(p) {
+			violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeInvalidValue,
+				"Value must have at most %d decimal places", p))
+		}
+		return violations
+	case "date", "date_time":
+		t, err := parseTime(s)
+		if err != nil {
+			return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be a %s", typ)}
+		}
+		return validateRange(rules, t, parseTime)
+	case "boolean":
+		if s != "true" && s != "false" {
+			return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be true or false")}
+		}
+	case "color":
+		if !colorPattern.MatchString(s) {
+			return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue,
+				"Value must be a hex color code, e.g. #FF0000")}
+		}
+	case "url":
+		return validateURL(rules, s)
+	case "json", "money", "rich_text_field", "rating", "dimension", "volume", "weight", "link":
+		if !json.Valid([]byte(s)) {
+			return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be valid JSON")}
+		}
+	}
+	return nil
+}
+
+func validateText(rules map[string]string, s string) []*violation {
+	var violations []*violation
+	length := utf8.RuneCountInString(s)
+	if n, err := strconv.Atoi(rules["min"]); err == nil && length < n {
+		violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeTooShort,
+			"Value is too short (minimum is %d characters)", n))
+	}
+	if n, err := strconv.Atoi(rules["max"]); err == nil && length > n {
+		violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeTooLong,
+			"Value is too long (maximum is %d characters)", n))
+	}
+	if pattern, ok := rules["regex"]; ok {
+		if !regexp.MustCompile(pattern).MatchString(s) {
+			violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeInvalidValue,
+				"Value does not match the pattern %s", pattern))
+		}
+	}
+	if choices, ok := rules["choices"]; ok {
+		var allowed []string
+		if json.Unmarshal([]byte(choices), &allowed) == nil && !slices.Contains(allowed, s) {
+			violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeInclusion,
+				"Value is not included in the list"))
+		}
+	}
+	return violations
+}
+
+// ordered is implemented by the values validated against min and max rules.
+type ordered[T any] interface {
+	Compare(T) int
+}
+
+// validateRange checks v against the min and max rules, parsed with parse.
+func validateRange[T ordered[T]](rules map[string]string, v T, parse func(string) (T, error)) []*violation {
+	var violations []*violation
+	if lower, ok := rules["min"]; ok {
+		if bound, err := parse(lower); err == nil && v.Compare(bound) < 0 {
+			violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeInvalidValue,
+				"Value must be greater than or equal to %s", lower))
+		}
+	}
+	if upper, ok := rules["max"]; ok {
+		if bound, err := parse(upper); err == nil && v.Compare(bound) > 0 {
+			violations = append(violations, violationf(model.MetafieldsSetUserErrorCodeLessThanOrEqualTo,
+				"Value must be less than or equal to %s", upper))
+		}
+	}
+	return violations
+}
+
+func validateURL(rules map[string]string, s string) []*violation {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "mailto" && u.Scheme != "sms" &&
+		u.Scheme != "tel") {
+		return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be a valid URL")}
+	}
+	var domains []string
+	if json.Unmarshal([]byte(rules["allowed_domains"]), &domains) != nil || len(domains) == 0 {
+		return nil
+	}
+	host := u.Hostname()
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return nil
+		}
+	}
+	return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue,
+		"Value must be a URL of %s", strings.Join(domains, ", "))}
+}
+
+func validateReference(typ string, refTypes []string, rules map[string]string, s string) []*violation {
+	refType, ok := gidType(s)
+	if !ok {
+		return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue, "Value must be a global ID")}
+	}
+	if typ == "file_reference" {
+		var options []string
+		if json.Unmarshal([]byte(rules["file_type_options"]), &options) == nil && len(options) > 0 {
+			refTypes = nil
+			for _, option := range options {
+				t, ok := fileTypes[option]
+				if !ok {
+					t = "GenericFile"
+				}
+				refTypes = append(refTypes, t)
+			}
+		}
+	}
+	if !slices.Contains(refTypes, refType) {
+		return []*violation{violationf(model.MetafieldsSetUserErrorCodeInvalidValue,
+			"Value must reference a %s", strings.Join(refTypes, " or "))}
+	}
+	return nil
+}
+
+// gidType returns the object type of the global ID s, e.g. Product for gid://shopify/Product/1.
+func gidType(s string) (string, bool) {
+	rest, ok := strings.CutPrefix(s, "gid://shopify/")
+	if !ok {
+		return "", false
+	}
+	typ, id, ok := strings.Cut(rest, "/")
+	return typ, ok && typ != "" && id != ""
+}
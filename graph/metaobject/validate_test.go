@@ -0,0 +1,174 @@
+package metaobject_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/metaobject"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+func definition(typ string, rules ...string) *model.MetafieldDefinition {
+	def := &model.MetafieldDefinition{Namespace: "custom", Key: "field", Type: &model.MetafieldDefinitionType{Name: typ}}
+	for i := 0; i < len(rules); i += 2 {
+		def.Validations = append(def.Validations, model.MetafieldDefinitionValidation{Name: rules[i], Value: &rules[i+1]})
+	}
+	return def
+}
+
+// codes returns the codes of errs.
+func codes(errs []model.MetafieldsSetUserError) []model.MetafieldsSetUserErrorCode {
+	var result []model.MetafieldsSetUserErrorCode
+	for _, e := range errs {
+		result = append(result, *e.Code)
+	}
+	return result
+}
+
+// validate returns the violations of value, failing on unchecked validations.
+func validate(def *model.MetafieldDefinition, value string) []model.MetafieldsSetUserError {
+	errs, err := metaobject.Validate(def, value)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return errs
+}
+
+var _ = Describe("Validate", func() {
+	It("checks text length, patterns and choices", func() {
+		def := definition("single_line_text_field", "min", "2", "max", "5", "regex", "^[a-z]+$")
+		Expect(validate(def, "abc")).To(BeEmpty())
+		Expect(codes(validate(def, "a"))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeTooShort,
+		}))
+		Expect(codes(validate(def, "ABCDEF"))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeTooLong, model.MetafieldsSetUserErrorCodeInvalidValue,
+		}))
+		Expect(codes(validate(def, ""))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeBlank,
+		}))
+
+		def = definition("single_line_text_field", "choices", `["red","blue"]`)
+		Expect(validate(def, "red")).To(BeEmpty())
+		errs := validate(def, "green")
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal([]string{"value"}))
+		Expect(errors.Is(errs[0], model.MetafieldsSetUserErrorCodeInclusion)).To(BeTrue())
+		Expect(errs[0].Error()).To(Equal("value: Value is not included in the list (INCLUSION)"))
+	})
+
+	It("checks numbers and dates against their bounds", func() {
+		def := definition("number_integer", "min", "1", "max", "10")
+		Expect(validate(def, "10")).To(BeEmpty())
+		Expect(codes(validate(def, "11"))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeLessThanOrEqualTo,
+		}))
+		Expect(codes(validate(def, "0"))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeInvalidValue,
+		}))
+		Expect(codes(validate(def, "1.5"))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeInvalidValue,
+		}))
+
+		def = definition("number_decimal", "max", "99.99", "max_precision", "2")
+		Expect(validate(def, "12.34")).To(BeEmpty())
+		Expect(validate(def, "12.345")).To(HaveLen(1))
+		Expect(validate(def, "100")).To(HaveLen(1))
+
+		def = definition("date", "min", "2024-01-01", "max", "2024-12-31")
+		Expect(validate(def, "2024-06-01")).To(BeEmpty())
+		Expect(validate(def, "2025-01-01")).To(HaveLen(1))
+		Expect(validate(def, "tomorrow")).To(HaveLen(1))
+	})
+
+	It("checks lists and their items", func() {
+		def := definition("list.number_integer", "list.min", "2", "list.max", "3", "max", "10")
+		Expect(validate(def, "[1,2]")).To(BeEmpty())
+		Expect(codes(validate(def, "[1]"))).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeTooShort,
+		}))
+
+		errs := validate(def, "[1,20,3,4]")
+		Expect(codes(errs)).To(Equal([]model.MetafieldsSetUserErrorCode{
+			model.MetafieldsSetUserErrorCodeTooLong, model.MetafieldsSetUserErrorCodeLessThanOrEqualTo,
+		}))
+		Expect(errs[0].ElementIndex).To(BeNil())
+		Expect(*errs[1].ElementIndex).To(Equal(1))
+
+		Expect(validate(def, "1,2")).To(HaveLen(1))
+	})
+
+	It("checks references, URLs and other types", func() {
+		def := definition("file_reference", "file_type_options", `["Image"]`)
+		Expect(validate(def, "gid://shopify/MediaImage/1")).To(BeEmpty())
+		Expect(validate(def, "gid://shopify/Video/1")).To(HaveLen(1))
+		Expect(validate(definition("list.product_reference"),
+			`["gid://shopify/Product/1","gid://shopify/Collection/2","x"]`)).To(HaveLen(2))
+
+		def = definition("url", "allowed_domains", `["example.com"]`)
+		Expect(validate(def, "https://shop.example.com/a")).To(BeEmpty())
+		Expect(validate(def, "https://example.org")).To(HaveLen(1))
+		Expect(validate(def, "javascript:alert(1)")).To(HaveLen(1))
+
+		Expect(validate(definition("boolean"), "yes")).To(HaveLen(1))
+		Expect(validate(definition("color"), "#00ff00")).To(BeEmpty())
+		Expect(validate(definition("color"), "green")).To(HaveLen(1))
+		Expect(validate(definition("json"), "{")).To(HaveLen(1))
+		Expect(validate(&model.MetafieldDefinition{}, "")).To(BeEmpty())
+	})
+
+	DescribeTable("reports the validations that cannot be checked",
+		func(def *model.MetafieldDefinition, value, message string) {
+			errs, err := metaobject.Validate(def, value)
+			Expect(err).To(MatchError(message))
+			Expect(errs).To(BeEmpty())
+		},
+		Entry("metaobject definition", definition("metaobject_reference", "metaobject_definition_id",
+			"gid://shopify/MetaobjectDefinition/1"), "gid://shopify/Metaobject/2",
+			`metaobject_definition_id validation "gid://shopify/MetaobjectDefinition/1" of metaobject_reference `+
+				"cannot be checked"),
+		Entry("rating bounds", definition("rating", "scale_min", "1", "scale_max", "5"),
+			`{"value":"3","scale_min":"1","scale_max":"5"}`,
+			"scale_min validation \"1\" of rating cannot be checked\n"+
+				"scale_max validation \"5\" of rating cannot be checked"),
+		Entry("weight bounds", definition("weight", "min", `{"value":1,"unit":"KILOGRAMS"}`),
+			`{"value":2,"unit":"KILOGRAMS"}`,
+			`min validation "{\"value\":1,\"unit\":\"KILOGRAMS\"}" of weight cannot be checked`),
+		Entry("volume bounds", definition("volume", "max", `{"value":1,"unit":"LITERS"}`),
+			`{"value":2,"unit":"LITERS"}`,
+			`max validation "{\"value\":1,\"unit\":\"LITERS\"}" of volume cannot be checked`),
+		Entry("dimension bounds", definition("list.dimension", "min", `{"value":1,"unit":"METERS"}`),
+			`[{"value":2,"unit":"METERS"}]`,
+			`min validation "{\"value\":1,\"unit\":\"METERS\"}" of list.dimension cannot be checked`),
+		Entry("list bounds of single values", definition("number_integer", "list.max", "2"), "1",
+			`list.max validation "2" of number_integer cannot be checked`),
+		Entry("unknown validations", definition("boolean", "must_be", "true"), "true",
+			`must_be validation "true" of boolean cannot be checked`),
+	)
+})
+
+var _ = Describe("ValidateInputs", func() {
+	It("reports violations on the inputs", func() {
+		namespace := "custom"
+		defs := []model.MetafieldDefinition{*definition("number_integer", "max", "5")}
+		errs, err := metaobject.ValidateInputs(defs, []model.MetafieldsSetInput{
+			{OwnerID: "gid://shopify/Product/1", Namespace: &namespace, Key: "field", Value: "3"},
+			{OwnerID: "gid://shopify/Product/1", Namespace: &namespace, Key: "other", Value: "x"},
+			{OwnerID: "gid://shopify/Product/2", Namespace: &namespace, Key: "field", Value: "6"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal([]string{"metafields", "2", "value"}))
+		Expect(model.JoinUserErrors(errs)).To(MatchError(model.MetafieldsSetUserErrorCodeLessThanOrEqualTo))
+	})
+
+	It("reports the validations that cannot be checked", func() {
+		namespace := "custom"
+		defs := []model.MetafieldDefinition{*definition("single_line_text_field", "regex", "^(?!x)", "max", "2")}
+		errs, err := metaobject.ValidateInputs(defs, []model.MetafieldsSetInput{
+			{OwnerID: "gid://shopify/Product/1", Namespace: &namespace, Key: "field", Value: "xyz"},
+		})
+		Expect(err).To(MatchError(ContainSubstring(`metafields.0: regex validation "^(?!x)" cannot be checked`)))
+		Expect(codes(errs)).To(Equal([]model.MetafieldsSetUserErrorCode{model.MetafieldsSetUserErrorCodeTooLong}))
+	})
+})
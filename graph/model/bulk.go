@@ -0,0 +1,309 @@
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// BulkParentIDKey is the key holding the ID of the parent object in the lines of a bulk operation result.
+const BulkParentIDKey = "__parentId"
+
+// BulkOption configures ReadBulk.
+type BulkOption func(*bulkOptions)
+
+type bulkOptions struct {
+	query  string
+	decode []DecodeOption
+}
+
+// WithBulkQuery gives ReadBulk the bulk query whose result it reads, to place the children of an object into the
+// connection the query selected when the object has several connections of their type.
+func WithBulkQuery(query string) BulkOption {
+	return func(o *bulkOptions) {
+		o.query = query
+	}
+}
+
+// WithDecodeOptions makes ReadBulk pass opts to Decode for every root object.
+func WithDecodeOptions(opts ...DecodeOption) BulkOption {
+	return func(o *bulkOptions) {
+		o.decode = append(o.decode, opts...)
+	}
+}
+
+// ReadBulk returns an iterator over the root objects of the JSONL result of a bulk query, decoded into T, e.g.
+// Product. The objects of nested connections, which are separate lines referencing their parent by
+// BulkParentIDKey, are placed into the nodes of the connection field of their parent. The field is the connection
+// of the parent whose nodes are of the type of the object, given by its __typename or by the type of its global ID.
+// When the parent has several such connections, e.g. lineItems and nonFulfillableLineItems of an Order, the bulk
+// query given by WithBulkQuery tells which one was selected, and an error is yielded without it.
+//
+// The children of a root object must follow it, before the next root object, as in the results of Shopify, so
+// that only one root object is held in memory at a time. Errors are yielded with a nil object, after which the
+// iteration stops.
+func ReadBulk[T any](r io.Reader, opts ...BulkOption) Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		var o bulkOptions
+		for _, opt := range opts {
+			opt(&o)
+		}
+		var selection *bulkSelection
+		if o.query != "" {
+			var err error
+			if selection, err = parseBulkQuery(o.query); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+
+		reader := bufio.NewReader(r)
+		var root *bulkObject
+		objects := map[string]*bulkObject{}
+
+		emit := func() bool {
+			if root == nil {
+				return true
+			}
+			target := new(T)
+			if _, err := Decode(root.data, target, o.decode...); err != nil {
+				yield(nil, fmt.Errorf("decode bulk object %v: %w", root.data["id"], err))
+				return false
+			}
+			return yield(target, nil)
+		}
+
+		for line := 1; ; line++ {
+			b, err := reader.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(nil, fmt.Errorf("read bulk line %d: %w", line, err))
+				return
+			}
+			if b = bytes.TrimSpace(b); len(b) > 0 {
+				var data map[string]any
+				if err := json.Unmarshal(b, &data); err != nil {
+					yield(nil, fmt.Errorf("parse bulk line %d: %w", line, err))
+					return
+				}
+
+				parentID, isChild := data[BulkParentIDKey].(string)
+				if !isChild {
+					if !emit() {
+						return
+					}
+					root = &bulkObject{data: data, typ: reflect.TypeOf((*T)(nil)).Elem(), selection: selection}
+					objects = map[string]*bulkObject{}
+					root.index(objects)
+					continue
+				}
+
+				parent := objects[parentID]
+				if parent == nil {
+					yield(nil, fmt.Errorf("bulk line %d: unknown parent %s", line, parentID))
+					return
+				}
+				child, err := parent.add(data)
+				if err != nil {
+					yield(nil, fmt.Errorf("bulk line %d: %w", line, err))
+					return
+				}
+				child.index(objects)
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+		}
+		emit()
+	}
+}
+
+// bulkObject is an object of a bulk operation result, with the Go type it is decoded into and the selection of
+// its connections in the bulk query, if known.
+type bulkObject struct {
+	data      map[string]any
+	typ       reflect.Type
+	selection *bulkSelection
+}
+
+func (o *bulkObject) index(objects map[string]*bulkObject) {
+	if id, ok := o.data["id"].(string); ok {
+		objects[id] = o
+	}
+}
+
+// add places the child object data into the nodes of the matching connection field of o.
+func (o *bulkObject) add(data map[string]any) (*bulkObject, error) {
+	delete(data, BulkParentIDKey)
+	typeName, _ := data[types.GqlTypeNameKey].(string)
+	if typeName == "" {
+		id, _ := data["id"].(string)
+		typeName = gidType(id)
+		if typeName == "" {
+			return nil, fmt.Errorf("cannot tell the type of the child of %s without __typename or id", o.typ.Name())
+		}
+		// __typename is required to decode the children into interfaces
+		data[types.GqlTypeNameKey] = typeName
+	}
+
+	key, nodeType, err := connectionField(o.typ, typeName, o.selection)
+	if err != nil {
+		return nil, err
+	}
+	conn, _ := o.data[key].(map[string]any)
+	if conn == nil {
+		conn = map[string]any{}
+		o.data[key] = conn
+	}
+	nodes, _ := conn["nodes"].([]any)
+	conn["nodes"] = append(nodes, data)
+	return &bulkObject{data: data, typ: nodeType, selection: o.selection.connection(key)}, nil
+}
+
+// gidType returns the type of the global ID id, e.g. Product for gid://shopify/Product/1.
+func gidType(id string) string {
+	rest, ok := strings.CutPrefix(id, "gid://shopify/")
+	if !ok {
+		return ""
+	}
+	typeName, _, _ := strings.Cut(rest, "/")
+	return typeName
+}
+
+// connectionField returns the JSON key of the connection field of the struct typ whose nodes can hold an object of
+// type typeName, and the Go type of the object. With a selection, only the selected connections are considered.
+func connectionField(typ reflect.Type, typeName string, selection *bulkSelection) (string, reflect.Type, error) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	var keys []string
+	var objType reflect.Type
+	for i := 0; typ.Kind() == reflect.Struct && i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		getNodes, ok := field.Type.MethodByName("GetNodes")
+		if !ok || field.Type.Kind() != reflect.Pointer {
+			continue
+		}
+		key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if selection != nil && selection.connection(key) == nil {
+			continue
+		}
+		if t := bulkNodeType(getNodes.Type.Out(0).Elem(), typeName); t != nil {
+			keys = append(keys, key)
+			objType = t
+		}
+	}
+	switch len(keys) {
+	case 0:
+		return "", nil, fmt.Errorf("%s has no connection of %s", typ.Name(), typeName)
+	case 1:
+		return keys[0], objType, nil
+	}
+	return "", nil, fmt.Errorf("%s has several connections of %s (%s), pass the bulk query with WithBulkQuery",
+		typ.Name(), typeName, strings.Join(keys, ", "))
+}
+
+// bulkNodeType returns the Go type of an object of type typeName held by nodes of type nodeType, or nil if the
+// nodes cannot hold it.
+func bulkNodeType(nodeType reflect.Type, typeName string) reflect.Type {
+	switch nodeType.Kind() {
+	case reflect.Struct:
+		if nodeType.Name() == typeName {
+			return nodeType
+		}
+	case reflect.Interface:
+		obj, err := concludeObjectType(types.GqlTypeName(typeName))
+		if err == nil && reflect.TypeOf(obj).Implements(nodeType) {
+			return reflect.TypeOf(obj).Elem()
+		}
+	}
+	return nil
+}
+
+// bulkSelection holds the connections selected on an object by a bulk query, by JSON key.
+type bulkSelection struct {
+	connections map[string]*bulkSelection
+}
+
+// connection returns the selection of the nodes of the connection key, or nil if it is not selected or s is nil.
+func (s *bulkSelection) connection(key string) *bulkSelection {
+	if s == nil {
+		return nil
+	}
+	return s.connections[key]
+}
+
+// parseBulkQuery returns the selection of the nodes of the top-level connection of the bulk query.
+func parseBulkQuery(query string) (*bulkSelection, error) {
+	doc, err := parser.ParseQuery(&ast.Source{Name: "bulk query", Input: query})
+	if err != nil {
+		return nil, fmt.Errorf("parse bulk query: %w", err)
+	}
+	if len(doc.Operations) != 1 {
+		return nil, fmt.Errorf("parse bulk query: expected one operation, got %d", len(doc.Operations))
+	}
+	fields := selectedFields(doc, doc.Operations[0].SelectionSet)
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("parse bulk query: expected one top-level field, got %d", len(fields))
+	}
+	nodes, ok := connectionNodes(doc, fields[0])
+	if !ok {
+		return nil, fmt.Errorf("parse bulk query: %s is not a connection", fields[0].Name)
+	}
+	return newBulkSelection(doc, nodes), nil
+}
+
+func newBulkSelection(doc *ast.QueryDocument, set ast.SelectionSet) *bulkSelection {
+	s := &bulkSelection{connections: map[string]*bulkSelection{}}
+	for _, field := range selectedFields(doc, set) {
+		if nodes, ok := connectionNodes(doc, field); ok {
+			s.connections[field.Alias] = newBulkSelection(doc, nodes)
+		}
+	}
+	return s
+}
+
+// connectionNodes returns the selection of the nodes of field, selected through nodes or edges.node, and whether
+// field is a connection.
+func connectionNodes(doc *ast.QueryDocument, field *ast.Field) (ast.SelectionSet, bool) {
+	for _, f := range selectedFields(doc, field.SelectionSet) {
+		switch f.Name {
+		case "nodes":
+			return f.SelectionSet, true
+		case "edges":
+			for _, e := range selectedFields(doc, f.SelectionSet) {
+				if e.Name == "node" {
+					return e.SelectionSet, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// selectedFields returns the fields of set, including the ones of its fragments.
+func selectedFields(doc *ast.QueryDocument, set ast.SelectionSet) []*ast.Field {
+	var fields []*ast.Field
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			fields = append(fields, sel)
+		case *ast.InlineFragment:
+			fields = append(fields, selectedFields(doc, sel.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if def := doc.Fragments.ForName(sel.Name); def != nil {
+				fields = append(fields, selectedFields(doc, def.SelectionSet)...)
+			}
+		}
+	}
+	return fields
+}
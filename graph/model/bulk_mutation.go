@@ -0,0 +1,150 @@
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// BulkMutationSizeLimit is the default size limit of the JSONL file of the variables of a bulk mutation, 20 MB, as
+// documented in https://shopify.dev/docs/api/usage/bulk-operations/imports. Use BulkWriter.SetLimit when Shopify
+// accepts a different size for the shop or API version.
+const BulkMutationSizeLimit = 20 << 20
+
+// ErrBulkSizeLimit is returned by BulkWriter.Write when the line would exceed the size limit of the file.
+var ErrBulkSizeLimit = errors.New("bulk mutation file size limit exceeded")
+
+// BulkWriter writes the variables of the calls of a bulk mutation as JSONL, the format uploaded for
+// bulkOperationRunMutation.
+type BulkWriter struct {
+	w     io.Writer
+	limit int64
+	size  int64
+	lines int
+}
+
+// NewBulkWriter returns a BulkWriter writing to w with the BulkMutationSizeLimit.
+func NewBulkWriter(w io.Writer) *BulkWriter {
+	return &BulkWriter{w: w, limit: BulkMutationSizeLimit}
+}
+
+// SetLimit sets the size limit of the file in bytes, overriding the BulkMutationSizeLimit. A limit that is not
+// positive disables the check.
+func (w *BulkWriter) SetLimit(limit int64) {
+	w.limit = limit
+}
+
+// Write writes the variables of a call of the mutation, e.g. `map[string]any{"input": ProductSetInput{...}}`, as a
+// line and returns its index, which is the line number of its result. The input structs omit their nil and empty
+// fields. A variable or field is sent as null when it is set to nil in a map.
+// When the line would exceed the size limit, nothing is written and ErrBulkSizeLimit is returned.
+func (w *BulkWriter) Write(variables any) (int, error) {
+	b, err := json.Marshal(variables)
+	if err != nil {
+		return 0, fmt.Errorf("marshal bulk variables: %w", err)
+	}
+	if !bytes.HasPrefix(b, []byte("{")) {
+		return 0, fmt.Errorf("bulk variables must be an object, got %s", b)
+	}
+	b = append(b, '\n')
+	if w.limit > 0 && w.size+int64(len(b)) > w.limit {
+		return 0, ErrBulkSizeLimit
+	}
+	if _, err = w.w.Write(b); err != nil {
+		return 0, fmt.Errorf("write bulk variables: %w", err)
+	}
+	w.size += int64(len(b))
+	w.lines++
+	return w.lines - 1, nil
+}
+
+// Lines returns the number of written lines.
+func (w *BulkWriter) Lines() int {
+	return w.lines
+}
+
+// Size returns the number of written bytes.
+func (w *BulkWriter) Size() int64 {
+	return w.size
+}
+
+// BulkMutationResult is the result of a call of a bulk mutation.
+type BulkMutationResult[T any] struct {
+	// Line is the index of the line of the variables of the call, as returned by BulkWriter.Write.
+	Line int
+	// Payload is the payload of the mutation, e.g. ProductSetPayload, which is nil when the call failed.
+	Payload *T
+	// Errors holds the errors of the call.
+	Errors []BulkMutationError
+}
+
+// BulkMutationError is an error of a call of a bulk mutation.
+type BulkMutationError struct {
+	Message string `json:"message"`
+	Path    []any  `json:"path,omitempty"`
+}
+
+func (e BulkMutationError) Error() string {
+	return e.Message
+}
+
+// ReadBulkMutationResults returns an iterator over the results of a bulk mutation, read from its JSONL result file.
+// The payloads are decoded into T, e.g. ProductSetPayload. Errors reading the results are yielded with an empty
+// result, after which the iteration stops.
+func ReadBulkMutationResults[T any](r io.Reader, opts ...DecodeOption) Seq2[BulkMutationResult[T], error] {
+	return func(yield func(BulkMutationResult[T], error) bool) {
+		reader := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			b, err := reader.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(BulkMutationResult[T]{}, fmt.Errorf("read bulk result line %d: %w", line, err))
+				return
+			}
+			if b = bytes.TrimSpace(b); len(b) > 0 {
+				result, err := decodeBulkMutationResult[T](b, opts)
+				if err != nil {
+					yield(BulkMutationResult[T]{}, fmt.Errorf("bulk result line %d: %w", line, err))
+					return
+				}
+				if !yield(result, nil) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+		}
+	}
+}
+
+func decodeBulkMutationResult[T any](b []byte, opts []DecodeOption) (BulkMutationResult[T], error) {
+	var line struct {
+		Data       map[string]map[string]any `json:"data"`
+		Errors     []BulkMutationError       `json:"errors"`
+		LineNumber *int                      `json:"__lineNumber"`
+	}
+	if err := json.Unmarshal(b, &line); err != nil {
+		return BulkMutationResult[T]{}, fmt.Errorf("parse: %w", err)
+	}
+	if line.LineNumber == nil {
+		return BulkMutationResult[T]{}, fmt.Errorf("missing __lineNumber")
+	}
+	if len(line.Data) > 1 {
+		return BulkMutationResult[T]{}, fmt.Errorf("expected the payload of a single mutation, got %d", len(line.Data))
+	}
+
+	result := BulkMutationResult[T]{Line: *line.LineNumber, Errors: line.Errors}
+	for _, data := range line.Data {
+		if data == nil {
+			continue
+		}
+		result.Payload = new(T)
+		if _, err := Decode(data, result.Payload, opts...); err != nil {
+			return BulkMutationResult[T]{}, fmt.Errorf("decode payload: %w", err)
+		}
+	}
+	return result, nil
+}
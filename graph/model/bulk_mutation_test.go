@@ -0,0 +1,97 @@
+package model_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("BulkWriter", func() {
+	var (
+		buf    bytes.Buffer
+		writer *model.BulkWriter
+	)
+
+	BeforeEach(func() {
+		buf.Reset()
+		writer = model.NewBulkWriter(&buf)
+	})
+
+	It("writes a line per call", func() {
+		title := "Shirt"
+		line, err := writer.Write(map[string]any{"input": model.ProductSetInput{Title: &title}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(line).To(Equal(0))
+
+		line, err = writer.Write(map[string]any{"input": model.ProductSetInput{}, "synchronous": nil})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(line).To(Equal(1))
+
+		Expect(buf.String()).To(Equal(`{"input":{"title":"Shirt"}}
+{"input":{},"synchronous":null}
+`))
+		Expect(writer.Lines()).To(Equal(2))
+		Expect(writer.Size()).To(Equal(int64(buf.Len())))
+	})
+
+	It("enforces the size limit", func() {
+		writer.SetLimit(30)
+		_, err := writer.Write(map[string]any{"input": map[string]any{"title": "Shirt"}})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = writer.Write(map[string]any{"input": map[string]any{"title": "Shirt"}})
+		Expect(err).To(MatchError(model.ErrBulkSizeLimit))
+		Expect(writer.Lines()).To(Equal(1))
+
+		writer.SetLimit(0)
+		_, err = writer.Write(map[string]any{"input": map[string]any{"title": "Shirt"}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects variables that are not objects", func() {
+		_, err := writer.Write([]string{"a"})
+		Expect(err).To(MatchError(`bulk variables must be an object, got ["a"]`))
+	})
+})
+
+var _ = Describe("ReadBulkMutationResults", func() {
+	It("reads the payloads with their line", func() {
+		var results []model.BulkMutationResult[model.ProductSetPayload]
+		model.ReadBulkMutationResults[model.ProductSetPayload](strings.NewReader(bulkMutationJSONL))(
+			func(r model.BulkMutationResult[model.ProductSetPayload], err error) bool {
+				Expect(err).NotTo(HaveOccurred())
+				results = append(results, r)
+				return true
+			})
+
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Line).To(Equal(0))
+		Expect(results[0].Payload.Product.ID).To(Equal("gid://shopify/Product/1"))
+
+		Expect(results[1].Line).To(Equal(1))
+		Expect(results[1].Payload.Product).To(BeNil())
+		Expect(results[1].Payload.UserErrors).To(HaveLen(1))
+		Expect(results[1].Payload.UserErrors[0].Message).To(Equal("Title can't be blank"))
+
+		Expect(results[2].Payload).To(BeNil())
+		Expect(results[2].Errors).To(ConsistOf(model.BulkMutationError{Message: "Throttled"}))
+	})
+
+	It("requires the line number", func() {
+		var err error
+		model.ReadBulkMutationResults[model.ProductSetPayload](strings.NewReader(`{"data":{}}`))(
+			func(_ model.BulkMutationResult[model.ProductSetPayload], e error) bool {
+				err = e
+				return false
+			})
+		Expect(err).To(MatchError("bulk result line 1: missing __lineNumber"))
+	})
+})
+
+const bulkMutationJSONL = `{"data":{"productSet":{"product":{"id":"gid://shopify/Product/1"},"userErrors":[]}},"__lineNumber":0}
+{"data":{"productSet":{"product":null,"userErrors":[{"field":["input","title"],"message":"Title can't be blank"}]}},"__lineNumber":1}
+{"errors":[{"message":"Throttled"}],"data":{"productSet":null},"__lineNumber":2}
+`
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// bulkOperationTransitions holds the statuses a bulk operation can move to from each non terminal status.
+var bulkOperationTransitions = map[BulkOperationStatus][]BulkOperationStatus{
+	BulkOperationStatusCreated: {
+		BulkOperationStatusRunning, BulkOperationStatusCanceling, BulkOperationStatusCanceled,
+		BulkOperationStatusFailed,
+	},
+	BulkOperationStatusRunning: {
+		BulkOperationStatusCompleted, BulkOperationStatusFailed, BulkOperationStatusCanceling,
+		BulkOperationStatusCanceled, BulkOperationStatusExpired,
+	},
+	BulkOperationStatusCanceling: {BulkOperationStatusCanceled, BulkOperationStatusCompleted, BulkOperationStatusFailed},
+	BulkOperationStatusCompleted: {BulkOperationStatusExpired},
+}
+
+// IsTerminal reports whether a bulk operation with status e is over. A completed operation can still expire,
+// which only makes its result unavailable.
+func (e BulkOperationStatus) IsTerminal() bool {
+	switch e {
+	case BulkOperationStatusCompleted, BulkOperationStatusFailed, BulkOperationStatusCanceled,
+		BulkOperationStatusExpired:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo reports whether a bulk operation can move from status e to status next.
+func (e BulkOperationStatus) CanTransitionTo(next BulkOperationStatus) bool {
+	for _, s := range bulkOperationTransitions[e] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal reports whether the operation is over.
+func (op *BulkOperation) IsTerminal() bool {
+	return op.Status.IsTerminal()
+}
+
+// BulkOperationProgress is the progress of a bulk operation.
+type BulkOperationProgress struct {
+	// Objects is the number of objects processed so far, including the nested ones.
+	Objects uint64
+	// RootObjects is the number of root objects processed so far.
+	RootObjects uint64
+}
+
+// Fraction returns the fraction of total root objects processed, between 0 and 1.
+func (p BulkOperationProgress) Fraction(total uint64) float64 {
+	if total == 0 || p.RootObjects >= total {
+		return 1
+	}
+	return float64(p.RootObjects) / float64(total)
+}
+
+// Progress returns the number of objects processed by the operation.
+func (op *BulkOperation) Progress() (BulkOperationProgress, error) {
+	var p BulkOperationProgress
+	var err error
+	if p.Objects, err = parseCount(op.ObjectCount); err != nil {
+		return p, fmt.Errorf("parse objectCount: %w", err)
+	}
+	if p.RootObjects, err = parseCount(op.RootObjectCount); err != nil {
+		return p, fmt.Errorf("parse rootObjectCount: %w", err)
+	}
+	return p, nil
+}
+
+// parseCount parses an UnsignedInt64 count, which is empty when it was not selected.
+func parseCount(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// BulkOperationError is the error of a bulk operation that failed, was canceled or expired.
+type BulkOperationError struct {
+	ID     string
+	Status BulkOperationStatus
+	// Code is the error code of a failed operation, which is nil for the other statuses.
+	Code *BulkOperationErrorCode
+	// PartialDataURL is the URL of the data processed before the operation failed.
+	PartialDataURL *string
+}
+
+func (e *BulkOperationError) Error() string {
+	if e.Code != nil {
+		return fmt.Sprintf("bulk operation %s %s: %s", e.ID, e.Status, *e.Code)
+	}
+	return fmt.Sprintf("bulk operation %s %s", e.ID, e.Status)
+}
+
+// Retryable reports whether running the operation again may succeed, i.e. it failed with a timeout or an internal
+// server error.
+func (e *BulkOperationError) Retryable() bool {
+	if e.Code == nil {
+		return false
+	}
+	switch *e.Code {
+	case BulkOperationErrorCodeTimeout, BulkOperationErrorCodeInternalServerError:
+		return true
+	}
+	return false
+}
+
+// Err returns a *BulkOperationError when the operation failed, was canceled or expired, and nil otherwise.
+func (op *BulkOperation) Err() error {
+	switch op.Status {
+	case BulkOperationStatusFailed, BulkOperationStatusCanceled, BulkOperationStatusExpired:
+		return &BulkOperationError{ID: op.ID, Status: op.Status, Code: op.ErrorCode, PartialDataURL: op.PartialDataURL}
+	}
+	return nil
+}
+
+// Backoff returns the delay before the poll following attempt, starting at 0.
+type Backoff func(attempt int) time.Duration
+
+// ExponentialBackoff returns a Backoff doubling the delay from initial up to maxDelay.
+func ExponentialBackoff(initial, maxDelay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := initial
+		for i := 0; i < attempt && d < maxDelay; i++ {
+			d *= 2
+		}
+		return min(d, maxDelay)
+	}
+}
+
+// BulkOperationFetcher fetches the current state of a bulk operation, e.g. with the currentBulkOperation query.
+type BulkOperationFetcher func(ctx context.Context) (*BulkOperation, error)
+
+// PollBulkOperation fetches the bulk operation until it is over, waiting between the polls according to backoff,
+// and returns it with the error of its Err method. It stops with the error of fetch or ctx.
+func PollBulkOperation(ctx context.Context, fetch BulkOperationFetcher, backoff Backoff) (*BulkOperation, error) {
+	for attempt := 0; ; attempt++ {
+		op, err := fetch(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch bulk operation: %w", err)
+		}
+		if op == nil {
+			return nil, fmt.Errorf("fetch bulk operation: no bulk operation")
+		}
+		if op.IsTerminal() {
+			return op, op.Err()
+		}
+
+		timer := time.NewTimer(backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return op, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("BulkOperation", func() {
+	It("models the status lifecycle", func() {
+		Expect(model.BulkOperationStatusCreated.IsTerminal()).To(BeFalse())
+		Expect(model.BulkOperationStatusRunning.IsTerminal()).To(BeFalse())
+		Expect(model.BulkOperationStatusCanceling.IsTerminal()).To(BeFalse())
+		Expect(model.BulkOperationStatusCompleted.IsTerminal()).To(BeTrue())
+		Expect(model.BulkOperationStatusExpired.IsTerminal()).To(BeTrue())
+
+		Expect(model.BulkOperationStatusCreated.CanTransitionTo(model.BulkOperationStatusRunning)).To(BeTrue())
+		Expect(model.BulkOperationStatusRunning.CanTransitionTo(model.BulkOperationStatusCompleted)).To(BeTrue())
+		Expect(model.BulkOperationStatusCompleted.CanTransitionTo(model.BulkOperationStatusRunning)).To(BeFalse())
+		Expect(model.BulkOperationStatusFailed.CanTransitionTo(model.BulkOperationStatusCompleted)).To(BeFalse())
+	})
+
+	It("returns the progress", func() {
+		op := &model.BulkOperation{ObjectCount: "120", RootObjectCount: "40"}
+		p, err := op.Progress()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p).To(Equal(model.BulkOperationProgress{Objects: 120, RootObjects: 40}))
+		Expect(p.Fraction(80)).To(Equal(0.5))
+		Expect(p.Fraction(0)).To(Equal(1.0))
+
+		_, err = (&model.BulkOperation{ObjectCount: "x"}).Progress()
+		Expect(err).To(MatchError(ContainSubstring("parse objectCount")))
+	})
+
+	It("classifies errors", func() {
+		Expect((&model.BulkOperation{Status: model.BulkOperationStatusCompleted}).Err()).To(Succeed())
+
+		code := model.BulkOperationErrorCodeTimeout
+		err := (&model.BulkOperation{ID: "1", Status: model.BulkOperationStatusFailed, ErrorCode: &code}).Err()
+		var opErr *model.BulkOperationError
+		Expect(errors.As(err, &opErr)).To(BeTrue())
+		Expect(opErr.Retryable()).To(BeTrue())
+		Expect(err).To(MatchError("bulk operation 1 FAILED: TIMEOUT"))
+
+		code = model.BulkOperationErrorCodeAccessDenied
+		Expect(opErr.Retryable()).To(BeFalse())
+
+		err = (&model.BulkOperation{ID: "2", Status: model.BulkOperationStatusCanceled}).Err()
+		Expect(err).To(MatchError("bulk operation 2 CANCELED"))
+	})
+})
+
+var _ = Describe("PollBulkOperation", func() {
+	// fakeFetcher returns the operations with the given statuses, then the last one.
+	fakeFetcher := func(statuses ...model.BulkOperationStatus) (model.BulkOperationFetcher, *int) {
+		calls := 0
+		return func(context.Context) (*model.BulkOperation, error) {
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+			return &model.BulkOperation{ID: "1", Status: status}, nil
+		}, &calls
+	}
+	backoff := model.ExponentialBackoff(time.Millisecond, 4*time.Millisecond)
+
+	It("polls until the operation is over", func() {
+		fetch, calls := fakeFetcher(model.BulkOperationStatusCreated, model.BulkOperationStatusRunning,
+			model.BulkOperationStatusCompleted)
+		op, err := model.PollBulkOperation(context.Background(), fetch, backoff)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op.Status).To(Equal(model.BulkOperationStatusCompleted))
+		Expect(*calls).To(Equal(3))
+	})
+
+	It("returns the error of the operation", func() {
+		fetch, _ := fakeFetcher(model.BulkOperationStatusRunning, model.BulkOperationStatusFailed)
+		op, err := model.PollBulkOperation(context.Background(), fetch, backoff)
+		Expect(err).To(MatchError("bulk operation 1 FAILED"))
+		Expect(op.Status).To(Equal(model.BulkOperationStatusFailed))
+	})
+
+	It("returns the error of fetch", func() {
+		fetch := func(context.Context) (*model.BulkOperation, error) { return nil, errors.New("throttled") }
+		_, err := model.PollBulkOperation(context.Background(), fetch, backoff)
+		Expect(err).To(MatchError("fetch bulk operation: throttled"))
+	})
+
+	It("stops when the context is done", func() {
+		fetch, _ := fakeFetcher(model.BulkOperationStatusRunning)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		op, err := model.PollBulkOperation(ctx, fetch, backoff)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(op.Status).To(Equal(model.BulkOperationStatusRunning))
+	})
+
+	It("backs off exponentially", func() {
+		Expect(backoff(0)).To(Equal(time.Millisecond))
+		Expect(backoff(1)).To(Equal(2 * time.Millisecond))
+		Expect(backoff(5)).To(Equal(4 * time.Millisecond))
+	})
+})
@@ -0,0 +1,140 @@
+package model_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("ReadBulk", func() {
+	read := func(jsonl string, opts ...model.BulkOption) ([]*model.Product, error) {
+		var products []*model.Product
+		var err error
+		model.ReadBulk[model.Product](strings.NewReader(jsonl), opts...)(func(p *model.Product, e error) bool {
+			if e != nil {
+				err = e
+				return false
+			}
+			products = append(products, p)
+			return true
+		})
+		return products, err
+	}
+
+	It("reassembles the nested objects", func() {
+		products, err := read(bulkJSONL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(products).To(HaveLen(2))
+
+		product := products[0]
+		Expect(product.Title).To(Equal("Shirt"))
+		Expect(product.Variants.GetNodes()).To(HaveLen(2))
+		Expect(product.Variants.Nodes[1].Title).To(Equal("Large"))
+		Expect(product.Variants.Nodes[1].Metafields.Nodes).To(HaveLen(1))
+		Expect(product.Variants.Nodes[1].Metafields.Nodes[0].Value).To(Equal("cotton"))
+
+		Expect(product.Media.Nodes).To(HaveLen(1))
+		image, ok := product.Media.Nodes[0].(*model.MediaImage)
+		Expect(ok).To(BeTrue())
+		Expect(*image.Alt).To(Equal("front"))
+
+		Expect(products[1].Title).To(Equal("Hat"))
+		Expect(products[1].Variants).To(BeNil())
+	})
+
+	It("passes the decode options to Decode", func() {
+		const jsonl = `{"id":"gid://shopify/Product/1","status":"UPCOMING"}`
+		products, err := read(jsonl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(products[0].Status).To(Equal(model.ProductStatus("UPCOMING")))
+
+		_, err = read(jsonl, model.WithDecodeOptions(model.WithEnumPolicy(model.EnumStrict)))
+		Expect(err).To(MatchError(ContainSubstring("UPCOMING is not a valid ProductStatus")))
+	})
+
+	It("stops when the consumer stops", func() {
+		count := 0
+		model.ReadBulk[model.Product](strings.NewReader(bulkJSONL))(func(*model.Product, error) bool {
+			count++
+			return false
+		})
+		Expect(count).To(Equal(1))
+	})
+
+	It("rejects children of unknown parents", func() {
+		_, err := read(`{"id":"gid://shopify/ProductVariant/1","__parentId":"gid://shopify/Product/9"}`)
+		Expect(err).To(MatchError("bulk line 1: unknown parent gid://shopify/Product/9"))
+	})
+
+	It("rejects children without a matching connection", func() {
+		_, err := read(`{"id":"gid://shopify/Product/1"}
+{"id":"gid://shopify/Order/1","__parentId":"gid://shopify/Product/1"}`)
+		Expect(err).To(MatchError("bulk line 2: Product has no connection of Order"))
+	})
+
+	Context("with several connections of the same node type", func() {
+		const orderJSONL = `{"id":"gid://shopify/Order/1"}
+{"id":"gid://shopify/LineItem/1","__parentId":"gid://shopify/Order/1"}`
+
+		readOrders := func(opts ...model.BulkOption) ([]*model.Order, error) {
+			var orders []*model.Order
+			var err error
+			model.ReadBulk[model.Order](strings.NewReader(orderJSONL), opts...)(func(o *model.Order, e error) bool {
+				if e != nil {
+					err = e
+					return false
+				}
+				orders = append(orders, o)
+				return true
+			})
+			return orders, err
+		}
+
+		It("rejects children without the bulk query", func() {
+			_, err := readOrders()
+			Expect(err).To(MatchError(ContainSubstring(
+				"bulk line 2: Order has several connections of LineItem (")))
+			Expect(err).To(MatchError(ContainSubstring("nonFulfillableLineItems")))
+		})
+
+		It("places children into the connection of the bulk query", func() {
+			orders, err := readOrders(model.WithBulkQuery(`
+fragment items on Order {
+  nonFulfillableLineItems { edges { node { id } } }
+}
+{
+  orders {
+    nodes { id ...items }
+  }
+}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orders).To(HaveLen(1))
+			Expect(orders[0].LineItems).To(BeNil())
+			Expect(orders[0].NonFulfillableLineItems.Nodes).To(HaveLen(1))
+			Expect(orders[0].NonFulfillableLineItems.Nodes[0].ID).To(Equal("gid://shopify/LineItem/1"))
+		})
+
+		It("rejects invalid bulk queries", func() {
+			_, err := readOrders(model.WithBulkQuery(`{ shop { name } }`))
+			Expect(err).To(MatchError("parse bulk query: shop is not a connection"))
+		})
+	})
+
+	It("rejects invalid lines", func() {
+		_, err := read(`{"id":"gid://shopify/Product/1"}
+not json`)
+		Expect(err).To(MatchError(ContainSubstring("parse bulk line 2")))
+	})
+})
+
+const bulkJSONL = `{"id":"gid://shopify/Product/1","title":"Shirt"}
+{"id":"gid://shopify/ProductVariant/1","title":"Small","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/MediaImage/1","alt":"front","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/ProductVariant/2","title":"Large","__parentId":"gid://shopify/Product/1"}
+{"id":"gid://shopify/Metafield/1","__typename":"Metafield","value":"cotton","__parentId":"gid://shopify/ProductVariant/2"}
+
+{"id":"gid://shopify/Product/2","title":"Hat"}
+`
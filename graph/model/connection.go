@@ -0,0 +1,167 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxPageSize is the maximum number of nodes of a page of a connection.
+const MaxPageSize = 250
+
+// Connection is implemented by the generated connections, e.g. *ProductConnection implements Connection[Product].
+type Connection[T any] interface {
+	// GetNodes returns the nodes of the page.
+	GetNodes() []T
+	// GetPageInfo returns the page info, which is nil when it was not selected.
+	GetPageInfo() *PageInfo
+}
+
+// Edge holds a node of a connection and its cursor.
+type Edge[T any] struct {
+	Cursor string
+	Node   T
+}
+
+// mergePageInfo returns the page info of two consecutive pages of a connection.
+func mergePageInfo(first, next *PageInfo) *PageInfo {
+	switch {
+	case first == nil:
+		return next
+	case next == nil:
+		return first
+	}
+	return &PageInfo{
+		HasPreviousPage: first.HasPreviousPage,
+		StartCursor:     first.StartCursor,
+		HasNextPage:     next.HasNextPage,
+		EndCursor:       next.EndCursor,
+	}
+}
+
+// PageArgs holds the pagination arguments of a connection field.
+type PageArgs struct {
+	First  *int    `json:"first,omitempty"`
+	After  *string `json:"after,omitempty"`
+	Last   *int    `json:"last,omitempty"`
+	Before *string `json:"before,omitempty"`
+}
+
+// PageFetcher fetches the page of a connection selected by args.
+type PageFetcher[T any] func(ctx context.Context, args PageArgs) (Connection[T], error)
+
+// Seq2 is an iterator over pairs of values, compatible with iter.Seq2.
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// PaginateOption configures Paginate.
+type PaginateOption func(*paginateOptions)
+
+type paginateOptions struct {
+	pageSize int
+	limit    int
+	backward bool
+}
+
+// WithPageSize sets the number of nodes fetched per page, which defaults to and is capped at MaxPageSize.
+func WithPageSize(size int) PaginateOption {
+	return func(o *paginateOptions) {
+		o.pageSize = size
+	}
+}
+
+// WithLimit stops the pagination after limit nodes.
+func WithLimit(limit int) PaginateOption {
+	return func(o *paginateOptions) {
+		o.limit = limit
+	}
+}
+
+// Backward pages from the end of the connection with the last and before arguments. The nodes are yielded in
+// reverse order, starting with the last node of the connection.
+func Backward() PaginateOption {
+	return func(o *paginateOptions) {
+		o.backward = true
+	}
+}
+
+// Paginate returns an iterator over the nodes of a connection, fetching the pages with fetch as the nodes are
+// consumed. The fetched connections must select pageInfo with the cursors and flags of the paging direction.
+// Errors of fetch and the cancellation of ctx are yielded with the zero node, after which the iteration stops.
+// A nil connection, e.g. for a connection field that is null, ends the iteration.
+func Paginate[T any](ctx context.Context, fetch PageFetcher[T], opts ...PaginateOption) Seq2[T, error] {
+	o := &paginateOptions{pageSize: MaxPageSize}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.pageSize <= 0 || o.pageSize > MaxPageSize {
+		o.pageSize = MaxPageSize
+	}
+
+	return func(yield func(T, error) bool) {
+		var (
+			zero   T
+			cursor *string
+			count  int
+		)
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			size := o.pageSize
+			if o.limit > 0 && o.limit-count < size {
+				size = o.limit - count
+			}
+			args := PageArgs{First: &size, After: cursor}
+			if o.backward {
+				args = PageArgs{Last: &size, Before: cursor}
+			}
+
+			conn, err := fetch(ctx, args)
+			if err != nil {
+				yield(zero, fmt.Errorf("fetch page: %w", err))
+				return
+			}
+
+			if conn == nil {
+				return
+			}
+			nodes := conn.GetNodes()
+			for i := range nodes {
+				node := nodes[i]
+				if o.backward {
+					node = nodes[len(nodes)-1-i]
+				}
+				if !yield(node, nil) {
+					return
+				}
+				count++
+				if o.limit > 0 && count >= o.limit {
+					return
+				}
+			}
+
+			pageInfo := conn.GetPageInfo()
+			if pageInfo == nil && len(nodes) == 0 {
+				// The connection is null or empty, e.g. when its parent does not exist.
+				return
+			}
+			if pageInfo == nil {
+				yield(zero, fmt.Errorf("pageInfo is not selected"))
+				return
+			}
+			hasMore, next := pageInfo.HasNextPage, pageInfo.EndCursor
+			if o.backward {
+				hasMore, next = pageInfo.HasPreviousPage, pageInfo.StartCursor
+			}
+			if !hasMore || len(nodes) == 0 {
+				return
+			}
+			if next == nil {
+				yield(zero, fmt.Errorf("pageInfo has no cursor for the next page"))
+				return
+			}
+			cursor = next
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("Paginate", func() {
+	var (
+		products []model.Product
+		requests []model.PageArgs
+		fetch    model.PageFetcher[model.Product]
+	)
+
+	BeforeEach(func() {
+		products = nil
+		for i := 0; i < 5; i++ {
+			products = append(products, model.Product{ID: strconv.Itoa(i)})
+		}
+		requests = nil
+		// fetch serves products with the cursors being the indices of the products.
+		fetch = func(_ context.Context, args model.PageArgs) (model.Connection[model.Product], error) {
+			requests = append(requests, args)
+			start, end := 0, len(products)
+			if args.First != nil {
+				if args.After != nil {
+					start, _ = strconv.Atoi(*args.After)
+					start++
+				}
+				end = min(start+*args.First, len(products))
+			} else {
+				if args.Before != nil {
+					end, _ = strconv.Atoi(*args.Before)
+				}
+				start = max(end-*args.Last, 0)
+			}
+			startCursor, endCursor := strconv.Itoa(start), strconv.Itoa(end-1)
+			return &model.ProductConnection{
+				Nodes: products[start:end],
+				PageInfo: &model.PageInfo{
+					HasNextPage:     end < len(products),
+					HasPreviousPage: start > 0,
+					StartCursor:     &startCursor,
+					EndCursor:       &endCursor,
+				},
+			}, nil
+		}
+	})
+
+	collect := func(seq model.Seq2[model.Product, error]) ([]string, error) {
+		var ids []string
+		var err error
+		seq(func(p model.Product, e error) bool {
+			if e != nil {
+				err = e
+				return false
+			}
+			ids = append(ids, p.ID)
+			return true
+		})
+		return ids, err
+	}
+
+	It("yields the nodes of all pages", func() {
+		ids, err := collect(model.Paginate(context.Background(), fetch, model.WithPageSize(2)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(Equal([]string{"0", "1", "2", "3", "4"}))
+		Expect(requests).To(HaveLen(3))
+		Expect(*requests[0].First).To(Equal(2))
+		Expect(requests[0].After).To(BeNil())
+		Expect(*requests[1].After).To(Equal("1"))
+	})
+
+	It("pages backward", func() {
+		ids, err := collect(model.Paginate(context.Background(), fetch, model.WithPageSize(2), model.Backward()))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(Equal([]string{"4", "3", "2", "1", "0"}))
+		Expect(*requests[0].Last).To(Equal(2))
+		Expect(*requests[1].Before).To(Equal("3"))
+	})
+
+	It("caps the page size", func() {
+		_, err := collect(model.Paginate(context.Background(), fetch, model.WithPageSize(1000)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*requests[0].First).To(Equal(model.MaxPageSize))
+	})
+
+	It("stops at the limit", func() {
+		ids, err := collect(model.Paginate(context.Background(), fetch, model.WithPageSize(2), model.WithLimit(3)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(Equal([]string{"0", "1", "2"}))
+		Expect(*requests[1].First).To(Equal(1))
+	})
+
+	It("fetches lazily", func() {
+		model.Paginate(context.Background(), fetch, model.WithPageSize(2))(func(model.Product, error) bool {
+			return false
+		})
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("stops when the context is cancelled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		ids, err := collect(func(yield func(model.Product, error) bool) {
+			model.Paginate(ctx, fetch, model.WithPageSize(2))(func(p model.Product, err error) bool {
+				cancel()
+				return yield(p, err)
+			})
+		})
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(ids).To(Equal([]string{"0", "1"}))
+	})
+
+	It("yields the errors of fetch", func() {
+		failing := func(context.Context, model.PageArgs) (model.Connection[model.Product], error) {
+			return nil, errors.New("throttled")
+		}
+		_, err := collect(model.Paginate(context.Background(), failing))
+		Expect(err).To(MatchError("fetch page: throttled"))
+	})
+
+	It("requires pageInfo", func() {
+		noPageInfo := func(context.Context, model.PageArgs) (model.Connection[model.Product], error) {
+			return &model.ProductConnection{Nodes: products}, nil
+		}
+		_, err := collect(model.Paginate(context.Background(), noPageInfo))
+		Expect(err).To(MatchError("pageInfo is not selected"))
+	})
+
+	It("stops at null connections", func() {
+		null := func(context.Context, model.PageArgs) (model.Connection[model.Product], error) {
+			var conn *model.ProductConnection
+			return conn, nil
+		}
+		ids, err := collect(model.Paginate(context.Background(), null))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(BeEmpty())
+
+		var conn *model.ProductConnection
+		Expect(conn.GetNodes()).To(BeNil())
+		Expect(conn.GetEdges()).To(BeNil())
+		Expect(conn.GetPageInfo()).To(BeNil())
+	})
+
+	It("reads the nodes of edge-only connections", func() {
+		conn := &model.StringConnection{Edges: []model.StringEdge{{Cursor: "a", Node: "x"}, {Cursor: "b", Node: "y"}}}
+		var c model.Connection[string] = conn
+		Expect(c.GetNodes()).To(Equal([]string{"x", "y"}))
+	})
+})
+
+var _ = Describe("Connection", func() {
+	cursor := func(s string) *string { return &s }
+
+	It("reads the nodes from the edges when nodes was not selected", func() {
+		conn := &model.ProductConnection{Edges: []model.ProductEdge{
+			{Cursor: "a", Node: &model.Product{ID: "1"}},
+			{Cursor: "b"},
+			{Cursor: "c", Node: &model.Product{ID: "3"}},
+		}}
+		Expect(conn.GetNodes()).To(Equal([]model.Product{{ID: "1"}, {ID: "3"}}))
+		Expect(conn.GetEdges()).To(Equal([]model.Edge[model.Product]{
+			{Cursor: "a", Node: model.Product{ID: "1"}},
+			{Cursor: "c", Node: model.Product{ID: "3"}},
+		}))
+
+		conn = &model.ProductConnection{Nodes: []model.Product{{ID: "2"}}}
+		Expect(conn.GetNodes()).To(Equal([]model.Product{{ID: "2"}}))
+		Expect(conn.GetEdges()).To(BeEmpty())
+	})
+
+	It("merges pages", func() {
+		first := &model.ProductConnection{
+			Edges: []model.ProductEdge{{Cursor: "a", Node: &model.Product{ID: "1"}}},
+			Nodes: []model.Product{{ID: "1"}},
+			PageInfo: &model.PageInfo{
+				HasNextPage: true, StartCursor: cursor("a"), EndCursor: cursor("a"),
+			},
+		}
+		next := &model.ProductConnection{
+			Edges: []model.ProductEdge{{Cursor: "b", Node: &model.Product{ID: "2"}}},
+			Nodes: []model.Product{{ID: "2"}},
+			PageInfo: &model.PageInfo{
+				HasPreviousPage: true, StartCursor: cursor("b"), EndCursor: cursor("b"),
+			},
+		}
+
+		merged := first.Merge(next)
+		Expect(merged.GetNodes()).To(Equal([]model.Product{{ID: "1"}, {ID: "2"}}))
+		Expect(merged.Edges).To(HaveLen(2))
+		Expect(merged.PageInfo).To(Equal(&model.PageInfo{StartCursor: cursor("a"), EndCursor: cursor("b")}))
+		Expect(first.Nodes).To(HaveLen(1))
+
+		var empty *model.ProductConnection
+		Expect(empty.Merge(first).Merge(next).GetNodes()).To(HaveLen(2))
+		Expect(first.Merge(nil)).To(Equal(first))
+	})
+})
@@ -0,0 +1,9451 @@
+// Code generated by github.com/gempages/go-shopify-graphql-model, DO NOT EDIT.
+
+package model
+
+import (
+	"slices"
+)
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AbandonedCheckoutConnection) GetNodes() []AbandonedCheckout {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AbandonedCheckout, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AbandonedCheckoutConnection) GetEdges() []Edge[AbandonedCheckout] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AbandonedCheckout], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AbandonedCheckout]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AbandonedCheckoutConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AbandonedCheckoutConnection) Merge(next *AbandonedCheckoutConnection) *AbandonedCheckoutConnection {
+	if c == nil {
+		c = &AbandonedCheckoutConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AbandonedCheckoutLineItemConnection) GetNodes() []AbandonedCheckoutLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AbandonedCheckoutLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AbandonedCheckoutLineItemConnection) GetEdges() []Edge[AbandonedCheckoutLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AbandonedCheckoutLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AbandonedCheckoutLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AbandonedCheckoutLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AbandonedCheckoutLineItemConnection) Merge(next *AbandonedCheckoutLineItemConnection) *AbandonedCheckoutLineItemConnection {
+	if c == nil {
+		c = &AbandonedCheckoutLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppConnection) GetNodes() []App {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]App, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppConnection) GetEdges() []Edge[App] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[App], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[App]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppConnection) Merge(next *AppConnection) *AppConnection {
+	if c == nil {
+		c = &AppConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppCreditConnection) GetNodes() []AppCredit {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AppCredit, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppCreditConnection) GetEdges() []Edge[AppCredit] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AppCredit], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AppCredit]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppCreditConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppCreditConnection) Merge(next *AppCreditConnection) *AppCreditConnection {
+	if c == nil {
+		c = &AppCreditConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppInstallationConnection) GetNodes() []AppInstallation {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AppInstallation, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppInstallationConnection) GetEdges() []Edge[AppInstallation] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AppInstallation], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AppInstallation]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppInstallationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppInstallationConnection) Merge(next *AppInstallationConnection) *AppInstallationConnection {
+	if c == nil {
+		c = &AppInstallationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppPurchaseOneTimeConnection) GetNodes() []AppPurchaseOneTime {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AppPurchaseOneTime, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppPurchaseOneTimeConnection) GetEdges() []Edge[AppPurchaseOneTime] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AppPurchaseOneTime], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AppPurchaseOneTime]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppPurchaseOneTimeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppPurchaseOneTimeConnection) Merge(next *AppPurchaseOneTimeConnection) *AppPurchaseOneTimeConnection {
+	if c == nil {
+		c = &AppPurchaseOneTimeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppRevenueAttributionRecordConnection) GetNodes() []AppRevenueAttributionRecord {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AppRevenueAttributionRecord, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppRevenueAttributionRecordConnection) GetEdges() []Edge[AppRevenueAttributionRecord] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AppRevenueAttributionRecord], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AppRevenueAttributionRecord]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppRevenueAttributionRecordConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppRevenueAttributionRecordConnection) Merge(next *AppRevenueAttributionRecordConnection) *AppRevenueAttributionRecordConnection {
+	if c == nil {
+		c = &AppRevenueAttributionRecordConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppSubscriptionConnection) GetNodes() []AppSubscription {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AppSubscription, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppSubscriptionConnection) GetEdges() []Edge[AppSubscription] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AppSubscription], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AppSubscription]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppSubscriptionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppSubscriptionConnection) Merge(next *AppSubscriptionConnection) *AppSubscriptionConnection {
+	if c == nil {
+		c = &AppSubscriptionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *AppUsageRecordConnection) GetNodes() []AppUsageRecord {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]AppUsageRecord, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *AppUsageRecordConnection) GetEdges() []Edge[AppUsageRecord] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[AppUsageRecord], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[AppUsageRecord]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *AppUsageRecordConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *AppUsageRecordConnection) Merge(next *AppUsageRecordConnection) *AppUsageRecordConnection {
+	if c == nil {
+		c = &AppUsageRecordConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ArticleConnection) GetNodes() []Article {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Article, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ArticleConnection) GetEdges() []Edge[Article] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Article], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Article]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ArticleConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ArticleConnection) Merge(next *ArticleConnection) *ArticleConnection {
+	if c == nil {
+		c = &ArticleConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *BlogConnection) GetNodes() []Blog {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Blog, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *BlogConnection) GetEdges() []Edge[Blog] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Blog], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Blog]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *BlogConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *BlogConnection) Merge(next *BlogConnection) *BlogConnection {
+	if c == nil {
+		c = &BlogConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CalculatedDiscountApplicationConnection) GetNodes() []CalculatedDiscountApplication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CalculatedDiscountApplication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CalculatedDiscountApplicationConnection) GetEdges() []Edge[CalculatedDiscountApplication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CalculatedDiscountApplication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CalculatedDiscountApplication]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CalculatedDiscountApplicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CalculatedDiscountApplicationConnection) Merge(next *CalculatedDiscountApplicationConnection) *CalculatedDiscountApplicationConnection {
+	if c == nil {
+		c = &CalculatedDiscountApplicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CalculatedLineItemConnection) GetNodes() []CalculatedLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CalculatedLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CalculatedLineItemConnection) GetEdges() []Edge[CalculatedLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CalculatedLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CalculatedLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CalculatedLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CalculatedLineItemConnection) Merge(next *CalculatedLineItemConnection) *CalculatedLineItemConnection {
+	if c == nil {
+		c = &CalculatedLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CartTransformConnection) GetNodes() []CartTransform {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CartTransform, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CartTransformConnection) GetEdges() []Edge[CartTransform] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CartTransform], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CartTransform]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CartTransformConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CartTransformConnection) Merge(next *CartTransformConnection) *CartTransformConnection {
+	if c == nil {
+		c = &CartTransformConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CashTrackingAdjustmentConnection) GetNodes() []CashTrackingAdjustment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CashTrackingAdjustment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CashTrackingAdjustmentConnection) GetEdges() []Edge[CashTrackingAdjustment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CashTrackingAdjustment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CashTrackingAdjustment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CashTrackingAdjustmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CashTrackingAdjustmentConnection) Merge(next *CashTrackingAdjustmentConnection) *CashTrackingAdjustmentConnection {
+	if c == nil {
+		c = &CashTrackingAdjustmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CashTrackingSessionConnection) GetNodes() []CashTrackingSession {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CashTrackingSession, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CashTrackingSessionConnection) GetEdges() []Edge[CashTrackingSession] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CashTrackingSession], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CashTrackingSession]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CashTrackingSessionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CashTrackingSessionConnection) Merge(next *CashTrackingSessionConnection) *CashTrackingSessionConnection {
+	if c == nil {
+		c = &CashTrackingSessionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CatalogConnection) GetNodes() []Catalog {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Catalog, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CatalogConnection) GetEdges() []Edge[Catalog] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Catalog], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Catalog]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CatalogConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CatalogConnection) Merge(next *CatalogConnection) *CatalogConnection {
+	if c == nil {
+		c = &CatalogConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ChannelConnection) GetNodes() []Channel {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Channel, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ChannelConnection) GetEdges() []Edge[Channel] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Channel], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Channel]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ChannelConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ChannelConnection) Merge(next *ChannelConnection) *ChannelConnection {
+	if c == nil {
+		c = &ChannelConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CheckoutProfileConnection) GetNodes() []CheckoutProfile {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CheckoutProfile, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CheckoutProfileConnection) GetEdges() []Edge[CheckoutProfile] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CheckoutProfile], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CheckoutProfile]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CheckoutProfileConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CheckoutProfileConnection) Merge(next *CheckoutProfileConnection) *CheckoutProfileConnection {
+	if c == nil {
+		c = &CheckoutProfileConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CollectionConnection) GetNodes() []Collection {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Collection, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CollectionConnection) GetEdges() []Edge[Collection] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Collection], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Collection]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CollectionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CollectionConnection) Merge(next *CollectionConnection) *CollectionConnection {
+	if c == nil {
+		c = &CollectionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CollectionPublicationConnection) GetNodes() []CollectionPublication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CollectionPublication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CollectionPublicationConnection) GetEdges() []Edge[CollectionPublication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CollectionPublication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CollectionPublication]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CollectionPublicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CollectionPublicationConnection) Merge(next *CollectionPublicationConnection) *CollectionPublicationConnection {
+	if c == nil {
+		c = &CollectionPublicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CombinedListingChildConnection) GetNodes() []CombinedListingChild {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CombinedListingChild, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CombinedListingChildConnection) GetEdges() []Edge[CombinedListingChild] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CombinedListingChild], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CombinedListingChild]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CombinedListingChildConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CombinedListingChildConnection) Merge(next *CombinedListingChildConnection) *CombinedListingChildConnection {
+	if c == nil {
+		c = &CombinedListingChildConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CommentConnection) GetNodes() []Comment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Comment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CommentConnection) GetEdges() []Edge[Comment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Comment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Comment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CommentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CommentConnection) Merge(next *CommentConnection) *CommentConnection {
+	if c == nil {
+		c = &CommentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CompanyConnection) GetNodes() []Company {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Company, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CompanyConnection) GetEdges() []Edge[Company] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Company], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Company]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CompanyConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CompanyConnection) Merge(next *CompanyConnection) *CompanyConnection {
+	if c == nil {
+		c = &CompanyConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CompanyContactConnection) GetNodes() []CompanyContact {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CompanyContact, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CompanyContactConnection) GetEdges() []Edge[CompanyContact] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CompanyContact], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CompanyContact]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CompanyContactConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CompanyContactConnection) Merge(next *CompanyContactConnection) *CompanyContactConnection {
+	if c == nil {
+		c = &CompanyContactConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CompanyContactRoleAssignmentConnection) GetNodes() []CompanyContactRoleAssignment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CompanyContactRoleAssignment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CompanyContactRoleAssignmentConnection) GetEdges() []Edge[CompanyContactRoleAssignment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CompanyContactRoleAssignment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CompanyContactRoleAssignment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CompanyContactRoleAssignmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CompanyContactRoleAssignmentConnection) Merge(next *CompanyContactRoleAssignmentConnection) *CompanyContactRoleAssignmentConnection {
+	if c == nil {
+		c = &CompanyContactRoleAssignmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CompanyContactRoleConnection) GetNodes() []CompanyContactRole {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CompanyContactRole, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CompanyContactRoleConnection) GetEdges() []Edge[CompanyContactRole] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CompanyContactRole], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CompanyContactRole]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CompanyContactRoleConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CompanyContactRoleConnection) Merge(next *CompanyContactRoleConnection) *CompanyContactRoleConnection {
+	if c == nil {
+		c = &CompanyContactRoleConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CompanyLocationConnection) GetNodes() []CompanyLocation {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CompanyLocation, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CompanyLocationConnection) GetEdges() []Edge[CompanyLocation] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CompanyLocation], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CompanyLocation]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CompanyLocationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CompanyLocationConnection) Merge(next *CompanyLocationConnection) *CompanyLocationConnection {
+	if c == nil {
+		c = &CompanyLocationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CompanyLocationStaffMemberAssignmentConnection) GetNodes() []CompanyLocationStaffMemberAssignment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CompanyLocationStaffMemberAssignment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CompanyLocationStaffMemberAssignmentConnection) GetEdges() []Edge[CompanyLocationStaffMemberAssignment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CompanyLocationStaffMemberAssignment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CompanyLocationStaffMemberAssignment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CompanyLocationStaffMemberAssignmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CompanyLocationStaffMemberAssignmentConnection) Merge(next *CompanyLocationStaffMemberAssignmentConnection) *CompanyLocationStaffMemberAssignmentConnection {
+	if c == nil {
+		c = &CompanyLocationStaffMemberAssignmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CountryHarmonizedSystemCodeConnection) GetNodes() []CountryHarmonizedSystemCode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CountryHarmonizedSystemCode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CountryHarmonizedSystemCodeConnection) GetEdges() []Edge[CountryHarmonizedSystemCode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CountryHarmonizedSystemCode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CountryHarmonizedSystemCode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CountryHarmonizedSystemCodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CountryHarmonizedSystemCodeConnection) Merge(next *CountryHarmonizedSystemCodeConnection) *CountryHarmonizedSystemCodeConnection {
+	if c == nil {
+		c = &CountryHarmonizedSystemCodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CurrencySettingConnection) GetNodes() []CurrencySetting {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CurrencySetting, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CurrencySettingConnection) GetEdges() []Edge[CurrencySetting] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CurrencySetting], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CurrencySetting]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CurrencySettingConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CurrencySettingConnection) Merge(next *CurrencySettingConnection) *CurrencySettingConnection {
+	if c == nil {
+		c = &CurrencySettingConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CustomerAccountPageConnection) GetNodes() []CustomerAccountPage {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CustomerAccountPage, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CustomerAccountPageConnection) GetEdges() []Edge[CustomerAccountPage] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CustomerAccountPage], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CustomerAccountPage]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CustomerAccountPageConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CustomerAccountPageConnection) Merge(next *CustomerAccountPageConnection) *CustomerAccountPageConnection {
+	if c == nil {
+		c = &CustomerAccountPageConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CustomerConnection) GetNodes() []Customer {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Customer, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CustomerConnection) GetEdges() []Edge[Customer] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Customer], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Customer]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CustomerConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CustomerConnection) Merge(next *CustomerConnection) *CustomerConnection {
+	if c == nil {
+		c = &CustomerConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CustomerMomentConnection) GetNodes() []CustomerMoment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CustomerMoment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CustomerMomentConnection) GetEdges() []Edge[CustomerMoment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CustomerMoment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CustomerMoment]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CustomerMomentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CustomerMomentConnection) Merge(next *CustomerMomentConnection) *CustomerMomentConnection {
+	if c == nil {
+		c = &CustomerMomentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CustomerPaymentMethodConnection) GetNodes() []CustomerPaymentMethod {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CustomerPaymentMethod, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CustomerPaymentMethodConnection) GetEdges() []Edge[CustomerPaymentMethod] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CustomerPaymentMethod], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CustomerPaymentMethod]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CustomerPaymentMethodConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CustomerPaymentMethodConnection) Merge(next *CustomerPaymentMethodConnection) *CustomerPaymentMethodConnection {
+	if c == nil {
+		c = &CustomerPaymentMethodConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CustomerSegmentMemberConnection) GetNodes() []CustomerSegmentMember {
+	if c == nil {
+		return nil
+	}
+	nodes := make([]CustomerSegmentMember, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CustomerSegmentMemberConnection) GetEdges() []Edge[CustomerSegmentMember] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CustomerSegmentMember], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CustomerSegmentMember]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CustomerSegmentMemberConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CustomerSegmentMemberConnection) Merge(next *CustomerSegmentMemberConnection) *CustomerSegmentMemberConnection {
+	if c == nil {
+		c = &CustomerSegmentMemberConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *CustomerVisitProductInfoConnection) GetNodes() []CustomerVisitProductInfo {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]CustomerVisitProductInfo, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *CustomerVisitProductInfoConnection) GetEdges() []Edge[CustomerVisitProductInfo] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[CustomerVisitProductInfo], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[CustomerVisitProductInfo]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *CustomerVisitProductInfoConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *CustomerVisitProductInfoConnection) Merge(next *CustomerVisitProductInfoConnection) *CustomerVisitProductInfoConnection {
+	if c == nil {
+		c = &CustomerVisitProductInfoConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeletionEventConnection) GetNodes() []DeletionEvent {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeletionEvent, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeletionEventConnection) GetEdges() []Edge[DeletionEvent] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeletionEvent], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeletionEvent]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeletionEventConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeletionEventConnection) Merge(next *DeletionEventConnection) *DeletionEventConnection {
+	if c == nil {
+		c = &DeletionEventConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeliveryCarrierServiceConnection) GetNodes() []DeliveryCarrierService {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeliveryCarrierService, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeliveryCarrierServiceConnection) GetEdges() []Edge[DeliveryCarrierService] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeliveryCarrierService], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeliveryCarrierService]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeliveryCarrierServiceConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeliveryCarrierServiceConnection) Merge(next *DeliveryCarrierServiceConnection) *DeliveryCarrierServiceConnection {
+	if c == nil {
+		c = &DeliveryCarrierServiceConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeliveryCustomizationConnection) GetNodes() []DeliveryCustomization {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeliveryCustomization, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeliveryCustomizationConnection) GetEdges() []Edge[DeliveryCustomization] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeliveryCustomization], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeliveryCustomization]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeliveryCustomizationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeliveryCustomizationConnection) Merge(next *DeliveryCustomizationConnection) *DeliveryCustomizationConnection {
+	if c == nil {
+		c = &DeliveryCustomizationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeliveryLocationGroupZoneConnection) GetNodes() []DeliveryLocationGroupZone {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeliveryLocationGroupZone, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeliveryLocationGroupZoneConnection) GetEdges() []Edge[DeliveryLocationGroupZone] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeliveryLocationGroupZone], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeliveryLocationGroupZone]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeliveryLocationGroupZoneConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeliveryLocationGroupZoneConnection) Merge(next *DeliveryLocationGroupZoneConnection) *DeliveryLocationGroupZoneConnection {
+	if c == nil {
+		c = &DeliveryLocationGroupZoneConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeliveryMethodDefinitionConnection) GetNodes() []DeliveryMethodDefinition {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeliveryMethodDefinition, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeliveryMethodDefinitionConnection) GetEdges() []Edge[DeliveryMethodDefinition] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeliveryMethodDefinition], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeliveryMethodDefinition]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeliveryMethodDefinitionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeliveryMethodDefinitionConnection) Merge(next *DeliveryMethodDefinitionConnection) *DeliveryMethodDefinitionConnection {
+	if c == nil {
+		c = &DeliveryMethodDefinitionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeliveryProfileConnection) GetNodes() []DeliveryProfile {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeliveryProfile, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeliveryProfileConnection) GetEdges() []Edge[DeliveryProfile] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeliveryProfile], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeliveryProfile]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeliveryProfileConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeliveryProfileConnection) Merge(next *DeliveryProfileConnection) *DeliveryProfileConnection {
+	if c == nil {
+		c = &DeliveryProfileConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DeliveryProfileItemConnection) GetNodes() []DeliveryProfileItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DeliveryProfileItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DeliveryProfileItemConnection) GetEdges() []Edge[DeliveryProfileItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DeliveryProfileItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DeliveryProfileItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DeliveryProfileItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DeliveryProfileItemConnection) Merge(next *DeliveryProfileItemConnection) *DeliveryProfileItemConnection {
+	if c == nil {
+		c = &DeliveryProfileItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountAllocationConnection) GetNodes() []DiscountAllocation {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountAllocation, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountAllocationConnection) GetEdges() []Edge[DiscountAllocation] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountAllocation], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountAllocation]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountAllocationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountAllocationConnection) Merge(next *DiscountAllocationConnection) *DiscountAllocationConnection {
+	if c == nil {
+		c = &DiscountAllocationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountApplicationConnection) GetNodes() []DiscountApplication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountApplication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountApplicationConnection) GetEdges() []Edge[DiscountApplication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountApplication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountApplication]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountApplicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountApplicationConnection) Merge(next *DiscountApplicationConnection) *DiscountApplicationConnection {
+	if c == nil {
+		c = &DiscountApplicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountAutomaticConnection) GetNodes() []DiscountAutomatic {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountAutomatic, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountAutomaticConnection) GetEdges() []Edge[DiscountAutomatic] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountAutomatic], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountAutomatic]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountAutomaticConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountAutomaticConnection) Merge(next *DiscountAutomaticConnection) *DiscountAutomaticConnection {
+	if c == nil {
+		c = &DiscountAutomaticConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountAutomaticNodeConnection) GetNodes() []DiscountAutomaticNode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountAutomaticNode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountAutomaticNodeConnection) GetEdges() []Edge[DiscountAutomaticNode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountAutomaticNode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountAutomaticNode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountAutomaticNodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountAutomaticNodeConnection) Merge(next *DiscountAutomaticNodeConnection) *DiscountAutomaticNodeConnection {
+	if c == nil {
+		c = &DiscountAutomaticNodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountCodeNodeConnection) GetNodes() []DiscountCodeNode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountCodeNode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountCodeNodeConnection) GetEdges() []Edge[DiscountCodeNode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountCodeNode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountCodeNode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountCodeNodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountCodeNodeConnection) Merge(next *DiscountCodeNodeConnection) *DiscountCodeNodeConnection {
+	if c == nil {
+		c = &DiscountCodeNodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountNodeConnection) GetNodes() []DiscountNode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountNode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountNodeConnection) GetEdges() []Edge[DiscountNode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountNode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountNode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountNodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountNodeConnection) Merge(next *DiscountNodeConnection) *DiscountNodeConnection {
+	if c == nil {
+		c = &DiscountNodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountRedeemCodeBulkCreationCodeConnection) GetNodes() []DiscountRedeemCodeBulkCreationCode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountRedeemCodeBulkCreationCode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountRedeemCodeBulkCreationCodeConnection) GetEdges() []Edge[DiscountRedeemCodeBulkCreationCode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountRedeemCodeBulkCreationCode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountRedeemCodeBulkCreationCode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountRedeemCodeBulkCreationCodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountRedeemCodeBulkCreationCodeConnection) Merge(next *DiscountRedeemCodeBulkCreationCodeConnection) *DiscountRedeemCodeBulkCreationCodeConnection {
+	if c == nil {
+		c = &DiscountRedeemCodeBulkCreationCodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DiscountRedeemCodeConnection) GetNodes() []DiscountRedeemCode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DiscountRedeemCode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DiscountRedeemCodeConnection) GetEdges() []Edge[DiscountRedeemCode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DiscountRedeemCode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DiscountRedeemCode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DiscountRedeemCodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DiscountRedeemCodeConnection) Merge(next *DiscountRedeemCodeConnection) *DiscountRedeemCodeConnection {
+	if c == nil {
+		c = &DiscountRedeemCodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DraftOrderConnection) GetNodes() []DraftOrder {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DraftOrder, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DraftOrderConnection) GetEdges() []Edge[DraftOrder] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DraftOrder], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DraftOrder]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DraftOrderConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DraftOrderConnection) Merge(next *DraftOrderConnection) *DraftOrderConnection {
+	if c == nil {
+		c = &DraftOrderConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *DraftOrderLineItemConnection) GetNodes() []DraftOrderLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]DraftOrderLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *DraftOrderLineItemConnection) GetEdges() []Edge[DraftOrderLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[DraftOrderLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[DraftOrderLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *DraftOrderLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *DraftOrderLineItemConnection) Merge(next *DraftOrderLineItemConnection) *DraftOrderLineItemConnection {
+	if c == nil {
+		c = &DraftOrderLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *EventConnection) GetNodes() []Event {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Event, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *EventConnection) GetEdges() []Edge[Event] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Event], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Event]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *EventConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *EventConnection) Merge(next *EventConnection) *EventConnection {
+	if c == nil {
+		c = &EventConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ExchangeLineItemConnection) GetNodes() []ExchangeLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ExchangeLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ExchangeLineItemConnection) GetEdges() []Edge[ExchangeLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ExchangeLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ExchangeLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ExchangeLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ExchangeLineItemConnection) Merge(next *ExchangeLineItemConnection) *ExchangeLineItemConnection {
+	if c == nil {
+		c = &ExchangeLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ExchangeV2Connection) GetNodes() []ExchangeV2 {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ExchangeV2, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ExchangeV2Connection) GetEdges() []Edge[ExchangeV2] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ExchangeV2], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ExchangeV2]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ExchangeV2Connection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ExchangeV2Connection) Merge(next *ExchangeV2Connection) *ExchangeV2Connection {
+	if c == nil {
+		c = &ExchangeV2Connection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FileConnection) GetNodes() []File {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]File, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FileConnection) GetEdges() []Edge[File] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[File], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[File]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FileConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FileConnection) Merge(next *FileConnection) *FileConnection {
+	if c == nil {
+		c = &FileConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentConnection) GetNodes() []Fulfillment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Fulfillment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentConnection) GetEdges() []Edge[Fulfillment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Fulfillment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Fulfillment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentConnection) Merge(next *FulfillmentConnection) *FulfillmentConnection {
+	if c == nil {
+		c = &FulfillmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentEventConnection) GetNodes() []FulfillmentEvent {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]FulfillmentEvent, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentEventConnection) GetEdges() []Edge[FulfillmentEvent] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[FulfillmentEvent], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[FulfillmentEvent]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentEventConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentEventConnection) Merge(next *FulfillmentEventConnection) *FulfillmentEventConnection {
+	if c == nil {
+		c = &FulfillmentEventConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentLineItemConnection) GetNodes() []FulfillmentLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]FulfillmentLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentLineItemConnection) GetEdges() []Edge[FulfillmentLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[FulfillmentLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[FulfillmentLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentLineItemConnection) Merge(next *FulfillmentLineItemConnection) *FulfillmentLineItemConnection {
+	if c == nil {
+		c = &FulfillmentLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentOrderConnection) GetNodes() []FulfillmentOrder {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]FulfillmentOrder, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentOrderConnection) GetEdges() []Edge[FulfillmentOrder] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[FulfillmentOrder], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[FulfillmentOrder]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentOrderConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentOrderConnection) Merge(next *FulfillmentOrderConnection) *FulfillmentOrderConnection {
+	if c == nil {
+		c = &FulfillmentOrderConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentOrderLineItemConnection) GetNodes() []FulfillmentOrderLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]FulfillmentOrderLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentOrderLineItemConnection) GetEdges() []Edge[FulfillmentOrderLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[FulfillmentOrderLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[FulfillmentOrderLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentOrderLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentOrderLineItemConnection) Merge(next *FulfillmentOrderLineItemConnection) *FulfillmentOrderLineItemConnection {
+	if c == nil {
+		c = &FulfillmentOrderLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentOrderLocationForMoveConnection) GetNodes() []FulfillmentOrderLocationForMove {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]FulfillmentOrderLocationForMove, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentOrderLocationForMoveConnection) GetEdges() []Edge[FulfillmentOrderLocationForMove] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[FulfillmentOrderLocationForMove], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[FulfillmentOrderLocationForMove]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentOrderLocationForMoveConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentOrderLocationForMoveConnection) Merge(next *FulfillmentOrderLocationForMoveConnection) *FulfillmentOrderLocationForMoveConnection {
+	if c == nil {
+		c = &FulfillmentOrderLocationForMoveConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *FulfillmentOrderMerchantRequestConnection) GetNodes() []FulfillmentOrderMerchantRequest {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]FulfillmentOrderMerchantRequest, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *FulfillmentOrderMerchantRequestConnection) GetEdges() []Edge[FulfillmentOrderMerchantRequest] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[FulfillmentOrderMerchantRequest], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[FulfillmentOrderMerchantRequest]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *FulfillmentOrderMerchantRequestConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *FulfillmentOrderMerchantRequestConnection) Merge(next *FulfillmentOrderMerchantRequestConnection) *FulfillmentOrderMerchantRequestConnection {
+	if c == nil {
+		c = &FulfillmentOrderMerchantRequestConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *GiftCardConnection) GetNodes() []GiftCard {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]GiftCard, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *GiftCardConnection) GetEdges() []Edge[GiftCard] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[GiftCard], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[GiftCard]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *GiftCardConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *GiftCardConnection) Merge(next *GiftCardConnection) *GiftCardConnection {
+	if c == nil {
+		c = &GiftCardConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *GiftCardTransactionConnection) GetNodes() []GiftCardTransaction {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]GiftCardTransaction, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *GiftCardTransactionConnection) GetEdges() []Edge[GiftCardTransaction] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[GiftCardTransaction], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[GiftCardTransaction]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *GiftCardTransactionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *GiftCardTransactionConnection) Merge(next *GiftCardTransactionConnection) *GiftCardTransactionConnection {
+	if c == nil {
+		c = &GiftCardTransactionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ImageConnection) GetNodes() []Image {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Image, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ImageConnection) GetEdges() []Edge[Image] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Image], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Image]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ImageConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ImageConnection) Merge(next *ImageConnection) *ImageConnection {
+	if c == nil {
+		c = &ImageConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *InventoryItemConnection) GetNodes() []InventoryItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]InventoryItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *InventoryItemConnection) GetEdges() []Edge[InventoryItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[InventoryItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[InventoryItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *InventoryItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *InventoryItemConnection) Merge(next *InventoryItemConnection) *InventoryItemConnection {
+	if c == nil {
+		c = &InventoryItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *InventoryLevelConnection) GetNodes() []InventoryLevel {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]InventoryLevel, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *InventoryLevelConnection) GetEdges() []Edge[InventoryLevel] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[InventoryLevel], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[InventoryLevel]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *InventoryLevelConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *InventoryLevelConnection) Merge(next *InventoryLevelConnection) *InventoryLevelConnection {
+	if c == nil {
+		c = &InventoryLevelConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *InventoryScheduledChangeConnection) GetNodes() []InventoryScheduledChange {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]InventoryScheduledChange, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *InventoryScheduledChangeConnection) GetEdges() []Edge[InventoryScheduledChange] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[InventoryScheduledChange], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[InventoryScheduledChange]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *InventoryScheduledChangeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *InventoryScheduledChangeConnection) Merge(next *InventoryScheduledChangeConnection) *InventoryScheduledChangeConnection {
+	if c == nil {
+		c = &InventoryScheduledChangeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *LineItemConnection) GetNodes() []LineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]LineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *LineItemConnection) GetEdges() []Edge[LineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[LineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[LineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *LineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *LineItemConnection) Merge(next *LineItemConnection) *LineItemConnection {
+	if c == nil {
+		c = &LineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *LocalizationExtensionConnection) GetNodes() []LocalizationExtension {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]LocalizationExtension, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *LocalizationExtensionConnection) GetEdges() []Edge[LocalizationExtension] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[LocalizationExtension], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[LocalizationExtension]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *LocalizationExtensionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *LocalizationExtensionConnection) Merge(next *LocalizationExtensionConnection) *LocalizationExtensionConnection {
+	if c == nil {
+		c = &LocalizationExtensionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *LocationConnection) GetNodes() []Location {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Location, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *LocationConnection) GetEdges() []Edge[Location] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Location], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Location]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *LocationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *LocationConnection) Merge(next *LocationConnection) *LocationConnection {
+	if c == nil {
+		c = &LocationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MailingAddressConnection) GetNodes() []MailingAddress {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MailingAddress, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MailingAddressConnection) GetEdges() []Edge[MailingAddress] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MailingAddress], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MailingAddress]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MailingAddressConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MailingAddressConnection) Merge(next *MailingAddressConnection) *MailingAddressConnection {
+	if c == nil {
+		c = &MailingAddressConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketCatalogConnection) GetNodes() []MarketCatalog {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MarketCatalog, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketCatalogConnection) GetEdges() []Edge[MarketCatalog] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MarketCatalog], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MarketCatalog]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketCatalogConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketCatalogConnection) Merge(next *MarketCatalogConnection) *MarketCatalogConnection {
+	if c == nil {
+		c = &MarketCatalogConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketConnection) GetNodes() []Market {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Market, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketConnection) GetEdges() []Edge[Market] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Market], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Market]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketConnection) Merge(next *MarketConnection) *MarketConnection {
+	if c == nil {
+		c = &MarketConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketLocalizableResourceConnection) GetNodes() []MarketLocalizableResource {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MarketLocalizableResource, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketLocalizableResourceConnection) GetEdges() []Edge[MarketLocalizableResource] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MarketLocalizableResource], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MarketLocalizableResource]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketLocalizableResourceConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketLocalizableResourceConnection) Merge(next *MarketLocalizableResourceConnection) *MarketLocalizableResourceConnection {
+	if c == nil {
+		c = &MarketLocalizableResourceConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketRegionConnection) GetNodes() []MarketRegion {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MarketRegion, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketRegionConnection) GetEdges() []Edge[MarketRegion] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MarketRegion], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MarketRegion]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketRegionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketRegionConnection) Merge(next *MarketRegionConnection) *MarketRegionConnection {
+	if c == nil {
+		c = &MarketRegionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketWebPresenceConnection) GetNodes() []MarketWebPresence {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MarketWebPresence, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketWebPresenceConnection) GetEdges() []Edge[MarketWebPresence] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MarketWebPresence], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MarketWebPresence]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketWebPresenceConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketWebPresenceConnection) Merge(next *MarketWebPresenceConnection) *MarketWebPresenceConnection {
+	if c == nil {
+		c = &MarketWebPresenceConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketingActivityConnection) GetNodes() []MarketingActivity {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MarketingActivity, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketingActivityConnection) GetEdges() []Edge[MarketingActivity] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MarketingActivity], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MarketingActivity]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketingActivityConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketingActivityConnection) Merge(next *MarketingActivityConnection) *MarketingActivityConnection {
+	if c == nil {
+		c = &MarketingActivityConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MarketingEventConnection) GetNodes() []MarketingEvent {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MarketingEvent, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MarketingEventConnection) GetEdges() []Edge[MarketingEvent] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MarketingEvent], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MarketingEvent]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MarketingEventConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MarketingEventConnection) Merge(next *MarketingEventConnection) *MarketingEventConnection {
+	if c == nil {
+		c = &MarketingEventConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MediaConnection) GetNodes() []Media {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Media, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MediaConnection) GetEdges() []Edge[Media] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Media], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Media]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MediaConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MediaConnection) Merge(next *MediaConnection) *MediaConnection {
+	if c == nil {
+		c = &MediaConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MenuConnection) GetNodes() []Menu {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Menu, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MenuConnection) GetEdges() []Edge[Menu] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Menu], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Menu]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MenuConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MenuConnection) Merge(next *MenuConnection) *MenuConnection {
+	if c == nil {
+		c = &MenuConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetafieldConnection) GetNodes() []Metafield {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Metafield, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetafieldConnection) GetEdges() []Edge[Metafield] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Metafield], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Metafield]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetafieldConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetafieldConnection) Merge(next *MetafieldConnection) *MetafieldConnection {
+	if c == nil {
+		c = &MetafieldConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetafieldDefinitionConnection) GetNodes() []MetafieldDefinition {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MetafieldDefinition, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetafieldDefinitionConnection) GetEdges() []Edge[MetafieldDefinition] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MetafieldDefinition], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MetafieldDefinition]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetafieldDefinitionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetafieldDefinitionConnection) Merge(next *MetafieldDefinitionConnection) *MetafieldDefinitionConnection {
+	if c == nil {
+		c = &MetafieldDefinitionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetafieldDefinitionConstraintValueConnection) GetNodes() []MetafieldDefinitionConstraintValue {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MetafieldDefinitionConstraintValue, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetafieldDefinitionConstraintValueConnection) GetEdges() []Edge[MetafieldDefinitionConstraintValue] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MetafieldDefinitionConstraintValue], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MetafieldDefinitionConstraintValue]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetafieldDefinitionConstraintValueConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetafieldDefinitionConstraintValueConnection) Merge(next *MetafieldDefinitionConstraintValueConnection) *MetafieldDefinitionConstraintValueConnection {
+	if c == nil {
+		c = &MetafieldDefinitionConstraintValueConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetafieldReferenceConnection) GetNodes() []MetafieldReference {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MetafieldReference, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetafieldReferenceConnection) GetEdges() []Edge[MetafieldReference] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MetafieldReference], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MetafieldReference]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetafieldReferenceConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetafieldReferenceConnection) Merge(next *MetafieldReferenceConnection) *MetafieldReferenceConnection {
+	if c == nil {
+		c = &MetafieldReferenceConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetafieldRelationConnection) GetNodes() []MetafieldRelation {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MetafieldRelation, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetafieldRelationConnection) GetEdges() []Edge[MetafieldRelation] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MetafieldRelation], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MetafieldRelation]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetafieldRelationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetafieldRelationConnection) Merge(next *MetafieldRelationConnection) *MetafieldRelationConnection {
+	if c == nil {
+		c = &MetafieldRelationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetafieldStorefrontVisibilityConnection) GetNodes() []MetafieldStorefrontVisibility {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MetafieldStorefrontVisibility, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetafieldStorefrontVisibilityConnection) GetEdges() []Edge[MetafieldStorefrontVisibility] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MetafieldStorefrontVisibility], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MetafieldStorefrontVisibility]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetafieldStorefrontVisibilityConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetafieldStorefrontVisibilityConnection) Merge(next *MetafieldStorefrontVisibilityConnection) *MetafieldStorefrontVisibilityConnection {
+	if c == nil {
+		c = &MetafieldStorefrontVisibilityConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetaobjectConnection) GetNodes() []Metaobject {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Metaobject, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetaobjectConnection) GetEdges() []Edge[Metaobject] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Metaobject], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Metaobject]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetaobjectConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetaobjectConnection) Merge(next *MetaobjectConnection) *MetaobjectConnection {
+	if c == nil {
+		c = &MetaobjectConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MetaobjectDefinitionConnection) GetNodes() []MetaobjectDefinition {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MetaobjectDefinition, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MetaobjectDefinitionConnection) GetEdges() []Edge[MetaobjectDefinition] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MetaobjectDefinition], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MetaobjectDefinition]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MetaobjectDefinitionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MetaobjectDefinitionConnection) Merge(next *MetaobjectDefinitionConnection) *MetaobjectDefinitionConnection {
+	if c == nil {
+		c = &MetaobjectDefinitionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *MobilePlatformApplicationConnection) GetNodes() []MobilePlatformApplication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]MobilePlatformApplication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *MobilePlatformApplicationConnection) GetEdges() []Edge[MobilePlatformApplication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[MobilePlatformApplication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[MobilePlatformApplication]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *MobilePlatformApplicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *MobilePlatformApplicationConnection) Merge(next *MobilePlatformApplicationConnection) *MobilePlatformApplicationConnection {
+	if c == nil {
+		c = &MobilePlatformApplicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *OnlineStoreThemeConnection) GetNodes() []OnlineStoreTheme {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]OnlineStoreTheme, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *OnlineStoreThemeConnection) GetEdges() []Edge[OnlineStoreTheme] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[OnlineStoreTheme], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[OnlineStoreTheme]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *OnlineStoreThemeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *OnlineStoreThemeConnection) Merge(next *OnlineStoreThemeConnection) *OnlineStoreThemeConnection {
+	if c == nil {
+		c = &OnlineStoreThemeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *OnlineStoreThemeFileConnection) GetNodes() []OnlineStoreThemeFile {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]OnlineStoreThemeFile, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *OnlineStoreThemeFileConnection) GetEdges() []Edge[OnlineStoreThemeFile] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[OnlineStoreThemeFile], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[OnlineStoreThemeFile]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *OnlineStoreThemeFileConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *OnlineStoreThemeFileConnection) Merge(next *OnlineStoreThemeFileConnection) *OnlineStoreThemeFileConnection {
+	if c == nil {
+		c = &OnlineStoreThemeFileConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *OrderAdjustmentConnection) GetNodes() []OrderAdjustment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]OrderAdjustment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *OrderAdjustmentConnection) GetEdges() []Edge[OrderAdjustment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[OrderAdjustment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[OrderAdjustment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *OrderAdjustmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *OrderAdjustmentConnection) Merge(next *OrderAdjustmentConnection) *OrderAdjustmentConnection {
+	if c == nil {
+		c = &OrderAdjustmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *OrderConnection) GetNodes() []Order {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Order, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *OrderConnection) GetEdges() []Edge[Order] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Order], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Order]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *OrderConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *OrderConnection) Merge(next *OrderConnection) *OrderConnection {
+	if c == nil {
+		c = &OrderConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *OrderStagedChangeConnection) GetNodes() []OrderStagedChange {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]OrderStagedChange, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *OrderStagedChangeConnection) GetEdges() []Edge[OrderStagedChange] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[OrderStagedChange], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[OrderStagedChange]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *OrderStagedChangeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *OrderStagedChangeConnection) Merge(next *OrderStagedChangeConnection) *OrderStagedChangeConnection {
+	if c == nil {
+		c = &OrderStagedChangeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *OrderTransactionConnection) GetNodes() []OrderTransaction {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]OrderTransaction, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *OrderTransactionConnection) GetEdges() []Edge[OrderTransaction] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[OrderTransaction], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[OrderTransaction]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *OrderTransactionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *OrderTransactionConnection) Merge(next *OrderTransactionConnection) *OrderTransactionConnection {
+	if c == nil {
+		c = &OrderTransactionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PageConnection) GetNodes() []Page {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Page, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PageConnection) GetEdges() []Edge[Page] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Page], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Page]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PageConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PageConnection) Merge(next *PageConnection) *PageConnection {
+	if c == nil {
+		c = &PageConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PaymentCustomizationConnection) GetNodes() []PaymentCustomization {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]PaymentCustomization, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PaymentCustomizationConnection) GetEdges() []Edge[PaymentCustomization] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[PaymentCustomization], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[PaymentCustomization]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PaymentCustomizationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PaymentCustomizationConnection) Merge(next *PaymentCustomizationConnection) *PaymentCustomizationConnection {
+	if c == nil {
+		c = &PaymentCustomizationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PaymentScheduleConnection) GetNodes() []PaymentSchedule {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]PaymentSchedule, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PaymentScheduleConnection) GetEdges() []Edge[PaymentSchedule] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[PaymentSchedule], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[PaymentSchedule]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PaymentScheduleConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PaymentScheduleConnection) Merge(next *PaymentScheduleConnection) *PaymentScheduleConnection {
+	if c == nil {
+		c = &PaymentScheduleConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PriceListConnection) GetNodes() []PriceList {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]PriceList, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PriceListConnection) GetEdges() []Edge[PriceList] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[PriceList], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[PriceList]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PriceListConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PriceListConnection) Merge(next *PriceListConnection) *PriceListConnection {
+	if c == nil {
+		c = &PriceListConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PriceListPriceConnection) GetNodes() []PriceListPrice {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]PriceListPrice, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PriceListPriceConnection) GetEdges() []Edge[PriceListPrice] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[PriceListPrice], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[PriceListPrice]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PriceListPriceConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PriceListPriceConnection) Merge(next *PriceListPriceConnection) *PriceListPriceConnection {
+	if c == nil {
+		c = &PriceListPriceConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PriceRuleDiscountCodeConnection) GetNodes() []PriceRuleDiscountCode {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]PriceRuleDiscountCode, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PriceRuleDiscountCodeConnection) GetEdges() []Edge[PriceRuleDiscountCode] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[PriceRuleDiscountCode], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[PriceRuleDiscountCode]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PriceRuleDiscountCodeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PriceRuleDiscountCodeConnection) Merge(next *PriceRuleDiscountCodeConnection) *PriceRuleDiscountCodeConnection {
+	if c == nil {
+		c = &PriceRuleDiscountCodeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PrivateMetafieldConnection) GetNodes() []PrivateMetafield {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]PrivateMetafield, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PrivateMetafieldConnection) GetEdges() []Edge[PrivateMetafield] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[PrivateMetafield], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[PrivateMetafield]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PrivateMetafieldConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PrivateMetafieldConnection) Merge(next *PrivateMetafieldConnection) *PrivateMetafieldConnection {
+	if c == nil {
+		c = &PrivateMetafieldConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductBundleComponentConnection) GetNodes() []ProductBundleComponent {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ProductBundleComponent, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductBundleComponentConnection) GetEdges() []Edge[ProductBundleComponent] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ProductBundleComponent], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ProductBundleComponent]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductBundleComponentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductBundleComponentConnection) Merge(next *ProductBundleComponentConnection) *ProductBundleComponentConnection {
+	if c == nil {
+		c = &ProductBundleComponentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductConnection) GetNodes() []Product {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Product, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductConnection) GetEdges() []Edge[Product] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Product], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Product]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductConnection) Merge(next *ProductConnection) *ProductConnection {
+	if c == nil {
+		c = &ProductConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductFeedConnection) GetNodes() []ProductFeed {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ProductFeed, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductFeedConnection) GetEdges() []Edge[ProductFeed] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ProductFeed], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ProductFeed]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductFeedConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductFeedConnection) Merge(next *ProductFeedConnection) *ProductFeedConnection {
+	if c == nil {
+		c = &ProductFeedConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductPublicationConnection) GetNodes() []ProductPublication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ProductPublication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductPublicationConnection) GetEdges() []Edge[ProductPublication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ProductPublication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ProductPublication]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductPublicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductPublicationConnection) Merge(next *ProductPublicationConnection) *ProductPublicationConnection {
+	if c == nil {
+		c = &ProductPublicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductVariantComponentConnection) GetNodes() []ProductVariantComponent {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ProductVariantComponent, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductVariantComponentConnection) GetEdges() []Edge[ProductVariantComponent] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ProductVariantComponent], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ProductVariantComponent]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductVariantComponentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductVariantComponentConnection) Merge(next *ProductVariantComponentConnection) *ProductVariantComponentConnection {
+	if c == nil {
+		c = &ProductVariantComponentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductVariantConnection) GetNodes() []ProductVariant {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ProductVariant, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductVariantConnection) GetEdges() []Edge[ProductVariant] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ProductVariant], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ProductVariant]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductVariantConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductVariantConnection) Merge(next *ProductVariantConnection) *ProductVariantConnection {
+	if c == nil {
+		c = &ProductVariantConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ProductVariantPricePairConnection) GetNodes() []ProductVariantPricePair {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ProductVariantPricePair, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ProductVariantPricePairConnection) GetEdges() []Edge[ProductVariantPricePair] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ProductVariantPricePair], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ProductVariantPricePair]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ProductVariantPricePairConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ProductVariantPricePairConnection) Merge(next *ProductVariantPricePairConnection) *ProductVariantPricePairConnection {
+	if c == nil {
+		c = &ProductVariantPricePairConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *PublicationConnection) GetNodes() []Publication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Publication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *PublicationConnection) GetEdges() []Edge[Publication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Publication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Publication]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *PublicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *PublicationConnection) Merge(next *PublicationConnection) *PublicationConnection {
+	if c == nil {
+		c = &PublicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *QuantityPriceBreakConnection) GetNodes() []QuantityPriceBreak {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]QuantityPriceBreak, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *QuantityPriceBreakConnection) GetEdges() []Edge[QuantityPriceBreak] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[QuantityPriceBreak], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[QuantityPriceBreak]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *QuantityPriceBreakConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *QuantityPriceBreakConnection) Merge(next *QuantityPriceBreakConnection) *QuantityPriceBreakConnection {
+	if c == nil {
+		c = &QuantityPriceBreakConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *QuantityRuleConnection) GetNodes() []QuantityRule {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]QuantityRule, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *QuantityRuleConnection) GetEdges() []Edge[QuantityRule] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[QuantityRule], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[QuantityRule]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *QuantityRuleConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *QuantityRuleConnection) Merge(next *QuantityRuleConnection) *QuantityRuleConnection {
+	if c == nil {
+		c = &QuantityRuleConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *RefundConnection) GetNodes() []Refund {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Refund, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *RefundConnection) GetEdges() []Edge[Refund] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Refund], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Refund]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *RefundConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *RefundConnection) Merge(next *RefundConnection) *RefundConnection {
+	if c == nil {
+		c = &RefundConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *RefundLineItemConnection) GetNodes() []RefundLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]RefundLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *RefundLineItemConnection) GetEdges() []Edge[RefundLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[RefundLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[RefundLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *RefundLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *RefundLineItemConnection) Merge(next *RefundLineItemConnection) *RefundLineItemConnection {
+	if c == nil {
+		c = &RefundLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *RefundShippingLineConnection) GetNodes() []RefundShippingLine {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]RefundShippingLine, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *RefundShippingLineConnection) GetEdges() []Edge[RefundShippingLine] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[RefundShippingLine], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[RefundShippingLine]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *RefundShippingLineConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *RefundShippingLineConnection) Merge(next *RefundShippingLineConnection) *RefundShippingLineConnection {
+	if c == nil {
+		c = &RefundShippingLineConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ResourcePublicationConnection) GetNodes() []ResourcePublication {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ResourcePublication, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ResourcePublicationConnection) GetEdges() []Edge[ResourcePublication] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ResourcePublication], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ResourcePublication]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ResourcePublicationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ResourcePublicationConnection) Merge(next *ResourcePublicationConnection) *ResourcePublicationConnection {
+	if c == nil {
+		c = &ResourcePublicationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ResourcePublicationV2Connection) GetNodes() []ResourcePublicationV2 {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ResourcePublicationV2, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ResourcePublicationV2Connection) GetEdges() []Edge[ResourcePublicationV2] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ResourcePublicationV2], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ResourcePublicationV2]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ResourcePublicationV2Connection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ResourcePublicationV2Connection) Merge(next *ResourcePublicationV2Connection) *ResourcePublicationV2Connection {
+	if c == nil {
+		c = &ResourcePublicationV2Connection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReturnConnection) GetNodes() []Return {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Return, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReturnConnection) GetEdges() []Edge[Return] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Return], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Return]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReturnConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReturnConnection) Merge(next *ReturnConnection) *ReturnConnection {
+	if c == nil {
+		c = &ReturnConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReturnLineItemTypeConnection) GetNodes() []ReturnLineItemType {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReturnLineItemType, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReturnLineItemTypeConnection) GetEdges() []Edge[ReturnLineItemType] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReturnLineItemType], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReturnLineItemType]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReturnLineItemTypeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReturnLineItemTypeConnection) Merge(next *ReturnLineItemTypeConnection) *ReturnLineItemTypeConnection {
+	if c == nil {
+		c = &ReturnLineItemTypeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReturnableFulfillmentConnection) GetNodes() []ReturnableFulfillment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReturnableFulfillment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReturnableFulfillmentConnection) GetEdges() []Edge[ReturnableFulfillment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReturnableFulfillment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReturnableFulfillment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReturnableFulfillmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReturnableFulfillmentConnection) Merge(next *ReturnableFulfillmentConnection) *ReturnableFulfillmentConnection {
+	if c == nil {
+		c = &ReturnableFulfillmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReturnableFulfillmentLineItemConnection) GetNodes() []ReturnableFulfillmentLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReturnableFulfillmentLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReturnableFulfillmentLineItemConnection) GetEdges() []Edge[ReturnableFulfillmentLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReturnableFulfillmentLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReturnableFulfillmentLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReturnableFulfillmentLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReturnableFulfillmentLineItemConnection) Merge(next *ReturnableFulfillmentLineItemConnection) *ReturnableFulfillmentLineItemConnection {
+	if c == nil {
+		c = &ReturnableFulfillmentLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReverseDeliveryConnection) GetNodes() []ReverseDelivery {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReverseDelivery, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReverseDeliveryConnection) GetEdges() []Edge[ReverseDelivery] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReverseDelivery], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReverseDelivery]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReverseDeliveryConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReverseDeliveryConnection) Merge(next *ReverseDeliveryConnection) *ReverseDeliveryConnection {
+	if c == nil {
+		c = &ReverseDeliveryConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReverseDeliveryLineItemConnection) GetNodes() []ReverseDeliveryLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReverseDeliveryLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReverseDeliveryLineItemConnection) GetEdges() []Edge[ReverseDeliveryLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReverseDeliveryLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReverseDeliveryLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReverseDeliveryLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReverseDeliveryLineItemConnection) Merge(next *ReverseDeliveryLineItemConnection) *ReverseDeliveryLineItemConnection {
+	if c == nil {
+		c = &ReverseDeliveryLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReverseFulfillmentOrderConnection) GetNodes() []ReverseFulfillmentOrder {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReverseFulfillmentOrder, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReverseFulfillmentOrderConnection) GetEdges() []Edge[ReverseFulfillmentOrder] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReverseFulfillmentOrder], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReverseFulfillmentOrder]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReverseFulfillmentOrderConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReverseFulfillmentOrderConnection) Merge(next *ReverseFulfillmentOrderConnection) *ReverseFulfillmentOrderConnection {
+	if c == nil {
+		c = &ReverseFulfillmentOrderConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ReverseFulfillmentOrderLineItemConnection) GetNodes() []ReverseFulfillmentOrderLineItem {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ReverseFulfillmentOrderLineItem, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ReverseFulfillmentOrderLineItemConnection) GetEdges() []Edge[ReverseFulfillmentOrderLineItem] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ReverseFulfillmentOrderLineItem], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ReverseFulfillmentOrderLineItem]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ReverseFulfillmentOrderLineItemConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ReverseFulfillmentOrderLineItemConnection) Merge(next *ReverseFulfillmentOrderLineItemConnection) *ReverseFulfillmentOrderLineItemConnection {
+	if c == nil {
+		c = &ReverseFulfillmentOrderLineItemConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SaleConnection) GetNodes() []Sale {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Sale, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SaleConnection) GetEdges() []Edge[Sale] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Sale], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Sale]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SaleConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SaleConnection) Merge(next *SaleConnection) *SaleConnection {
+	if c == nil {
+		c = &SaleConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SalesAgreementConnection) GetNodes() []SalesAgreement {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SalesAgreement, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SalesAgreementConnection) GetEdges() []Edge[SalesAgreement] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SalesAgreement], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SalesAgreement]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SalesAgreementConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SalesAgreementConnection) Merge(next *SalesAgreementConnection) *SalesAgreementConnection {
+	if c == nil {
+		c = &SalesAgreementConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SavedSearchConnection) GetNodes() []SavedSearch {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SavedSearch, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SavedSearchConnection) GetEdges() []Edge[SavedSearch] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SavedSearch], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SavedSearch]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SavedSearchConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SavedSearchConnection) Merge(next *SavedSearchConnection) *SavedSearchConnection {
+	if c == nil {
+		c = &SavedSearchConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ScriptTagConnection) GetNodes() []ScriptTag {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ScriptTag, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ScriptTagConnection) GetEdges() []Edge[ScriptTag] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ScriptTag], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ScriptTag]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ScriptTagConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ScriptTagConnection) Merge(next *ScriptTagConnection) *ScriptTagConnection {
+	if c == nil {
+		c = &ScriptTagConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SearchResultConnection) GetNodes() []SearchResult {
+	if c == nil {
+		return nil
+	}
+	nodes := make([]SearchResult, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SearchResultConnection) GetEdges() []Edge[SearchResult] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SearchResult], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SearchResult]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SearchResultConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SearchResultConnection) Merge(next *SearchResultConnection) *SearchResultConnection {
+	if c == nil {
+		c = &SearchResultConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SegmentConnection) GetNodes() []Segment {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Segment, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SegmentConnection) GetEdges() []Edge[Segment] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Segment], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Segment]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SegmentConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SegmentConnection) Merge(next *SegmentConnection) *SegmentConnection {
+	if c == nil {
+		c = &SegmentConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SegmentFilterConnection) GetNodes() []SegmentFilter {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SegmentFilter, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SegmentFilterConnection) GetEdges() []Edge[SegmentFilter] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SegmentFilter], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SegmentFilter]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SegmentFilterConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SegmentFilterConnection) Merge(next *SegmentFilterConnection) *SegmentFilterConnection {
+	if c == nil {
+		c = &SegmentFilterConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SegmentMigrationConnection) GetNodes() []SegmentMigration {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SegmentMigration, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SegmentMigrationConnection) GetEdges() []Edge[SegmentMigration] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SegmentMigration], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SegmentMigration]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SegmentMigrationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SegmentMigrationConnection) Merge(next *SegmentMigrationConnection) *SegmentMigrationConnection {
+	if c == nil {
+		c = &SegmentMigrationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SegmentValueConnection) GetNodes() []SegmentValue {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SegmentValue, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SegmentValueConnection) GetEdges() []Edge[SegmentValue] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SegmentValue], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SegmentValue]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SegmentValueConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SegmentValueConnection) Merge(next *SegmentValueConnection) *SegmentValueConnection {
+	if c == nil {
+		c = &SegmentValueConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SellingPlanConnection) GetNodes() []SellingPlan {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SellingPlan, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SellingPlanConnection) GetEdges() []Edge[SellingPlan] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SellingPlan], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SellingPlan]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SellingPlanConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SellingPlanConnection) Merge(next *SellingPlanConnection) *SellingPlanConnection {
+	if c == nil {
+		c = &SellingPlanConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SellingPlanGroupConnection) GetNodes() []SellingPlanGroup {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SellingPlanGroup, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SellingPlanGroupConnection) GetEdges() []Edge[SellingPlanGroup] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SellingPlanGroup], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SellingPlanGroup]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SellingPlanGroupConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SellingPlanGroupConnection) Merge(next *SellingPlanGroupConnection) *SellingPlanGroupConnection {
+	if c == nil {
+		c = &SellingPlanGroupConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ShippingLineConnection) GetNodes() []ShippingLine {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ShippingLine, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ShippingLineConnection) GetEdges() []Edge[ShippingLine] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ShippingLine], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ShippingLine]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ShippingLineConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ShippingLineConnection) Merge(next *ShippingLineConnection) *ShippingLineConnection {
+	if c == nil {
+		c = &ShippingLineConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ShopifyFunctionConnection) GetNodes() []ShopifyFunction {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ShopifyFunction, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ShopifyFunctionConnection) GetEdges() []Edge[ShopifyFunction] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ShopifyFunction], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ShopifyFunction]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ShopifyFunctionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ShopifyFunctionConnection) Merge(next *ShopifyFunctionConnection) *ShopifyFunctionConnection {
+	if c == nil {
+		c = &ShopifyFunctionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ShopifyPaymentsBalanceTransactionConnection) GetNodes() []ShopifyPaymentsBalanceTransaction {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ShopifyPaymentsBalanceTransaction, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ShopifyPaymentsBalanceTransactionConnection) GetEdges() []Edge[ShopifyPaymentsBalanceTransaction] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ShopifyPaymentsBalanceTransaction], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ShopifyPaymentsBalanceTransaction]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ShopifyPaymentsBalanceTransactionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ShopifyPaymentsBalanceTransactionConnection) Merge(next *ShopifyPaymentsBalanceTransactionConnection) *ShopifyPaymentsBalanceTransactionConnection {
+	if c == nil {
+		c = &ShopifyPaymentsBalanceTransactionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ShopifyPaymentsBankAccountConnection) GetNodes() []ShopifyPaymentsBankAccount {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ShopifyPaymentsBankAccount, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ShopifyPaymentsBankAccountConnection) GetEdges() []Edge[ShopifyPaymentsBankAccount] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ShopifyPaymentsBankAccount], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ShopifyPaymentsBankAccount]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ShopifyPaymentsBankAccountConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ShopifyPaymentsBankAccountConnection) Merge(next *ShopifyPaymentsBankAccountConnection) *ShopifyPaymentsBankAccountConnection {
+	if c == nil {
+		c = &ShopifyPaymentsBankAccountConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ShopifyPaymentsDisputeConnection) GetNodes() []ShopifyPaymentsDispute {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ShopifyPaymentsDispute, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ShopifyPaymentsDisputeConnection) GetEdges() []Edge[ShopifyPaymentsDispute] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ShopifyPaymentsDispute], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ShopifyPaymentsDispute]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ShopifyPaymentsDisputeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ShopifyPaymentsDisputeConnection) Merge(next *ShopifyPaymentsDisputeConnection) *ShopifyPaymentsDisputeConnection {
+	if c == nil {
+		c = &ShopifyPaymentsDisputeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ShopifyPaymentsPayoutConnection) GetNodes() []ShopifyPaymentsPayout {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]ShopifyPaymentsPayout, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ShopifyPaymentsPayoutConnection) GetEdges() []Edge[ShopifyPaymentsPayout] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[ShopifyPaymentsPayout], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[ShopifyPaymentsPayout]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ShopifyPaymentsPayoutConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ShopifyPaymentsPayoutConnection) Merge(next *ShopifyPaymentsPayoutConnection) *ShopifyPaymentsPayoutConnection {
+	if c == nil {
+		c = &ShopifyPaymentsPayoutConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *StaffMemberConnection) GetNodes() []StaffMember {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]StaffMember, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *StaffMemberConnection) GetEdges() []Edge[StaffMember] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[StaffMember], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[StaffMember]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *StaffMemberConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *StaffMemberConnection) Merge(next *StaffMemberConnection) *StaffMemberConnection {
+	if c == nil {
+		c = &StaffMemberConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *StandardMetafieldDefinitionTemplateConnection) GetNodes() []StandardMetafieldDefinitionTemplate {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]StandardMetafieldDefinitionTemplate, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *StandardMetafieldDefinitionTemplateConnection) GetEdges() []Edge[StandardMetafieldDefinitionTemplate] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[StandardMetafieldDefinitionTemplate], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[StandardMetafieldDefinitionTemplate]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *StandardMetafieldDefinitionTemplateConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *StandardMetafieldDefinitionTemplateConnection) Merge(next *StandardMetafieldDefinitionTemplateConnection) *StandardMetafieldDefinitionTemplateConnection {
+	if c == nil {
+		c = &StandardMetafieldDefinitionTemplateConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *StoreCreditAccountConnection) GetNodes() []StoreCreditAccount {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]StoreCreditAccount, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *StoreCreditAccountConnection) GetEdges() []Edge[StoreCreditAccount] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[StoreCreditAccount], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[StoreCreditAccount]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *StoreCreditAccountConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *StoreCreditAccountConnection) Merge(next *StoreCreditAccountConnection) *StoreCreditAccountConnection {
+	if c == nil {
+		c = &StoreCreditAccountConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *StoreCreditAccountTransactionConnection) GetNodes() []StoreCreditAccountTransaction {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]StoreCreditAccountTransaction, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *StoreCreditAccountTransactionConnection) GetEdges() []Edge[StoreCreditAccountTransaction] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[StoreCreditAccountTransaction], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[StoreCreditAccountTransaction]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *StoreCreditAccountTransactionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *StoreCreditAccountTransactionConnection) Merge(next *StoreCreditAccountTransactionConnection) *StoreCreditAccountTransactionConnection {
+	if c == nil {
+		c = &StoreCreditAccountTransactionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *StorefrontAccessTokenConnection) GetNodes() []StorefrontAccessToken {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]StorefrontAccessToken, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *StorefrontAccessTokenConnection) GetEdges() []Edge[StorefrontAccessToken] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[StorefrontAccessToken], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[StorefrontAccessToken]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *StorefrontAccessTokenConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *StorefrontAccessTokenConnection) Merge(next *StorefrontAccessTokenConnection) *StorefrontAccessTokenConnection {
+	if c == nil {
+		c = &StorefrontAccessTokenConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *StringConnection) GetNodes() []string {
+	if c == nil {
+		return nil
+	}
+	nodes := make([]string, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *StringConnection) GetEdges() []Edge[string] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[string], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		edges = append(edges, Edge[string]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *StringConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *StringConnection) Merge(next *StringConnection) *StringConnection {
+	if c == nil {
+		c = &StringConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SubscriptionBillingAttemptConnection) GetNodes() []SubscriptionBillingAttempt {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SubscriptionBillingAttempt, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SubscriptionBillingAttemptConnection) GetEdges() []Edge[SubscriptionBillingAttempt] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SubscriptionBillingAttempt], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SubscriptionBillingAttempt]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SubscriptionBillingAttemptConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SubscriptionBillingAttemptConnection) Merge(next *SubscriptionBillingAttemptConnection) *SubscriptionBillingAttemptConnection {
+	if c == nil {
+		c = &SubscriptionBillingAttemptConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SubscriptionBillingCycleConnection) GetNodes() []SubscriptionBillingCycle {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SubscriptionBillingCycle, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SubscriptionBillingCycleConnection) GetEdges() []Edge[SubscriptionBillingCycle] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SubscriptionBillingCycle], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SubscriptionBillingCycle]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SubscriptionBillingCycleConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SubscriptionBillingCycleConnection) Merge(next *SubscriptionBillingCycleConnection) *SubscriptionBillingCycleConnection {
+	if c == nil {
+		c = &SubscriptionBillingCycleConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SubscriptionContractConnection) GetNodes() []SubscriptionContract {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SubscriptionContract, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SubscriptionContractConnection) GetEdges() []Edge[SubscriptionContract] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SubscriptionContract], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SubscriptionContract]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SubscriptionContractConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SubscriptionContractConnection) Merge(next *SubscriptionContractConnection) *SubscriptionContractConnection {
+	if c == nil {
+		c = &SubscriptionContractConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SubscriptionDiscountConnection) GetNodes() []SubscriptionDiscount {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SubscriptionDiscount, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SubscriptionDiscountConnection) GetEdges() []Edge[SubscriptionDiscount] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SubscriptionDiscount], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SubscriptionDiscount]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SubscriptionDiscountConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SubscriptionDiscountConnection) Merge(next *SubscriptionDiscountConnection) *SubscriptionDiscountConnection {
+	if c == nil {
+		c = &SubscriptionDiscountConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SubscriptionLineConnection) GetNodes() []SubscriptionLine {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SubscriptionLine, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SubscriptionLineConnection) GetEdges() []Edge[SubscriptionLine] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SubscriptionLine], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SubscriptionLine]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SubscriptionLineConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SubscriptionLineConnection) Merge(next *SubscriptionLineConnection) *SubscriptionLineConnection {
+	if c == nil {
+		c = &SubscriptionLineConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *SubscriptionManualDiscountConnection) GetNodes() []SubscriptionManualDiscount {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]SubscriptionManualDiscount, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *SubscriptionManualDiscountConnection) GetEdges() []Edge[SubscriptionManualDiscount] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[SubscriptionManualDiscount], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[SubscriptionManualDiscount]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *SubscriptionManualDiscountConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *SubscriptionManualDiscountConnection) Merge(next *SubscriptionManualDiscountConnection) *SubscriptionManualDiscountConnection {
+	if c == nil {
+		c = &SubscriptionManualDiscountConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *TaxonomyCategoryAttributeConnection) GetNodes() []TaxonomyCategoryAttribute {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]TaxonomyCategoryAttribute, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *TaxonomyCategoryAttributeConnection) GetEdges() []Edge[TaxonomyCategoryAttribute] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[TaxonomyCategoryAttribute], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[TaxonomyCategoryAttribute]{Cursor: edge.Cursor, Node: edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *TaxonomyCategoryAttributeConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *TaxonomyCategoryAttributeConnection) Merge(next *TaxonomyCategoryAttributeConnection) *TaxonomyCategoryAttributeConnection {
+	if c == nil {
+		c = &TaxonomyCategoryAttributeConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *TaxonomyCategoryConnection) GetNodes() []TaxonomyCategory {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]TaxonomyCategory, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *TaxonomyCategoryConnection) GetEdges() []Edge[TaxonomyCategory] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[TaxonomyCategory], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[TaxonomyCategory]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *TaxonomyCategoryConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *TaxonomyCategoryConnection) Merge(next *TaxonomyCategoryConnection) *TaxonomyCategoryConnection {
+	if c == nil {
+		c = &TaxonomyCategoryConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *TaxonomyValueConnection) GetNodes() []TaxonomyValue {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]TaxonomyValue, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *TaxonomyValueConnection) GetEdges() []Edge[TaxonomyValue] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[TaxonomyValue], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[TaxonomyValue]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *TaxonomyValueConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *TaxonomyValueConnection) Merge(next *TaxonomyValueConnection) *TaxonomyValueConnection {
+	if c == nil {
+		c = &TaxonomyValueConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *TenderTransactionConnection) GetNodes() []TenderTransaction {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]TenderTransaction, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *TenderTransactionConnection) GetEdges() []Edge[TenderTransaction] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[TenderTransaction], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[TenderTransaction]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *TenderTransactionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *TenderTransactionConnection) Merge(next *TenderTransactionConnection) *TenderTransactionConnection {
+	if c == nil {
+		c = &TenderTransactionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *TranslatableResourceConnection) GetNodes() []TranslatableResource {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]TranslatableResource, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *TranslatableResourceConnection) GetEdges() []Edge[TranslatableResource] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[TranslatableResource], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[TranslatableResource]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *TranslatableResourceConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *TranslatableResourceConnection) Merge(next *TranslatableResourceConnection) *TranslatableResourceConnection {
+	if c == nil {
+		c = &TranslatableResourceConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *URLRedirectConnection) GetNodes() []URLRedirect {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]URLRedirect, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *URLRedirectConnection) GetEdges() []Edge[URLRedirect] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[URLRedirect], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[URLRedirect]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *URLRedirectConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *URLRedirectConnection) Merge(next *URLRedirectConnection) *URLRedirectConnection {
+	if c == nil {
+		c = &URLRedirectConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *ValidationConnection) GetNodes() []Validation {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]Validation, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *ValidationConnection) GetEdges() []Edge[Validation] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[Validation], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[Validation]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *ValidationConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *ValidationConnection) Merge(next *ValidationConnection) *ValidationConnection {
+	if c == nil {
+		c = &ValidationConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
+
+// GetNodes returns the nodes of the connection, read from the edges when nodes was not selected.
+func (c *WebhookSubscriptionConnection) GetNodes() []WebhookSubscription {
+	if c == nil {
+		return nil
+	}
+	if len(c.Nodes) > 0 || len(c.Edges) == 0 {
+		return c.Nodes
+	}
+	nodes := make([]WebhookSubscription, 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		nodes = append(nodes, *edge.Node)
+	}
+	return nodes
+}
+
+// GetEdges returns the cursors and nodes of the edges of the connection, skipping the edges without node.
+func (c *WebhookSubscriptionConnection) GetEdges() []Edge[WebhookSubscription] {
+	if c == nil {
+		return nil
+	}
+	edges := make([]Edge[WebhookSubscription], 0, len(c.Edges))
+	for _, edge := range c.Edges {
+		if edge.Node == nil {
+			continue
+		}
+		edges = append(edges, Edge[WebhookSubscription]{Cursor: edge.Cursor, Node: *edge.Node})
+	}
+	return edges
+}
+
+// GetPageInfo returns the page info of the connection.
+func (c *WebhookSubscriptionConnection) GetPageInfo() *PageInfo {
+	if c == nil {
+		return nil
+	}
+	return c.PageInfo
+}
+
+// Merge returns a connection holding the edges and nodes of c followed by the ones of next.
+// The page info spans both pages, the other fields are the ones of c.
+func (c *WebhookSubscriptionConnection) Merge(next *WebhookSubscriptionConnection) *WebhookSubscriptionConnection {
+	if c == nil {
+		c = &WebhookSubscriptionConnection{}
+	}
+	merged := *c
+	if next == nil {
+		return &merged
+	}
+	merged.Edges = slices.Concat(c.Edges, next.Edges)
+	merged.Nodes = slices.Concat(c.Nodes, next.Nodes)
+	merged.PageInfo = mergePageInfo(c.PageInfo, next.PageInfo)
+	return &merged
+}
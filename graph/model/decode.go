@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"time"
@@ -11,10 +12,49 @@ import (
 	"github.com/gempages/go-shopify-graphql-model/graph/types"
 )
 
+// DecodeOption configures Decode.
+type DecodeOption func(*decodeOptions)
+
+type decodeOptions struct {
+	fields     *FieldSet
+	enumPolicy *EnumPolicy
+}
+
+// WithFieldSet makes Decode record the JSON paths present in the input map into fields.
+func WithFieldSet(fields *FieldSet) DecodeOption {
+	return func(o *decodeOptions) {
+		o.fields = fields
+	}
+}
+
+// WithEnumPolicy makes Decode apply policy to enum values instead of the policy set by SetEnumPolicy.
+func WithEnumPolicy(policy EnumPolicy) DecodeOption {
+	return func(o *decodeOptions) {
+		o.enumPolicy = &policy
+	}
+}
+
 // Decode decodes the input map into an object of the type specified by GqlTypeNameKey.
 // If the target is a non-empty interface, it will decode the data into the concrete type.
-func Decode(data map[string]any, target any) (any, error) {
-	decoder, err := newDecoder(decodeHook, target)
+func Decode(data map[string]any, target any, opts ...DecodeOption) (any, error) {
+	var o decodeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.fields != nil {
+		*o.fields = FieldSet{}
+		o.fields.add("", data)
+	}
+	policy := CurrentEnumPolicy()
+	if o.enumPolicy != nil {
+		policy = *o.enumPolicy
+	}
+
+	return decode(data, target, policy)
+}
+
+func decode(data map[string]any, target any, policy EnumPolicy) (any, error) {
+	decoder, err := newDecoder(decodeHook(policy), target)
 	if err != nil {
 		return nil, fmt.Errorf("newDecoder: %w", err)
 	}
@@ -26,6 +66,18 @@ func Decode(data map[string]any, target any) (any, error) {
 	return target, nil
 }
 
+// Unmarshal parses the JSON-encoded data and decodes it into target with Decode.
+func Unmarshal(b []byte, target any, opts ...DecodeOption) error {
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	if _, err := Decode(m, target, opts...); err != nil {
+		return fmt.Errorf("decode %T: %w", target, err)
+	}
+	return nil
+}
+
 // newDecoder creates a new `mapstructure.Decoder` configured with a custom decode hook and output target.
 func newDecoder(decodeHook any, output any) (*mapstructure.Decoder, error) {
 	decoderConfig := &mapstructure.DecoderConfig{
@@ -44,7 +96,14 @@ func newDecoder(decodeHook any, output any) (*mapstructure.Decoder, error) {
 	return decoder, nil
 }
 
-func decodeHook(_ reflect.Type, to reflect.Type, data any) (any, error) {
+// decodeHook returns the hook converting the input data to the types of the models.
+func decodeHook(policy EnumPolicy) mapstructure.DecodeHookFuncType {
+	return func(_ reflect.Type, to reflect.Type, data any) (any, error) {
+		return decodeValue(to, data, policy)
+	}
+}
+
+func decodeValue(to reflect.Type, data any, policy EnumPolicy) (any, error) {
 	isInterface := to.Name() != "" && to.Kind() == reflect.Interface
 	// If the target is a non-empty interface, we need to decode the data into the concrete type
 	if m, ok := data.(map[string]any); ok && isInterface {
@@ -57,13 +116,22 @@ func decodeHook(_ reflect.Type, to reflect.Type, data any) (any, error) {
 			return nil, fmt.Errorf("concludeObjectType: %w", err)
 		}
 		// Calling Decode recursively to decode children interfaces
-		result, err := Decode(m, objType)
+		result, err := decode(m, objType, policy)
 		if err != nil {
 			return nil, fmt.Errorf("decode to interface %s: %w", to.Name(), err)
 		}
 		return result, nil
 	}
 
+	if s, ok := data.(string); ok && to.Kind() == reflect.String && to.Implements(enumType) {
+		// Handle validates enum values
+		e := reflect.ValueOf(s).Convert(to).Interface().(Enum)
+		if err := checkEnum(e, to.Name(), policy); err != nil {
+			return nil, fmt.Errorf("decode enum: %w", err)
+		}
+		return data, nil
+	}
+
 	if to == reflect.TypeOf(decimal.Decimal{}) {
 		// Handle converts types to decimal.Decimal
 		num, err := decodeDecimal(data)
@@ -85,6 +153,8 @@ func decodeHook(_ reflect.Type, to reflect.Type, data any) (any, error) {
 	return data, nil
 }
 
+var enumType = reflect.TypeOf((*Enum)(nil)).Elem()
+
 // decodeDecimal converts various types to decimal.Decimal.
 func decodeDecimal(data any) (*decimal.Decimal, error) {
 	switch v := data.(type) {
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// EnumPolicy decides how enum values that are not declared in the schema are decoded.
+type EnumPolicy int32
+
+const (
+	// EnumKeepUnknown keeps unknown enum values, which are flagged by the IsValid method of the enum.
+	EnumKeepUnknown EnumPolicy = iota
+	// EnumStrict rejects unknown enum values with an error.
+	EnumStrict
+)
+
+var enumPolicy atomic.Int32
+
+// SetEnumPolicy sets the policy applied by the UnmarshalJSON and UnmarshalText methods of the enums, and by
+// Decode unless WithEnumPolicy is given. The default policy is EnumKeepUnknown, so that values added to the schema
+// after the models were generated can still be decoded.
+func SetEnumPolicy(p EnumPolicy) {
+	enumPolicy.Store(int32(p))
+}
+
+// CurrentEnumPolicy returns the policy set by SetEnumPolicy.
+func CurrentEnumPolicy() EnumPolicy {
+	return EnumPolicy(enumPolicy.Load())
+}
+
+// Enum is implemented by the generated enums.
+//
+// IsValid is generated by gqlgen, whose UnmarshalGQL rejects the values it does not report as valid. IsKnown
+// reports whether the value was declared by the schema the models were generated from, and is what EnumPolicy is
+// checked against: an unknown value is not an error with EnumKeepUnknown, it is a value added by a newer API version.
+// String returns the value as sent over the wire, GoString renders unknown values as a conversion, e.g.
+// `model.ProductStatus("UPCOMING")`, so that they stand out from the declared constants.
+type Enum interface {
+	types.Enum
+	IsKnown() bool
+	GoString() string
+}
+
+// checkEnum applies policy to the decoded value e of the enum named name.
+func checkEnum(e Enum, name string, policy EnumPolicy) error {
+	if e.IsKnown() {
+		return nil
+	}
+	if policy == EnumKeepUnknown {
+		logrus.Debugf("keep unknown %s value %q", name, e.String())
+		return nil
+	}
+	return fmt.Errorf("%s is not a valid %s", e.String(), name)
+}
+
+// unmarshalEnumText decodes text into e with the current policy.
+func unmarshalEnumText[T interface {
+	~string
+	Enum
+}](e *T, text []byte, name string) error {
+	v := T(text)
+	if err := checkEnum(v, name, CurrentEnumPolicy()); err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// unmarshalEnumJSON decodes the JSON string b into e with the current policy. JSON null leaves e unchanged.
+func unmarshalEnumJSON[T interface {
+	~string
+	Enum
+}](e *T, b []byte, name string) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("enums must be strings")
+	}
+	return unmarshalEnumText(e, []byte(s), name)
+}
+
+// enumValues returns all as a slice of Enum.
+func enumValues[T Enum](all []T) []Enum {
+	values := make([]Enum, len(all))
+	for i, v := range all {
+		values[i] = v
+	}
+	return values
+}
+
+// lookupEnum returns the value of all named name.
+func lookupEnum[T ~string](all []T, name string) (T, bool) {
+	for _, v := range all {
+		if string(v) == name {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// enumGoString returns the Go syntax of e, a value of the enum type typ, e.g. `model.ProductStatus`: the name of its
+// constant when e is one of all, whose constants are named typ followed by constants, or a conversion otherwise.
+func enumGoString[T ~string](all []T, constants []string, e T, typ string) string {
+	if i := slices.Index(all, e); i >= 0 && i < len(constants) {
+		return typ + constants[i]
+	}
+	return fmt.Sprintf("%s(%q)", typ, string(e))
+}
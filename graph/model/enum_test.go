@@ -0,0 +1,152 @@
+package model_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("EnumPolicy", func() {
+	AfterEach(func() {
+		model.SetEnumPolicy(model.EnumKeepUnknown)
+	})
+
+	It("keeps unknown values by default", func() {
+		var product model.Product
+		err := json.Unmarshal([]byte(`{"status": "UPCOMING"}`), &product)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(product.Status).To(Equal(model.ProductStatus("UPCOMING")))
+		Expect(product.Status.IsValid()).To(BeFalse())
+	})
+
+	It("rejects unknown values when strict", func() {
+		model.SetEnumPolicy(model.EnumStrict)
+		Expect(model.CurrentEnumPolicy()).To(Equal(model.EnumStrict))
+
+		var product model.Product
+		err := json.Unmarshal([]byte(`{"status": "UPCOMING"}`), &product)
+		Expect(err).To(MatchError(ContainSubstring("UPCOMING is not a valid ProductStatus")))
+
+		err = json.Unmarshal([]byte(`{"status": "ACTIVE"}`), &product)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(product.Status).To(Equal(model.ProductStatusActive))
+	})
+
+	It("validates text", func() {
+		model.SetEnumPolicy(model.EnumStrict)
+		var status model.ProductStatus
+		Expect(status.UnmarshalText([]byte("DRAFT"))).To(Succeed())
+		Expect(status).To(Equal(model.ProductStatusDraft))
+		Expect(status.UnmarshalText([]byte("draft"))).NotTo(Succeed())
+	})
+
+	It("rejects values that are not strings", func() {
+		var status model.ProductStatus
+		Expect(json.Unmarshal([]byte(`1`), &status)).To(MatchError("enums must be strings"))
+	})
+
+	It("leaves the value unchanged on null", func() {
+		status := model.ProductStatusActive
+		Expect(json.Unmarshal([]byte(`null`), &status)).To(Succeed())
+		Expect(status).To(Equal(model.ProductStatusActive))
+	})
+
+	It("is applied by Decode", func() {
+		data := map[string]any{"status": "UPCOMING"}
+		_, err := model.Decode(data, &model.Product{}, model.WithEnumPolicy(model.EnumStrict))
+		Expect(err).To(MatchError(ContainSubstring("UPCOMING is not a valid ProductStatus")))
+
+		result, err := model.Decode(data, &model.Product{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.(*model.Product).Status).To(Equal(model.ProductStatus("UPCOMING")))
+	})
+
+	It("is applied to the objects decoded into interfaces", func() {
+		model.SetEnumPolicy(model.EnumStrict)
+		_, err := model.Decode(map[string]any{
+			"nodes": []any{
+				map[string]any{"__typename": "Video", "status": "UNKNOWN"},
+			},
+		}, &model.MediaConnection{})
+		Expect(err).To(MatchError(ContainSubstring("UNKNOWN is not a valid MediaStatus")))
+	})
+})
+
+var _ = Describe("Enum", func() {
+	It("tells known values apart", func() {
+		Expect(model.ProductStatusActive.IsKnown()).To(BeTrue())
+		Expect(model.ProductStatus("UPCOMING").IsKnown()).To(BeFalse())
+	})
+
+	It("renders unknown values distinctly", func() {
+		Expect(fmt.Sprintf("%#v", model.ProductStatusActive)).To(Equal("model.ProductStatusActive"))
+		Expect(fmt.Sprintf("%#v", model.ProductStatus("UPCOMING"))).To(Equal(`model.ProductStatus("UPCOMING")`))
+		Expect(model.ProductStatus("UPCOMING").String()).To(Equal("UPCOMING"))
+	})
+
+	It("looks values up by name", func() {
+		status, ok := model.LookupProductStatus("ARCHIVED")
+		Expect(ok).To(BeTrue())
+		Expect(status).To(Equal(model.ProductStatusArchived))
+
+		_, ok = model.LookupProductStatus("UPCOMING")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("round-trips every value through JSON, GQL and text", func() {
+		Expect(model.Enums).NotTo(BeEmpty())
+		for name, values := range model.Enums {
+			Expect(values).NotTo(BeEmpty(), name)
+			for _, v := range values {
+				Expect(v.IsKnown()).To(BeTrue(), v.GoString())
+				Expect(v.IsValid()).To(BeTrue(), v.GoString())
+				typ := reflect.TypeOf(v)
+				Expect(v.GoString()).To(HavePrefix("model."+typ.Name()), v.String())
+				Expect(v.GoString()).NotTo(ContainSubstring("("), v.String())
+
+				b, err := json.Marshal(v)
+				Expect(err).NotTo(HaveOccurred())
+				decoded := reflect.New(typ)
+				Expect(json.Unmarshal(b, decoded.Interface())).To(Succeed())
+				Expect(decoded.Elem().Interface()).To(Equal(v), v.GoString())
+
+				var buf bytes.Buffer
+				v.(interface{ MarshalGQL(w io.Writer) }).MarshalGQL(&buf)
+				s, err := strconv.Unquote(buf.String())
+				Expect(err).NotTo(HaveOccurred())
+				decoded = reflect.New(typ)
+				Expect(decoded.Interface().(interface{ UnmarshalGQL(any) error }).UnmarshalGQL(s)).To(Succeed())
+				Expect(decoded.Elem().Interface()).To(Equal(v), v.GoString())
+
+				text, err := v.(encoding.TextMarshaler).MarshalText()
+				Expect(err).NotTo(HaveOccurred())
+				decoded = reflect.New(typ)
+				Expect(decoded.Interface().(encoding.TextUnmarshaler).UnmarshalText(text)).To(Succeed())
+				Expect(decoded.Elem().Interface()).To(Equal(v), v.GoString())
+			}
+		}
+	})
+
+	It("round-trips unknown values through JSON and text", func() {
+		status := model.ProductStatus("UPCOMING")
+		b, err := json.Marshal(status)
+		Expect(err).NotTo(HaveOccurred())
+		var decoded model.ProductStatus
+		Expect(json.Unmarshal(b, &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(status))
+
+		text, err := status.MarshalText()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decoded.UnmarshalText(text)).To(Succeed())
+		Expect(decoded).To(Equal(status))
+	})
+})
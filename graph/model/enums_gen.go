@@ -0,0 +1,14255 @@
+// Code generated by github.com/gempages/go-shopify-graphql-model, DO NOT EDIT.
+
+package model
+
+import (
+	"slices"
+)
+
+// Enums maps the name of every enum to its known values.
+var Enums = map[string][]Enum{
+	"AbandonedCheckoutSortKeys":                                   enumValues(AllAbandonedCheckoutSortKeys),
+	"AbandonmentAbandonmentType":                                  enumValues(AllAbandonmentAbandonmentType),
+	"AbandonmentDeliveryState":                                    enumValues(AllAbandonmentDeliveryState),
+	"AbandonmentEmailState":                                       enumValues(AllAbandonmentEmailState),
+	"AbandonmentEmailStateUpdateUserErrorCode":                    enumValues(AllAbandonmentEmailStateUpdateUserErrorCode),
+	"AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode":    enumValues(AllAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode),
+	"AccountType":                                                 enumValues(AllAccountType),
+	"AdjustmentsSortKeys":                                         enumValues(AllAdjustmentsSortKeys),
+	"AppDeveloperType":                                            enumValues(AllAppDeveloperType),
+	"AppInstallationCategory":                                     enumValues(AllAppInstallationCategory),
+	"AppInstallationPrivacy":                                      enumValues(AllAppInstallationPrivacy),
+	"AppInstallationSortKeys":                                     enumValues(AllAppInstallationSortKeys),
+	"AppPricingInterval":                                          enumValues(AllAppPricingInterval),
+	"AppPublicCategory":                                           enumValues(AllAppPublicCategory),
+	"AppPurchaseStatus":                                           enumValues(AllAppPurchaseStatus),
+	"AppRevenueAttributionRecordSortKeys":                         enumValues(AllAppRevenueAttributionRecordSortKeys),
+	"AppRevenueAttributionType":                                   enumValues(AllAppRevenueAttributionType),
+	"AppRevokeAccessScopesAppRevokeScopeErrorCode":                enumValues(AllAppRevokeAccessScopesAppRevokeScopeErrorCode),
+	"AppSubscriptionReplacementBehavior":                          enumValues(AllAppSubscriptionReplacementBehavior),
+	"AppSubscriptionSortKeys":                                     enumValues(AllAppSubscriptionSortKeys),
+	"AppSubscriptionStatus":                                       enumValues(AllAppSubscriptionStatus),
+	"AppSubscriptionTrialExtendUserErrorCode":                     enumValues(AllAppSubscriptionTrialExtendUserErrorCode),
+	"AppTransactionSortKeys":                                      enumValues(AllAppTransactionSortKeys),
+	"AppUsageRecordSortKeys":                                      enumValues(AllAppUsageRecordSortKeys),
+	"ArticleCreateUserErrorCode":                                  enumValues(AllArticleCreateUserErrorCode),
+	"ArticleDeleteUserErrorCode":                                  enumValues(AllArticleDeleteUserErrorCode),
+	"ArticleSortKeys":                                             enumValues(AllArticleSortKeys),
+	"ArticleTagSort":                                              enumValues(AllArticleTagSort),
+	"ArticleUpdateUserErrorCode":                                  enumValues(AllArticleUpdateUserErrorCode),
+	"AutomaticDiscountSortKeys":                                   enumValues(AllAutomaticDiscountSortKeys),
+	"BadgeType":                                                   enumValues(AllBadgeType),
+	"BalanceTransactionSortKeys":                                  enumValues(AllBalanceTransactionSortKeys),
+	"BillingAttemptUserErrorCode":                                 enumValues(AllBillingAttemptUserErrorCode),
+	"BlogCreateUserErrorCode":                                     enumValues(AllBlogCreateUserErrorCode),
+	"BlogDeleteUserErrorCode":                                     enumValues(AllBlogDeleteUserErrorCode),
+	"BlogSortKeys":                                                enumValues(AllBlogSortKeys),
+	"BlogUpdateUserErrorCode":                                     enumValues(AllBlogUpdateUserErrorCode),
+	"BulkMutationErrorCode":                                       enumValues(AllBulkMutationErrorCode),
+	"BulkOperationErrorCode":                                      enumValues(AllBulkOperationErrorCode),
+	"BulkOperationStatus":                                         enumValues(AllBulkOperationStatus),
+	"BulkOperationType":                                           enumValues(AllBulkOperationType),
+	"BulkProductResourceFeedbackCreateUserErrorCode":              enumValues(AllBulkProductResourceFeedbackCreateUserErrorCode),
+	"BusinessCustomerErrorCode":                                   enumValues(AllBusinessCustomerErrorCode),
+	"CalculatedShippingLineStagedStatus":                          enumValues(AllCalculatedShippingLineStagedStatus),
+	"CarrierServiceCreateUserErrorCode":                           enumValues(AllCarrierServiceCreateUserErrorCode),
+	"CarrierServiceDeleteUserErrorCode":                           enumValues(AllCarrierServiceDeleteUserErrorCode),
+	"CarrierServiceSortKeys":                                      enumValues(AllCarrierServiceSortKeys),
+	"CarrierServiceUpdateUserErrorCode":                           enumValues(AllCarrierServiceUpdateUserErrorCode),
+	"CartTransformCreateUserErrorCode":                            enumValues(AllCartTransformCreateUserErrorCode),
+	"CartTransformDeleteUserErrorCode":                            enumValues(AllCartTransformDeleteUserErrorCode),
+	"CashTrackingSessionTransactionsSortKeys":                     enumValues(AllCashTrackingSessionTransactionsSortKeys),
+	"CashTrackingSessionsSortKeys":                                enumValues(AllCashTrackingSessionsSortKeys),
+	"CatalogSortKeys":                                             enumValues(AllCatalogSortKeys),
+	"CatalogStatus":                                               enumValues(AllCatalogStatus),
+	"CatalogType":                                                 enumValues(AllCatalogType),
+	"CatalogUserErrorCode":                                        enumValues(AllCatalogUserErrorCode),
+	"CheckoutBrandingBackground":                                  enumValues(AllCheckoutBrandingBackground),
+	"CheckoutBrandingBackgroundStyle":                             enumValues(AllCheckoutBrandingBackgroundStyle),
+	"CheckoutBrandingBorder":                                      enumValues(AllCheckoutBrandingBorder),
+	"CheckoutBrandingBorderStyle":                                 enumValues(AllCheckoutBrandingBorderStyle),
+	"CheckoutBrandingBorderWidth":                                 enumValues(AllCheckoutBrandingBorderWidth),
+	"CheckoutBrandingCartLinkContentType":                         enumValues(AllCheckoutBrandingCartLinkContentType),
+	"CheckoutBrandingColorSchemeSelection":                        enumValues(AllCheckoutBrandingColorSchemeSelection),
+	"CheckoutBrandingColorSelection":                              enumValues(AllCheckoutBrandingColorSelection),
+	"CheckoutBrandingCornerRadius":                                enumValues(AllCheckoutBrandingCornerRadius),
+	"CheckoutBrandingFontLoadingStrategy":                         enumValues(AllCheckoutBrandingFontLoadingStrategy),
+	"CheckoutBrandingFooterAlignment":                             enumValues(AllCheckoutBrandingFooterAlignment),
+	"CheckoutBrandingFooterPosition":                              enumValues(AllCheckoutBrandingFooterPosition),
+	"CheckoutBrandingGlobalCornerRadius":                          enumValues(AllCheckoutBrandingGlobalCornerRadius),
+	"CheckoutBrandingHeaderAlignment":                             enumValues(AllCheckoutBrandingHeaderAlignment),
+	"CheckoutBrandingHeaderPosition":                              enumValues(AllCheckoutBrandingHeaderPosition),
+	"CheckoutBrandingLabelPosition":                               enumValues(AllCheckoutBrandingLabelPosition),
+	"CheckoutBrandingShadow":                                      enumValues(AllCheckoutBrandingShadow),
+	"CheckoutBrandingSimpleBorder":                                enumValues(AllCheckoutBrandingSimpleBorder),
+	"CheckoutBrandingSpacing":                                     enumValues(AllCheckoutBrandingSpacing),
+	"CheckoutBrandingSpacingKeyword":                              enumValues(AllCheckoutBrandingSpacingKeyword),
+	"CheckoutBrandingTypographyFont":                              enumValues(AllCheckoutBrandingTypographyFont),
+	"CheckoutBrandingTypographyKerning":                           enumValues(AllCheckoutBrandingTypographyKerning),
+	"CheckoutBrandingTypographyLetterCase":                        enumValues(AllCheckoutBrandingTypographyLetterCase),
+	"CheckoutBrandingTypographySize":                              enumValues(AllCheckoutBrandingTypographySize),
+	"CheckoutBrandingTypographyWeight":                            enumValues(AllCheckoutBrandingTypographyWeight),
+	"CheckoutBrandingUpsertUserErrorCode":                         enumValues(AllCheckoutBrandingUpsertUserErrorCode),
+	"CheckoutBrandingVisibility":                                  enumValues(AllCheckoutBrandingVisibility),
+	"CheckoutProfileSortKeys":                                     enumValues(AllCheckoutProfileSortKeys),
+	"CodeDiscountSortKeys":                                        enumValues(AllCodeDiscountSortKeys),
+	"CollectionAddProductsV2UserErrorCode":                        enumValues(AllCollectionAddProductsV2UserErrorCode),
+	"CollectionRuleColumn":                                        enumValues(AllCollectionRuleColumn),
+	"CollectionRuleRelation":                                      enumValues(AllCollectionRuleRelation),
+	"CollectionSortKeys":                                          enumValues(AllCollectionSortKeys),
+	"CollectionSortOrder":                                         enumValues(AllCollectionSortOrder),
+	"CombinedListingUpdateUserErrorCode":                          enumValues(AllCombinedListingUpdateUserErrorCode),
+	"CombinedListingsRole":                                        enumValues(AllCombinedListingsRole),
+	"CommentApproveUserErrorCode":                                 enumValues(AllCommentApproveUserErrorCode),
+	"CommentDeleteUserErrorCode":                                  enumValues(AllCommentDeleteUserErrorCode),
+	"CommentNotSpamUserErrorCode":                                 enumValues(AllCommentNotSpamUserErrorCode),
+	"CommentPolicy":                                               enumValues(AllCommentPolicy),
+	"CommentSortKeys":                                             enumValues(AllCommentSortKeys),
+	"CommentSpamUserErrorCode":                                    enumValues(AllCommentSpamUserErrorCode),
+	"CommentStatus":                                               enumValues(AllCommentStatus),
+	"CompanyAddressType":                                          enumValues(AllCompanyAddressType),
+	"CompanyContactRoleAssignmentSortKeys":                        enumValues(AllCompanyContactRoleAssignmentSortKeys),
+	"CompanyContactRoleSortKeys":                                  enumValues(AllCompanyContactRoleSortKeys),
+	"CompanyContactSortKeys":                                      enumValues(AllCompanyContactSortKeys),
+	"CompanyLocationSortKeys":                                     enumValues(AllCompanyLocationSortKeys),
+	"CompanyLocationStaffMemberAssignmentSortKeys":                enumValues(AllCompanyLocationStaffMemberAssignmentSortKeys),
+	"CompanySortKeys":                                             enumValues(AllCompanySortKeys),
+	"CountPrecision":                                              enumValues(AllCountPrecision),
+	"CountryCode":                                                 enumValues(AllCountryCode),
+	"CropRegion":                                                  enumValues(AllCropRegion),
+	"CurrencyCode":                                                enumValues(AllCurrencyCode),
+	"CustomerAccountNativePagePageType":                           enumValues(AllCustomerAccountNativePagePageType),
+	"CustomerAccountsVersion":                                     enumValues(AllCustomerAccountsVersion),
+	"CustomerCancelDataErasureErrorCode":                          enumValues(AllCustomerCancelDataErasureErrorCode),
+	"CustomerConsentCollectedFrom":                                enumValues(AllCustomerConsentCollectedFrom),
+	"CustomerEmailAddressMarketingState":                          enumValues(AllCustomerEmailAddressMarketingState),
+	"CustomerEmailAddressOpenTrackingLevel":                       enumValues(AllCustomerEmailAddressOpenTrackingLevel),
+	"CustomerEmailMarketingConsentUpdateUserErrorCode":            enumValues(AllCustomerEmailMarketingConsentUpdateUserErrorCode),
+	"CustomerEmailMarketingState":                                 enumValues(AllCustomerEmailMarketingState),
+	"CustomerMarketingOptInLevel":                                 enumValues(AllCustomerMarketingOptInLevel),
+	"CustomerMergeErrorCode":                                      enumValues(AllCustomerMergeErrorCode),
+	"CustomerMergeErrorFieldType":                                 enumValues(AllCustomerMergeErrorFieldType),
+	"CustomerMergeRequestStatus":                                  enumValues(AllCustomerMergeRequestStatus),
+	"CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode": enumValues(AllCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode),
+	"CustomerPaymentMethodGetDuplicationDataUserErrorCode":        enumValues(AllCustomerPaymentMethodGetDuplicationDataUserErrorCode),
+	"CustomerPaymentMethodGetUpdateURLUserErrorCode":              enumValues(AllCustomerPaymentMethodGetUpdateURLUserErrorCode),
+	"CustomerPaymentMethodRemoteUserErrorCode":                    enumValues(AllCustomerPaymentMethodRemoteUserErrorCode),
+	"CustomerPaymentMethodRevocationReason":                       enumValues(AllCustomerPaymentMethodRevocationReason),
+	"CustomerPaymentMethodUserErrorCode":                          enumValues(AllCustomerPaymentMethodUserErrorCode),
+	"CustomerPredictedSpendTier":                                  enumValues(AllCustomerPredictedSpendTier),
+	"CustomerProductSubscriberStatus":                             enumValues(AllCustomerProductSubscriberStatus),
+	"CustomerRequestDataErasureErrorCode":                         enumValues(AllCustomerRequestDataErasureErrorCode),
+	"CustomerSavedSearchSortKeys":                                 enumValues(AllCustomerSavedSearchSortKeys),
+	"CustomerSegmentMembersQueryUserErrorCode":                    enumValues(AllCustomerSegmentMembersQueryUserErrorCode),
+	"CustomerSendAccountInviteEmailUserErrorCode":                 enumValues(AllCustomerSendAccountInviteEmailUserErrorCode),
+	"CustomerSmsMarketingConsentErrorCode":                        enumValues(AllCustomerSmsMarketingConsentErrorCode),
+	"CustomerSmsMarketingState":                                   enumValues(AllCustomerSmsMarketingState),
+	"CustomerSortKeys":                                            enumValues(AllCustomerSortKeys),
+	"CustomerState":                                               enumValues(AllCustomerState),
+	"DataSaleOptOutUserErrorCode":                                 enumValues(AllDataSaleOptOutUserErrorCode),
+	"DayOfTheWeek":                                                enumValues(AllDayOfTheWeek),
+	"DelegateAccessTokenCreateUserErrorCode":                      enumValues(AllDelegateAccessTokenCreateUserErrorCode),
+	"DelegateAccessTokenDestroyUserErrorCode":                     enumValues(AllDelegateAccessTokenDestroyUserErrorCode),
+	"DeletionEventSortKeys":                                       enumValues(AllDeletionEventSortKeys),
+	"DeletionEventSubjectType":                                    enumValues(AllDeletionEventSubjectType),
+	"DeliveryConditionField":                                      enumValues(AllDeliveryConditionField),
+	"DeliveryConditionOperator":                                   enumValues(AllDeliveryConditionOperator),
+	"DeliveryCustomizationErrorCode":                              enumValues(AllDeliveryCustomizationErrorCode),
+	"DeliveryLegacyModeBlockedReason":                             enumValues(AllDeliveryLegacyModeBlockedReason),
+	"DeliveryLocalPickupTime":                                     enumValues(AllDeliveryLocalPickupTime),
+	"DeliveryLocationLocalPickupSettingsErrorCode":                enumValues(AllDeliveryLocationLocalPickupSettingsErrorCode),
+	"DeliveryMethodDefinitionType":                                enumValues(AllDeliveryMethodDefinitionType),
+	"DeliveryMethodType":                                          enumValues(AllDeliveryMethodType),
+	"DeliveryPromiseProviderUpsertUserErrorCode":                  enumValues(AllDeliveryPromiseProviderUpsertUserErrorCode),
+	"DigitalWallet":                                               enumValues(AllDigitalWallet),
+	"DiscountApplicationAllocationMethod":                         enumValues(AllDiscountApplicationAllocationMethod),
+	"DiscountApplicationLevel":                                    enumValues(AllDiscountApplicationLevel),
+	"DiscountApplicationTargetSelection":                          enumValues(AllDiscountApplicationTargetSelection),
+	"DiscountApplicationTargetType":                               enumValues(AllDiscountApplicationTargetType),
+	"DiscountClass":                                               enumValues(AllDiscountClass),
+	"DiscountCodeSortKeys":                                        enumValues(AllDiscountCodeSortKeys),
+	"DiscountErrorCode":                                           enumValues(AllDiscountErrorCode),
+	"DiscountShareableURLTargetType":                              enumValues(AllDiscountShareableURLTargetType),
+	"DiscountSortKeys":                                            enumValues(AllDiscountSortKeys),
+	"DiscountStatus":                                              enumValues(AllDiscountStatus),
+	"DiscountTargetType":                                          enumValues(AllDiscountTargetType),
+	"DiscountType":                                                enumValues(AllDiscountType),
+	"DisputeEvidenceUpdateUserErrorCode":                          enumValues(AllDisputeEvidenceUpdateUserErrorCode),
+	"DisputeStatus":                                               enumValues(AllDisputeStatus),
+	"DisputeType":                                                 enumValues(AllDisputeType),
+	"DraftOrderAppliedDiscountType":                               enumValues(AllDraftOrderAppliedDiscountType),
+	"DraftOrderSortKeys":                                          enumValues(AllDraftOrderSortKeys),
+	"DraftOrderStatus":                                            enumValues(AllDraftOrderStatus),
+	"ErrorsServerPixelUserErrorCode":                              enumValues(AllErrorsServerPixelUserErrorCode),
+	"ErrorsWebPixelUserErrorCode":                                 enumValues(AllErrorsWebPixelUserErrorCode),
+	"EventSortKeys":                                               enumValues(AllEventSortKeys),
+	"EventSubjectType":                                            enumValues(AllEventSubjectType),
+	"FileContentType":                                             enumValues(AllFileContentType),
+	"FileCreateInputDuplicateResolutionMode":                      enumValues(AllFileCreateInputDuplicateResolutionMode),
+	"FileErrorCode":                                               enumValues(AllFileErrorCode),
+	"FileSortKeys":                                                enumValues(AllFileSortKeys),
+	"FileStatus":                                                  enumValues(AllFileStatus),
+	"FilesErrorCode":                                              enumValues(AllFilesErrorCode),
+	"FulfillmentConstraintRuleCreateUserErrorCode":                enumValues(AllFulfillmentConstraintRuleCreateUserErrorCode),
+	"FulfillmentConstraintRuleDeleteUserErrorCode":                enumValues(AllFulfillmentConstraintRuleDeleteUserErrorCode),
+	"FulfillmentConstraintRuleUpdateUserErrorCode":                enumValues(AllFulfillmentConstraintRuleUpdateUserErrorCode),
+	"FulfillmentDisplayStatus":                                    enumValues(AllFulfillmentDisplayStatus),
+	"FulfillmentEventSortKeys":                                    enumValues(AllFulfillmentEventSortKeys),
+	"FulfillmentEventStatus":                                      enumValues(AllFulfillmentEventStatus),
+	"FulfillmentHoldReason":                                       enumValues(AllFulfillmentHoldReason),
+	"FulfillmentOrderAction":                                      enumValues(AllFulfillmentOrderAction),
+	"FulfillmentOrderAssignmentStatus":                            enumValues(AllFulfillmentOrderAssignmentStatus),
+	"FulfillmentOrderHoldUserErrorCode":                           enumValues(AllFulfillmentOrderHoldUserErrorCode),
+	"FulfillmentOrderLineItemsPreparedForPickupUserErrorCode":     enumValues(AllFulfillmentOrderLineItemsPreparedForPickupUserErrorCode),
+	"FulfillmentOrderMerchantRequestKind":                         enumValues(AllFulfillmentOrderMerchantRequestKind),
+	"FulfillmentOrderMergeUserErrorCode":                          enumValues(AllFulfillmentOrderMergeUserErrorCode),
+	"FulfillmentOrderRejectionReason":                             enumValues(AllFulfillmentOrderRejectionReason),
+	"FulfillmentOrderReleaseHoldUserErrorCode":                    enumValues(AllFulfillmentOrderReleaseHoldUserErrorCode),
+	"FulfillmentOrderRequestStatus":                               enumValues(AllFulfillmentOrderRequestStatus),
+	"FulfillmentOrderRescheduleUserErrorCode":                     enumValues(AllFulfillmentOrderRescheduleUserErrorCode),
+	"FulfillmentOrderSortKeys":                                    enumValues(AllFulfillmentOrderSortKeys),
+	"FulfillmentOrderSplitUserErrorCode":                          enumValues(AllFulfillmentOrderSplitUserErrorCode),
+	"FulfillmentOrderStatus":                                      enumValues(AllFulfillmentOrderStatus),
+	"FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode":        enumValues(AllFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode),
+	"FulfillmentServiceDeleteInventoryAction":                     enumValues(AllFulfillmentServiceDeleteInventoryAction),
+	"FulfillmentServiceType":                                      enumValues(AllFulfillmentServiceType),
+	"FulfillmentStatus":                                           enumValues(AllFulfillmentStatus),
+	"GiftCardDeactivateUserErrorCode":                             enumValues(AllGiftCardDeactivateUserErrorCode),
+	"GiftCardErrorCode":                                           enumValues(AllGiftCardErrorCode),
+	"GiftCardSendNotificationToCustomerUserErrorCode":             enumValues(AllGiftCardSendNotificationToCustomerUserErrorCode),
+	"GiftCardSendNotificationToRecipientUserErrorCode":            enumValues(AllGiftCardSendNotificationToRecipientUserErrorCode),
+	"GiftCardSortKeys":                                            enumValues(AllGiftCardSortKeys),
+	"GiftCardTransactionUserErrorCode":                            enumValues(AllGiftCardTransactionUserErrorCode),
+	"ImageContentType":                                            enumValues(AllImageContentType),
+	"InventoryAdjustQuantitiesUserErrorCode":                      enumValues(AllInventoryAdjustQuantitiesUserErrorCode),
+	"InventoryBulkToggleActivationUserErrorCode":                  enumValues(AllInventoryBulkToggleActivationUserErrorCode),
+	"InventoryMoveQuantitiesUserErrorCode":                        enumValues(AllInventoryMoveQuantitiesUserErrorCode),
+	"InventorySetOnHandQuantitiesUserErrorCode":                   enumValues(AllInventorySetOnHandQuantitiesUserErrorCode),
+	"InventorySetQuantitiesUserErrorCode":                         enumValues(AllInventorySetQuantitiesUserErrorCode),
+	"InventorySetScheduledChangesUserErrorCode":                   enumValues(AllInventorySetScheduledChangesUserErrorCode),
+	"LanguageCode":                                                enumValues(AllLanguageCode),
+	"LengthUnit":                                                  enumValues(AllLengthUnit),
+	"LocalizableContentType":                                      enumValues(AllLocalizableContentType),
+	"LocalizationExtensionKey":                                    enumValues(AllLocalizationExtensionKey),
+	"LocalizationExtensionPurpose":                                enumValues(AllLocalizationExtensionPurpose),
+	"LocationActivateUserErrorCode":                               enumValues(AllLocationActivateUserErrorCode),
+	"LocationAddUserErrorCode":                                    enumValues(AllLocationAddUserErrorCode),
+	"LocationDeactivateUserErrorCode":                             enumValues(AllLocationDeactivateUserErrorCode),
+	"LocationDeleteUserErrorCode":                                 enumValues(AllLocationDeleteUserErrorCode),
+	"LocationEditUserErrorCode":                                   enumValues(AllLocationEditUserErrorCode),
+	"LocationSortKeys":                                            enumValues(AllLocationSortKeys),
+	"MailingAddressValidationResult":                              enumValues(AllMailingAddressValidationResult),
+	"MarketCurrencySettingsUserErrorCode":                         enumValues(AllMarketCurrencySettingsUserErrorCode),
+	"MarketLocalizableResourceType":                               enumValues(AllMarketLocalizableResourceType),
+	"MarketUserErrorCode":                                         enumValues(AllMarketUserErrorCode),
+	"MarketingActivityExtensionAppErrorCode":                      enumValues(AllMarketingActivityExtensionAppErrorCode),
+	"MarketingActivityExternalStatus":                             enumValues(AllMarketingActivityExternalStatus),
+	"MarketingActivityHierarchyLevel":                             enumValues(AllMarketingActivityHierarchyLevel),
+	"MarketingActivitySortKeys":                                   enumValues(AllMarketingActivitySortKeys),
+	"MarketingActivityStatus":                                     enumValues(AllMarketingActivityStatus),
+	"MarketingActivityStatusBadgeType":                            enumValues(AllMarketingActivityStatusBadgeType),
+	"MarketingActivityUserErrorCode":                              enumValues(AllMarketingActivityUserErrorCode),
+	"MarketingBudgetBudgetType":                                   enumValues(AllMarketingBudgetBudgetType),
+	"MarketingChannel":                                            enumValues(AllMarketingChannel),
+	"MarketingEventSortKeys":                                      enumValues(AllMarketingEventSortKeys),
+	"MarketingTactic":                                             enumValues(AllMarketingTactic),
+	"MediaContentType":                                            enumValues(AllMediaContentType),
+	"MediaErrorCode":                                              enumValues(AllMediaErrorCode),
+	"MediaHost":                                                   enumValues(AllMediaHost),
+	"MediaPreviewImageStatus":                                     enumValues(AllMediaPreviewImageStatus),
+	"MediaStatus":                                                 enumValues(AllMediaStatus),
+	"MediaUserErrorCode":                                          enumValues(AllMediaUserErrorCode),
+	"MediaWarningCode":                                            enumValues(AllMediaWarningCode),
+	"MenuCreateUserErrorCode":                                     enumValues(AllMenuCreateUserErrorCode),
+	"MenuDeleteUserErrorCode":                                     enumValues(AllMenuDeleteUserErrorCode),
+	"MenuItemType":                                                enumValues(AllMenuItemType),
+	"MenuSortKeys":                                                enumValues(AllMenuSortKeys),
+	"MenuUpdateUserErrorCode":                                     enumValues(AllMenuUpdateUserErrorCode),
+	"MerchandiseDiscountClass":                                    enumValues(AllMerchandiseDiscountClass),
+	"MetafieldAdminAccess":                                        enumValues(AllMetafieldAdminAccess),
+	"MetafieldAdminAccessInput":                                   enumValues(AllMetafieldAdminAccessInput),
+	"MetafieldCustomerAccountAccess":                              enumValues(AllMetafieldCustomerAccountAccess),
+	"MetafieldCustomerAccountAccessInput":                         enumValues(AllMetafieldCustomerAccountAccessInput),
+	"MetafieldDefinitionAdminFilterStatus":                        enumValues(AllMetafieldDefinitionAdminFilterStatus),
+	"MetafieldDefinitionConstraintStatus":                         enumValues(AllMetafieldDefinitionConstraintStatus),
+	"MetafieldDefinitionCreateUserErrorCode":                      enumValues(AllMetafieldDefinitionCreateUserErrorCode),
+	"MetafieldDefinitionDeleteUserErrorCode":                      enumValues(AllMetafieldDefinitionDeleteUserErrorCode),
+	"MetafieldDefinitionPinUserErrorCode":                         enumValues(AllMetafieldDefinitionPinUserErrorCode),
+	"MetafieldDefinitionPinnedStatus":                             enumValues(AllMetafieldDefinitionPinnedStatus),
+	"MetafieldDefinitionSortKeys":                                 enumValues(AllMetafieldDefinitionSortKeys),
+	"MetafieldDefinitionUnpinUserErrorCode":                       enumValues(AllMetafieldDefinitionUnpinUserErrorCode),
+	"MetafieldDefinitionUpdateUserErrorCode":                      enumValues(AllMetafieldDefinitionUpdateUserErrorCode),
+	"MetafieldDefinitionValidationStatus":                         enumValues(AllMetafieldDefinitionValidationStatus),
+	"MetafieldGrantAccessLevel":                                   enumValues(AllMetafieldGrantAccessLevel),
+	"MetafieldOwnerType":                                          enumValues(AllMetafieldOwnerType),
+	"MetafieldStorefrontAccess":                                   enumValues(AllMetafieldStorefrontAccess),
+	"MetafieldStorefrontAccessInput":                              enumValues(AllMetafieldStorefrontAccessInput),
+	"MetafieldValidationStatus":                                   enumValues(AllMetafieldValidationStatus),
+	"MetafieldValueType":                                          enumValues(AllMetafieldValueType),
+	"MetafieldsSetUserErrorCode":                                  enumValues(AllMetafieldsSetUserErrorCode),
+	"MetaobjectAdminAccess":                                       enumValues(AllMetaobjectAdminAccess),
+	"MetaobjectStatus":                                            enumValues(AllMetaobjectStatus),
+	"MetaobjectStorefrontAccess":                                  enumValues(AllMetaobjectStorefrontAccess),
+	"MetaobjectUserErrorCode":                                     enumValues(AllMetaobjectUserErrorCode),
+	"MethodDefinitionSortKeys":                                    enumValues(AllMethodDefinitionSortKeys),
+	"MobilePlatformApplicationUserErrorCode":                      enumValues(AllMobilePlatformApplicationUserErrorCode),
+	"OnlineStoreThemeFileBodyInputType":                           enumValues(AllOnlineStoreThemeFileBodyInputType),
+	"OnlineStoreThemeFileResultType":                              enumValues(AllOnlineStoreThemeFileResultType),
+	"OnlineStoreThemeFilesUserErrorsCode":                         enumValues(AllOnlineStoreThemeFilesUserErrorsCode),
+	"OrderActionType":                                             enumValues(AllOrderActionType),
+	"OrderAdjustmentDiscrepancyReason":                            enumValues(AllOrderAdjustmentDiscrepancyReason),
+	"OrderAdjustmentInputDiscrepancyReason":                       enumValues(AllOrderAdjustmentInputDiscrepancyReason),
+	"OrderCancelReason":                                           enumValues(AllOrderCancelReason),
+	"OrderCancelUserErrorCode":                                    enumValues(AllOrderCancelUserErrorCode),
+	"OrderCreateFinancialStatus":                                  enumValues(AllOrderCreateFinancialStatus),
+	"OrderCreateFulfillmentStatus":                                enumValues(AllOrderCreateFulfillmentStatus),
+	"OrderCreateInputsInventoryBehavior":                          enumValues(AllOrderCreateInputsInventoryBehavior),
+	"OrderCreateMandatePaymentUserErrorCode":                      enumValues(AllOrderCreateMandatePaymentUserErrorCode),
+	"OrderCreateUserErrorCode":                                    enumValues(AllOrderCreateUserErrorCode),
+	"OrderDeleteUserErrorCode":                                    enumValues(AllOrderDeleteUserErrorCode),
+	"OrderDisplayFinancialStatus":                                 enumValues(AllOrderDisplayFinancialStatus),
+	"OrderDisplayFulfillmentStatus":                               enumValues(AllOrderDisplayFulfillmentStatus),
+	"OrderEditAddShippingLineUserErrorCode":                       enumValues(AllOrderEditAddShippingLineUserErrorCode),
+	"OrderEditRemoveDiscountUserErrorCode":                        enumValues(AllOrderEditRemoveDiscountUserErrorCode),
+	"OrderEditRemoveShippingLineUserErrorCode":                    enumValues(AllOrderEditRemoveShippingLineUserErrorCode),
+	"OrderEditUpdateDiscountUserErrorCode":                        enumValues(AllOrderEditUpdateDiscountUserErrorCode),
+	"OrderEditUpdateShippingLineUserErrorCode":                    enumValues(AllOrderEditUpdateShippingLineUserErrorCode),
+	"OrderInvoiceSendUserErrorCode":                               enumValues(AllOrderInvoiceSendUserErrorCode),
+	"OrderPaymentStatusResult":                                    enumValues(AllOrderPaymentStatusResult),
+	"OrderReturnStatus":                                           enumValues(AllOrderReturnStatus),
+	"OrderRiskAssessmentCreateUserErrorCode":                      enumValues(AllOrderRiskAssessmentCreateUserErrorCode),
+	"OrderRiskLevel":                                              enumValues(AllOrderRiskLevel),
+	"OrderRiskRecommendationResult":                               enumValues(AllOrderRiskRecommendationResult),
+	"OrderSortKeys":                                               enumValues(AllOrderSortKeys),
+	"OrderTransactionErrorCode":                                   enumValues(AllOrderTransactionErrorCode),
+	"OrderTransactionKind":                                        enumValues(AllOrderTransactionKind),
+	"OrderTransactionStatus":                                      enumValues(AllOrderTransactionStatus),
+	"PageCreateUserErrorCode":                                     enumValues(AllPageCreateUserErrorCode),
+	"PageDeleteUserErrorCode":                                     enumValues(AllPageDeleteUserErrorCode),
+	"PageUpdateUserErrorCode":                                     enumValues(AllPageUpdateUserErrorCode),
+	"PaymentCustomizationErrorCode":                               enumValues(AllPaymentCustomizationErrorCode),
+	"PaymentMethods":                                              enumValues(AllPaymentMethods),
+	"PaymentReminderSendUserErrorCode":                            enumValues(AllPaymentReminderSendUserErrorCode),
+	"PaymentTermsCreateUserErrorCode":                             enumValues(AllPaymentTermsCreateUserErrorCode),
+	"PaymentTermsDeleteUserErrorCode":                             enumValues(AllPaymentTermsDeleteUserErrorCode),
+	"PaymentTermsType":                                            enumValues(AllPaymentTermsType),
+	"PaymentTermsUpdateUserErrorCode":                             enumValues(AllPaymentTermsUpdateUserErrorCode),
+	"PayoutSortKeys":                                              enumValues(AllPayoutSortKeys),
+	"PaypalExpressSubscriptionsGatewayStatus":                     enumValues(AllPaypalExpressSubscriptionsGatewayStatus),
+	"PriceCalculationType":                                        enumValues(AllPriceCalculationType),
+	"PriceListAdjustmentType":                                     enumValues(AllPriceListAdjustmentType),
+	"PriceListCompareAtMode":                                      enumValues(AllPriceListCompareAtMode),
+	"PriceListFixedPricesByProductBulkUpdateUserErrorCode":        enumValues(AllPriceListFixedPricesByProductBulkUpdateUserErrorCode),
+	"PriceListPriceOriginType":                                    enumValues(AllPriceListPriceOriginType),
+	"PriceListPriceUserErrorCode":                                 enumValues(AllPriceListPriceUserErrorCode),
+	"PriceListSortKeys":                                           enumValues(AllPriceListSortKeys),
+	"PriceListUserErrorCode":                                      enumValues(AllPriceListUserErrorCode),
+	"PriceRuleAllocationMethod":                                   enumValues(AllPriceRuleAllocationMethod),
+	"PriceRuleFeature":                                            enumValues(AllPriceRuleFeature),
+	"PriceRuleShareableURLTargetType":                             enumValues(AllPriceRuleShareableURLTargetType),
+	"PriceRuleStatus":                                             enumValues(AllPriceRuleStatus),
+	"PriceRuleTarget":                                             enumValues(AllPriceRuleTarget),
+	"PriceRuleTrait":                                              enumValues(AllPriceRuleTrait),
+	"PrivateMetafieldValueType":                                   enumValues(AllPrivateMetafieldValueType),
+	"ProductBundleComponentOptionSelectionStatus":                 enumValues(AllProductBundleComponentOptionSelectionStatus),
+	"ProductBundleMutationUserErrorCode":                          enumValues(AllProductBundleMutationUserErrorCode),
+	"ProductChangeStatusUserErrorCode":                            enumValues(AllProductChangeStatusUserErrorCode),
+	"ProductCollectionSortKeys":                                   enumValues(AllProductCollectionSortKeys),
+	"ProductFeedCreateUserErrorCode":                              enumValues(AllProductFeedCreateUserErrorCode),
+	"ProductFeedDeleteUserErrorCode":                              enumValues(AllProductFeedDeleteUserErrorCode),
+	"ProductFeedStatus":                                           enumValues(AllProductFeedStatus),
+	"ProductFullSyncUserErrorCode":                                enumValues(AllProductFullSyncUserErrorCode),
+	"ProductImageSortKeys":                                        enumValues(AllProductImageSortKeys),
+	"ProductMediaSortKeys":                                        enumValues(AllProductMediaSortKeys),
+	"ProductOperationStatus":                                      enumValues(AllProductOperationStatus),
+	"ProductOptionCreateVariantStrategy":                          enumValues(AllProductOptionCreateVariantStrategy),
+	"ProductOptionDeleteStrategy":                                 enumValues(AllProductOptionDeleteStrategy),
+	"ProductOptionUpdateUserErrorCode":                            enumValues(AllProductOptionUpdateUserErrorCode),
+	"ProductOptionUpdateVariantStrategy":                          enumValues(AllProductOptionUpdateVariantStrategy),
+	"ProductOptionsCreateUserErrorCode":                           enumValues(AllProductOptionsCreateUserErrorCode),
+	"ProductOptionsDeleteUserErrorCode":                           enumValues(AllProductOptionsDeleteUserErrorCode),
+	"ProductOptionsReorderUserErrorCode":                          enumValues(AllProductOptionsReorderUserErrorCode),
+	"ProductSetUserErrorCode":                                     enumValues(AllProductSetUserErrorCode),
+	"ProductSortKeys":                                             enumValues(AllProductSortKeys),
+	"ProductStatus":                                               enumValues(AllProductStatus),
+	"ProductVariantInventoryPolicy":                               enumValues(AllProductVariantInventoryPolicy),
+	"ProductVariantRelationshipBulkUpdateUserErrorCode":           enumValues(AllProductVariantRelationshipBulkUpdateUserErrorCode),
+	"ProductVariantSortKeys":                                      enumValues(AllProductVariantSortKeys),
+	"ProductVariantsBulkCreateStrategy":                           enumValues(AllProductVariantsBulkCreateStrategy),
+	"ProductVariantsBulkCreateUserErrorCode":                      enumValues(AllProductVariantsBulkCreateUserErrorCode),
+	"ProductVariantsBulkDeleteUserErrorCode":                      enumValues(AllProductVariantsBulkDeleteUserErrorCode),
+	"ProductVariantsBulkReorderUserErrorCode":                     enumValues(AllProductVariantsBulkReorderUserErrorCode),
+	"ProductVariantsBulkUpdateUserErrorCode":                      enumValues(AllProductVariantsBulkUpdateUserErrorCode),
+	"ProfileItemSortKeys":                                         enumValues(AllProfileItemSortKeys),
+	"PubSubWebhookSubscriptionCreateUserErrorCode":                enumValues(AllPubSubWebhookSubscriptionCreateUserErrorCode),
+	"PubSubWebhookSubscriptionUpdateUserErrorCode":                enumValues(AllPubSubWebhookSubscriptionUpdateUserErrorCode),
+	"PublicationCreateInputPublicationDefaultState":               enumValues(AllPublicationCreateInputPublicationDefaultState),
+	"PublicationUserErrorCode":                                    enumValues(AllPublicationUserErrorCode),
+	"QuantityPriceBreakSortKeys":                                  enumValues(AllQuantityPriceBreakSortKeys),
+	"QuantityPricingByVariantUserErrorCode":                       enumValues(AllQuantityPricingByVariantUserErrorCode),
+	"QuantityRuleOriginType":                                      enumValues(AllQuantityRuleOriginType),
+	"QuantityRuleUserErrorCode":                                   enumValues(AllQuantityRuleUserErrorCode),
+	"RefundDutyRefundType":                                        enumValues(AllRefundDutyRefundType),
+	"RefundLineItemRestockType":                                   enumValues(AllRefundLineItemRestockType),
+	"ResourceAlertIcon":                                           enumValues(AllResourceAlertIcon),
+	"ResourceAlertSeverity":                                       enumValues(AllResourceAlertSeverity),
+	"ResourceFeedbackState":                                       enumValues(AllResourceFeedbackState),
+	"ResourceOperationStatus":                                     enumValues(AllResourceOperationStatus),
+	"ReturnDeclineReason":                                         enumValues(AllReturnDeclineReason),
+	"ReturnErrorCode":                                             enumValues(AllReturnErrorCode),
+	"ReturnReason":                                                enumValues(AllReturnReason),
+	"ReturnStatus":                                                enumValues(AllReturnStatus),
+	"ReverseFulfillmentOrderDispositionType":                      enumValues(AllReverseFulfillmentOrderDispositionType),
+	"ReverseFulfillmentOrderStatus":                               enumValues(AllReverseFulfillmentOrderStatus),
+	"ReverseFulfillmentOrderThirdPartyConfirmationStatus":         enumValues(AllReverseFulfillmentOrderThirdPartyConfirmationStatus),
+	"RiskAssessmentResult":                                        enumValues(AllRiskAssessmentResult),
+	"RiskFactSentiment":                                           enumValues(AllRiskFactSentiment),
+	"SaleActionType":                                              enumValues(AllSaleActionType),
+	"SaleLineType":                                                enumValues(AllSaleLineType),
+	"ScheduledChangeSortKeys":                                     enumValues(AllScheduledChangeSortKeys),
+	"ScriptTagDisplayScope":                                       enumValues(AllScriptTagDisplayScope),
+	"SearchResultType":                                            enumValues(AllSearchResultType),
+	"SegmentSortKeys":                                             enumValues(AllSegmentSortKeys),
+	"SellingPlanAnchorType":                                       enumValues(AllSellingPlanAnchorType),
+	"SellingPlanCategory":                                         enumValues(AllSellingPlanCategory),
+	"SellingPlanCheckoutChargeType":                               enumValues(AllSellingPlanCheckoutChargeType),
+	"SellingPlanFixedDeliveryPolicyIntent":                        enumValues(AllSellingPlanFixedDeliveryPolicyIntent),
+	"SellingPlanFixedDeliveryPolicyPreAnchorBehavior":             enumValues(AllSellingPlanFixedDeliveryPolicyPreAnchorBehavior),
+	"SellingPlanFulfillmentTrigger":                               enumValues(AllSellingPlanFulfillmentTrigger),
+	"SellingPlanGroupSortKeys":                                    enumValues(AllSellingPlanGroupSortKeys),
+	"SellingPlanGroupUserErrorCode":                               enumValues(AllSellingPlanGroupUserErrorCode),
+	"SellingPlanInterval":                                         enumValues(AllSellingPlanInterval),
+	"SellingPlanPricingPolicyAdjustmentType":                      enumValues(AllSellingPlanPricingPolicyAdjustmentType),
+	"SellingPlanRecurringDeliveryPolicyIntent":                    enumValues(AllSellingPlanRecurringDeliveryPolicyIntent),
+	"SellingPlanRecurringDeliveryPolicyPreAnchorBehavior":         enumValues(AllSellingPlanRecurringDeliveryPolicyPreAnchorBehavior),
+	"SellingPlanRemainingBalanceChargeTrigger":                    enumValues(AllSellingPlanRemainingBalanceChargeTrigger),
+	"SellingPlanReserve":                                          enumValues(AllSellingPlanReserve),
+	"ServerPixelStatus":                                           enumValues(AllServerPixelStatus),
+	"ShippingDiscountClass":                                       enumValues(AllShippingDiscountClass),
+	"ShippingPackageType":                                         enumValues(AllShippingPackageType),
+	"ShopBranding":                                                enumValues(AllShopBranding),
+	"ShopCustomerAccountsSetting":                                 enumValues(AllShopCustomerAccountsSetting),
+	"ShopPolicyErrorCode":                                         enumValues(AllShopPolicyErrorCode),
+	"ShopPolicyType":                                              enumValues(AllShopPolicyType),
+	"ShopResourceFeedbackCreateUserErrorCode":                     enumValues(AllShopResourceFeedbackCreateUserErrorCode),
+	"ShopTagSort":                                                 enumValues(AllShopTagSort),
+	"ShopifyPaymentsBalanceTransactionPayoutStatus":               enumValues(AllShopifyPaymentsBalanceTransactionPayoutStatus),
+	"ShopifyPaymentsBankAccountStatus":                            enumValues(AllShopifyPaymentsBankAccountStatus),
+	"ShopifyPaymentsDisputeEvidenceFileType":                      enumValues(AllShopifyPaymentsDisputeEvidenceFileType),
+	"ShopifyPaymentsDisputeReason":                                enumValues(AllShopifyPaymentsDisputeReason),
+	"ShopifyPaymentsPayoutInterval":                               enumValues(AllShopifyPaymentsPayoutInterval),
+	"ShopifyPaymentsPayoutStatus":                                 enumValues(AllShopifyPaymentsPayoutStatus),
+	"ShopifyPaymentsPayoutTransactionType":                        enumValues(AllShopifyPaymentsPayoutTransactionType),
+	"ShopifyPaymentsSourceType":                                   enumValues(AllShopifyPaymentsSourceType),
+	"ShopifyPaymentsTransactionType":                              enumValues(AllShopifyPaymentsTransactionType),
+	"ShopifyPaymentsVerificationStatus":                           enumValues(AllShopifyPaymentsVerificationStatus),
+	"ShopifyProtectEligibilityStatus":                             enumValues(AllShopifyProtectEligibilityStatus),
+	"ShopifyProtectStatus":                                        enumValues(AllShopifyProtectStatus),
+	"StaffMemberDefaultImage":                                     enumValues(AllStaffMemberDefaultImage),
+	"StaffMemberPermission":                                       enumValues(AllStaffMemberPermission),
+	"StaffMembersSortKeys":                                        enumValues(AllStaffMembersSortKeys),
+	"StagedUploadHTTPMethodType":                                  enumValues(AllStagedUploadHTTPMethodType),
+	"StagedUploadTargetGenerateUploadResource":                    enumValues(AllStagedUploadTargetGenerateUploadResource),
+	"StandardMetafieldDefinitionEnableUserErrorCode":              enumValues(AllStandardMetafieldDefinitionEnableUserErrorCode),
+	"StoreCreditAccountCreditUserErrorCode":                       enumValues(AllStoreCreditAccountCreditUserErrorCode),
+	"StoreCreditAccountDebitUserErrorCode":                        enumValues(AllStoreCreditAccountDebitUserErrorCode),
+	"SubscriptionBillingAttemptErrorCode":                         enumValues(AllSubscriptionBillingAttemptErrorCode),
+	"SubscriptionBillingAttemptsSortKeys":                         enumValues(AllSubscriptionBillingAttemptsSortKeys),
+	"SubscriptionBillingCycleBillingAttemptStatus":                enumValues(AllSubscriptionBillingCycleBillingAttemptStatus),
+	"SubscriptionBillingCycleBillingCycleStatus":                  enumValues(AllSubscriptionBillingCycleBillingCycleStatus),
+	"SubscriptionBillingCycleBulkUserErrorCode":                   enumValues(AllSubscriptionBillingCycleBulkUserErrorCode),
+	"SubscriptionBillingCycleErrorCode":                           enumValues(AllSubscriptionBillingCycleErrorCode),
+	"SubscriptionBillingCycleScheduleEditInputScheduleEditReason": enumValues(AllSubscriptionBillingCycleScheduleEditInputScheduleEditReason),
+	"SubscriptionBillingCycleSkipUserErrorCode":                   enumValues(AllSubscriptionBillingCycleSkipUserErrorCode),
+	"SubscriptionBillingCycleUnskipUserErrorCode":                 enumValues(AllSubscriptionBillingCycleUnskipUserErrorCode),
+	"SubscriptionBillingCyclesSortKeys":                           enumValues(AllSubscriptionBillingCyclesSortKeys),
+	"SubscriptionBillingCyclesTargetSelection":                    enumValues(AllSubscriptionBillingCyclesTargetSelection),
+	"SubscriptionContractErrorCode":                               enumValues(AllSubscriptionContractErrorCode),
+	"SubscriptionContractLastBillingErrorType":                    enumValues(AllSubscriptionContractLastBillingErrorType),
+	"SubscriptionContractLastPaymentStatus":                       enumValues(AllSubscriptionContractLastPaymentStatus),
+	"SubscriptionContractStatusUpdateErrorCode":                   enumValues(AllSubscriptionContractStatusUpdateErrorCode),
+	"SubscriptionContractSubscriptionStatus":                      enumValues(AllSubscriptionContractSubscriptionStatus),
+	"SubscriptionDiscountRejectionReason":                         enumValues(AllSubscriptionDiscountRejectionReason),
+	"SubscriptionDraftErrorCode":                                  enumValues(AllSubscriptionDraftErrorCode),
+	"SuggestedOrderTransactionKind":                               enumValues(AllSuggestedOrderTransactionKind),
+	"TaxAppConfigureUserErrorCode":                                enumValues(AllTaxAppConfigureUserErrorCode),
+	"TaxExemption":                                                enumValues(AllTaxExemption),
+	"TaxPartnerState":                                             enumValues(AllTaxPartnerState),
+	"ThemeCreateUserErrorCode":                                    enumValues(AllThemeCreateUserErrorCode),
+	"ThemeDeleteUserErrorCode":                                    enumValues(AllThemeDeleteUserErrorCode),
+	"ThemePublishUserErrorCode":                                   enumValues(AllThemePublishUserErrorCode),
+	"ThemeRole":                                                   enumValues(AllThemeRole),
+	"ThemeUpdateUserErrorCode":                                    enumValues(AllThemeUpdateUserErrorCode),
+	"TransactionSortKeys":                                         enumValues(AllTransactionSortKeys),
+	"TransactionVoidUserErrorCode":                                enumValues(AllTransactionVoidUserErrorCode),
+	"TranslatableResourceType":                                    enumValues(AllTranslatableResourceType),
+	"TranslationErrorCode":                                        enumValues(AllTranslationErrorCode),
+	"URLRedirectBulkDeleteByIdsUserErrorCode":                     enumValues(AllURLRedirectBulkDeleteByIdsUserErrorCode),
+	"URLRedirectBulkDeleteBySavedSearchUserErrorCode":             enumValues(AllURLRedirectBulkDeleteBySavedSearchUserErrorCode),
+	"URLRedirectBulkDeleteBySearchUserErrorCode":                  enumValues(AllURLRedirectBulkDeleteBySearchUserErrorCode),
+	"URLRedirectErrorCode":                                        enumValues(AllURLRedirectErrorCode),
+	"URLRedirectImportErrorCode":                                  enumValues(AllURLRedirectImportErrorCode),
+	"URLRedirectSortKeys":                                         enumValues(AllURLRedirectSortKeys),
+	"UnitPriceMeasurementMeasuredType":                            enumValues(AllUnitPriceMeasurementMeasuredType),
+	"UnitPriceMeasurementMeasuredUnit":                            enumValues(AllUnitPriceMeasurementMeasuredUnit),
+	"UnitSystem":                                                  enumValues(AllUnitSystem),
+	"ValidationSortKeys":                                          enumValues(AllValidationSortKeys),
+	"ValidationUserErrorCode":                                     enumValues(AllValidationUserErrorCode),
+	"WebhookSubscriptionFormat":                                   enumValues(AllWebhookSubscriptionFormat),
+	"WebhookSubscriptionSortKeys":                                 enumValues(AllWebhookSubscriptionSortKeys),
+	"WebhookSubscriptionTopic":                                    enumValues(AllWebhookSubscriptionTopic),
+	"WeightUnit":                                                  enumValues(AllWeightUnit),
+}
+
+// enumConstants maps the name of every enum to the names of its constants without the name of the
+// enum, in the order of All<Enum>.
+var enumConstants = map[string][]string{
+	"AbandonedCheckoutSortKeys":                                   {"CheckoutID", "CreatedAt", "CustomerName", "TotalPrice", "ID", "Relevance"},
+	"AbandonmentAbandonmentType":                                  {"Browse", "Cart", "Checkout"},
+	"AbandonmentDeliveryState":                                    {"NotSent", "Sent", "Scheduled"},
+	"AbandonmentEmailState":                                       {"NotSent", "Sent", "Scheduled"},
+	"AbandonmentEmailStateUpdateUserErrorCode":                    {"AbandonmentNotFound"},
+	"AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode":    {"AbandonmentNotFound", "MarketingActivityNotFound", "DeliveryStatusInfoNotFound"},
+	"AccountType":                                                 {"Regular", "Restricted", "Invited", "Requested", "Collaborator", "CollaboratorTeamMember", "Saml", "InvitedStoreOwner"},
+	"AdjustmentsSortKeys":                                         {"Time", "ID", "Relevance"},
+	"AppDeveloperType":                                            {"Shopify", "Partner", "Merchant", "Unknown"},
+	"AppInstallationCategory":                                     {"Channel", "PosEmbedded"},
+	"AppInstallationPrivacy":                                      {"Public", "Private"},
+	"AppInstallationSortKeys":                                     {"InstalledAt", "AppTitle", "ID", "Relevance"},
+	"AppPricingInterval":                                          {"Annual", "Every30Days"},
+	"AppPublicCategory":                                           {"Private", "Public", "Custom", "Other"},
+	"AppPurchaseStatus":                                           {"Accepted", "Active", "Declined", "Expired", "Pending"},
+	"AppRevenueAttributionRecordSortKeys":                         {"CreatedAt", "ID", "Relevance"},
+	"AppRevenueAttributionType":                                   {"ApplicationPurchase", "ApplicationSubscription", "ApplicationUsage", "Other"},
+	"AppRevokeAccessScopesAppRevokeScopeErrorCode":                {"MissingSourceApp", "ApplicationCannotBeFound", "UnknownScopes", "CannotRevokeRequiredScopes", "CannotRevokeImpliedScopes", "CannotRevokeUndeclaredScopes", "AppNotInstalled"},
+	"AppSubscriptionReplacementBehavior":                          {"ApplyImmediately", "ApplyOnNextBillingCycle", "Standard"},
+	"AppSubscriptionSortKeys":                                     {"CreatedAt", "ID", "Relevance"},
+	"AppSubscriptionStatus":                                       {"Pending", "Accepted", "Active", "Declined", "Expired", "Frozen", "Cancelled"},
+	"AppSubscriptionTrialExtendUserErrorCode":                     {"SubscriptionNotFound", "TrialNotActive", "SubscriptionNotActive"},
+	"AppTransactionSortKeys":                                      {"CreatedAt", "ID", "Relevance"},
+	"AppUsageRecordSortKeys":                                      {"CreatedAt", "ID", "Relevance"},
+	"ArticleCreateUserErrorCode":                                  {"AmbiguousAuthor", "AmbiguousBlog", "AuthorFieldRequired", "AuthorMustExist", "InvalidPublishDate", "BlogReferenceRequired", "UploadFailed", "NotFound", "TooLong", "Taken", "InvalidValue", "InvalidType"},
+	"ArticleDeleteUserErrorCode":                                  {"NotFound"},
+	"ArticleSortKeys":                                             {"Title", "BlogTitle", "Author", "UpdatedAt", "PublishedAt", "ID", "Relevance"},
+	"ArticleTagSort":                                              {"Alphabetical", "Popular"},
+	"ArticleUpdateUserErrorCode":                                  {"AmbiguousAuthor", "AmbiguousBlog", "AuthorMustExist", "InvalidPublishDate", "UploadFailed", "Blank", "NotFound", "TooLong", "Taken"},
+	"AutomaticDiscountSortKeys":                                   {"CreatedAt", "ID", "Relevance"},
+	"BadgeType":                                                   {"Default", "Success", "Attention", "Warning", "Info"},
+	"BalanceTransactionSortKeys":                                  {"PayoutDate", "PayoutStatus", "ProcessedAt", "Amount", "Fee", "Net", "TransactionType", "OrderName", "PaymentMethodName", "ID", "Relevance"},
+	"BillingAttemptUserErrorCode":                                 {"Invalid", "Blank", "ContractNotFound", "OriginTimeBeforeContractCreation", "UpcomingCycleLimitExceeded", "CycleIndexOutOfRange", "CycleStartDateOutOfRange", "OriginTimeOutOfRange", "BillingCycleChargeBeforeExpectedDate", "BillingCycleSkipped", "ContractUnderReview", "ContractTerminated", "ContractPaused"},
+	"BlogCreateUserErrorCode":                                     {"Invalid", "TooLong", "Inclusion", "InvalidValue", "InvalidType"},
+	"BlogDeleteUserErrorCode":                                     {"NotFound"},
+	"BlogSortKeys":                                                {"Handle", "Title", "ID", "Relevance"},
+	"BlogUpdateUserErrorCode":                                     {"NotFound", "Invalid", "Blank", "TooLong", "Inclusion"},
+	"BulkMutationErrorCode":                                       {"OperationInProgress", "InvalidMutation", "InvalidStagedUploadFile", "NoSuchFile", "InternalFileServerError"},
+	"BulkOperationErrorCode":                                      {"AccessDenied", "InternalServerError", "Timeout"},
+	"BulkOperationStatus":                                         {"Canceled", "Canceling", "Completed", "Created", "Expired", "Failed", "Running"},
+	"BulkOperationType":                                           {"Query", "Mutation"},
+	"BulkProductResourceFeedbackCreateUserErrorCode":              {"MaximumFeedbackLimitExceeded", "OutdatedFeedback", "ProductNotFound", "Invalid", "Blank", "Present", "LessThanOrEqualTo"},
+	"BusinessCustomerErrorCode":                                   {"InternalError", "ResourceNotFound", "FailedToDelete", "Required", "NoInput", "InvalidInput", "UnexpectedType", "TooLong", "LimitReached", "Invalid", "Blank", "Taken"},
+	"CalculatedShippingLineStagedStatus":                          {"None", "Added", "Removed"},
+	"CarrierServiceCreateUserErrorCode":                           {"CarrierServiceCreateFailed"},
+	"CarrierServiceDeleteUserErrorCode":                           {"CarrierServiceDeleteFailed"},
+	"CarrierServiceSortKeys":                                      {"CreatedAt", "UpdatedAt", "ID", "Relevance"},
+	"CarrierServiceUpdateUserErrorCode":                           {"CarrierServiceUpdateFailed"},
+	"CartTransformCreateUserErrorCode":                            {"InputInvalid", "FunctionNotFound", "FunctionAlreadyRegistered", "FunctionDoesNotImplement", "InvalidMetafields"},
+	"CartTransformDeleteUserErrorCode":                            {"NotFound", "UnauthorizedAppScope"},
+	"CashTrackingSessionTransactionsSortKeys":                     {"ProcessedAt", "ID", "Relevance"},
+	"CashTrackingSessionsSortKeys":                                {"OpeningTimeDesc", "OpeningTimeAsc", "ClosingTimeDesc", "ClosingTimeAsc", "TotalDiscrepancyDesc", "TotalDiscrepancyAsc", "ID", "Relevance"},
+	"CatalogSortKeys":                                             {"Title", "ID", "Relevance"},
+	"CatalogStatus":                                               {"Active", "Archived", "Draft"},
+	"CatalogType":                                                 {"None", "App", "CompanyLocation", "Market"},
+	"CatalogUserErrorCode":                                        {"AppCatalogPriceListAssignment", "CatalogFailedToSave", "CatalogNotFound", "PriceListNotAllowedForPrimaryMarket", "CatalogContextDoesNotSupportQuantityRules", "CatalogContextDoesNotSupportQuantityPriceBreaks", "CannotAddMoreThanOneMarket", "CompanyLocationCatalogStatusPlan", "ContextAlreadyAssignedToCatalog", "ContextCatalogLimitReached", "CompanyLocationNotFound", "ContextDriverMismatch", "CountryPriceListAssignment", "InvalidCatalogContextType", "MarketCatalogStatus", "MarketNotFound", "MarketAndPriceListCurrencyMismatch", "MarketTaken", "MustProvideExactlyOneContextType", "PriceListFailedToSave", "PriceListNotFound", "PriceListLocked", "PublicationNotFound", "RequiresContextsToAddOrRemove", "UnsupportedCatalogAction", "CannotCreateAppCatalog", "CannotModifyAppCatalog", "CannotDeleteAppCatalog", "CannotCreateMarketCatalog", "CannotModifyMarketCatalog", "CannotDeleteMarketCatalog", "Invalid", "Taken", "TooLong", "TooShort", "Blank"},
+	"CheckoutBrandingBackground":                                  {"Base", "Subdued", "Transparent"},
+	"CheckoutBrandingBackgroundStyle":                             {"Solid", "None"},
+	"CheckoutBrandingBorder":                                      {"None", "BlockEnd", "Full"},
+	"CheckoutBrandingBorderStyle":                                 {"Base", "Dashed", "Dotted"},
+	"CheckoutBrandingBorderWidth":                                 {"Base", "Large100", "Large200", "Large"},
+	"CheckoutBrandingCartLinkContentType":                         {"Icon", "Image", "Text"},
+	"CheckoutBrandingColorSchemeSelection":                        {"Transparent", "ColorScheme1", "ColorScheme2", "ColorScheme3", "ColorScheme4"},
+	"CheckoutBrandingColorSelection":                              {"Transparent"},
+	"CheckoutBrandingCornerRadius":                                {"None", "Small", "Base", "Large"},
+	"CheckoutBrandingFontLoadingStrategy":                         {"Auto", "Block", "Swap", "Fallback", "Optional"},
+	"CheckoutBrandingFooterAlignment":                             {"Start", "Center", "End"},
+	"CheckoutBrandingFooterPosition":                              {"End", "Inline"},
+	"CheckoutBrandingGlobalCornerRadius":                          {"None"},
+	"CheckoutBrandingHeaderAlignment":                             {"Start", "Center", "End"},
+	"CheckoutBrandingHeaderPosition":                              {"Inline", "InlineSecondary", "Start"},
+	"CheckoutBrandingLabelPosition":                               {"Inside", "Outside"},
+	"CheckoutBrandingShadow":                                      {"Small200", "Small100", "Base", "Large100", "Large200"},
+	"CheckoutBrandingSimpleBorder":                                {"None", "Full"},
+	"CheckoutBrandingSpacing":                                     {"None", "ExtraTight", "Tight", "Base", "Loose", "ExtraLoose"},
+	"CheckoutBrandingSpacingKeyword":                              {"None", "Base", "Small", "Small100", "Small200", "Small300", "Small400", "Small500", "Large", "Large100", "Large200", "Large300", "Large400", "Large500"},
+	"CheckoutBrandingTypographyFont":                              {"Primary", "Secondary"},
+	"CheckoutBrandingTypographyKerning":                           {"Base", "Loose", "ExtraLoose"},
+	"CheckoutBrandingTypographyLetterCase":                        {"Lower", "None", "Title", "Upper"},
+	"CheckoutBrandingTypographySize":                              {"ExtraSmall", "Small", "Base", "Medium", "Large", "ExtraLarge", "ExtraExtraLarge"},
+	"CheckoutBrandingTypographyWeight":                            {"Base", "Bold"},
+	"CheckoutBrandingUpsertUserErrorCode":                         {"InternalError"},
+	"CheckoutBrandingVisibility":                                  {"Hidden", "Visible"},
+	"CheckoutProfileSortKeys":                                     {"CreatedAt", "UpdatedAt", "EditedAt", "IsPublished", "ID", "Relevance"},
+	"CodeDiscountSortKeys":                                        {"StartsAt", "EndsAt", "Title", "CreatedAt", "UpdatedAt", "ID", "Relevance"},
+	"CollectionAddProductsV2UserErrorCode":                        {"CantAddToSmartCollection", "CollectionDoesNotExist"},
+	"CollectionRuleColumn":                                        {"Tag", "Title", "Type", "ProductTaxonomyNodeID", "ProductCategoryID", "Vendor", "VariantPrice", "IsPriceReduced", "VariantCompareAtPrice", "VariantWeight", "VariantInventory", "VariantTitle", "ProductMetafieldDefinition", "VariantMetafieldDefinition"},
+	"CollectionRuleRelation":                                      {"Contains", "EndsWith", "Equals", "GreaterThan", "IsNotSet", "IsSet", "LessThan", "NotContains", "NotEquals", "StartsWith"},
+	"CollectionSortKeys":                                          {"Title", "UpdatedAt", "ID", "Relevance"},
+	"CollectionSortOrder":                                         {"AlphaAsc", "AlphaDesc", "BestSelling", "Created", "CreatedDesc", "Manual", "PriceAsc", "PriceDesc"},
+	"CombinedListingUpdateUserErrorCode":                          {"CannotHaveDuplicatedProducts", "CannotHaveParentAsChild", "CannotHaveRepeatedOptionValues", "CannotHaveRepeatedOptions", "CantAddOptionsValuesIfAlreadyExists", "CombinedListingsNotEnabled", "EditAndRemoveOnSameProducts", "FailedToAddProducts", "FailedToRemoveProducts", "FailedToUpdateProducts", "LinkedMetafieldCannotBeChanged", "LinkedMetafieldValueMissing", "LinkedMetafieldsCannotBeRepeated", "LinkedOptionsNotSupportedForShop", "MustHaveSelectedOptionValues", "OptionNameCannotBeBlank", "OptionNotFound", "OptionsMustBeEqualToTheOtherComponents", "OptionValuesCannotBeBlank", "OptionValuesCannotBeEmpty", "ParentProductMustBeACombinedListing", "ProductIsAlreadyAChild", "ProductMembershipNotFound", "ProductNotFound", "TitleTooLong", "TooManyVariants", "TooManyProducts", "UnexpectedError"},
+	"CombinedListingsRole":                                        {"Parent", "Child"},
+	"CommentApproveUserErrorCode":                                 {"NotFound"},
+	"CommentDeleteUserErrorCode":                                  {"NotFound"},
+	"CommentNotSpamUserErrorCode":                                 {"NotFound"},
+	"CommentPolicy":                                               {"AutoPublished", "Closed", "Moderated"},
+	"CommentSortKeys":                                             {"CreatedAt", "ID", "Relevance"},
+	"CommentSpamUserErrorCode":                                    {"NotFound"},
+	"CommentStatus":                                               {"Spam", "Removed", "Published", "Unapproved", "Pending"},
+	"CompanyAddressType":                                          {"Billing", "Shipping"},
+	"CompanyContactRoleAssignmentSortKeys":                        {"CreatedAt", "UpdatedAt", "LocationName", "ID", "Relevance"},
+	"CompanyContactRoleSortKeys":                                  {"CreatedAt", "UpdatedAt", "ID", "Relevance"},
+	"CompanyContactSortKeys":                                      {"CreatedAt", "UpdatedAt", "Title", "CompanyID", "Name", "Email", "NameEmail", "ID", "Relevance"},
+	"CompanyLocationSortKeys":                                     {"CreatedAt", "UpdatedAt", "Name", "CompanyID", "CompanyAndLocationName", "ID", "Relevance"},
+	"CompanyLocationStaffMemberAssignmentSortKeys":                {"CreatedAt", "UpdatedAt", "ID", "Relevance"},
+	"CompanySortKeys":                                             {"CreatedAt", "UpdatedAt", "SinceDate", "Name", "OrderCount", "TotalSpent", "ID", "Relevance"},
+	"CountPrecision":                                              {"Exact", "AtLeast"},
+	"CountryCode":                                                 {"Af", "Ax", "Al", "Dz", "Ad", "Ao", "Ai", "Ag", "Ar", "Am", "Aw", "Ac", "Au", "At", "Az", "Bs", "Bh", "Bd", "Bb", "By", "Be", "Bz", "Bj", "Bm", "Bt", "Bo", "Ba", "Bw", "Bv", "Br", "Io", "Bn", "Bg", "Bf", "Bi", "Kh", "Ca", "Cv", "Bq", "Ky", "Cf", "Td", "Cl", "Cn", "Cx", "Cc", "Co", "Km", "Cg", "Cd", "Ck", "Cr", "Hr", "Cu", "Cw", "Cy", "Cz", "Ci", "Dk", "Dj", "Dm", "Do", "Ec", "Eg", "Sv", "Gq", "Er", "Ee", "Sz", "Et", "Fk", "Fo", "Fj", "Fi", "Fr", "Gf", "Pf", "Tf", "Ga", "Gm", "Ge", "De", "Gh", "Gi", "Gr", "Gl", "Gd", "Gp", "Gt", "Gg", "Gn", "Gw", "Gy", "Ht", "Hm", "Va", "Hn", "Hk", "Hu", "Is", "In", "ID", "Ir", "Iq", "Ie", "Im", "Il", "It", "Jm", "Jp", "Je", "Jo", "Kz", "Ke", "Ki", "Kp", "Xk", "Kw", "Kg", "La", "Lv", "Lb", "Ls", "Lr", "Ly", "Li", "Lt", "Lu", "Mo", "Mg", "Mw", "My", "Mv", "Ml", "Mt", "Mq", "Mr", "Mu", "Yt", "Mx", "Md", "Mc", "Mn", "Me", "Ms", "Ma", "Mz", "Mm", "Na", "Nr", "Np", "Nl", "An", "Nc", "Nz", "Ni", "Ne", "Ng", "Nu", "Nf", "Mk", "No", "Om", "Pk", "Ps", "Pa", "Pg", "Py", "Pe", "Ph", "Pn", "Pl", "Pt", "Qa", "Cm", "Re", "Ro", "Ru", "Rw", "Bl", "Sh", "Kn", "Lc", "Mf", "Pm", "Ws", "Sm", "St", "Sa", "Sn", "Rs", "Sc", "Sl", "Sg", "Sx", "Sk", "Si", "Sb", "So", "Za", "Gs", "Kr", "Ss", "Es", "Lk", "Vc", "Sd", "Sr", "Sj", "Se", "Ch", "Sy", "Tw", "Tj", "Tz", "Th", "Tl", "Tg", "Tk", "To", "Tt", "Ta", "Tn", "Tr", "Tm", "Tc", "Tv", "Ug", "Ua", "Ae", "Gb", "Us", "Um", "Uy", "Uz", "Vu", "Ve", "Vn", "Vg", "Wf", "Eh", "Ye", "Zm", "Zw", "Zz"},
+	"CropRegion":                                                  {"Center", "Top", "Bottom", "Left", "Right"},
+	"CurrencyCode":                                                {"Usd", "Eur", "Gbp", "Cad", "Afn", "All", "Dzd", "Aoa", "Ars", "Amd", "Awg", "Aud", "Bbd", "Azn", "Bdt", "Bsd", "Bhd", "Bif", "Byn", "Bzd", "Bmd", "Btn", "Bam", "Brl", "Bob", "Bwp", "Bnd", "Bgn", "Mmk", "Khr", "Cve", "Kyd", "Xaf", "Clp", "Cny", "Cop", "Kmf", "Cdf", "Crc", "Hrk", "Czk", "Dkk", "Djf", "Dop", "Xcd", "Egp", "Ern", "Etb", "Fkp", "Xpf", "Fjd", "Gip", "Gmd", "Ghs", "Gtq", "Gyd", "Gel", "Gnf", "Htg", "Hnl", "Hkd", "Huf", "Isk", "Inr", "IDR", "Ils", "Irr", "Iqd", "Jmd", "Jpy", "Jep", "Jod", "Kzt", "Kes", "Kid", "Kwd", "Kgs", "Lak", "Lvl", "Lbp", "Lsl", "Lrd", "Lyd", "Ltl", "Mga", "Mkd", "Mop", "Mwk", "Mvr", "Mru", "Mxn", "Myr", "Mur", "Mdl", "Mad", "Mnt", "Mzn", "Nad", "Npr", "Ang", "Nzd", "Nio", "Ngn", "Nok", "Omr", "Pab", "Pkr", "Pgk", "Pyg", "Pen", "Php", "Pln", "Qar", "Ron", "Rub", "Rwf", "Wst", "Shp", "Sar", "Rsd", "Scr", "Sll", "Sgd", "Sdg", "Sos", "Syp", "Zar", "Krw", "Ssp", "Sbd", "Lkr", "Srd", "Szl", "Sek", "Chf", "Twd", "Thb", "Tjs", "Tzs", "Top", "Ttd", "Tnd", "Try", "Tmt", "Ugx", "Uah", "Aed", "Uyu", "Uzs", "Vuv", "Ves", "Vnd", "Xof", "Yer", "Zmw", "Byr", "Std", "Stn", "Ved", "Vef", "Xxx"},
+	"CustomerAccountNativePagePageType":                           {"NativeOrders", "NativeSettings", "NativeProfile", "Unknown"},
+	"CustomerAccountsVersion":                                     {"Classic", "NewCustomerAccounts"},
+	"CustomerCancelDataErasureErrorCode":                          {"DoesNotExist", "FailedToCancel", "NotBeingErased"},
+	"CustomerConsentCollectedFrom":                                {"Shopify", "Other"},
+	"CustomerEmailAddressMarketingState":                          {"Invalid", "NotSubscribed", "Pending", "Subscribed", "Unsubscribed"},
+	"CustomerEmailAddressOpenTrackingLevel":                       {"Unknown", "OptedIn", "OptedOut"},
+	"CustomerEmailMarketingConsentUpdateUserErrorCode":            {"Invalid", "Inclusion", "InternalError", "MissingArgument"},
+	"CustomerEmailMarketingState":                                 {"NotSubscribed", "Pending", "Subscribed", "Unsubscribed", "Redacted", "Invalid"},
+	"CustomerMarketingOptInLevel":                                 {"SingleOptIn", "ConfirmedOptIn", "Unknown"},
+	"CustomerMergeErrorCode":                                      {"InternalError", "InvalidCustomer", "InvalidCustomerID", "CustomerHasGiftCards", "MissingOverrideAttribute", "OverrideAttributeInvalid"},
+	"CustomerMergeErrorFieldType":                                 {"DeletedAt", "RedactedAt", "Subscriptions", "MergeInProgress", "GiftCards", "StoreCredit", "CompanyContact", "CustomerPaymentMethods", "PendingDataRequest", "MultipassIDEntifier"},
+	"CustomerMergeRequestStatus":                                  {"Requested", "InProgress", "Completed", "Failed"},
+	"CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode": {"TooManyRequests", "CustomerDoesNotExist", "InvalidEncryptedDuplicationData"},
+	"CustomerPaymentMethodGetDuplicationDataUserErrorCode":        {"PaymentMethodDoesNotExist", "InvalidInstrument", "TooManyRequests", "CustomerDoesNotExist", "SameShop", "InvalidOrganizationShop"},
+	"CustomerPaymentMethodGetUpdateURLUserErrorCode":              {"PaymentMethodDoesNotExist", "InvalidInstrument", "TooManyRequests", "CustomerDoesNotExist"},
+	"CustomerPaymentMethodRemoteUserErrorCode":                    {"Invalid", "Present", "Taken", "ExactlyOneRemoteReferenceRequired", "AuthorizeNetNotEnabledForSubscriptions", "BraintreeNotEnabledForSubscriptions"},
+	"CustomerPaymentMethodRevocationReason":                       {"AuthorizeNetGatewayNotEnabled", "AuthorizeNetReturnedNoPaymentMethod", "FailedToUpdateCreditCard", "StripeAPIAuthenticationError", "StripeAPIInvalidRequestError", "StripeGatewayNotEnabled", "StripeReturnedNoPaymentMethod", "StripePaymentMethodNotCard", "BraintreeAPIAuthenticationError", "BraintreeGatewayNotEnabled", "BraintreeReturnedNoPaymentMethod", "BraintreePaymentMethodNotCard", "ManuallyRevoked", "FailedToRetrieveBillingAddress", "Merged"},
+	"CustomerPaymentMethodUserErrorCode":                          {"Invalid", "Present", "Taken"},
+	"CustomerPredictedSpendTier":                                  {"High", "Medium", "Low"},
+	"CustomerProductSubscriberStatus":                             {"Active", "Cancelled", "Expired", "Failed", "NeverSubscribed", "Paused"},
+	"CustomerRequestDataErasureErrorCode":                         {"DoesNotExist", "FailedToRequest"},
+	"CustomerSavedSearchSortKeys":                                 {"Name", "ID", "Relevance"},
+	"CustomerSegmentMembersQueryUserErrorCode":                    {"Invalid"},
+	"CustomerSendAccountInviteEmailUserErrorCode":                 {"Invalid"},
+	"CustomerSmsMarketingConsentErrorCode":                        {"Invalid", "Inclusion", "InternalError", "MissingArgument"},
+	"CustomerSmsMarketingState":                                   {"NotSubscribed", "Pending", "Subscribed", "Unsubscribed", "Redacted"},
+	"CustomerSortKeys":                                            {"CreatedAt", "Name", "Location", "UpdatedAt", "ID", "Relevance"},
+	"CustomerState":                                               {"Declined", "Disabled", "Enabled", "Invited"},
+	"DataSaleOptOutUserErrorCode":                                 {"Failed"},
+	"DayOfTheWeek":                                                {"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+	"DelegateAccessTokenCreateUserErrorCode":                      {"EmptyAccessScope", "DelegateAccessToken", "NegativeExpiresIn", "ExpiresAfterParent", "RefreshToken", "PersistenceFailed", "UnknownScopes"},
+	"DelegateAccessTokenDestroyUserErrorCode":                     {"PersistenceFailed", "AccessTokenNotFound", "CanOnlyDeleteDelegateTokens", "AccessDenied"},
+	"DeletionEventSortKeys":                                       {"CreatedAt", "ID", "Relevance"},
+	"DeletionEventSubjectType":                                    {"Collection", "Product"},
+	"DeliveryConditionField":                                      {"TotalWeight", "TotalPrice"},
+	"DeliveryConditionOperator":                                   {"GreaterThanOrEqualTo", "LessThanOrEqualTo"},
+	"DeliveryCustomizationErrorCode":                              {"Invalid", "FunctionNotFound", "DeliveryCustomizationNotFound", "DeliveryCustomizationFunctionNotEligible", "UnauthorizedAppScope", "MaximumActiveDeliveryCustomizations", "CustomAppFunctionNotEligible", "FunctionDoesNotImplement", "FunctionPendingDeletion", "FunctionIDCannotBeChanged", "RequiredInputField", "InvalidMetafields"},
+	"DeliveryLegacyModeBlockedReason":                             {"MultiLocationDisabled", "NoLocationsFulfillingOnlineOrders"},
+	"DeliveryLocalPickupTime":                                     {"OneHour", "TwoHours", "FourHours", "TwentyFourHours", "TwoToFourDays", "FiveOrMoreDays"},
+	"DeliveryLocationLocalPickupSettingsErrorCode":                {"ActiveLocationNotFound", "GenericError"},
+	"DeliveryMethodDefinitionType":                                {"Merchant", "Participant"},
+	"DeliveryMethodType":                                          {"Shipping", "PickUp", "None", "Retail", "Local", "PickupPoint"},
+	"DeliveryPromiseProviderUpsertUserErrorCode":                  {"NotFound", "TooLong", "MustBelongToApp", "InvalidTimeZone"},
+	"DigitalWallet":                                               {"ApplePay", "AndroidPay", "GooglePay", "ShopifyPay"},
+	"DiscountApplicationAllocationMethod":                         {"Across", "Each", "One"},
+	"DiscountApplicationLevel":                                    {"Order", "Line"},
+	"DiscountApplicationTargetSelection":                          {"All", "Entitled", "Explicit"},
+	"DiscountApplicationTargetType":                               {"LineItem", "ShippingLine"},
+	"DiscountClass":                                               {"Product", "Order", "Shipping"},
+	"DiscountCodeSortKeys":                                        {"Code", "CreatedAt", "ID", "Relevance"},
+	"DiscountErrorCode":                                           {"Blank", "Present", "EqualTo", "GreaterThan", "GreaterThanOrEqualTo", "Invalid", "LessThanOrEqualTo", "LessThan", "Taken", "TooLong", "TooShort", "InternalError", "TooManyArguments", "MissingArgument", "ActivePeriodOverlap", "ExceededMax", "MinimumSubtotalAndQuantityRangeBothPresent", "ValueOutsideRange", "Conflict", "ImplicitDuplicate", "Duplicate", "Inclusion", "InvalidCombinesWithForDiscountClass", "InvalidDiscountClassForPriceRule", "MaxAppDiscounts"},
+	"DiscountShareableURLTargetType":                              {"Home", "Product", "Collection"},
+	"DiscountSortKeys":                                            {"StartsAt", "EndsAt", "Title", "CreatedAt", "UpdatedAt", "ID", "Relevance"},
+	"DiscountStatus":                                              {"Active", "Expired", "Scheduled"},
+	"DiscountTargetType":                                          {"LineItem", "ShippingLine"},
+	"DiscountType":                                                {"Manual", "CodeDiscount", "AutomaticDiscount"},
+	"DisputeEvidenceUpdateUserErrorCode":                          {"DisputeEvidenceNotFound", "EvidenceAlreadyAccepted", "EvidencePastDueDate", "FilesSizeExceededLimit", "TooLarge", "Invalid"},
+	"DisputeStatus":                                               {"Accepted", "Lost", "NeedsResponse", "UnderReview", "Won", "ChargeRefunded"},
+	"DisputeType":                                                 {"Chargeback", "Inquiry"},
+	"DraftOrderAppliedDiscountType":                               {"FixedAmount", "Percentage"},
+	"DraftOrderSortKeys":                                          {"Number", "UpdatedAt", "Status", "TotalPrice", "CustomerName", "ID", "Relevance"},
+	"DraftOrderStatus":                                            {"Completed", "InvoiceSent", "Open"},
+	"ErrorsServerPixelUserErrorCode":                              {"NotFound", "AlreadyExists", "PubSubError", "NeedsConfigurationToConnect"},
+	"ErrorsWebPixelUserErrorCode":                                 {"Blank", "Taken", "NotFound", "InvalidSettings", "UnableToDelete"},
+	"EventSortKeys":                                               {"CreatedAt", "ID", "Relevance"},
+	"EventSubjectType":                                            {"CompanyLocation", "Company", "Customer", "DraftOrder", "Collection", "Product", "ProductVariant", "Article", "Blog", "Comment", "Page", "DiscountAutomaticBxgy", "DiscountAutomaticNode", "DiscountCodeNode", "DiscountNode", "PriceRule", "Order", "Unknown"},
+	"FileContentType":                                             {"Image", "File", "Video", "ExternalVideo", "Model3d"},
+	"FileCreateInputDuplicateResolutionMode":                      {"AppendUUID", "RaiseError", "Replace"},
+	"FileErrorCode":                                               {"Unknown", "InvalidSignedURL", "ImageDownloadFailure", "ImageProcessingFailure", "MediaTimeoutError", "ExternalVideoNotFound", "ExternalVideoUnlisted", "ExternalVideoInvalidAspectRatio", "ExternalVideoEmbedDisabled", "ExternalVideoEmbedNotFoundOrTranscoding", "GenericFileDownloadFailure", "GenericFileInvalidSize", "VideoMetadataReadError", "VideoInvalidFiletypeError", "VideoMinWidthError", "VideoMaxWidthError", "VideoMinHeightError", "VideoMaxHeightError", "VideoMinDurationError", "VideoMaxDurationError", "VideoValidationError", "Model3dValidationError", "Model3dThumbnailGenerationError", "Model3dThumbnailRegenerationError", "Model3dGlbToUsdzConversionError", "Model3dGlbOutputCreationError", "Model3dProcessingFailure", "UnsupportedImageFileType", "InvalidImageFileSize", "InvalidImageAspectRatio", "InvalidImageResolution", "FileStorageLimitExceeded", "DuplicateFilenameError"},
+	"FileSortKeys":                                                {"Filename", "OriginalUploadSize", "CreatedAt", "UpdatedAt", "ID", "Relevance"},
+	"FileStatus":                                                  {"Uploaded", "Processing", "Ready", "Failed"},
+	"FilesErrorCode":                                              {"Invalid", "FileDoesNotExist", "FileLocked", "UnsupportedMediaTypeForFilenameUpdate", "TooManyArguments", "BlankSearch", "MissingArguments", "InvalidQuery", "InvalidFilenameExtension", "InvalidFilename", "FilenameAlreadyExists", "UnacceptableUnverifiedTrialAsset", "UnacceptableAsset", "UnacceptableTrialAsset", "AltValueLimitExceeded", "NonReadyState", "NonImageMediaPerShopLimitExceeded", "MismatchedFilenameAndOriginalSource", "InvalidDuplicateModeForType", "InvalidImageSourceURL", "MissingFilenameForDuplicateModeReplace", "ProductMediaLimitExceeded", "UnsupportedFileReference", "ReferenceTargetDoesNotExist", "TooManyFileReference"},
+	"FulfillmentConstraintRuleCreateUserErrorCode":                {"InputInvalid", "FunctionNotFound", "FunctionAlreadyRegistered", "FunctionDoesNotImplement", "CustomAppFunctionNotEligible", "FunctionPendingDeletion", "MaximumFulfillmentConstraintRulesReached"},
+	"FulfillmentConstraintRuleDeleteUserErrorCode":                {"NotFound", "UnauthorizedAppScope"},
+	"FulfillmentConstraintRuleUpdateUserErrorCode":                {"NotFound", "UnauthorizedAppScope"},
+	"FulfillmentDisplayStatus":                                    {"AttemptedDelivery", "Canceled", "Confirmed", "Delivered", "Failure", "Fulfilled", "InTransit", "LabelPrinted", "LabelPurchased", "LabelVoided", "MarkedAsFulfilled", "NotDelivered", "OutForDelivery", "ReadyForPickup", "PickedUp", "Submitted"},
+	"FulfillmentEventSortKeys":                                    {"HappenedAt", "ID", "Relevance"},
+	"FulfillmentEventStatus":                                      {"LabelPurchased", "LabelPrinted", "ReadyForPickup", "Confirmed", "InTransit", "OutForDelivery", "AttemptedDelivery", "Delivered", "Failure"},
+	"FulfillmentHoldReason":                                       {"AwaitingPayment", "HighRiskOfFraud", "IncorrectAddress", "InventoryOutOfStock", "UnknownDeliveryDate", "OnlineStorePostPurchaseCrossSell", "AwaitingReturnItems", "Other"},
+	"FulfillmentOrderAction":                                      {"CreateFulfillment", "RequestFulfillment", "CancelFulfillmentOrder", "Move", "RequestCancellation", "MarkAsOpen", "ReleaseHold", "Hold", "External", "Split", "Merge"},
+	"FulfillmentOrderAssignmentStatus":                            {"CancellationRequested", "FulfillmentRequested", "FulfillmentAccepted", "FulfillmentUnsubmitted"},
+	"FulfillmentOrderHoldUserErrorCode":                           {"FulfillmentOrderNotFound", "Taken", "GreaterThanZero", "InvalidLineItemQuantity"},
+	"FulfillmentOrderLineItemsPreparedForPickupUserErrorCode":     {"NoLineItemsToPrepareForFulfillmentOrder", "FulfillmentOrderInvalid", "UnableToPrepareQuantity"},
+	"FulfillmentOrderMerchantRequestKind":                         {"FulfillmentRequest", "CancellationRequest"},
+	"FulfillmentOrderMergeUserErrorCode":                          {"FulfillmentOrderNotFound", "GreaterThan", "InvalidLineItemQuantity"},
+	"FulfillmentOrderRejectionReason":                             {"IncorrectAddress", "InventoryOutOfStock", "IneligibleProduct", "UndeliverableDestination", "Other"},
+	"FulfillmentOrderReleaseHoldUserErrorCode":                    {"FulfillmentOrderNotFound", "InvalidAccess"},
+	"FulfillmentOrderRequestStatus":                               {"Unsubmitted", "Submitted", "Accepted", "Rejected", "CancellationRequested", "CancellationAccepted", "CancellationRejected", "Closed"},
+	"FulfillmentOrderRescheduleUserErrorCode":                     {"FulfillmentOrderNotFound"},
+	"FulfillmentOrderSortKeys":                                    {"ID", "UpdatedAt", "Relevance"},
+	"FulfillmentOrderSplitUserErrorCode":                          {"FulfillmentOrderNotFound", "GreaterThan", "InvalidLineItemQuantity", "NoLineItemsProvidedToSplit"},
+	"FulfillmentOrderStatus":                                      {"Open", "InProgress", "Cancelled", "Incomplete", "Closed", "Scheduled", "OnHold"},
+	"FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode":        {"FulfillmentOrdersNotFound"},
+	"FulfillmentServiceDeleteInventoryAction":                     {"Delete", "Keep", "Transfer"},
+	"FulfillmentServiceType":                                      {"GiftCard", "Manual", "ThirdParty"},
+	"FulfillmentStatus":                                           {"Pending", "Open", "Success", "Cancelled", "Error", "Failure"},
+	"GiftCardDeactivateUserErrorCode":                             {"GiftCardNotFound"},
+	"GiftCardErrorCode":                                           {"TooLong", "TooShort", "Taken", "Invalid", "InternalError", "MissingArgument", "GreaterThan", "CustomerNotFound", "RecipientNotFound"},
+	"GiftCardSendNotificationToCustomerUserErrorCode":             {"Invalid", "CustomerNotFound", "GiftCardNotFound"},
+	"GiftCardSendNotificationToRecipientUserErrorCode":            {"Invalid", "RecipientNotFound", "GiftCardNotFound"},
+	"GiftCardSortKeys":                                            {"CreatedAt", "UpdatedAt", "CustomerName", "Code", "Balance", "AmountSpent", "InitialValue", "DisabledAt", "ExpiresOn", "ID", "Relevance"},
+	"GiftCardTransactionUserErrorCode":                            {"Invalid", "InternalError", "GiftCardLimitExceeded", "GiftCardNotFound", "NegativeOrZeroAmount", "InsufficientFunds", "MismatchingCurrency"},
+	"ImageContentType":                                            {"Png", "Jpg", "Webp"},
+	"InventoryAdjustQuantitiesUserErrorCode":                      {"InternalLedgerDocument", "InvalidAvailableDocument", "InvalidInventoryItem", "InvalidLedgerDocument", "InvalidLocation", "InvalidQuantityDocument", "InvalidQuantityName", "InvalidQuantityTooLow", "InvalidQuantityTooHigh", "InvalidReason", "InvalidReferenceDocument", "AdjustQuantitiesFailed", "MaxOneLedgerDocument", "ItemNotStockedAtLocation", "NonMutableInventoryItem"},
+	"InventoryBulkToggleActivationUserErrorCode":                  {"GenericError", "CannotDeactivateFromOnlyLocation", "CommittedAndIncomingInventoryAtLocation", "IncomingInventoryAtLocation", "CommittedInventoryAtLocation", "ReservedInventoryAtLocation", "FailedToUnstockFromLocation", "InventoryManagedBy3rdParty", "InventoryManagedByShopify", "FailedToStockAtLocation", "MissingSku", "LocationNotFound", "InventoryItemNotFound"},
+	"InventoryMoveQuantitiesUserErrorCode":                        {"InternalLedgerDocument", "InvalidAvailableDocument", "InvalidInventoryItem", "InvalidLedgerDocument", "InvalidLocation", "InvalidQuantityDocument", "InvalidQuantityName", "InvalidQuantityNegative", "InvalidQuantityTooHigh", "InvalidReason", "InvalidReferenceDocument", "MoveQuantitiesFailed", "DifferentLocations", "SameQuantityName", "MaximumLedgerDocumentURIS", "ItemNotStockedAtLocation", "NonMutableInventoryItem"},
+	"InventorySetOnHandQuantitiesUserErrorCode":                   {"InvalidInventoryItem", "InvalidLocation", "InvalidQuantityNegative", "InvalidReason", "InvalidReferenceDocument", "SetOnHandQuantitiesFailed", "ItemNotStockedAtLocation", "NonMutableInventoryItem", "InvalidQuantityTooHigh"},
+	"InventorySetQuantitiesUserErrorCode":                         {"InvalidInventoryItem", "InvalidLocation", "InvalidQuantityNegative", "InvalidReason", "InvalidReferenceDocument", "ItemNotStockedAtLocation", "InvalidQuantityTooHigh", "InvalidQuantityTooLow", "CompareQuantityRequired", "CompareQuantityStale", "InvalidName", "NoDuplicateInventoryItemIDGroupIDPair"},
+	"InventorySetScheduledChangesUserErrorCode":                   {"ErrorUpdatingScheduled", "SameFromToNames", "InvalidFromName", "InvalidToName", "DuplicateToName", "InvalidReason", "DuplicateFromName", "LocationNotFound", "InventoryStateNotFound", "ItemsEmpty", "InventoryItemNotFound", "Inclusion", "LedgerDocumentInvalid"},
+	"LanguageCode":                                                {"Af", "Ak", "Am", "Ar", "As", "Az", "Be", "Bg", "Bm", "Bn", "Bo", "Br", "Bs", "Ca", "Ce", "Ckb", "Cs", "Cy", "Da", "De", "Dz", "Ee", "El", "En", "Eo", "Es", "Et", "Eu", "Fa", "Ff", "Fi", "Fil", "Fo", "Fr", "Fy", "Ga", "Gd", "Gl", "Gu", "Gv", "Ha", "He", "Hi", "Hr", "Hu", "Hy", "Ia", "ID", "Ig", "Ii", "Is", "It", "Ja", "Jv", "Ka", "Ki", "Kk", "Kl", "Km", "Kn", "Ko", "Ks", "Ku", "Kw", "Ky", "Lb", "Lg", "Ln", "Lo", "Lt", "Lu", "Lv", "Mg", "Mi", "Mk", "Ml", "Mn", "Mr", "Ms", "Mt", "My", "Nb", "Nd", "Ne", "Nl", "Nn", "No", "Om", "Or", "Os", "Pa", "Pl", "Ps", "PtBr", "PtPt", "Qu", "Rm", "Rn", "Ro", "Ru", "Rw", "Sa", "Sc", "Sd", "Se", "Sg", "Si", "Sk", "Sl", "Sn", "So", "Sq", "Sr", "Su", "Sv", "Sw", "Ta", "Te", "Tg", "Th", "Ti", "Tk", "To", "Tr", "Tt", "Ug", "Uk", "Ur", "Uz", "Vi", "Wo", "Xh", "Yi", "Yo", "ZhCn", "ZhTw", "Zu", "Zh", "Pt", "Cu", "Vo"},
+	"LengthUnit":                                                  {"Millimeters", "Centimeters", "Meters", "Inches", "Feet", "Yards"},
+	"LocalizableContentType":                                      {"JSONString", "JSON", "ListMultiLineTextField", "ListSingleLineTextField", "ListURL", "MultiLineTextField", "RichTextField", "SingleLineTextField", "String", "URL", "Link", "ListLink", "FileReference", "ListFileReference", "HTML", "URI", "InlineRichText"},
+	"LocalizationExtensionKey":                                    {"TaxCredentialBr", "ShippingCredentialBr", "TaxCredentialCl", "ShippingCredentialCl", "ShippingCredentialCn", "TaxCredentialCo", "TaxCredentialTypeCo", "ShippingCredentialCo", "ShippingCredentialTypeCo", "TaxCredentialCr", "ShippingCredentialCr", "TaxCredentialEc", "ShippingCredentialEc", "TaxCredentialGt", "ShippingCredentialGt", "TaxCredentialID", "ShippingCredentialID", "TaxCredentialIt", "TaxEmailIt", "TaxCredentialMy", "ShippingCredentialMy", "TaxCredentialMx", "TaxCredentialTypeMx", "TaxCredentialUseMx", "TaxCredentialPy", "ShippingCredentialPy", "TaxCredentialPe", "ShippingCredentialPe", "TaxCredentialPt", "ShippingCredentialPt", "ShippingCredentialKr", "TaxCredentialEs", "ShippingCredentialEs", "ShippingCredentialTw", "TaxCredentialTr", "ShippingCredentialTr"},
+	"LocalizationExtensionPurpose":                                {"Shipping", "Tax"},
+	"LocationActivateUserErrorCode":                               {"GenericError", "LocationLimit", "HasOngoingRelocation", "LocationNotFound", "HasNonUniqueName"},
+	"LocationAddUserErrorCode":                                    {"Invalid", "TooLong", "Taken", "Blank", "InvalidUsZipcode", "GenericError", "InvalidType", "InvalidValue", "AppNotAuthorized", "UnstructuredReservedNamespace", "DisallowedOwnerType", "Inclusion", "Present", "TooShort", "CapabilityViolation", "InternalError"},
+	"LocationDeactivateUserErrorCode":                             {"LocationNotFound", "PermanentlyBlockedFromDeactivationError", "TemporarilyBlockedFromDeactivationError", "HasActiveRetailSubscriptions", "DestinationLocationIsTheSameLocation", "DestinationLocationNotFoundOrInactive", "HasActiveInventoryError", "HasFulfillmentOrdersError", "HasIncomingMovementsError", "HasOpenPurchaseOrdersError", "FailedToRelocateActiveInventories", "FailedToRelocateOpenPurchaseOrders", "FailedToRelocateIncomingMovements", "CannotDisableOnlineOrderFulfillment"},
+	"LocationDeleteUserErrorCode":                                 {"LocationNotFound", "LocationIsActive", "GenericError", "LocationHasInventory", "LocationHasPendingOrders", "LocationHasActiveRetailSubscription"},
+	"LocationEditUserErrorCode":                                   {"TooLong", "Blank", "NotFound", "Invalid", "Taken", "InvalidUsZipcode", "GenericError", "CannotDisableOnlineOrderFulfillment", "CannotModifyOnlineOrderFulfillmentForFsLocation", "InvalidType", "InvalidValue", "AppNotAuthorized", "UnstructuredReservedNamespace", "DisallowedOwnerType", "Inclusion", "Present", "TooShort", "CapabilityViolation", "InternalError"},
+	"LocationSortKeys":                                            {"Name", "ID", "Relevance"},
+	"MailingAddressValidationResult":                              {"NoIssues", "Error", "Warning"},
+	"MarketCurrencySettingsUserErrorCode":                         {"MarketNotFound", "ManagedMarket", "MultipleCurrenciesNotSupported", "NoLocalCurrenciesOnSingleCountryMarket", "UnsupportedCurrency", "PrimaryMarketUsesShopCurrency"},
+	"MarketLocalizableResourceType":                               {"Metafield", "Metaobject"},
+	"MarketUserErrorCode":                                         {"Invalid", "Taken", "TooLong", "TooShort", "Blank", "MarketNotFound", "RegionNotFound", "WebPresenceNotFound", "CannotAddRegionsToPrimaryMarket", "CannotDeleteOnlyRegion", "RequiresExactlyOneOption", "CannotDeletePrimaryMarket", "DomainNotFound", "SubfolderSuffixMustContainOnlyLetters", "SubfolderSuffixCannotBeScriptCode", "NoLanguages", "DuplicateLanguages", "RegionSpecificLanguage", "CannotHaveSubfolderAndDomain", "CannotAddWebPresenceToPrimaryMarket", "MarketReachedWebPresenceLimit", "CannotHaveMultipleSubfoldersPerMarket", "CannotHaveBothSubfolderAndDomainWebPresences", "RequiresDomainOrSubfolder", "PrimaryMarketMustUsePrimaryDomain", "CannotDeletePrimaryMarketWebPresence", "ShopReachedMarketsLimit", "CannotDisablePrimaryMarket", "UnpublishedLanguage", "DisabledLanguage", "CannotSetDefaultLocaleToNull", "UnsupportedCountryRegion", "CannotAddCustomerDomain"},
+	"MarketingActivityExtensionAppErrorCode":                      {"NotOnboardedError", "ValidationError", "APIError", "PlatformError", "InstallRequiredError"},
+	"MarketingActivityExternalStatus":                             {"Active", "Inactive", "Paused", "Scheduled", "DeletedExternally", "Undefined"},
+	"MarketingActivityHierarchyLevel":                             {"Ad", "AdGroup", "Campaign"},
+	"MarketingActivitySortKeys":                                   {"Title", "CreatedAt", "ID", "Relevance"},
+	"MarketingActivityStatus":                                     {"Active", "Deleted", "DeletedExternally", "Disconnected", "Draft", "Failed", "Inactive", "Paused", "Pending", "Scheduled", "Undefined"},
+	"MarketingActivityStatusBadgeType":                            {"Default", "Success", "Attention", "Warning", "Info"},
+	"MarketingActivityUserErrorCode":                              {"Invalid", "Taken", "MarketingActivityDoesNotExist", "MarketingEventDoesNotExist", "CurrencyCodeMismatchInput", "MarketingActivityCurrencyCodeMismatch", "DeleteJobFailedToEnqueue", "NonHierarchialRequiresUtmURLParameter", "DeleteJobEnqueued", "ActivityNotExternal", "ImmutableChannelHandle", "ImmutableURLParameter", "ImmutableUtmParameters", "ImmutableParentID", "ImmutableHierarchyLevel", "InvalidRemoteID", "InvalidChannelHandle", "InvalidDeleteActivityExternalArguments", "InvalidDeleteEngagementsArguments", "InvalidMarketingActivityExternalArguments", "InvalidMarketingEngagementArguments", "InvalidMarketingEngagementArgumentMissing", "CannotDeleteActivityWithChildEvents", "CannotUpdateTacticToStorefrontApp", "CannotUpdateTacticIfOriginallyStorefrontApp"},
+	"MarketingBudgetBudgetType":                                   {"Daily", "Lifetime"},
+	"MarketingChannel":                                            {"Search", "Display", "Social", "Email", "Referral"},
+	"MarketingEventSortKeys":                                      {"StartedAt", "ID", "Relevance"},
+	"MarketingTactic":                                             {"AbandonedCart", "Ad", "Affiliate", "Link", "Loyalty", "Message", "Newsletter", "Notification", "Post", "Retargeting", "Transactional", "StorefrontApp", "Seo"},
+	"MediaContentType":                                            {"Video", "ExternalVideo", "Model3d", "Image"},
+	"MediaErrorCode":                                              {"Unknown", "InvalidSignedURL", "ImageDownloadFailure", "ImageProcessingFailure", "MediaTimeoutError", "ExternalVideoNotFound", "ExternalVideoUnlisted", "ExternalVideoInvalidAspectRatio", "ExternalVideoEmbedDisabled", "ExternalVideoEmbedNotFoundOrTranscoding", "GenericFileDownloadFailure", "GenericFileInvalidSize", "VideoMetadataReadError", "VideoInvalidFiletypeError", "VideoMinWidthError", "VideoMaxWidthError", "VideoMinHeightError", "VideoMaxHeightError", "VideoMinDurationError", "VideoMaxDurationError", "VideoValidationError", "Model3dValidationError", "Model3dThumbnailGenerationError", "Model3dThumbnailRegenerationError", "Model3dGlbToUsdzConversionError", "Model3dGlbOutputCreationError", "Model3dProcessingFailure", "UnsupportedImageFileType", "InvalidImageFileSize", "InvalidImageAspectRatio", "InvalidImageResolution", "FileStorageLimitExceeded", "DuplicateFilenameError"},
+	"MediaHost":                                                   {"Youtube", "Vimeo"},
+	"MediaPreviewImageStatus":                                     {"Uploaded", "Processing", "Ready", "Failed"},
+	"MediaStatus":                                                 {"Uploaded", "Processing", "Ready", "Failed"},
+	"MediaUserErrorCode":                                          {"Invalid", "Blank", "VideoValidationError", "Model3dValidationError", "VideoThrottleExceeded", "Model3dThrottleExceeded", "ProductMediaLimitExceeded", "ShopMediaLimitExceeded", "ProductDoesNotExist", "MediaDoesNotExist", "MediaDoesNotExistOnProduct", "TooManyMediaPerInputPair", "MaximumVariantMediaPairsExceeded", "InvalidMediaType", "ProductVariantSpecifiedMultipleTimes", "ProductVariantDoesNotExistOnProduct", "NonReadyMedia", "ProductVariantAlreadyHasMedia", "MediaIsNotAttachedToVariant", "MediaCannotBeModified", "MissingArguments"},
+	"MediaWarningCode":                                            {"ModelSmallPhysicalSize", "ModelLargePhysicalSize"},
+	"MenuCreateUserErrorCode":                                     {"NotFound", "NestingTooDeep"},
+	"MenuDeleteUserErrorCode":                                     {"MenuDoesNotExist", "UnableToDeleteDefaultMenu"},
+	"MenuItemType":                                                {"Frontpage", "Collection", "Collections", "Product", "Catalog", "Page", "Blog", "Article", "Search", "ShopPolicy", "HTTP", "Metaobject", "CustomerAccountPage"},
+	"MenuSortKeys":                                                {"Title", "UpdatedAt", "ID", "Relevance"},
+	"MenuUpdateUserErrorCode":                                     {"NotFound", "NestingTooDeep"},
+	"MerchandiseDiscountClass":                                    {"Product", "Order"},
+	"MetafieldAdminAccess":                                        {"Private", "PublicRead", "PublicReadWrite", "MerchantRead", "MerchantReadWrite"},
+	"MetafieldAdminAccessInput":                                   {"Private", "PublicRead", "PublicReadWrite", "MerchantRead", "MerchantReadWrite"},
+	"MetafieldCustomerAccountAccess":                              {"ReadWrite", "Read", "None"},
+	"MetafieldCustomerAccountAccessInput":                         {"ReadWrite", "Read", "None"},
+	"MetafieldDefinitionAdminFilterStatus":                        {"NotFilterable", "InProgress", "Filterable", "Failed"},
+	"MetafieldDefinitionConstraintStatus":                         {"ConstrainedAndUnconstrained", "ConstrainedOnly", "UnconstrainedOnly"},
+	"MetafieldDefinitionCreateUserErrorCode":                      {"Invalid", "Inclusion", "Present", "Taken", "TooLong", "TooShort", "ResourceTypeLimitExceeded", "LimitExceeded", "InvalidOption", "DuplicateOption", "ReservedNamespaceKey", "PinnedLimitReached", "UnstructuredAlreadyExists", "InvalidCharacter", "TypeNotAllowedForConditions", "OwnerTypeLimitExceededForAutomatedCollections", "GrantLimitExceeded", "InvalidInputCombination", "InvalidCapability"},
+	"MetafieldDefinitionDeleteUserErrorCode":                      {"Present", "NotFound", "InternalError", "ReferenceTypeDeletionError", "ReservedNamespaceOrphanedMetafields", "MetafieldDefinitionInUse", "DisallowedOwnerType"},
+	"MetafieldDefinitionPinUserErrorCode":                         {"NotFound", "PinnedLimitReached", "AlreadyPinned", "InternalError", "DisallowedOwnerType"},
+	"MetafieldDefinitionPinnedStatus":                             {"Any", "Pinned", "Unpinned"},
+	"MetafieldDefinitionSortKeys":                                 {"ID", "Name", "PinnedPosition", "Relevance"},
+	"MetafieldDefinitionUnpinUserErrorCode":                       {"NotFound", "NotPinned", "InternalError", "DisallowedOwnerType"},
+	"MetafieldDefinitionUpdateUserErrorCode":                      {"Present", "TooLong", "NotFound", "InvalidInput", "PinnedLimitReached", "InternalError", "TypeNotAllowedForConditions", "MetafieldDefinitionInUse", "OwnerTypeLimitExceededForAutomatedCollections", "MetaobjectDefinitionChanged", "GrantLimitExceeded", "InvalidInputCombination", "InvalidCapability", "CapabilityCannotBeDisabled"},
+	"MetafieldDefinitionValidationStatus":                         {"AllValid", "InProgress", "SomeInvalid"},
+	"MetafieldGrantAccessLevel":                                   {"Read", "ReadWrite"},
+	"MetafieldOwnerType":                                          {"APIPermission", "Company", "CompanyLocation", "PaymentCustomization", "Validation", "Customer", "DeliveryCustomization", "Draftorder", "GiftCardTransaction", "Market", "Carttransform", "Collection", "MediaImage", "Product", "Productvariant", "SellingPlan", "Article", "Blog", "Page", "FulfillmentConstraintRule", "OrderRoutingLocationRule", "Discount", "Order", "Location", "Shop"},
+	"MetafieldStorefrontAccess":                                   {"PublicRead", "None", "LegacyLiquidOnly"},
+	"MetafieldStorefrontAccessInput":                              {"PublicRead", "None"},
+	"MetafieldValidationStatus":                                   {"Any", "Valid", "Invalid"},
+	"MetafieldValueType":                                          {"String", "Integer", "JSONString", "Boolean"},
+	"MetafieldsSetUserErrorCode":                                  {"CapabilityViolation", "StaleObject", "InvalidCompareDigest", "InvalidType", "InvalidValue", "AppNotAuthorized", "Inclusion", "Present", "Blank", "TooLong", "TooShort", "LessThanOrEqualTo", "InternalError"},
+	"MetaobjectAdminAccess":                                       {"Private", "MerchantRead", "MerchantReadWrite", "PublicRead", "PublicReadWrite"},
+	"MetaobjectStatus":                                            {"Draft", "Active"},
+	"MetaobjectStorefrontAccess":                                  {"None", "PublicRead"},
+	"MetaobjectUserErrorCode":                                     {"Invalid", "Inclusion", "Taken", "TooLong", "TooShort", "Present", "Blank", "InvalidType", "InvalidValue", "InvalidOption", "DuplicateFieldInput", "UndefinedObjectType", "UndefinedObjectField", "ObjectFieldTaken", "ObjectFieldRequired", "RecordNotFound", "InternalError", "MaxDefinitionsExceeded", "MaxObjectsExceeded", "Immutable", "NotAuthorized", "ReservedName", "DisplayNameConflict", "CapabilityNotEnabled", "URLHandleTaken", "URLHandleInvalid", "URLHandleBlank", "FieldTypeInvalid", "MissingRequiredKeys"},
+	"MethodDefinitionSortKeys":                                    {"RateProviderType", "ID", "Relevance"},
+	"MobilePlatformApplicationUserErrorCode":                      {"Invalid", "NotFound", "TooLong"},
+	"OnlineStoreThemeFileBodyInputType":                           {"Text", "Base64", "URL"},
+	"OnlineStoreThemeFileResultType":                              {"Success", "Error", "Conflict", "UnprocessableEntity", "BadRequest", "Timeout", "NotFound"},
+	"OnlineStoreThemeFilesUserErrorsCode":                         {"NotFound", "LessThanOrEqualTo", "ThemeFilesConflict", "DuplicateFileInput", "AccessDenied", "ThemeLimitedPlan", "FileValidationError", "Error"},
+	"OrderActionType":                                             {"Order", "OrderEdit", "Refund", "Return", "Unknown"},
+	"OrderAdjustmentDiscrepancyReason":                            {"Restock", "Damage", "Customer", "RefundDiscrepancy", "FullReturnBalancingAdjustment", "PendingRefundDiscrepancy"},
+	"OrderAdjustmentInputDiscrepancyReason":                       {"Restock", "Damage", "Customer", "Other"},
+	"OrderCancelReason":                                           {"Customer", "Declined", "Fraud", "Inventory", "Staff", "Other"},
+	"OrderCancelUserErrorCode":                                    {"NoRefundPermission", "NotFound", "Invalid"},
+	"OrderCreateFinancialStatus":                                  {"Pending", "Authorized", "PartiallyPaid", "Paid", "PartiallyRefunded", "Refunded", "Voided", "Expired"},
+	"OrderCreateFulfillmentStatus":                                {"Fulfilled", "Partial", "Restocked"},
+	"OrderCreateInputsInventoryBehavior":                          {"Bypass", "DecrementIgnoringPolicy", "DecrementObeyingPolicy"},
+	"OrderCreateMandatePaymentUserErrorCode":                      {"OrderMandatePaymentErrorCode"},
+	"OrderCreateUserErrorCode":                                    {"Invalid", "FulfillmentServiceInvalid", "InventoryClaimFailed", "ProcessedAtInvalid", "TaxLineRateMissing"},
+	"OrderDeleteUserErrorCode":                                    {"NotFound", "Invalid"},
+	"OrderDisplayFinancialStatus":                                 {"Pending", "Authorized", "PartiallyPaid", "PartiallyRefunded", "Voided", "Paid", "Refunded", "Expired"},
+	"OrderDisplayFulfillmentStatus":                               {"Unfulfilled", "PartiallyFulfilled", "Fulfilled", "Restocked", "PendingFulfillment", "Open", "InProgress", "OnHold", "Scheduled", "RequestDeclined"},
+	"OrderEditAddShippingLineUserErrorCode":                       {"Invalid"},
+	"OrderEditRemoveDiscountUserErrorCode":                        {"Invalid"},
+	"OrderEditRemoveShippingLineUserErrorCode":                    {"Invalid"},
+	"OrderEditUpdateDiscountUserErrorCode":                        {"Invalid"},
+	"OrderEditUpdateShippingLineUserErrorCode":                    {"Invalid"},
+	"OrderInvoiceSendUserErrorCode":                               {"OrderInvoiceSendUnsuccessful"},
+	"OrderPaymentStatusResult":                                    {"Success", "Authorized", "Voided", "Refunded", "Captured", "Purchased", "Error", "Processing", "RedirectRequired", "Retryable", "Unknown", "Initiated", "Pending"},
+	"OrderReturnStatus":                                           {"InProgress", "InspectionComplete", "NoReturn", "Returned", "ReturnFailed", "ReturnRequested"},
+	"OrderRiskAssessmentCreateUserErrorCode":                      {"TooManyFacts", "OrderAlreadyFulfilled", "Invalid", "NotFound"},
+	"OrderRiskLevel":                                              {"Low", "Medium", "High"},
+	"OrderRiskRecommendationResult":                               {"Cancel", "Investigate", "Accept", "None"},
+	"OrderSortKeys":                                               {"CreatedAt", "CustomerName", "Destination", "FinancialStatus", "FulfillmentStatus", "OrderNumber", "ProcessedAt", "TotalItemsQuantity", "TotalPrice", "UpdatedAt", "PoNumber", "ID", "Relevance"},
+	"OrderTransactionErrorCode":                                   {"IncorrectNumber", "InvalidNumber", "InvalidExpiryDate", "InvalidCvc", "ExpiredCard", "IncorrectCvc", "IncorrectZip", "IncorrectAddress", "IncorrectPin", "CardDeclined", "ProcessingError", "CallIssuer", "PickUpCard", "ConfigError", "TestModeLiveCard", "UnsupportedFeature", "GenericError", "InvalidCountry", "InvalidAmount", "PaymentMethodUnavailable", "AmazonPaymentsInvalidPaymentMethod", "AmazonPaymentsMaxAmountCharged", "AmazonPaymentsMaxAmountRefunded", "AmazonPaymentsMaxAuthorizationsCaptured", "AmazonPaymentsMaxRefundsProcessed", "AmazonPaymentsOrderReferenceCanceled", "AmazonPaymentsStale"},
+	"OrderTransactionKind":                                        {"Sale", "Capture", "Authorization", "Void", "Refund", "Change", "EmvAuthorization", "SuggestedRefund"},
+	"OrderTransactionStatus":                                      {"Success", "Failure", "Pending", "Error", "AwaitingResponse", "Unknown"},
+	"PageCreateUserErrorCode":                                     {"InvalidPublishDate", "TooLong", "Taken", "InvalidValue", "InvalidType"},
+	"PageDeleteUserErrorCode":                                     {"NotFound"},
+	"PageUpdateUserErrorCode":                                     {"InvalidPublishDate", "NotFound", "Blank", "TooLong", "Taken"},
+	"PaymentCustomizationErrorCode":                               {"CustomAppFunctionNotEligible", "FunctionDoesNotImplement", "FunctionNotFound", "FunctionPendingDeletion", "Invalid", "PaymentCustomizationNotFound", "PaymentCustomizationFunctionNotEligible", "MaximumActivePaymentCustomizations", "RequiredInputField", "InvalidMetafields", "FunctionIDCannotBeChanged"},
+	"PaymentMethods":                                              {"Visa", "Mastercard", "Discover", "AmericanExpress", "DinersClub", "Jcb", "Unionpay", "Elo", "Dankort", "Maestro", "Forbrugsforeningen", "Paypal", "Bogus", "Bitcoin", "Litecoin", "Dogecoin", "Interac", "Eftpos"},
+	"PaymentReminderSendUserErrorCode":                            {"PaymentReminderSendUnsuccessful"},
+	"PaymentTermsCreateUserErrorCode":                             {"PaymentTermsCreationUnsuccessful"},
+	"PaymentTermsDeleteUserErrorCode":                             {"PaymentTermsDeleteUnsuccessful"},
+	"PaymentTermsType":                                            {"Receipt", "Net", "Fixed", "Fulfillment", "Unknown"},
+	"PaymentTermsUpdateUserErrorCode":                             {"PaymentTermsUpdateUnsuccessful"},
+	"PayoutSortKeys":                                              {"IssuedAt", "Status", "ChargeGross", "RefundGross", "AdjustmentGross", "DutiesGross", "AdvanceGross", "ShippingLabelGross", "FeeAmount", "Amount", "ID", "Relevance"},
+	"PaypalExpressSubscriptionsGatewayStatus":                     {"Enabled", "Disabled", "Pending"},
+	"PriceCalculationType":                                        {"ComponentsSum", "Fixed", "None"},
+	"PriceListAdjustmentType":                                     {"PercentageDecrease", "PercentageIncrease"},
+	"PriceListCompareAtMode":                                      {"Adjusted", "Nullify"},
+	"PriceListFixedPricesByProductBulkUpdateUserErrorCode":        {"NoUpdateOperationsSpecified", "PricesToAddCurrencyMismatch", "PriceListDoesNotExist", "DuplicateIDInInput", "IDMustBeMutuallyExclusive", "ProductDoesNotExist", "PriceLimitExceeded"},
+	"PriceListPriceOriginType":                                    {"Fixed", "Relative"},
+	"PriceListPriceUserErrorCode":                                 {"Blank", "PriceListNotFound", "PriceListCurrencyMismatch", "VariantNotFound", "PriceNotFixed"},
+	"PriceListSortKeys":                                           {"Name", "ID", "Relevance"},
+	"PriceListUserErrorCode":                                      {"Taken", "Blank", "Inclusion", "TooLong", "PriceListNotFound", "PriceListLocked", "ContextRuleLimitReached", "ContextRuleCountriesLimit", "CurrencyCountryMismatch", "CountryCurrencyMismatch", "CurrencyMarketMismatch", "MarketCurrencyMismatch", "InvalidAdjustmentValue", "InvalidAdjustmentMinValue", "InvalidAdjustmentMaxValue", "ContextRuleCountryTaken", "CatalogContextDoesNotSupportQuantityRules", "CatalogContextDoesNotSupportQuantityPriceBreaks", "ContextRuleLimitOneOption", "ContextRuleMarketNotFound", "ContextRuleMarketTaken", "CurrencyNotSupported", "PriceListNotAllowedForPrimaryMarket", "CatalogAssignmentNotAllowed", "CatalogDoesNotExist", "CatalogCannotChangeContextType", "CatalogMarketAndPriceListCurrencyMismatch", "CatalogTaken", "CountryPriceListAssignment", "AppCatalogPriceListAssignment", "GenericError"},
+	"PriceRuleAllocationMethod":                                   {"Each", "Across"},
+	"PriceRuleFeature":                                            {"BuyOneGetOne", "BuyOneGetOneWithAllocationLimit", "Bulk", "SpecificCustomers", "QuantityDiscounts"},
+	"PriceRuleShareableURLTargetType":                             {"Home", "Product", "Collection"},
+	"PriceRuleStatus":                                             {"Active", "Expired", "Scheduled"},
+	"PriceRuleTarget":                                             {"LineItem", "ShippingLine"},
+	"PriceRuleTrait":                                              {"BuyOneGetOne", "BuyOneGetOneWithAllocationLimit", "Bulk", "SpecificCustomers", "QuantityDiscounts"},
+	"PrivateMetafieldValueType":                                   {"String", "Integer", "JSONString"},
+	"ProductBundleComponentOptionSelectionStatus":                 {"Selected", "Deselected", "New", "Unavailable"},
+	"ProductBundleMutationUserErrorCode":                          {"GenericError", "ProductDoesNotExist", "InvalidInput", "JobError"},
+	"ProductChangeStatusUserErrorCode":                            {"ProductNotFound", "CombinedListingsNotCompatibleWithShop"},
+	"ProductCollectionSortKeys":                                   {"Title", "Price", "BestSelling", "Created", "ID", "Manual", "CollectionDefault", "Relevance"},
+	"ProductFeedCreateUserErrorCode":                              {"Invalid", "Taken"},
+	"ProductFeedDeleteUserErrorCode":                              {"Invalid"},
+	"ProductFeedStatus":                                           {"Active", "Inactive"},
+	"ProductFullSyncUserErrorCode":                                {"Invalid"},
+	"ProductImageSortKeys":                                        {"CreatedAt", "Position", "ID", "Relevance"},
+	"ProductMediaSortKeys":                                        {"Position", "ID", "Relevance"},
+	"ProductOperationStatus":                                      {"Created", "Active", "Complete"},
+	"ProductOptionCreateVariantStrategy":                          {"LeaveAsIs", "Create"},
+	"ProductOptionDeleteStrategy":                                 {"Default", "Position", "NonDestructive"},
+	"ProductOptionUpdateUserErrorCode":                            {"ProductDoesNotExist", "ProductSuspended", "OptionDoesNotExist", "OptionAlreadyExists", "InvalidPosition", "InvalidName", "OptionValuesOverLimit", "OptionValueDoesNotExist", "OptionValueAlreadyExists", "OptionValueHasVariants", "CannotDeleteAllOptionValuesInOption", "CannotLeaveOptionsWithoutVariants", "NoKeyOnCreate", "KeyMissingInInput", "DuplicatedOptionValue", "OptionNameTooLong", "OptionValueNameTooLong", "OptionValueConflictingOperation", "CannotCreateVariantsAboveLimit", "CannotCombineLinkedAndNonlinkedOptionValues", "InvalidMetafieldValueForLinkedOption", "DuplicateLinkedOption", "OptionLinkedMetafieldAlreadyTaken", "LinkedOptionUpdateMissingValues", "LinkedOptionsNotSupportedForShop", "LinkedMetafieldDefinitionNotFound", "CannotMakeChangesIfVariantIsMissingRequiredSku", "UnsupportedCombinedListingParentOperation", "CannotDeleteVariantWithoutPermission", "TooManyVariantsCreated"},
+	"ProductOptionUpdateVariantStrategy":                          {"LeaveAsIs", "Manage"},
+	"ProductOptionsCreateUserErrorCode":                           {"OptionAlreadyExists", "OptionsOverLimit", "OptionValuesOverLimit", "InvalidName", "ProductSuspended", "NewOptionWithoutValueForExistingVariants", "DuplicatedOptionName", "DuplicatedOptionValue", "OptionNameMissing", "OptionValuesMissing", "PositionOutOfBounds", "OptionPositionMissing", "ProductDoesNotExist", "LinkedMetafieldDefinitionNotFound", "InvalidMetafieldValueForLinkedOption", "MissingMetafieldValuesForLinkedOption", "CannotCombineLinkedMetafieldAndOptionValues", "DuplicateLinkedOption", "OptionLinkedMetafieldAlreadyTaken", "LinkedOptionsNotSupportedForShop", "CannotMakeChangesIfVariantIsMissingRequiredSku", "UnsupportedCombinedListingParentOperation", "LinkedMetafieldValueWithoutLinkedOption", "TooManyVariantsCreated"},
+	"ProductOptionsDeleteUserErrorCode":                           {"ProductDoesNotExist", "ProductSuspended", "OptionDoesNotExist", "OptionsDoNotBelongToTheSameProduct", "CannotDeleteOptionWithMultipleValues", "CannotUseNonDestructiveStrategy", "CannotMakeChangesIfVariantIsMissingRequiredSku", "UnsupportedCombinedListingParentOperation"},
+	"ProductOptionsReorderUserErrorCode":                          {"OptionNameDoesNotExist", "OptionValueDoesNotExist", "OptionIDDoesNotExist", "OptionValueIDDoesNotExist", "DuplicatedOptionName", "DuplicatedOptionValue", "MissingOptionName", "MissingOptionValue", "ProductDoesNotExist", "NoKeyOnReorder", "MixingIDAndNameKeysIsNotAllowed", "CannotMakeChangesIfVariantIsMissingRequiredSku"},
+	"ProductSetUserErrorCode":                                     {"GenericError", "InvalidMetafield", "InvalidVariant", "ProductDoesNotExist", "ProductVariantDoesNotExist", "OptionDoesNotExist", "OptionValueDoesNotExist", "OptionsOverLimit", "OptionValuesOverLimit", "OptionValuesMissing", "DuplicatedOptionName", "DuplicatedOptionValue", "VariantsOverLimit", "ProductOptionsInputMissing", "VariantsInputMissing", "GiftCardsNotActivated", "GiftCardAttributeCannotBeChanged", "InvalidProduct", "InvalidInput", "JobError", "CapabilityViolation", "CannotCombineLinkedAndNonlinkedOptionValues", "InvalidMetafieldValueForLinkedOption", "DuplicateLinkedOption", "LinkedOptionsNotSupportedForShop", "LinkedMetafieldDefinitionNotFound", "DuplicatedValue"},
+	"ProductSortKeys":                                             {"Title", "ProductType", "Vendor", "InventoryTotal", "UpdatedAt", "CreatedAt", "PublishedAt", "ID", "Relevance"},
+	"ProductStatus":                                               {"Active", "Archived", "Draft"},
+	"ProductVariantInventoryPolicy":                               {"Deny", "Continue"},
+	"ProductVariantRelationshipBulkUpdateUserErrorCode":           {"ParentRequired", "FailedToCreate", "ProductVariantsNotFound", "CircularReference", "NestedParentProductVariant", "InvalidQuantity", "DuplicateProductVariantRelationship", "ExceededProductVariantRelationshipLimit", "ProductVariantRelationshipTypeConflict", "UnexpectedError", "FailedToRemove", "MustSpecifyComponents", "FailedToUpdate", "FailedToUpdateParentProductVariantPrice", "UpdateParentVariantPriceRequired", "ProductVariantsNotComponents", "ProductExpanderAppOwnershipAlreadyExists", "UnsupportedMultipackRelationship", "ParentProductVariantCannotBeGiftCard", "ParentProductVariantCannotRequireSellingPlan", "ParentProductVariantCannotBeCombinedListing", "ChildProductVariantCannotBeCombinedListing"},
+	"ProductVariantSortKeys":                                      {"Title", "Name", "Sku", "InventoryQuantity", "InventoryManagement", "InventoryLevelsAvailable", "InventoryPolicy", "FullTitle", "Popular", "Position", "ID", "Relevance"},
+	"ProductVariantsBulkCreateStrategy":                           {"Default", "RemoveStandaloneVariant"},
+	"ProductVariantsBulkCreateUserErrorCode":                      {"InvalidInput", "ProductDoesNotExist", "NoKeyOnCreate", "VariantAlreadyExists", "GreaterThanOrEqualTo", "NeedToAddOptionValues", "OptionValuesForNumberOfUnknownOptions", "TooManyInventoryLocations", "SubscriptionViolation", "VariantAlreadyExistsChangeOptionValue", "TrackedVariantLocationNotFound", "MustBeForThisProduct", "NotDefinedForShop", "Invalid", "NegativePriceValue", "UnsupportedCombinedListingParentOperation", "CannotSetNameForLinkedOptionValue"},
+	"ProductVariantsBulkDeleteUserErrorCode":                      {"ProductDoesNotExist", "CannotDeleteLastVariant", "AtLeastOneVariantDoesNotBelongToTheProduct", "UnsupportedCombinedListingParentOperation"},
+	"ProductVariantsBulkReorderUserErrorCode":                     {"ProductDoesNotExist", "MissingVariant", "InvalidPosition", "DuplicatedVariantID"},
+	"ProductVariantsBulkUpdateUserErrorCode":                      {"InvalidInput", "CannotSpecifyBoth", "MustSpecifyOneOfPair", "ProductDoesNotExist", "ProductVariantIDMissing", "ProductVariantDoesNotExist", "OptionDoesNotExist", "OptionValueDoesNotExist", "MustBeForThisProduct", "NoInventoryQuantitiesOnVariantsUpdate", "VariantAlreadyExists", "GreaterThanOrEqualTo", "NeedToAddOptionValues", "OptionValuesForNumberOfUnknownOptions", "SubscriptionViolation", "NoInventoryQuantitesDuringUpdate", "NegativePriceValue", "CannotSetNameForLinkedOptionValue", "UnsupportedCombinedListingParentOperation"},
+	"ProfileItemSortKeys":                                         {"Title", "ProductType", "Vendor", "InventoryTotal", "UpdatedAt", "CreatedAt", "PublishedAt", "ID", "Relevance"},
+	"PubSubWebhookSubscriptionCreateUserErrorCode":                {"InvalidParameters", "Taken"},
+	"PubSubWebhookSubscriptionUpdateUserErrorCode":                {"InvalidParameters"},
+	"PublicationCreateInputPublicationDefaultState":               {"Empty", "AllProducts"},
+	"PublicationUserErrorCode":                                    {"UnsupportedPublicationAction", "PublicationNotFound", "PublicationLocked", "UnsupportedPublishableType", "InvalidPublishableID", "MarketNotFound", "CatalogNotFound", "CannotModifyAppCatalogPublication", "CannotModifyMarketCatalogPublication", "CannotModifyAppCatalog", "CannotModifyMarketCatalog", "Invalid", "Taken", "TooLong", "TooShort", "Blank", "ProductTypeIncompatibleWithCatalogType", "PublicationUpdateLimitExceeded"},
+	"QuantityPriceBreakSortKeys":                                  {"MinimumQuantity", "ID", "Relevance"},
+	"QuantityPricingByVariantUserErrorCode":                       {"Blank", "PriceListNotFound", "GenericError", "QuantityPriceBreakAddInvalid", "QuantityPriceBreakAddPriceListPriceNotFound", "QuantityPriceBreakAddLimitExceeded", "QuantityPriceBreakAddCurrencyMismatch", "QuantityPriceBreakAddFailedToSave", "QuantityPriceBreakAddMinLowerThanQuantityRulesMin", "QuantityPriceBreakAddMinHigherThanQuantityRulesMax", "QuantityPriceBreakAddMinNotAMultipleOfQuantityRulesIncrement", "QuantityPriceBreakAddVariantNotFound", "QuantityPriceBreakAddDuplicateInputForVariantAndMin", "QuantityPriceBreakDeleteNotFound", "QuantityPriceBreakDeleteFailed", "QuantityRuleAddVariantNotFound", "QuantityRuleAddMinHigherThanQuantityPriceBreakMin", "QuantityRuleAddMaxLowerThanQuantityPriceBreakMin", "QuantityRuleAddIncrementNotAMultipleOfQuantityPriceBreakMin", "QuantityRuleAddCatalogContextNotSupported", "QuantityRuleAddIncrementIsGreaterThanMinimum", "QuantityRuleAddMinimumNotAMultipleOfIncrement", "QuantityRuleAddMaximumNotAMultipleOfIncrement", "QuantityRuleAddMinimumGreaterThanMaximum", "QuantityRuleAddIncrementIsLessThanOne", "QuantityRuleAddMinimumIsLessThanOne", "QuantityRuleAddMaximumIsLessThanOne", "QuantityRuleAddDuplicateInputForVariant", "QuantityRuleDeleteRuleNotFound", "QuantityRuleDeleteVariantNotFound", "PriceAddCurrencyMismatch", "PriceAddVariantNotFound", "PriceAddDuplicateInputForVariant", "PriceDeletePriceNotFixed", "PriceDeleteVariantNotFound"},
+	"QuantityRuleOriginType":                                      {"Fixed", "Relative"},
+	"QuantityRuleUserErrorCode":                                   {"Blank", "ProductVariantDoesNotExist", "PriceListDoesNotExist", "VariantQuantityRuleDoesNotExist", "MinimumIsGreaterThanMaximum", "MinimumIsHigherThanQuantityPriceBreakMinimum", "MaximumIsLowerThanQuantityPriceBreakMinimum", "IncrementNotAMultipleOfQuantityPriceBreakMinimum", "IncrementIsGreaterThanMinimum", "GreaterThanOrEqualTo", "MaximumNotMultipleOfIncrement", "MinimumNotMultipleOfIncrement", "CatalogContextDoesNotSupportQuantityRules", "DuplicateInputForVariant", "GenericError"},
+	"RefundDutyRefundType":                                        {"Proportional", "Full"},
+	"RefundLineItemRestockType":                                   {"Return", "Cancel", "LegacyRestock", "NoRestock"},
+	"ResourceAlertIcon":                                           {"CheckmarkCircle", "InformationCircle"},
+	"ResourceAlertSeverity":                                       {"Default", "Info", "Warning", "Success", "Critical", "Error"},
+	"ResourceFeedbackState":                                       {"Accepted", "RequiresAction"},
+	"ResourceOperationStatus":                                     {"Created", "Active", "Complete"},
+	"ReturnDeclineReason":                                         {"ReturnPeriodEnded", "FinalSale", "Other"},
+	"ReturnErrorCode":                                             {"InternalError", "TooManyArguments", "Blank", "EqualTo", "GreaterThan", "GreaterThanOrEqualTo", "Inclusion", "Invalid", "LessThan", "LessThanOrEqualTo", "NotANumber", "Present", "Taken", "TooBig", "TooLong", "TooShort", "WrongLength", "AlreadyExists", "CreationFailed", "FeatureNotEnabled", "InvalidState", "NotificationFailed", "NotEditable", "NotFound"},
+	"ReturnReason":                                                {"SizeTooSmall", "SizeTooLarge", "Unwanted", "NotAsDescribed", "WrongItem", "Defective", "Style", "Color", "Other", "Unknown"},
+	"ReturnStatus":                                                {"Canceled", "Closed", "Open", "Requested", "Declined"},
+	"ReverseFulfillmentOrderDispositionType":                      {"Restocked", "ProcessingRequired", "NotRestocked", "Missing"},
+	"ReverseFulfillmentOrderStatus":                               {"Canceled", "Closed", "Open"},
+	"ReverseFulfillmentOrderThirdPartyConfirmationStatus":         {"Accepted", "CancelAccepted", "CancelRejected", "PendingAcceptance", "PendingCancelation", "Rejected"},
+	"RiskAssessmentResult":                                        {"High", "Medium", "Low", "None", "Pending"},
+	"RiskFactSentiment":                                           {"Positive", "Neutral", "Negative"},
+	"SaleActionType":                                              {"Order", "Return", "Update", "Unknown"},
+	"SaleLineType":                                                {"Product", "Tip", "GiftCard", "Shipping", "Duty", "AdditionalFee", "Fee", "Unknown", "Adjustment"},
+	"ScheduledChangeSortKeys":                                     {"ExpectedAt", "ID", "Relevance"},
+	"ScriptTagDisplayScope":                                       {"All", "OrderStatus", "OnlineStore"},
+	"SearchResultType":                                            {"Customer", "DraftOrder", "Product", "Collection", "File", "Page", "Blog", "Article", "URLRedirect", "PriceRule", "DiscountRedeemCode", "Order", "BalanceTransaction"},
+	"SegmentSortKeys":                                             {"CreationDate", "LastEditDate", "ID", "Relevance"},
+	"SellingPlanAnchorType":                                       {"Weekday", "Monthday", "Yearday"},
+	"SellingPlanCategory":                                         {"Other", "PreOrder", "Subscription", "TryBeforeYouBuy"},
+	"SellingPlanCheckoutChargeType":                               {"Percentage", "Price"},
+	"SellingPlanFixedDeliveryPolicyIntent":                        {"FulfillmentBegin"},
+	"SellingPlanFixedDeliveryPolicyPreAnchorBehavior":             {"Asap", "Next"},
+	"SellingPlanFulfillmentTrigger":                               {"Anchor", "Asap", "ExactTime", "Unknown"},
+	"SellingPlanGroupSortKeys":                                    {"Name", "UpdatedAt", "CreatedAt", "ID", "Relevance"},
+	"SellingPlanGroupUserErrorCode":                               {"Blank", "EqualTo", "GreaterThan", "GreaterThanOrEqualTo", "Inclusion", "Invalid", "LessThan", "LessThanOrEqualTo", "NotANumber", "NotFound", "Present", "Taken", "TooBig", "TooLong", "TooShort", "WrongLength", "SellingPlanCountUpperBound", "SellingPlanCountLowerBound", "SellingPlanMaxCyclesMustBeGreaterThanMinCycles", "SellingPlanBillingAndDeliveryPolicyAnchorsMustBeEqual", "SellingPlanBillingCycleMustBeAMultipleOfDeliveryCycle", "SellingPlanPricingPoliciesMustContainAFixedPricingPolicy", "SellingPlanMissingOption2LabelOnParentGroup", "SellingPlanMissingOption3LabelOnParentGroup", "SellingPlanOption2RequiredAsDefinedOnParentGroup", "SellingPlanOption3RequiredAsDefinedOnParentGroup", "SellingPlanPricingPoliciesLimit", "ResourceListContainsInvalidIDS", "ProductVariantDoesNotExist", "ProductDoesNotExist", "GroupDoesNotExist", "GroupCouldNotBeDeleted", "ErrorAddingResourceToGroup", "SellingPlanDeliveryPolicyMissing", "SellingPlanBillingPolicyMissing", "PlanDoesNotExist", "PlanIDMustBeSpecifiedToUpdate", "OnlyNeedOneBillingPolicyType", "OnlyNeedOneDeliveryPolicyType", "OnlyNeedOnePricingPolicyType", "BillingAndDeliveryPolicyTypesMustBeTheSame", "OnlyNeedOnePricingPolicyValue", "PricingPolicyAdjustmentValueAndTypeMustMatch", "SellingPlanDuplicateName", "SellingPlanDuplicateOptions", "SellingPlanFixedPricingPoliciesLimit", "RemainingBalanceChargeExactTimeRequired", "CheckoutChargeValueAndTypeMustMatch", "OnlyNeedOneCheckoutChargeValue", "RemainingBalanceChargeExactTimeNotAllowed", "RemainingBalanceChargeTimeAfterCheckoutMustBeGreaterThanZero", "RemainingBalanceChargeTriggerOnFullCheckout", "RemainingBalanceChargeTriggerNoRemainingBalanceOnPartialPercentageCheckoutCharge", "RemainingBalanceChargeTriggerNoRemainingBalanceOnPriceCheckoutCharge", "FulfillmentExactTimeRequired", "FulfillmentExactTimeNotAllowed", "SellingPlanAnchorsNotAllowed", "SellingPlanAnchorsRequired", "OnlyOneOfFixedOrRecurringBilling", "OnlyOneOfFixedOrRecurringDelivery", "BillingPolicyIntervalTooLarge", "DeliveryPolicyIntervalTooLarge", "InvalidInput"},
+	"SellingPlanInterval":                                         {"Day", "Week", "Month", "Year"},
+	"SellingPlanPricingPolicyAdjustmentType":                      {"Percentage", "FixedAmount", "Price"},
+	"SellingPlanRecurringDeliveryPolicyIntent":                    {"FulfillmentBegin"},
+	"SellingPlanRecurringDeliveryPolicyPreAnchorBehavior":         {"Asap", "Next"},
+	"SellingPlanRemainingBalanceChargeTrigger":                    {"NoRemainingBalance", "ExactTime", "TimeAfterCheckout"},
+	"SellingPlanReserve":                                          {"OnFulfillment", "OnSale"},
+	"ServerPixelStatus":                                           {"Connected", "DisconnectedUnconfigured", "DisconnectedConfigured"},
+	"ShippingDiscountClass":                                       {"Shipping"},
+	"ShippingPackageType":                                         {"Box", "FlatRate", "Envelope", "SoftPack"},
+	"ShopBranding":                                                {"ShopifyGold", "ShopifyPlus", "Rogers", "Shopify"},
+	"ShopCustomerAccountsSetting":                                 {"Required", "Optional", "Disabled"},
+	"ShopPolicyErrorCode":                                         {"TooBig"},
+	"ShopPolicyType":                                              {"RefundPolicy", "ShippingPolicy", "PrivacyPolicy", "TermsOfService", "TermsOfSale", "LegalNotice", "SubscriptionPolicy", "ContactInformation"},
+	"ShopResourceFeedbackCreateUserErrorCode":                     {"OutdatedFeedback", "Invalid", "Blank", "Present"},
+	"ShopTagSort":                                                 {"Alphabetical", "Popular"},
+	"ShopifyPaymentsBalanceTransactionPayoutStatus":               {"Scheduled", "InTransit", "Paid", "Failed", "Canceled", "Pending", "ActionRequired"},
+	"ShopifyPaymentsBankAccountStatus":                            {"New", "Validated", "Verified", "Errored"},
+	"ShopifyPaymentsDisputeEvidenceFileType":                      {"CustomerCommunicationFile", "RefundPolicyFile", "CancellationPolicyFile", "UncategorizedFile", "ShippingDocumentationFile", "ServiceDocumentationFile"},
+	"ShopifyPaymentsDisputeReason":                                {"Fraudulent", "General", "Unrecognized", "Duplicate", "SubscriptionCancelled", "ProductUnacceptable", "ProductNotReceived", "CreditNotProcessed", "IncorrectAccountDetails", "InsufficientFunds", "BankCannotProcess", "DebitNotAuthorized", "CustomerInitiated"},
+	"ShopifyPaymentsPayoutInterval":                               {"Daily", "Weekly", "Monthly", "Manual"},
+	"ShopifyPaymentsPayoutStatus":                                 {"Scheduled", "InTransit", "Paid", "Failed", "Canceled"},
+	"ShopifyPaymentsPayoutTransactionType":                        {"Deposit", "Withdrawal"},
+	"ShopifyPaymentsSourceType":                                   {"AdjustmentReversal", "Charge", "Refund", "SystemAdjustment", "Dispute", "Adjustment", "Transfer"},
+	"ShopifyPaymentsTransactionType":                              {"ChargebackProtectionCredit", "ChargebackProtectionCreditReversal", "ChargebackProtectionDebit", "ChargebackProtectionDebitReversal", "CollectionsCredit", "CollectionsCreditReversal", "PromotionCredit", "PromotionCreditReversal", "AnomalyCredit", "AnomalyCreditReversal", "AnomalyDebit", "AnomalyDebitReversal", "VatRefundCredit", "VatRefundCreditReversal", "ChannelCredit", "ChannelCreditReversal", "ChannelTransferCredit", "ChannelTransferCreditReversal", "ChannelTransferDebit", "ChannelTransferDebitReversal", "ChannelPromotionCredit", "ChannelPromotionCreditReversal", "MarketplaceFeeCredit", "MarketplaceFeeCreditReversal", "MerchantGoodwillCredit", "MerchantGoodwillCreditReversal", "TaxAdjustmentDebit", "TaxAdjustmentDebitReversal", "TaxAdjustmentCredit", "TaxAdjustmentCreditReversal", "BillingDebit", "BillingDebitReversal", "ShopCashCredit", "ShopCashCreditReversal", "ShopCashBillingDebit", "ShopCashBillingDebitReversal", "ShopCashRefundDebit", "ShopCashRefundDebitReversal", "ShopCashCampaignBillingDebit", "ShopCashCampaignBillingDebitReversal", "ShopCashCampaignBillingCredit", "ShopCashCampaignBillingCreditReversal", "SellerProtectionCredit", "SellerProtectionCreditReversal", "ShopifyCollectiveDebit", "ShopifyCollectiveDebitReversal", "ShopifyCollectiveCredit", "ShopifyCollectiveCreditReversal", "BalanceTransferInbound", "MarketsProCredit", "CustomsDutyAdjustment", "ImportTaxAdjustment", "ShippingLabelAdjustment", "ShippingLabelAdjustmentBase", "ShippingLabelAdjustmentSurcharge", "ShippingReturnToOriginAdjustment", "ShippingOtherCarrierChargeAdjustment", "ChargeAdjustment", "RefundAdjustment", "ChargebackFee", "ChargebackFeeRefund", "Transfer", "TransferFailure", "TransferCancel", "ReservedFundsWithdrawal", "ReservedFundsReversal", "RiskReversal", "RiskWithdrawal", "MerchantToMerchantDebit", "MerchantToMerchantDebitReversal", "MerchantToMerchantCredit", "MerchantToMerchantCreditReversal", "ShopifySourceDebit", "ShopifySourceDebitReversal", "ShopifySourceCredit", "ShopifySourceCreditReversal", "Charge", "Refund", "RefundFailure", "ApplicationFeeRefund", "Adjustment", "DisputeWithdrawal", "DisputeReversal", "ShippingLabel", "CustomsDuty", "ImportTax", "ChargebackHold", "ChargebackHoldRelease", "ReservedFunds", "StripeFee", "TransferRefund", "Advance", "AdvanceFunding"},
+	"ShopifyPaymentsVerificationStatus":                           {"Verified", "Unverified", "Pending"},
+	"ShopifyProtectEligibilityStatus":                             {"Pending", "Eligible", "NotEligible"},
+	"ShopifyProtectStatus":                                        {"Pending", "Active", "Inactive", "Protected", "NotProtected"},
+	"StaffMemberDefaultImage":                                     {"Default", "Transparent", "NotFound"},
+	"StaffMemberPermission":                                       {"Applications", "Channels", "CreateAndEditCustomers", "CreateAndEditGiftCards", "Customers", "Dashboard", "DeactivateGiftCards", "DeleteCustomers", "Domains", "DraftOrders", "EditOrders", "EraseCustomerData", "ExportCustomers", "ExportGiftCards", "Full", "GiftCards", "Links", "Locations", "Marketing", "MarketingSection", "MergeCustomers", "Orders", "Overviews", "Pages", "PayOrdersByVaultedCard", "Preferences", "Products", "Reports", "RequestCustomerData", "Themes", "Translations"},
+	"StaffMembersSortKeys":                                        {"FirstName", "LastName", "Email", "ID"},
+	"StagedUploadHTTPMethodType":                                  {"Post", "Put"},
+	"StagedUploadTargetGenerateUploadResource":                    {"CollectionImage", "File", "Image", "Model3d", "ProductImage", "ShopImage", "Video", "BulkMutationVariables", "ReturnLabel", "URLRedirectImport"},
+	"StandardMetafieldDefinitionEnableUserErrorCode":              {"Invalid", "Taken", "TemplateNotFound", "LimitExceeded", "UnstructuredAlreadyExists", "TypeNotAllowedForConditions", "InvalidInputCombination"},
+	"StoreCreditAccountCreditUserErrorCode":                       {"AccountNotFound", "OwnerNotFound", "NegativeOrZeroAmount", "MismatchingCurrency", "ExpiresAtInPast", "CreditLimitExceeded"},
+	"StoreCreditAccountDebitUserErrorCode":                        {"AccountNotFound", "NegativeOrZeroAmount", "InsufficientFunds", "MismatchingCurrency"},
+	"SubscriptionBillingAttemptErrorCode":                         {"PaymentMethodNotFound", "PaymentProviderIsNotEnabled", "InvalidPaymentMethod", "UnexpectedError", "ExpiredPaymentMethod", "PaymentMethodDeclined", "AuthenticationError", "TestMode", "BuyerCanceledPaymentMethod", "CustomerNotFound", "CustomerInvalid", "InvalidShippingAddress", "InvalidCustomerBillingAgreement", "InvoiceAlreadyPaid", "PaymentMethodIncompatibleWithGatewayConfig", "AmountTooSmall", "InventoryAllocationsNotFound", "InsufficientInventory", "TransientError", "InsufficientFunds", "PurchaseTypeNotSupported", "PaypalErrorGeneral", "CardNumberIncorrect", "FraudSuspected"},
+	"SubscriptionBillingAttemptsSortKeys":                         {"CreatedAt", "ID", "Relevance"},
+	"SubscriptionBillingCycleBillingAttemptStatus":                {"HasAttempt", "NoAttempt", "Any"},
+	"SubscriptionBillingCycleBillingCycleStatus":                  {"Billed", "Unbilled"},
+	"SubscriptionBillingCycleBulkUserErrorCode":                   {"Invalid", "Blank", "EndDateInTheFuture", "InvalidDateRange", "StartDateBeforeEndDate"},
+	"SubscriptionBillingCycleErrorCode":                           {"Invalid", "CycleNotFound", "NoCycleEdits", "InvalidCycleIndex", "InvalidDate", "EmptyBillingCycleEditScheduleInput", "BillingDateSetOnSkipped", "OutOfBounds", "UpcomingCycleLimitExceeded", "CycleIndexOutOfRange", "CycleStartDateOutOfRange", "IncompleteBillingAttempts"},
+	"SubscriptionBillingCycleScheduleEditInputScheduleEditReason": {"BuyerInitiated", "MerchantInitiated", "DevInitiated"},
+	"SubscriptionBillingCycleSkipUserErrorCode":                   {"Invalid"},
+	"SubscriptionBillingCycleUnskipUserErrorCode":                 {"Invalid"},
+	"SubscriptionBillingCyclesSortKeys":                           {"CycleIndex", "ID", "Relevance"},
+	"SubscriptionBillingCyclesTargetSelection":                    {"All"},
+	"SubscriptionContractErrorCode":                               {"Invalid"},
+	"SubscriptionContractLastBillingErrorType":                    {"PaymentError", "CustomerError", "InventoryError", "Other"},
+	"SubscriptionContractLastPaymentStatus":                       {"Succeeded", "Failed"},
+	"SubscriptionContractStatusUpdateErrorCode":                   {"Invalid", "ContractTerminated"},
+	"SubscriptionContractSubscriptionStatus":                      {"Active", "Paused", "Cancelled", "Expired", "Failed"},
+	"SubscriptionDiscountRejectionReason":                         {"NotFound", "NoEntitledLineItems", "QuantityNotInRange", "PurchaseNotInRange", "CustomerNotEligible", "UsageLimitReached", "CustomerUsageLimitReached", "CurrentlyInactive", "NoEntitledShippingLines", "IncompatiblePurchaseType", "InternalError"},
+	"SubscriptionDraftErrorCode":                                  {"AlreadyRemoved", "Presence", "Committed", "NotInRange", "NotAnInteger", "SellingPlanMaxCyclesMustBeGreaterThanMinCycles", "DeliveryMustBeMultipleOfBilling", "InvalidBillingDate", "InvalidNoteLength", "InvalidLines", "NoEntitledLines", "CustomerDoesNotExist", "CustomerMismatch", "DeliveryMethodRequired", "MissingLocalDeliveryOptions", "CycleDiscountsUniqueAfterCycle", "InvalidAdjustmentType", "InvalidAdjustmentValue", "StaleContract", "CurrencyNotEnabled", "HasFutureEdits", "BillingCyclePresent", "BillingCycleAbsent", "BillingCycleContractDraftDeliveryPolicyInvalid", "BillingCycleContractDraftBillingPolicyInvalid", "ConcatenationBillingCycleContractDraftRequired", "DuplicateConcatenatedContracts", "UpcomingCycleLimitExceeded", "CycleIndexOutOfRange", "CycleStartDateOutOfRange", "CycleSelectorValidateOneOf", "ExceededMaxConcatenatedContracts", "CustomerRedacted", "MissingCustomerPaymentMethod", "Invalid", "Blank", "GreaterThan", "GreaterThanOrEqualTo", "LessThan", "LessThanOrEqualTo", "TooLong", "TooShort"},
+	"SuggestedOrderTransactionKind":                               {"SuggestedRefund"},
+	"TaxAppConfigureUserErrorCode":                                {"TaxPartnerNotFound", "TaxPartnerStateUpdateFailed", "TaxPartnerAlreadyActive"},
+	"TaxExemption":                                                {"CaStatusCardExemption", "CaBcResellerExemption", "CaMbResellerExemption", "CaSkResellerExemption", "CaDiplomatExemption", "CaBcCommercialFisheryExemption", "CaMbCommercialFisheryExemption", "CaNsCommercialFisheryExemption", "CaPeCommercialFisheryExemption", "CaSkCommercialFisheryExemption", "CaBcProductionAndMachineryExemption", "CaSkProductionAndMachineryExemption", "CaBcSubContractorExemption", "CaSkSubContractorExemption", "CaBcContractorExemption", "CaSkContractorExemption", "CaOnPurchaseExemption", "CaMbFarmerExemption", "CaNsFarmerExemption", "CaSkFarmerExemption", "EuReverseChargeExemptionRule", "UsAlResellerExemption", "UsAkResellerExemption", "UsAzResellerExemption", "UsArResellerExemption", "UsCaResellerExemption", "UsCoResellerExemption", "UsCtResellerExemption", "UsDeResellerExemption", "UsFlResellerExemption", "UsGaResellerExemption", "UsHiResellerExemption", "UsIDResellerExemption", "UsIlResellerExemption", "UsInResellerExemption", "UsIaResellerExemption", "UsKsResellerExemption", "UsKyResellerExemption", "UsLaResellerExemption", "UsMeResellerExemption", "UsMdResellerExemption", "UsMaResellerExemption", "UsMiResellerExemption", "UsMnResellerExemption", "UsMsResellerExemption", "UsMoResellerExemption", "UsMtResellerExemption", "UsNeResellerExemption", "UsNvResellerExemption", "UsNhResellerExemption", "UsNjResellerExemption", "UsNmResellerExemption", "UsNyResellerExemption", "UsNcResellerExemption", "UsNdResellerExemption", "UsOhResellerExemption", "UsOkResellerExemption", "UsOrResellerExemption", "UsPaResellerExemption", "UsRiResellerExemption", "UsScResellerExemption", "UsSdResellerExemption", "UsTnResellerExemption", "UsTxResellerExemption", "UsUtResellerExemption", "UsVtResellerExemption", "UsVaResellerExemption", "UsWaResellerExemption", "UsWvResellerExemption", "UsWiResellerExemption", "UsWyResellerExemption", "UsDcResellerExemption"},
+	"TaxPartnerState":                                             {"Pending", "Ready", "Active"},
+	"ThemeCreateUserErrorCode":                                    {"InvalidZip", "ZipIsEmpty", "ZipTooLarge"},
+	"ThemeDeleteUserErrorCode":                                    {"NotFound"},
+	"ThemePublishUserErrorCode":                                   {"NotFound", "CannotPublishThemeDuringInstall", "ThemePublishNotAvailableForThemeLimitedPlan"},
+	"ThemeRole":                                                   {"Main", "Unpublished", "Demo", "Development", "Archived", "Locked", "Mobile"},
+	"ThemeUpdateUserErrorCode":                                    {"NotFound", "TooLong", "Invalid"},
+	"TransactionSortKeys":                                         {"CreatedAt", "ExpiresAt"},
+	"TransactionVoidUserErrorCode":                                {"TransactionNotFound", "AuthNotSuccessful", "AuthNotVoidable", "GenericError"},
+	"TranslatableResourceType":                                    {"Article", "Blog", "Collection", "DeliveryMethodDefinition", "EmailTemplate", "Filter", "Link", "Menu", "Metafield", "Metaobject", "OnlineStoreTheme", "OnlineStoreThemeAppEmbed", "OnlineStoreThemeJSONTemplate", "OnlineStoreThemeLocaleContent", "OnlineStoreThemeSectionGroup", "OnlineStoreThemeSettingsCategory", "OnlineStoreThemeSettingsDataSections", "PackingSlipTemplate", "Page", "PaymentGateway", "Product", "ProductOption", "ProductOptionValue", "SellingPlan", "SellingPlanGroup", "Shop", "ShopPolicy"},
+	"TranslationErrorCode":                                        {"Blank", "Invalid", "ResourceNotFound", "ResourceNotTranslatable", "TooManyKeysForResource", "InvalidKeyForModel", "FailsResourceValidation", "InvalidTranslatableContent", "InvalidMarketLocalizableContent", "InvalidLocaleForShop", "InvalidCode", "InvalidFormat", "MarketCustomContentNotAllowed", "MarketDoesNotExist", "MarketLocaleCreationFailed", "ResourceNotMarketCustomizable", "InvalidLocaleForMarket", "InvalidValueForHandleTranslation"},
+	"URLRedirectBulkDeleteByIdsUserErrorCode":                     {"IDSEmpty"},
+	"URLRedirectBulkDeleteBySavedSearchUserErrorCode":             {"SavedSearchNotFound", "InvalidSavedSearchQuery"},
+	"URLRedirectBulkDeleteBySearchUserErrorCode":                  {"InvalidSearchArgument"},
+	"URLRedirectErrorCode":                                        {"DoesNotExist", "CreateFailed", "UpdateFailed", "DeleteFailed"},
+	"URLRedirectImportErrorCode":                                  {"FileDoesNotExist", "NotFound", "AlreadyImported", "InProgress"},
+	"URLRedirectSortKeys":                                         {"Relevance", "Path", "ID"},
+	"UnitPriceMeasurementMeasuredType":                            {"Volume", "Weight", "Length", "Area"},
+	"UnitPriceMeasurementMeasuredUnit":                            {"Ml", "Cl", "L", "M3", "Mg", "G", "Kg", "Mm", "Cm", "M", "M2"},
+	"UnitSystem":                                                  {"ImperialSystem", "MetricSystem"},
+	"ValidationSortKeys":                                          {"ID", "Relevance"},
+	"ValidationUserErrorCode":                                     {"NotFound", "FunctionNotFound", "CustomAppFunctionNotEligible", "FunctionDoesNotImplement", "PublicAppNotAllowed", "FunctionPendingDeletion", "InvalidType", "InvalidValue", "AppNotAuthorized", "UnstructuredReservedNamespace", "DisallowedOwnerType", "Inclusion", "Taken", "Present", "Blank", "TooLong", "TooShort", "CapabilityViolation", "InternalError"},
+	"WebhookSubscriptionFormat":                                   {"JSON", "XML"},
+	"WebhookSubscriptionSortKeys":                                 {"CreatedAt", "ID", "Relevance"},
+	"WebhookSubscriptionTopic":                                    {"AppUninstalled", "AppScopesUpdate", "CartsCreate", "CartsUpdate", "ChannelsDelete", "CheckoutsCreate", "CheckoutsDelete", "CheckoutsUpdate", "CustomerPaymentMethodsCreate", "CustomerPaymentMethodsUpdate", "CustomerPaymentMethodsRevoke", "CollectionListingsAdd", "CollectionListingsRemove", "CollectionListingsUpdate", "CollectionPublicationsCreate", "CollectionPublicationsDelete", "CollectionPublicationsUpdate", "CollectionsCreate", "CollectionsDelete", "CollectionsUpdate", "CustomerGroupsCreate", "CustomerGroupsDelete", "CustomerGroupsUpdate", "CustomersCreate", "CustomersDelete", "CustomersDisable", "CustomersEnable", "CustomersUpdate", "CustomersMarketingConsentUpdate", "CustomerTagsAdded", "CustomerTagsRemoved", "CustomersEmailMarketingConsentUpdate", "DisputesCreate", "DisputesUpdate", "DraftOrdersCreate", "DraftOrdersDelete", "DraftOrdersUpdate", "FulfillmentEventsCreate", "FulfillmentEventsDelete", "FulfillmentsCreate", "FulfillmentsUpdate", "AttributedSessionsFirst", "AttributedSessionsLast", "OrderTransactionsCreate", "OrdersCancelled", "OrdersCreate", "OrdersDelete", "OrdersEdited", "OrdersFulfilled", "OrdersPaid", "OrdersPartiallyFulfilled", "OrdersUpdated", "FulfillmentOrdersMoved", "FulfillmentOrdersHoldReleased", "FulfillmentOrdersScheduledFulfillmentOrderReady", "FulfillmentOrdersOrderRoutingComplete", "FulfillmentOrdersCancelled", "FulfillmentOrdersFulfillmentServiceFailedToComplete", "FulfillmentOrdersFulfillmentRequestRejected", "FulfillmentOrdersCancellationRequestSubmitted", "FulfillmentOrdersCancellationRequestAccepted", "FulfillmentOrdersCancellationRequestRejected", "FulfillmentOrdersFulfillmentRequestSubmitted", "FulfillmentOrdersFulfillmentRequestAccepted", "FulfillmentOrdersLineItemsPreparedForLocalDelivery", "FulfillmentOrdersPlacedOnHold", "FulfillmentOrdersMerged", "FulfillmentOrdersSplit", "ProductListingsAdd", "ProductListingsRemove", "ProductListingsUpdate", "ScheduledProductListingsAdd", "ScheduledProductListingsUpdate", "ScheduledProductListingsRemove", "ProductPublicationsCreate", "ProductPublicationsDelete", "ProductPublicationsUpdate", "ProductsCreate", "ProductsDelete", "ProductsUpdate", "RefundsCreate", "SegmentsCreate", "SegmentsDelete", "SegmentsUpdate", "ShippingAddressesCreate", "ShippingAddressesUpdate", "ShopUpdate", "TaxPartnersUpdate", "TaxServicesCreate", "TaxServicesUpdate", "ThemesCreate", "ThemesDelete", "ThemesPublish", "ThemesUpdate", "VariantsInStock", "VariantsOutOfStock", "InventoryLevelsConnect", "InventoryLevelsUpdate", "InventoryLevelsDisconnect", "InventoryItemsCreate", "InventoryItemsUpdate", "InventoryItemsDelete", "LocationsActivate", "LocationsDeactivate", "LocationsCreate", "LocationsUpdate", "LocationsDelete", "TenderTransactionsCreate", "AppPurchasesOneTimeUpdate", "AppSubscriptionsApproachingCappedAmount", "AppSubscriptionsUpdate", "LocalesCreate", "LocalesUpdate", "DomainsCreate", "DomainsUpdate", "DomainsDestroy", "SubscriptionContractsCreate", "SubscriptionContractsUpdate", "SubscriptionBillingCycleEditsCreate", "SubscriptionBillingCycleEditsUpdate", "SubscriptionBillingCycleEditsDelete", "ProfilesCreate", "ProfilesUpdate", "ProfilesDelete", "SubscriptionBillingAttemptsSuccess", "SubscriptionBillingAttemptsFailure", "SubscriptionBillingAttemptsChallenged", "ReturnsCancel", "ReturnsClose", "ReturnsReopen", "ReturnsRequest", "ReturnsApprove", "ReturnsUpdate", "ReturnsDecline", "ReverseDeliveriesAttachDeliverable", "ReverseFulfillmentOrdersDispose", "PaymentTermsCreate", "PaymentTermsDelete", "PaymentTermsUpdate", "PaymentSchedulesDue", "SellingPlanGroupsCreate", "SellingPlanGroupsUpdate", "SellingPlanGroupsDelete", "BulkOperationsFinish", "ProductFeedsCreate", "ProductFeedsUpdate", "ProductFeedsIncrementalSync", "ProductFeedsFullSync", "ProductFeedsFullSyncFinish", "MarketsCreate", "MarketsUpdate", "MarketsDelete", "OrdersRiskAssessmentChanged", "OrdersShopifyProtectEligibilityChanged", "FulfillmentOrdersRescheduled", "PublicationsDelete", "AuditEventsAdminAPIActivity", "FulfillmentOrdersLineItemsPreparedForPickup", "CompaniesCreate", "CompaniesUpdate", "CompaniesDelete", "CompanyLocationsCreate", "CompanyLocationsUpdate", "CompanyLocationsDelete", "CompanyContactsCreate", "CompanyContactsUpdate", "CompanyContactsDelete", "CustomersMerge", "CustomerAccountSettingsUpdate", "CompanyContactRolesAssign", "CompanyContactRolesRevoke", "SubscriptionContractsActivate", "SubscriptionContractsPause", "SubscriptionContractsCancel", "SubscriptionContractsFail", "SubscriptionContractsExpire", "SubscriptionBillingCyclesSkip", "SubscriptionBillingCyclesUnskip", "MetaobjectsCreate", "MetaobjectsUpdate", "MetaobjectsDelete", "DiscountsCreate", "DiscountsUpdate", "DiscountsDelete", "DiscountsRedeemcodeAdded", "DiscountsRedeemcodeRemoved", "MetafieldDefinitionsCreate", "MetafieldDefinitionsUpdate", "MetafieldDefinitionsDelete"},
+	"WeightUnit":                                                  {"Kilograms", "Grams", "Pounds", "Ounces"},
+}
+
+// LookupAbandonedCheckoutSortKeys returns the value of AllAbandonedCheckoutSortKeys named name.
+func LookupAbandonedCheckoutSortKeys(name string) (AbandonedCheckoutSortKeys, bool) {
+	return lookupEnum(AllAbandonedCheckoutSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAbandonedCheckoutSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AbandonedCheckoutSortKeys) IsKnown() bool {
+	return slices.Contains(AllAbandonedCheckoutSortKeys, e)
+}
+
+func (e AbandonedCheckoutSortKeys) GoString() string {
+	return enumGoString(AllAbandonedCheckoutSortKeys, enumConstants["AbandonedCheckoutSortKeys"], e, "model.AbandonedCheckoutSortKeys")
+}
+
+func (e AbandonedCheckoutSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AbandonedCheckoutSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AbandonedCheckoutSortKeys")
+}
+
+func (e *AbandonedCheckoutSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AbandonedCheckoutSortKeys")
+}
+
+// LookupAbandonmentAbandonmentType returns the value of AllAbandonmentAbandonmentType named name.
+func LookupAbandonmentAbandonmentType(name string) (AbandonmentAbandonmentType, bool) {
+	return lookupEnum(AllAbandonmentAbandonmentType, name)
+}
+
+// IsKnown reports whether e is one of AllAbandonmentAbandonmentType, the values declared by the schema the models were
+// generated from.
+func (e AbandonmentAbandonmentType) IsKnown() bool {
+	return slices.Contains(AllAbandonmentAbandonmentType, e)
+}
+
+func (e AbandonmentAbandonmentType) GoString() string {
+	return enumGoString(AllAbandonmentAbandonmentType, enumConstants["AbandonmentAbandonmentType"], e, "model.AbandonmentAbandonmentType")
+}
+
+func (e AbandonmentAbandonmentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AbandonmentAbandonmentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AbandonmentAbandonmentType")
+}
+
+func (e *AbandonmentAbandonmentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AbandonmentAbandonmentType")
+}
+
+// LookupAbandonmentDeliveryState returns the value of AllAbandonmentDeliveryState named name.
+func LookupAbandonmentDeliveryState(name string) (AbandonmentDeliveryState, bool) {
+	return lookupEnum(AllAbandonmentDeliveryState, name)
+}
+
+// IsKnown reports whether e is one of AllAbandonmentDeliveryState, the values declared by the schema the models were
+// generated from.
+func (e AbandonmentDeliveryState) IsKnown() bool {
+	return slices.Contains(AllAbandonmentDeliveryState, e)
+}
+
+func (e AbandonmentDeliveryState) GoString() string {
+	return enumGoString(AllAbandonmentDeliveryState, enumConstants["AbandonmentDeliveryState"], e, "model.AbandonmentDeliveryState")
+}
+
+func (e AbandonmentDeliveryState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AbandonmentDeliveryState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AbandonmentDeliveryState")
+}
+
+func (e *AbandonmentDeliveryState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AbandonmentDeliveryState")
+}
+
+// LookupAbandonmentEmailState returns the value of AllAbandonmentEmailState named name.
+func LookupAbandonmentEmailState(name string) (AbandonmentEmailState, bool) {
+	return lookupEnum(AllAbandonmentEmailState, name)
+}
+
+// IsKnown reports whether e is one of AllAbandonmentEmailState, the values declared by the schema the models were
+// generated from.
+func (e AbandonmentEmailState) IsKnown() bool {
+	return slices.Contains(AllAbandonmentEmailState, e)
+}
+
+func (e AbandonmentEmailState) GoString() string {
+	return enumGoString(AllAbandonmentEmailState, enumConstants["AbandonmentEmailState"], e, "model.AbandonmentEmailState")
+}
+
+func (e AbandonmentEmailState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AbandonmentEmailState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AbandonmentEmailState")
+}
+
+func (e *AbandonmentEmailState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AbandonmentEmailState")
+}
+
+// LookupAbandonmentEmailStateUpdateUserErrorCode returns the value of AllAbandonmentEmailStateUpdateUserErrorCode named name.
+func LookupAbandonmentEmailStateUpdateUserErrorCode(name string) (AbandonmentEmailStateUpdateUserErrorCode, bool) {
+	return lookupEnum(AllAbandonmentEmailStateUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllAbandonmentEmailStateUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e AbandonmentEmailStateUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllAbandonmentEmailStateUpdateUserErrorCode, e)
+}
+
+func (e AbandonmentEmailStateUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllAbandonmentEmailStateUpdateUserErrorCode, enumConstants["AbandonmentEmailStateUpdateUserErrorCode"], e, "model.AbandonmentEmailStateUpdateUserErrorCode")
+}
+
+func (e AbandonmentEmailStateUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AbandonmentEmailStateUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AbandonmentEmailStateUpdateUserErrorCode")
+}
+
+func (e *AbandonmentEmailStateUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AbandonmentEmailStateUpdateUserErrorCode")
+}
+
+// LookupAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode returns the value of AllAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode named name.
+func LookupAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode(name string) (AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode, bool) {
+	return lookupEnum(AllAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode, e)
+}
+
+func (e AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode) GoString() string {
+	return enumGoString(AllAbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode, enumConstants["AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode"], e, "model.AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode")
+}
+
+func (e AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode")
+}
+
+func (e *AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode")
+}
+
+// LookupAccountType returns the value of AllAccountType named name.
+func LookupAccountType(name string) (AccountType, bool) {
+	return lookupEnum(AllAccountType, name)
+}
+
+// IsKnown reports whether e is one of AllAccountType, the values declared by the schema the models were
+// generated from.
+func (e AccountType) IsKnown() bool {
+	return slices.Contains(AllAccountType, e)
+}
+
+func (e AccountType) GoString() string {
+	return enumGoString(AllAccountType, enumConstants["AccountType"], e, "model.AccountType")
+}
+
+func (e AccountType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AccountType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AccountType")
+}
+
+func (e *AccountType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AccountType")
+}
+
+// LookupAdjustmentsSortKeys returns the value of AllAdjustmentsSortKeys named name.
+func LookupAdjustmentsSortKeys(name string) (AdjustmentsSortKeys, bool) {
+	return lookupEnum(AllAdjustmentsSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAdjustmentsSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AdjustmentsSortKeys) IsKnown() bool {
+	return slices.Contains(AllAdjustmentsSortKeys, e)
+}
+
+func (e AdjustmentsSortKeys) GoString() string {
+	return enumGoString(AllAdjustmentsSortKeys, enumConstants["AdjustmentsSortKeys"], e, "model.AdjustmentsSortKeys")
+}
+
+func (e AdjustmentsSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AdjustmentsSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AdjustmentsSortKeys")
+}
+
+func (e *AdjustmentsSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AdjustmentsSortKeys")
+}
+
+// LookupAppDeveloperType returns the value of AllAppDeveloperType named name.
+func LookupAppDeveloperType(name string) (AppDeveloperType, bool) {
+	return lookupEnum(AllAppDeveloperType, name)
+}
+
+// IsKnown reports whether e is one of AllAppDeveloperType, the values declared by the schema the models were
+// generated from.
+func (e AppDeveloperType) IsKnown() bool {
+	return slices.Contains(AllAppDeveloperType, e)
+}
+
+func (e AppDeveloperType) GoString() string {
+	return enumGoString(AllAppDeveloperType, enumConstants["AppDeveloperType"], e, "model.AppDeveloperType")
+}
+
+func (e AppDeveloperType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppDeveloperType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppDeveloperType")
+}
+
+func (e *AppDeveloperType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppDeveloperType")
+}
+
+// LookupAppInstallationCategory returns the value of AllAppInstallationCategory named name.
+func LookupAppInstallationCategory(name string) (AppInstallationCategory, bool) {
+	return lookupEnum(AllAppInstallationCategory, name)
+}
+
+// IsKnown reports whether e is one of AllAppInstallationCategory, the values declared by the schema the models were
+// generated from.
+func (e AppInstallationCategory) IsKnown() bool {
+	return slices.Contains(AllAppInstallationCategory, e)
+}
+
+func (e AppInstallationCategory) GoString() string {
+	return enumGoString(AllAppInstallationCategory, enumConstants["AppInstallationCategory"], e, "model.AppInstallationCategory")
+}
+
+func (e AppInstallationCategory) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppInstallationCategory) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppInstallationCategory")
+}
+
+func (e *AppInstallationCategory) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppInstallationCategory")
+}
+
+// LookupAppInstallationPrivacy returns the value of AllAppInstallationPrivacy named name.
+func LookupAppInstallationPrivacy(name string) (AppInstallationPrivacy, bool) {
+	return lookupEnum(AllAppInstallationPrivacy, name)
+}
+
+// IsKnown reports whether e is one of AllAppInstallationPrivacy, the values declared by the schema the models were
+// generated from.
+func (e AppInstallationPrivacy) IsKnown() bool {
+	return slices.Contains(AllAppInstallationPrivacy, e)
+}
+
+func (e AppInstallationPrivacy) GoString() string {
+	return enumGoString(AllAppInstallationPrivacy, enumConstants["AppInstallationPrivacy"], e, "model.AppInstallationPrivacy")
+}
+
+func (e AppInstallationPrivacy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppInstallationPrivacy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppInstallationPrivacy")
+}
+
+func (e *AppInstallationPrivacy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppInstallationPrivacy")
+}
+
+// LookupAppInstallationSortKeys returns the value of AllAppInstallationSortKeys named name.
+func LookupAppInstallationSortKeys(name string) (AppInstallationSortKeys, bool) {
+	return lookupEnum(AllAppInstallationSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAppInstallationSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AppInstallationSortKeys) IsKnown() bool {
+	return slices.Contains(AllAppInstallationSortKeys, e)
+}
+
+func (e AppInstallationSortKeys) GoString() string {
+	return enumGoString(AllAppInstallationSortKeys, enumConstants["AppInstallationSortKeys"], e, "model.AppInstallationSortKeys")
+}
+
+func (e AppInstallationSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppInstallationSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppInstallationSortKeys")
+}
+
+func (e *AppInstallationSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppInstallationSortKeys")
+}
+
+// LookupAppPricingInterval returns the value of AllAppPricingInterval named name.
+func LookupAppPricingInterval(name string) (AppPricingInterval, bool) {
+	return lookupEnum(AllAppPricingInterval, name)
+}
+
+// IsKnown reports whether e is one of AllAppPricingInterval, the values declared by the schema the models were
+// generated from.
+func (e AppPricingInterval) IsKnown() bool {
+	return slices.Contains(AllAppPricingInterval, e)
+}
+
+func (e AppPricingInterval) GoString() string {
+	return enumGoString(AllAppPricingInterval, enumConstants["AppPricingInterval"], e, "model.AppPricingInterval")
+}
+
+func (e AppPricingInterval) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppPricingInterval) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppPricingInterval")
+}
+
+func (e *AppPricingInterval) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppPricingInterval")
+}
+
+// LookupAppPublicCategory returns the value of AllAppPublicCategory named name.
+func LookupAppPublicCategory(name string) (AppPublicCategory, bool) {
+	return lookupEnum(AllAppPublicCategory, name)
+}
+
+// IsKnown reports whether e is one of AllAppPublicCategory, the values declared by the schema the models were
+// generated from.
+func (e AppPublicCategory) IsKnown() bool {
+	return slices.Contains(AllAppPublicCategory, e)
+}
+
+func (e AppPublicCategory) GoString() string {
+	return enumGoString(AllAppPublicCategory, enumConstants["AppPublicCategory"], e, "model.AppPublicCategory")
+}
+
+func (e AppPublicCategory) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppPublicCategory) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppPublicCategory")
+}
+
+func (e *AppPublicCategory) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppPublicCategory")
+}
+
+// LookupAppPurchaseStatus returns the value of AllAppPurchaseStatus named name.
+func LookupAppPurchaseStatus(name string) (AppPurchaseStatus, bool) {
+	return lookupEnum(AllAppPurchaseStatus, name)
+}
+
+// IsKnown reports whether e is one of AllAppPurchaseStatus, the values declared by the schema the models were
+// generated from.
+func (e AppPurchaseStatus) IsKnown() bool {
+	return slices.Contains(AllAppPurchaseStatus, e)
+}
+
+func (e AppPurchaseStatus) GoString() string {
+	return enumGoString(AllAppPurchaseStatus, enumConstants["AppPurchaseStatus"], e, "model.AppPurchaseStatus")
+}
+
+func (e AppPurchaseStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppPurchaseStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppPurchaseStatus")
+}
+
+func (e *AppPurchaseStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppPurchaseStatus")
+}
+
+// LookupAppRevenueAttributionRecordSortKeys returns the value of AllAppRevenueAttributionRecordSortKeys named name.
+func LookupAppRevenueAttributionRecordSortKeys(name string) (AppRevenueAttributionRecordSortKeys, bool) {
+	return lookupEnum(AllAppRevenueAttributionRecordSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAppRevenueAttributionRecordSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AppRevenueAttributionRecordSortKeys) IsKnown() bool {
+	return slices.Contains(AllAppRevenueAttributionRecordSortKeys, e)
+}
+
+func (e AppRevenueAttributionRecordSortKeys) GoString() string {
+	return enumGoString(AllAppRevenueAttributionRecordSortKeys, enumConstants["AppRevenueAttributionRecordSortKeys"], e, "model.AppRevenueAttributionRecordSortKeys")
+}
+
+func (e AppRevenueAttributionRecordSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppRevenueAttributionRecordSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppRevenueAttributionRecordSortKeys")
+}
+
+func (e *AppRevenueAttributionRecordSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppRevenueAttributionRecordSortKeys")
+}
+
+// LookupAppRevenueAttributionType returns the value of AllAppRevenueAttributionType named name.
+func LookupAppRevenueAttributionType(name string) (AppRevenueAttributionType, bool) {
+	return lookupEnum(AllAppRevenueAttributionType, name)
+}
+
+// IsKnown reports whether e is one of AllAppRevenueAttributionType, the values declared by the schema the models were
+// generated from.
+func (e AppRevenueAttributionType) IsKnown() bool {
+	return slices.Contains(AllAppRevenueAttributionType, e)
+}
+
+func (e AppRevenueAttributionType) GoString() string {
+	return enumGoString(AllAppRevenueAttributionType, enumConstants["AppRevenueAttributionType"], e, "model.AppRevenueAttributionType")
+}
+
+func (e AppRevenueAttributionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppRevenueAttributionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppRevenueAttributionType")
+}
+
+func (e *AppRevenueAttributionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppRevenueAttributionType")
+}
+
+// LookupAppRevokeAccessScopesAppRevokeScopeErrorCode returns the value of AllAppRevokeAccessScopesAppRevokeScopeErrorCode named name.
+func LookupAppRevokeAccessScopesAppRevokeScopeErrorCode(name string) (AppRevokeAccessScopesAppRevokeScopeErrorCode, bool) {
+	return lookupEnum(AllAppRevokeAccessScopesAppRevokeScopeErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllAppRevokeAccessScopesAppRevokeScopeErrorCode, the values declared by the schema the models were
+// generated from.
+func (e AppRevokeAccessScopesAppRevokeScopeErrorCode) IsKnown() bool {
+	return slices.Contains(AllAppRevokeAccessScopesAppRevokeScopeErrorCode, e)
+}
+
+func (e AppRevokeAccessScopesAppRevokeScopeErrorCode) GoString() string {
+	return enumGoString(AllAppRevokeAccessScopesAppRevokeScopeErrorCode, enumConstants["AppRevokeAccessScopesAppRevokeScopeErrorCode"], e, "model.AppRevokeAccessScopesAppRevokeScopeErrorCode")
+}
+
+func (e AppRevokeAccessScopesAppRevokeScopeErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppRevokeAccessScopesAppRevokeScopeErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppRevokeAccessScopesAppRevokeScopeErrorCode")
+}
+
+func (e *AppRevokeAccessScopesAppRevokeScopeErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppRevokeAccessScopesAppRevokeScopeErrorCode")
+}
+
+// LookupAppSubscriptionReplacementBehavior returns the value of AllAppSubscriptionReplacementBehavior named name.
+func LookupAppSubscriptionReplacementBehavior(name string) (AppSubscriptionReplacementBehavior, bool) {
+	return lookupEnum(AllAppSubscriptionReplacementBehavior, name)
+}
+
+// IsKnown reports whether e is one of AllAppSubscriptionReplacementBehavior, the values declared by the schema the models were
+// generated from.
+func (e AppSubscriptionReplacementBehavior) IsKnown() bool {
+	return slices.Contains(AllAppSubscriptionReplacementBehavior, e)
+}
+
+func (e AppSubscriptionReplacementBehavior) GoString() string {
+	return enumGoString(AllAppSubscriptionReplacementBehavior, enumConstants["AppSubscriptionReplacementBehavior"], e, "model.AppSubscriptionReplacementBehavior")
+}
+
+func (e AppSubscriptionReplacementBehavior) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppSubscriptionReplacementBehavior) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppSubscriptionReplacementBehavior")
+}
+
+func (e *AppSubscriptionReplacementBehavior) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppSubscriptionReplacementBehavior")
+}
+
+// LookupAppSubscriptionSortKeys returns the value of AllAppSubscriptionSortKeys named name.
+func LookupAppSubscriptionSortKeys(name string) (AppSubscriptionSortKeys, bool) {
+	return lookupEnum(AllAppSubscriptionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAppSubscriptionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AppSubscriptionSortKeys) IsKnown() bool {
+	return slices.Contains(AllAppSubscriptionSortKeys, e)
+}
+
+func (e AppSubscriptionSortKeys) GoString() string {
+	return enumGoString(AllAppSubscriptionSortKeys, enumConstants["AppSubscriptionSortKeys"], e, "model.AppSubscriptionSortKeys")
+}
+
+func (e AppSubscriptionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppSubscriptionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppSubscriptionSortKeys")
+}
+
+func (e *AppSubscriptionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppSubscriptionSortKeys")
+}
+
+// LookupAppSubscriptionStatus returns the value of AllAppSubscriptionStatus named name.
+func LookupAppSubscriptionStatus(name string) (AppSubscriptionStatus, bool) {
+	return lookupEnum(AllAppSubscriptionStatus, name)
+}
+
+// IsKnown reports whether e is one of AllAppSubscriptionStatus, the values declared by the schema the models were
+// generated from.
+func (e AppSubscriptionStatus) IsKnown() bool {
+	return slices.Contains(AllAppSubscriptionStatus, e)
+}
+
+func (e AppSubscriptionStatus) GoString() string {
+	return enumGoString(AllAppSubscriptionStatus, enumConstants["AppSubscriptionStatus"], e, "model.AppSubscriptionStatus")
+}
+
+func (e AppSubscriptionStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppSubscriptionStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppSubscriptionStatus")
+}
+
+func (e *AppSubscriptionStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppSubscriptionStatus")
+}
+
+// LookupAppSubscriptionTrialExtendUserErrorCode returns the value of AllAppSubscriptionTrialExtendUserErrorCode named name.
+func LookupAppSubscriptionTrialExtendUserErrorCode(name string) (AppSubscriptionTrialExtendUserErrorCode, bool) {
+	return lookupEnum(AllAppSubscriptionTrialExtendUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllAppSubscriptionTrialExtendUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e AppSubscriptionTrialExtendUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllAppSubscriptionTrialExtendUserErrorCode, e)
+}
+
+func (e AppSubscriptionTrialExtendUserErrorCode) GoString() string {
+	return enumGoString(AllAppSubscriptionTrialExtendUserErrorCode, enumConstants["AppSubscriptionTrialExtendUserErrorCode"], e, "model.AppSubscriptionTrialExtendUserErrorCode")
+}
+
+func (e AppSubscriptionTrialExtendUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppSubscriptionTrialExtendUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppSubscriptionTrialExtendUserErrorCode")
+}
+
+func (e *AppSubscriptionTrialExtendUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppSubscriptionTrialExtendUserErrorCode")
+}
+
+// LookupAppTransactionSortKeys returns the value of AllAppTransactionSortKeys named name.
+func LookupAppTransactionSortKeys(name string) (AppTransactionSortKeys, bool) {
+	return lookupEnum(AllAppTransactionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAppTransactionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AppTransactionSortKeys) IsKnown() bool {
+	return slices.Contains(AllAppTransactionSortKeys, e)
+}
+
+func (e AppTransactionSortKeys) GoString() string {
+	return enumGoString(AllAppTransactionSortKeys, enumConstants["AppTransactionSortKeys"], e, "model.AppTransactionSortKeys")
+}
+
+func (e AppTransactionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppTransactionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppTransactionSortKeys")
+}
+
+func (e *AppTransactionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppTransactionSortKeys")
+}
+
+// LookupAppUsageRecordSortKeys returns the value of AllAppUsageRecordSortKeys named name.
+func LookupAppUsageRecordSortKeys(name string) (AppUsageRecordSortKeys, bool) {
+	return lookupEnum(AllAppUsageRecordSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAppUsageRecordSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AppUsageRecordSortKeys) IsKnown() bool {
+	return slices.Contains(AllAppUsageRecordSortKeys, e)
+}
+
+func (e AppUsageRecordSortKeys) GoString() string {
+	return enumGoString(AllAppUsageRecordSortKeys, enumConstants["AppUsageRecordSortKeys"], e, "model.AppUsageRecordSortKeys")
+}
+
+func (e AppUsageRecordSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AppUsageRecordSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AppUsageRecordSortKeys")
+}
+
+func (e *AppUsageRecordSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AppUsageRecordSortKeys")
+}
+
+// LookupArticleCreateUserErrorCode returns the value of AllArticleCreateUserErrorCode named name.
+func LookupArticleCreateUserErrorCode(name string) (ArticleCreateUserErrorCode, bool) {
+	return lookupEnum(AllArticleCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllArticleCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ArticleCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllArticleCreateUserErrorCode, e)
+}
+
+func (e ArticleCreateUserErrorCode) GoString() string {
+	return enumGoString(AllArticleCreateUserErrorCode, enumConstants["ArticleCreateUserErrorCode"], e, "model.ArticleCreateUserErrorCode")
+}
+
+func (e ArticleCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ArticleCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ArticleCreateUserErrorCode")
+}
+
+func (e *ArticleCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ArticleCreateUserErrorCode")
+}
+
+// LookupArticleDeleteUserErrorCode returns the value of AllArticleDeleteUserErrorCode named name.
+func LookupArticleDeleteUserErrorCode(name string) (ArticleDeleteUserErrorCode, bool) {
+	return lookupEnum(AllArticleDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllArticleDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ArticleDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllArticleDeleteUserErrorCode, e)
+}
+
+func (e ArticleDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllArticleDeleteUserErrorCode, enumConstants["ArticleDeleteUserErrorCode"], e, "model.ArticleDeleteUserErrorCode")
+}
+
+func (e ArticleDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ArticleDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ArticleDeleteUserErrorCode")
+}
+
+func (e *ArticleDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ArticleDeleteUserErrorCode")
+}
+
+// LookupArticleSortKeys returns the value of AllArticleSortKeys named name.
+func LookupArticleSortKeys(name string) (ArticleSortKeys, bool) {
+	return lookupEnum(AllArticleSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllArticleSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ArticleSortKeys) IsKnown() bool {
+	return slices.Contains(AllArticleSortKeys, e)
+}
+
+func (e ArticleSortKeys) GoString() string {
+	return enumGoString(AllArticleSortKeys, enumConstants["ArticleSortKeys"], e, "model.ArticleSortKeys")
+}
+
+func (e ArticleSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ArticleSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ArticleSortKeys")
+}
+
+func (e *ArticleSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ArticleSortKeys")
+}
+
+// LookupArticleTagSort returns the value of AllArticleTagSort named name.
+func LookupArticleTagSort(name string) (ArticleTagSort, bool) {
+	return lookupEnum(AllArticleTagSort, name)
+}
+
+// IsKnown reports whether e is one of AllArticleTagSort, the values declared by the schema the models were
+// generated from.
+func (e ArticleTagSort) IsKnown() bool {
+	return slices.Contains(AllArticleTagSort, e)
+}
+
+func (e ArticleTagSort) GoString() string {
+	return enumGoString(AllArticleTagSort, enumConstants["ArticleTagSort"], e, "model.ArticleTagSort")
+}
+
+func (e ArticleTagSort) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ArticleTagSort) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ArticleTagSort")
+}
+
+func (e *ArticleTagSort) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ArticleTagSort")
+}
+
+// LookupArticleUpdateUserErrorCode returns the value of AllArticleUpdateUserErrorCode named name.
+func LookupArticleUpdateUserErrorCode(name string) (ArticleUpdateUserErrorCode, bool) {
+	return lookupEnum(AllArticleUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllArticleUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ArticleUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllArticleUpdateUserErrorCode, e)
+}
+
+func (e ArticleUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllArticleUpdateUserErrorCode, enumConstants["ArticleUpdateUserErrorCode"], e, "model.ArticleUpdateUserErrorCode")
+}
+
+func (e ArticleUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ArticleUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ArticleUpdateUserErrorCode")
+}
+
+func (e *ArticleUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ArticleUpdateUserErrorCode")
+}
+
+// LookupAutomaticDiscountSortKeys returns the value of AllAutomaticDiscountSortKeys named name.
+func LookupAutomaticDiscountSortKeys(name string) (AutomaticDiscountSortKeys, bool) {
+	return lookupEnum(AllAutomaticDiscountSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllAutomaticDiscountSortKeys, the values declared by the schema the models were
+// generated from.
+func (e AutomaticDiscountSortKeys) IsKnown() bool {
+	return slices.Contains(AllAutomaticDiscountSortKeys, e)
+}
+
+func (e AutomaticDiscountSortKeys) GoString() string {
+	return enumGoString(AllAutomaticDiscountSortKeys, enumConstants["AutomaticDiscountSortKeys"], e, "model.AutomaticDiscountSortKeys")
+}
+
+func (e AutomaticDiscountSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *AutomaticDiscountSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "AutomaticDiscountSortKeys")
+}
+
+func (e *AutomaticDiscountSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "AutomaticDiscountSortKeys")
+}
+
+// LookupBadgeType returns the value of AllBadgeType named name.
+func LookupBadgeType(name string) (BadgeType, bool) {
+	return lookupEnum(AllBadgeType, name)
+}
+
+// IsKnown reports whether e is one of AllBadgeType, the values declared by the schema the models were
+// generated from.
+func (e BadgeType) IsKnown() bool {
+	return slices.Contains(AllBadgeType, e)
+}
+
+func (e BadgeType) GoString() string {
+	return enumGoString(AllBadgeType, enumConstants["BadgeType"], e, "model.BadgeType")
+}
+
+func (e BadgeType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BadgeType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BadgeType")
+}
+
+func (e *BadgeType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BadgeType")
+}
+
+// LookupBalanceTransactionSortKeys returns the value of AllBalanceTransactionSortKeys named name.
+func LookupBalanceTransactionSortKeys(name string) (BalanceTransactionSortKeys, bool) {
+	return lookupEnum(AllBalanceTransactionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllBalanceTransactionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e BalanceTransactionSortKeys) IsKnown() bool {
+	return slices.Contains(AllBalanceTransactionSortKeys, e)
+}
+
+func (e BalanceTransactionSortKeys) GoString() string {
+	return enumGoString(AllBalanceTransactionSortKeys, enumConstants["BalanceTransactionSortKeys"], e, "model.BalanceTransactionSortKeys")
+}
+
+func (e BalanceTransactionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BalanceTransactionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BalanceTransactionSortKeys")
+}
+
+func (e *BalanceTransactionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BalanceTransactionSortKeys")
+}
+
+// LookupBillingAttemptUserErrorCode returns the value of AllBillingAttemptUserErrorCode named name.
+func LookupBillingAttemptUserErrorCode(name string) (BillingAttemptUserErrorCode, bool) {
+	return lookupEnum(AllBillingAttemptUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBillingAttemptUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BillingAttemptUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllBillingAttemptUserErrorCode, e)
+}
+
+func (e BillingAttemptUserErrorCode) GoString() string {
+	return enumGoString(AllBillingAttemptUserErrorCode, enumConstants["BillingAttemptUserErrorCode"], e, "model.BillingAttemptUserErrorCode")
+}
+
+func (e BillingAttemptUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BillingAttemptUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BillingAttemptUserErrorCode")
+}
+
+func (e *BillingAttemptUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BillingAttemptUserErrorCode")
+}
+
+// LookupBlogCreateUserErrorCode returns the value of AllBlogCreateUserErrorCode named name.
+func LookupBlogCreateUserErrorCode(name string) (BlogCreateUserErrorCode, bool) {
+	return lookupEnum(AllBlogCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBlogCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BlogCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllBlogCreateUserErrorCode, e)
+}
+
+func (e BlogCreateUserErrorCode) GoString() string {
+	return enumGoString(AllBlogCreateUserErrorCode, enumConstants["BlogCreateUserErrorCode"], e, "model.BlogCreateUserErrorCode")
+}
+
+func (e BlogCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BlogCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BlogCreateUserErrorCode")
+}
+
+func (e *BlogCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BlogCreateUserErrorCode")
+}
+
+// LookupBlogDeleteUserErrorCode returns the value of AllBlogDeleteUserErrorCode named name.
+func LookupBlogDeleteUserErrorCode(name string) (BlogDeleteUserErrorCode, bool) {
+	return lookupEnum(AllBlogDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBlogDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BlogDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllBlogDeleteUserErrorCode, e)
+}
+
+func (e BlogDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllBlogDeleteUserErrorCode, enumConstants["BlogDeleteUserErrorCode"], e, "model.BlogDeleteUserErrorCode")
+}
+
+func (e BlogDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BlogDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BlogDeleteUserErrorCode")
+}
+
+func (e *BlogDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BlogDeleteUserErrorCode")
+}
+
+// LookupBlogSortKeys returns the value of AllBlogSortKeys named name.
+func LookupBlogSortKeys(name string) (BlogSortKeys, bool) {
+	return lookupEnum(AllBlogSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllBlogSortKeys, the values declared by the schema the models were
+// generated from.
+func (e BlogSortKeys) IsKnown() bool {
+	return slices.Contains(AllBlogSortKeys, e)
+}
+
+func (e BlogSortKeys) GoString() string {
+	return enumGoString(AllBlogSortKeys, enumConstants["BlogSortKeys"], e, "model.BlogSortKeys")
+}
+
+func (e BlogSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BlogSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BlogSortKeys")
+}
+
+func (e *BlogSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BlogSortKeys")
+}
+
+// LookupBlogUpdateUserErrorCode returns the value of AllBlogUpdateUserErrorCode named name.
+func LookupBlogUpdateUserErrorCode(name string) (BlogUpdateUserErrorCode, bool) {
+	return lookupEnum(AllBlogUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBlogUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BlogUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllBlogUpdateUserErrorCode, e)
+}
+
+func (e BlogUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllBlogUpdateUserErrorCode, enumConstants["BlogUpdateUserErrorCode"], e, "model.BlogUpdateUserErrorCode")
+}
+
+func (e BlogUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BlogUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BlogUpdateUserErrorCode")
+}
+
+func (e *BlogUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BlogUpdateUserErrorCode")
+}
+
+// LookupBulkMutationErrorCode returns the value of AllBulkMutationErrorCode named name.
+func LookupBulkMutationErrorCode(name string) (BulkMutationErrorCode, bool) {
+	return lookupEnum(AllBulkMutationErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBulkMutationErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BulkMutationErrorCode) IsKnown() bool {
+	return slices.Contains(AllBulkMutationErrorCode, e)
+}
+
+func (e BulkMutationErrorCode) GoString() string {
+	return enumGoString(AllBulkMutationErrorCode, enumConstants["BulkMutationErrorCode"], e, "model.BulkMutationErrorCode")
+}
+
+func (e BulkMutationErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BulkMutationErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BulkMutationErrorCode")
+}
+
+func (e *BulkMutationErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BulkMutationErrorCode")
+}
+
+// LookupBulkOperationErrorCode returns the value of AllBulkOperationErrorCode named name.
+func LookupBulkOperationErrorCode(name string) (BulkOperationErrorCode, bool) {
+	return lookupEnum(AllBulkOperationErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBulkOperationErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BulkOperationErrorCode) IsKnown() bool {
+	return slices.Contains(AllBulkOperationErrorCode, e)
+}
+
+func (e BulkOperationErrorCode) GoString() string {
+	return enumGoString(AllBulkOperationErrorCode, enumConstants["BulkOperationErrorCode"], e, "model.BulkOperationErrorCode")
+}
+
+func (e BulkOperationErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BulkOperationErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BulkOperationErrorCode")
+}
+
+func (e *BulkOperationErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BulkOperationErrorCode")
+}
+
+// LookupBulkOperationStatus returns the value of AllBulkOperationStatus named name.
+func LookupBulkOperationStatus(name string) (BulkOperationStatus, bool) {
+	return lookupEnum(AllBulkOperationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllBulkOperationStatus, the values declared by the schema the models were
+// generated from.
+func (e BulkOperationStatus) IsKnown() bool {
+	return slices.Contains(AllBulkOperationStatus, e)
+}
+
+func (e BulkOperationStatus) GoString() string {
+	return enumGoString(AllBulkOperationStatus, enumConstants["BulkOperationStatus"], e, "model.BulkOperationStatus")
+}
+
+func (e BulkOperationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BulkOperationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BulkOperationStatus")
+}
+
+func (e *BulkOperationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BulkOperationStatus")
+}
+
+// LookupBulkOperationType returns the value of AllBulkOperationType named name.
+func LookupBulkOperationType(name string) (BulkOperationType, bool) {
+	return lookupEnum(AllBulkOperationType, name)
+}
+
+// IsKnown reports whether e is one of AllBulkOperationType, the values declared by the schema the models were
+// generated from.
+func (e BulkOperationType) IsKnown() bool {
+	return slices.Contains(AllBulkOperationType, e)
+}
+
+func (e BulkOperationType) GoString() string {
+	return enumGoString(AllBulkOperationType, enumConstants["BulkOperationType"], e, "model.BulkOperationType")
+}
+
+func (e BulkOperationType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BulkOperationType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BulkOperationType")
+}
+
+func (e *BulkOperationType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BulkOperationType")
+}
+
+// LookupBulkProductResourceFeedbackCreateUserErrorCode returns the value of AllBulkProductResourceFeedbackCreateUserErrorCode named name.
+func LookupBulkProductResourceFeedbackCreateUserErrorCode(name string) (BulkProductResourceFeedbackCreateUserErrorCode, bool) {
+	return lookupEnum(AllBulkProductResourceFeedbackCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBulkProductResourceFeedbackCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BulkProductResourceFeedbackCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllBulkProductResourceFeedbackCreateUserErrorCode, e)
+}
+
+func (e BulkProductResourceFeedbackCreateUserErrorCode) GoString() string {
+	return enumGoString(AllBulkProductResourceFeedbackCreateUserErrorCode, enumConstants["BulkProductResourceFeedbackCreateUserErrorCode"], e, "model.BulkProductResourceFeedbackCreateUserErrorCode")
+}
+
+func (e BulkProductResourceFeedbackCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BulkProductResourceFeedbackCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BulkProductResourceFeedbackCreateUserErrorCode")
+}
+
+func (e *BulkProductResourceFeedbackCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BulkProductResourceFeedbackCreateUserErrorCode")
+}
+
+// LookupBusinessCustomerErrorCode returns the value of AllBusinessCustomerErrorCode named name.
+func LookupBusinessCustomerErrorCode(name string) (BusinessCustomerErrorCode, bool) {
+	return lookupEnum(AllBusinessCustomerErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllBusinessCustomerErrorCode, the values declared by the schema the models were
+// generated from.
+func (e BusinessCustomerErrorCode) IsKnown() bool {
+	return slices.Contains(AllBusinessCustomerErrorCode, e)
+}
+
+func (e BusinessCustomerErrorCode) GoString() string {
+	return enumGoString(AllBusinessCustomerErrorCode, enumConstants["BusinessCustomerErrorCode"], e, "model.BusinessCustomerErrorCode")
+}
+
+func (e BusinessCustomerErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *BusinessCustomerErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "BusinessCustomerErrorCode")
+}
+
+func (e *BusinessCustomerErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "BusinessCustomerErrorCode")
+}
+
+// LookupCalculatedShippingLineStagedStatus returns the value of AllCalculatedShippingLineStagedStatus named name.
+func LookupCalculatedShippingLineStagedStatus(name string) (CalculatedShippingLineStagedStatus, bool) {
+	return lookupEnum(AllCalculatedShippingLineStagedStatus, name)
+}
+
+// IsKnown reports whether e is one of AllCalculatedShippingLineStagedStatus, the values declared by the schema the models were
+// generated from.
+func (e CalculatedShippingLineStagedStatus) IsKnown() bool {
+	return slices.Contains(AllCalculatedShippingLineStagedStatus, e)
+}
+
+func (e CalculatedShippingLineStagedStatus) GoString() string {
+	return enumGoString(AllCalculatedShippingLineStagedStatus, enumConstants["CalculatedShippingLineStagedStatus"], e, "model.CalculatedShippingLineStagedStatus")
+}
+
+func (e CalculatedShippingLineStagedStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CalculatedShippingLineStagedStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CalculatedShippingLineStagedStatus")
+}
+
+func (e *CalculatedShippingLineStagedStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CalculatedShippingLineStagedStatus")
+}
+
+// LookupCarrierServiceCreateUserErrorCode returns the value of AllCarrierServiceCreateUserErrorCode named name.
+func LookupCarrierServiceCreateUserErrorCode(name string) (CarrierServiceCreateUserErrorCode, bool) {
+	return lookupEnum(AllCarrierServiceCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCarrierServiceCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CarrierServiceCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCarrierServiceCreateUserErrorCode, e)
+}
+
+func (e CarrierServiceCreateUserErrorCode) GoString() string {
+	return enumGoString(AllCarrierServiceCreateUserErrorCode, enumConstants["CarrierServiceCreateUserErrorCode"], e, "model.CarrierServiceCreateUserErrorCode")
+}
+
+func (e CarrierServiceCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CarrierServiceCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CarrierServiceCreateUserErrorCode")
+}
+
+func (e *CarrierServiceCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CarrierServiceCreateUserErrorCode")
+}
+
+// LookupCarrierServiceDeleteUserErrorCode returns the value of AllCarrierServiceDeleteUserErrorCode named name.
+func LookupCarrierServiceDeleteUserErrorCode(name string) (CarrierServiceDeleteUserErrorCode, bool) {
+	return lookupEnum(AllCarrierServiceDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCarrierServiceDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CarrierServiceDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCarrierServiceDeleteUserErrorCode, e)
+}
+
+func (e CarrierServiceDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllCarrierServiceDeleteUserErrorCode, enumConstants["CarrierServiceDeleteUserErrorCode"], e, "model.CarrierServiceDeleteUserErrorCode")
+}
+
+func (e CarrierServiceDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CarrierServiceDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CarrierServiceDeleteUserErrorCode")
+}
+
+func (e *CarrierServiceDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CarrierServiceDeleteUserErrorCode")
+}
+
+// LookupCarrierServiceSortKeys returns the value of AllCarrierServiceSortKeys named name.
+func LookupCarrierServiceSortKeys(name string) (CarrierServiceSortKeys, bool) {
+	return lookupEnum(AllCarrierServiceSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCarrierServiceSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CarrierServiceSortKeys) IsKnown() bool {
+	return slices.Contains(AllCarrierServiceSortKeys, e)
+}
+
+func (e CarrierServiceSortKeys) GoString() string {
+	return enumGoString(AllCarrierServiceSortKeys, enumConstants["CarrierServiceSortKeys"], e, "model.CarrierServiceSortKeys")
+}
+
+func (e CarrierServiceSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CarrierServiceSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CarrierServiceSortKeys")
+}
+
+func (e *CarrierServiceSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CarrierServiceSortKeys")
+}
+
+// LookupCarrierServiceUpdateUserErrorCode returns the value of AllCarrierServiceUpdateUserErrorCode named name.
+func LookupCarrierServiceUpdateUserErrorCode(name string) (CarrierServiceUpdateUserErrorCode, bool) {
+	return lookupEnum(AllCarrierServiceUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCarrierServiceUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CarrierServiceUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCarrierServiceUpdateUserErrorCode, e)
+}
+
+func (e CarrierServiceUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllCarrierServiceUpdateUserErrorCode, enumConstants["CarrierServiceUpdateUserErrorCode"], e, "model.CarrierServiceUpdateUserErrorCode")
+}
+
+func (e CarrierServiceUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CarrierServiceUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CarrierServiceUpdateUserErrorCode")
+}
+
+func (e *CarrierServiceUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CarrierServiceUpdateUserErrorCode")
+}
+
+// LookupCartTransformCreateUserErrorCode returns the value of AllCartTransformCreateUserErrorCode named name.
+func LookupCartTransformCreateUserErrorCode(name string) (CartTransformCreateUserErrorCode, bool) {
+	return lookupEnum(AllCartTransformCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCartTransformCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CartTransformCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCartTransformCreateUserErrorCode, e)
+}
+
+func (e CartTransformCreateUserErrorCode) GoString() string {
+	return enumGoString(AllCartTransformCreateUserErrorCode, enumConstants["CartTransformCreateUserErrorCode"], e, "model.CartTransformCreateUserErrorCode")
+}
+
+func (e CartTransformCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CartTransformCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CartTransformCreateUserErrorCode")
+}
+
+func (e *CartTransformCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CartTransformCreateUserErrorCode")
+}
+
+// LookupCartTransformDeleteUserErrorCode returns the value of AllCartTransformDeleteUserErrorCode named name.
+func LookupCartTransformDeleteUserErrorCode(name string) (CartTransformDeleteUserErrorCode, bool) {
+	return lookupEnum(AllCartTransformDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCartTransformDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CartTransformDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCartTransformDeleteUserErrorCode, e)
+}
+
+func (e CartTransformDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllCartTransformDeleteUserErrorCode, enumConstants["CartTransformDeleteUserErrorCode"], e, "model.CartTransformDeleteUserErrorCode")
+}
+
+func (e CartTransformDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CartTransformDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CartTransformDeleteUserErrorCode")
+}
+
+func (e *CartTransformDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CartTransformDeleteUserErrorCode")
+}
+
+// LookupCashTrackingSessionTransactionsSortKeys returns the value of AllCashTrackingSessionTransactionsSortKeys named name.
+func LookupCashTrackingSessionTransactionsSortKeys(name string) (CashTrackingSessionTransactionsSortKeys, bool) {
+	return lookupEnum(AllCashTrackingSessionTransactionsSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCashTrackingSessionTransactionsSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CashTrackingSessionTransactionsSortKeys) IsKnown() bool {
+	return slices.Contains(AllCashTrackingSessionTransactionsSortKeys, e)
+}
+
+func (e CashTrackingSessionTransactionsSortKeys) GoString() string {
+	return enumGoString(AllCashTrackingSessionTransactionsSortKeys, enumConstants["CashTrackingSessionTransactionsSortKeys"], e, "model.CashTrackingSessionTransactionsSortKeys")
+}
+
+func (e CashTrackingSessionTransactionsSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CashTrackingSessionTransactionsSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CashTrackingSessionTransactionsSortKeys")
+}
+
+func (e *CashTrackingSessionTransactionsSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CashTrackingSessionTransactionsSortKeys")
+}
+
+// LookupCashTrackingSessionsSortKeys returns the value of AllCashTrackingSessionsSortKeys named name.
+func LookupCashTrackingSessionsSortKeys(name string) (CashTrackingSessionsSortKeys, bool) {
+	return lookupEnum(AllCashTrackingSessionsSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCashTrackingSessionsSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CashTrackingSessionsSortKeys) IsKnown() bool {
+	return slices.Contains(AllCashTrackingSessionsSortKeys, e)
+}
+
+func (e CashTrackingSessionsSortKeys) GoString() string {
+	return enumGoString(AllCashTrackingSessionsSortKeys, enumConstants["CashTrackingSessionsSortKeys"], e, "model.CashTrackingSessionsSortKeys")
+}
+
+func (e CashTrackingSessionsSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CashTrackingSessionsSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CashTrackingSessionsSortKeys")
+}
+
+func (e *CashTrackingSessionsSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CashTrackingSessionsSortKeys")
+}
+
+// LookupCatalogSortKeys returns the value of AllCatalogSortKeys named name.
+func LookupCatalogSortKeys(name string) (CatalogSortKeys, bool) {
+	return lookupEnum(AllCatalogSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCatalogSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CatalogSortKeys) IsKnown() bool {
+	return slices.Contains(AllCatalogSortKeys, e)
+}
+
+func (e CatalogSortKeys) GoString() string {
+	return enumGoString(AllCatalogSortKeys, enumConstants["CatalogSortKeys"], e, "model.CatalogSortKeys")
+}
+
+func (e CatalogSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CatalogSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CatalogSortKeys")
+}
+
+func (e *CatalogSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CatalogSortKeys")
+}
+
+// LookupCatalogStatus returns the value of AllCatalogStatus named name.
+func LookupCatalogStatus(name string) (CatalogStatus, bool) {
+	return lookupEnum(AllCatalogStatus, name)
+}
+
+// IsKnown reports whether e is one of AllCatalogStatus, the values declared by the schema the models were
+// generated from.
+func (e CatalogStatus) IsKnown() bool {
+	return slices.Contains(AllCatalogStatus, e)
+}
+
+func (e CatalogStatus) GoString() string {
+	return enumGoString(AllCatalogStatus, enumConstants["CatalogStatus"], e, "model.CatalogStatus")
+}
+
+func (e CatalogStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CatalogStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CatalogStatus")
+}
+
+func (e *CatalogStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CatalogStatus")
+}
+
+// LookupCatalogType returns the value of AllCatalogType named name.
+func LookupCatalogType(name string) (CatalogType, bool) {
+	return lookupEnum(AllCatalogType, name)
+}
+
+// IsKnown reports whether e is one of AllCatalogType, the values declared by the schema the models were
+// generated from.
+func (e CatalogType) IsKnown() bool {
+	return slices.Contains(AllCatalogType, e)
+}
+
+func (e CatalogType) GoString() string {
+	return enumGoString(AllCatalogType, enumConstants["CatalogType"], e, "model.CatalogType")
+}
+
+func (e CatalogType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CatalogType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CatalogType")
+}
+
+func (e *CatalogType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CatalogType")
+}
+
+// LookupCatalogUserErrorCode returns the value of AllCatalogUserErrorCode named name.
+func LookupCatalogUserErrorCode(name string) (CatalogUserErrorCode, bool) {
+	return lookupEnum(AllCatalogUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCatalogUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CatalogUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCatalogUserErrorCode, e)
+}
+
+func (e CatalogUserErrorCode) GoString() string {
+	return enumGoString(AllCatalogUserErrorCode, enumConstants["CatalogUserErrorCode"], e, "model.CatalogUserErrorCode")
+}
+
+func (e CatalogUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CatalogUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CatalogUserErrorCode")
+}
+
+func (e *CatalogUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CatalogUserErrorCode")
+}
+
+// LookupCheckoutBrandingBackground returns the value of AllCheckoutBrandingBackground named name.
+func LookupCheckoutBrandingBackground(name string) (CheckoutBrandingBackground, bool) {
+	return lookupEnum(AllCheckoutBrandingBackground, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingBackground, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingBackground) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingBackground, e)
+}
+
+func (e CheckoutBrandingBackground) GoString() string {
+	return enumGoString(AllCheckoutBrandingBackground, enumConstants["CheckoutBrandingBackground"], e, "model.CheckoutBrandingBackground")
+}
+
+func (e CheckoutBrandingBackground) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingBackground) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingBackground")
+}
+
+func (e *CheckoutBrandingBackground) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingBackground")
+}
+
+// LookupCheckoutBrandingBackgroundStyle returns the value of AllCheckoutBrandingBackgroundStyle named name.
+func LookupCheckoutBrandingBackgroundStyle(name string) (CheckoutBrandingBackgroundStyle, bool) {
+	return lookupEnum(AllCheckoutBrandingBackgroundStyle, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingBackgroundStyle, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingBackgroundStyle) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingBackgroundStyle, e)
+}
+
+func (e CheckoutBrandingBackgroundStyle) GoString() string {
+	return enumGoString(AllCheckoutBrandingBackgroundStyle, enumConstants["CheckoutBrandingBackgroundStyle"], e, "model.CheckoutBrandingBackgroundStyle")
+}
+
+func (e CheckoutBrandingBackgroundStyle) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingBackgroundStyle) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingBackgroundStyle")
+}
+
+func (e *CheckoutBrandingBackgroundStyle) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingBackgroundStyle")
+}
+
+// LookupCheckoutBrandingBorder returns the value of AllCheckoutBrandingBorder named name.
+func LookupCheckoutBrandingBorder(name string) (CheckoutBrandingBorder, bool) {
+	return lookupEnum(AllCheckoutBrandingBorder, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingBorder, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingBorder) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingBorder, e)
+}
+
+func (e CheckoutBrandingBorder) GoString() string {
+	return enumGoString(AllCheckoutBrandingBorder, enumConstants["CheckoutBrandingBorder"], e, "model.CheckoutBrandingBorder")
+}
+
+func (e CheckoutBrandingBorder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingBorder) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingBorder")
+}
+
+func (e *CheckoutBrandingBorder) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingBorder")
+}
+
+// LookupCheckoutBrandingBorderStyle returns the value of AllCheckoutBrandingBorderStyle named name.
+func LookupCheckoutBrandingBorderStyle(name string) (CheckoutBrandingBorderStyle, bool) {
+	return lookupEnum(AllCheckoutBrandingBorderStyle, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingBorderStyle, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingBorderStyle) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingBorderStyle, e)
+}
+
+func (e CheckoutBrandingBorderStyle) GoString() string {
+	return enumGoString(AllCheckoutBrandingBorderStyle, enumConstants["CheckoutBrandingBorderStyle"], e, "model.CheckoutBrandingBorderStyle")
+}
+
+func (e CheckoutBrandingBorderStyle) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingBorderStyle) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingBorderStyle")
+}
+
+func (e *CheckoutBrandingBorderStyle) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingBorderStyle")
+}
+
+// LookupCheckoutBrandingBorderWidth returns the value of AllCheckoutBrandingBorderWidth named name.
+func LookupCheckoutBrandingBorderWidth(name string) (CheckoutBrandingBorderWidth, bool) {
+	return lookupEnum(AllCheckoutBrandingBorderWidth, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingBorderWidth, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingBorderWidth) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingBorderWidth, e)
+}
+
+func (e CheckoutBrandingBorderWidth) GoString() string {
+	return enumGoString(AllCheckoutBrandingBorderWidth, enumConstants["CheckoutBrandingBorderWidth"], e, "model.CheckoutBrandingBorderWidth")
+}
+
+func (e CheckoutBrandingBorderWidth) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingBorderWidth) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingBorderWidth")
+}
+
+func (e *CheckoutBrandingBorderWidth) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingBorderWidth")
+}
+
+// LookupCheckoutBrandingCartLinkContentType returns the value of AllCheckoutBrandingCartLinkContentType named name.
+func LookupCheckoutBrandingCartLinkContentType(name string) (CheckoutBrandingCartLinkContentType, bool) {
+	return lookupEnum(AllCheckoutBrandingCartLinkContentType, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingCartLinkContentType, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingCartLinkContentType) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingCartLinkContentType, e)
+}
+
+func (e CheckoutBrandingCartLinkContentType) GoString() string {
+	return enumGoString(AllCheckoutBrandingCartLinkContentType, enumConstants["CheckoutBrandingCartLinkContentType"], e, "model.CheckoutBrandingCartLinkContentType")
+}
+
+func (e CheckoutBrandingCartLinkContentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingCartLinkContentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingCartLinkContentType")
+}
+
+func (e *CheckoutBrandingCartLinkContentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingCartLinkContentType")
+}
+
+// LookupCheckoutBrandingColorSchemeSelection returns the value of AllCheckoutBrandingColorSchemeSelection named name.
+func LookupCheckoutBrandingColorSchemeSelection(name string) (CheckoutBrandingColorSchemeSelection, bool) {
+	return lookupEnum(AllCheckoutBrandingColorSchemeSelection, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingColorSchemeSelection, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingColorSchemeSelection) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingColorSchemeSelection, e)
+}
+
+func (e CheckoutBrandingColorSchemeSelection) GoString() string {
+	return enumGoString(AllCheckoutBrandingColorSchemeSelection, enumConstants["CheckoutBrandingColorSchemeSelection"], e, "model.CheckoutBrandingColorSchemeSelection")
+}
+
+func (e CheckoutBrandingColorSchemeSelection) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingColorSchemeSelection) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingColorSchemeSelection")
+}
+
+func (e *CheckoutBrandingColorSchemeSelection) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingColorSchemeSelection")
+}
+
+// LookupCheckoutBrandingColorSelection returns the value of AllCheckoutBrandingColorSelection named name.
+func LookupCheckoutBrandingColorSelection(name string) (CheckoutBrandingColorSelection, bool) {
+	return lookupEnum(AllCheckoutBrandingColorSelection, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingColorSelection, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingColorSelection) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingColorSelection, e)
+}
+
+func (e CheckoutBrandingColorSelection) GoString() string {
+	return enumGoString(AllCheckoutBrandingColorSelection, enumConstants["CheckoutBrandingColorSelection"], e, "model.CheckoutBrandingColorSelection")
+}
+
+func (e CheckoutBrandingColorSelection) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingColorSelection) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingColorSelection")
+}
+
+func (e *CheckoutBrandingColorSelection) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingColorSelection")
+}
+
+// LookupCheckoutBrandingCornerRadius returns the value of AllCheckoutBrandingCornerRadius named name.
+func LookupCheckoutBrandingCornerRadius(name string) (CheckoutBrandingCornerRadius, bool) {
+	return lookupEnum(AllCheckoutBrandingCornerRadius, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingCornerRadius, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingCornerRadius) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingCornerRadius, e)
+}
+
+func (e CheckoutBrandingCornerRadius) GoString() string {
+	return enumGoString(AllCheckoutBrandingCornerRadius, enumConstants["CheckoutBrandingCornerRadius"], e, "model.CheckoutBrandingCornerRadius")
+}
+
+func (e CheckoutBrandingCornerRadius) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingCornerRadius) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingCornerRadius")
+}
+
+func (e *CheckoutBrandingCornerRadius) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingCornerRadius")
+}
+
+// LookupCheckoutBrandingFontLoadingStrategy returns the value of AllCheckoutBrandingFontLoadingStrategy named name.
+func LookupCheckoutBrandingFontLoadingStrategy(name string) (CheckoutBrandingFontLoadingStrategy, bool) {
+	return lookupEnum(AllCheckoutBrandingFontLoadingStrategy, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingFontLoadingStrategy, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingFontLoadingStrategy) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingFontLoadingStrategy, e)
+}
+
+func (e CheckoutBrandingFontLoadingStrategy) GoString() string {
+	return enumGoString(AllCheckoutBrandingFontLoadingStrategy, enumConstants["CheckoutBrandingFontLoadingStrategy"], e, "model.CheckoutBrandingFontLoadingStrategy")
+}
+
+func (e CheckoutBrandingFontLoadingStrategy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingFontLoadingStrategy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingFontLoadingStrategy")
+}
+
+func (e *CheckoutBrandingFontLoadingStrategy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingFontLoadingStrategy")
+}
+
+// LookupCheckoutBrandingFooterAlignment returns the value of AllCheckoutBrandingFooterAlignment named name.
+func LookupCheckoutBrandingFooterAlignment(name string) (CheckoutBrandingFooterAlignment, bool) {
+	return lookupEnum(AllCheckoutBrandingFooterAlignment, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingFooterAlignment, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingFooterAlignment) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingFooterAlignment, e)
+}
+
+func (e CheckoutBrandingFooterAlignment) GoString() string {
+	return enumGoString(AllCheckoutBrandingFooterAlignment, enumConstants["CheckoutBrandingFooterAlignment"], e, "model.CheckoutBrandingFooterAlignment")
+}
+
+func (e CheckoutBrandingFooterAlignment) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingFooterAlignment) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingFooterAlignment")
+}
+
+func (e *CheckoutBrandingFooterAlignment) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingFooterAlignment")
+}
+
+// LookupCheckoutBrandingFooterPosition returns the value of AllCheckoutBrandingFooterPosition named name.
+func LookupCheckoutBrandingFooterPosition(name string) (CheckoutBrandingFooterPosition, bool) {
+	return lookupEnum(AllCheckoutBrandingFooterPosition, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingFooterPosition, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingFooterPosition) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingFooterPosition, e)
+}
+
+func (e CheckoutBrandingFooterPosition) GoString() string {
+	return enumGoString(AllCheckoutBrandingFooterPosition, enumConstants["CheckoutBrandingFooterPosition"], e, "model.CheckoutBrandingFooterPosition")
+}
+
+func (e CheckoutBrandingFooterPosition) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingFooterPosition) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingFooterPosition")
+}
+
+func (e *CheckoutBrandingFooterPosition) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingFooterPosition")
+}
+
+// LookupCheckoutBrandingGlobalCornerRadius returns the value of AllCheckoutBrandingGlobalCornerRadius named name.
+func LookupCheckoutBrandingGlobalCornerRadius(name string) (CheckoutBrandingGlobalCornerRadius, bool) {
+	return lookupEnum(AllCheckoutBrandingGlobalCornerRadius, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingGlobalCornerRadius, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingGlobalCornerRadius) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingGlobalCornerRadius, e)
+}
+
+func (e CheckoutBrandingGlobalCornerRadius) GoString() string {
+	return enumGoString(AllCheckoutBrandingGlobalCornerRadius, enumConstants["CheckoutBrandingGlobalCornerRadius"], e, "model.CheckoutBrandingGlobalCornerRadius")
+}
+
+func (e CheckoutBrandingGlobalCornerRadius) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingGlobalCornerRadius) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingGlobalCornerRadius")
+}
+
+func (e *CheckoutBrandingGlobalCornerRadius) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingGlobalCornerRadius")
+}
+
+// LookupCheckoutBrandingHeaderAlignment returns the value of AllCheckoutBrandingHeaderAlignment named name.
+func LookupCheckoutBrandingHeaderAlignment(name string) (CheckoutBrandingHeaderAlignment, bool) {
+	return lookupEnum(AllCheckoutBrandingHeaderAlignment, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingHeaderAlignment, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingHeaderAlignment) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingHeaderAlignment, e)
+}
+
+func (e CheckoutBrandingHeaderAlignment) GoString() string {
+	return enumGoString(AllCheckoutBrandingHeaderAlignment, enumConstants["CheckoutBrandingHeaderAlignment"], e, "model.CheckoutBrandingHeaderAlignment")
+}
+
+func (e CheckoutBrandingHeaderAlignment) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingHeaderAlignment) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingHeaderAlignment")
+}
+
+func (e *CheckoutBrandingHeaderAlignment) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingHeaderAlignment")
+}
+
+// LookupCheckoutBrandingHeaderPosition returns the value of AllCheckoutBrandingHeaderPosition named name.
+func LookupCheckoutBrandingHeaderPosition(name string) (CheckoutBrandingHeaderPosition, bool) {
+	return lookupEnum(AllCheckoutBrandingHeaderPosition, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingHeaderPosition, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingHeaderPosition) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingHeaderPosition, e)
+}
+
+func (e CheckoutBrandingHeaderPosition) GoString() string {
+	return enumGoString(AllCheckoutBrandingHeaderPosition, enumConstants["CheckoutBrandingHeaderPosition"], e, "model.CheckoutBrandingHeaderPosition")
+}
+
+func (e CheckoutBrandingHeaderPosition) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingHeaderPosition) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingHeaderPosition")
+}
+
+func (e *CheckoutBrandingHeaderPosition) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingHeaderPosition")
+}
+
+// LookupCheckoutBrandingLabelPosition returns the value of AllCheckoutBrandingLabelPosition named name.
+func LookupCheckoutBrandingLabelPosition(name string) (CheckoutBrandingLabelPosition, bool) {
+	return lookupEnum(AllCheckoutBrandingLabelPosition, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingLabelPosition, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingLabelPosition) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingLabelPosition, e)
+}
+
+func (e CheckoutBrandingLabelPosition) GoString() string {
+	return enumGoString(AllCheckoutBrandingLabelPosition, enumConstants["CheckoutBrandingLabelPosition"], e, "model.CheckoutBrandingLabelPosition")
+}
+
+func (e CheckoutBrandingLabelPosition) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingLabelPosition) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingLabelPosition")
+}
+
+func (e *CheckoutBrandingLabelPosition) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingLabelPosition")
+}
+
+// LookupCheckoutBrandingShadow returns the value of AllCheckoutBrandingShadow named name.
+func LookupCheckoutBrandingShadow(name string) (CheckoutBrandingShadow, bool) {
+	return lookupEnum(AllCheckoutBrandingShadow, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingShadow, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingShadow) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingShadow, e)
+}
+
+func (e CheckoutBrandingShadow) GoString() string {
+	return enumGoString(AllCheckoutBrandingShadow, enumConstants["CheckoutBrandingShadow"], e, "model.CheckoutBrandingShadow")
+}
+
+func (e CheckoutBrandingShadow) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingShadow) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingShadow")
+}
+
+func (e *CheckoutBrandingShadow) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingShadow")
+}
+
+// LookupCheckoutBrandingSimpleBorder returns the value of AllCheckoutBrandingSimpleBorder named name.
+func LookupCheckoutBrandingSimpleBorder(name string) (CheckoutBrandingSimpleBorder, bool) {
+	return lookupEnum(AllCheckoutBrandingSimpleBorder, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingSimpleBorder, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingSimpleBorder) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingSimpleBorder, e)
+}
+
+func (e CheckoutBrandingSimpleBorder) GoString() string {
+	return enumGoString(AllCheckoutBrandingSimpleBorder, enumConstants["CheckoutBrandingSimpleBorder"], e, "model.CheckoutBrandingSimpleBorder")
+}
+
+func (e CheckoutBrandingSimpleBorder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingSimpleBorder) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingSimpleBorder")
+}
+
+func (e *CheckoutBrandingSimpleBorder) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingSimpleBorder")
+}
+
+// LookupCheckoutBrandingSpacing returns the value of AllCheckoutBrandingSpacing named name.
+func LookupCheckoutBrandingSpacing(name string) (CheckoutBrandingSpacing, bool) {
+	return lookupEnum(AllCheckoutBrandingSpacing, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingSpacing, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingSpacing) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingSpacing, e)
+}
+
+func (e CheckoutBrandingSpacing) GoString() string {
+	return enumGoString(AllCheckoutBrandingSpacing, enumConstants["CheckoutBrandingSpacing"], e, "model.CheckoutBrandingSpacing")
+}
+
+func (e CheckoutBrandingSpacing) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingSpacing) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingSpacing")
+}
+
+func (e *CheckoutBrandingSpacing) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingSpacing")
+}
+
+// LookupCheckoutBrandingSpacingKeyword returns the value of AllCheckoutBrandingSpacingKeyword named name.
+func LookupCheckoutBrandingSpacingKeyword(name string) (CheckoutBrandingSpacingKeyword, bool) {
+	return lookupEnum(AllCheckoutBrandingSpacingKeyword, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingSpacingKeyword, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingSpacingKeyword) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingSpacingKeyword, e)
+}
+
+func (e CheckoutBrandingSpacingKeyword) GoString() string {
+	return enumGoString(AllCheckoutBrandingSpacingKeyword, enumConstants["CheckoutBrandingSpacingKeyword"], e, "model.CheckoutBrandingSpacingKeyword")
+}
+
+func (e CheckoutBrandingSpacingKeyword) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingSpacingKeyword) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingSpacingKeyword")
+}
+
+func (e *CheckoutBrandingSpacingKeyword) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingSpacingKeyword")
+}
+
+// LookupCheckoutBrandingTypographyFont returns the value of AllCheckoutBrandingTypographyFont named name.
+func LookupCheckoutBrandingTypographyFont(name string) (CheckoutBrandingTypographyFont, bool) {
+	return lookupEnum(AllCheckoutBrandingTypographyFont, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingTypographyFont, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingTypographyFont) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingTypographyFont, e)
+}
+
+func (e CheckoutBrandingTypographyFont) GoString() string {
+	return enumGoString(AllCheckoutBrandingTypographyFont, enumConstants["CheckoutBrandingTypographyFont"], e, "model.CheckoutBrandingTypographyFont")
+}
+
+func (e CheckoutBrandingTypographyFont) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingTypographyFont) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingTypographyFont")
+}
+
+func (e *CheckoutBrandingTypographyFont) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingTypographyFont")
+}
+
+// LookupCheckoutBrandingTypographyKerning returns the value of AllCheckoutBrandingTypographyKerning named name.
+func LookupCheckoutBrandingTypographyKerning(name string) (CheckoutBrandingTypographyKerning, bool) {
+	return lookupEnum(AllCheckoutBrandingTypographyKerning, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingTypographyKerning, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingTypographyKerning) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingTypographyKerning, e)
+}
+
+func (e CheckoutBrandingTypographyKerning) GoString() string {
+	return enumGoString(AllCheckoutBrandingTypographyKerning, enumConstants["CheckoutBrandingTypographyKerning"], e, "model.CheckoutBrandingTypographyKerning")
+}
+
+func (e CheckoutBrandingTypographyKerning) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingTypographyKerning) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingTypographyKerning")
+}
+
+func (e *CheckoutBrandingTypographyKerning) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingTypographyKerning")
+}
+
+// LookupCheckoutBrandingTypographyLetterCase returns the value of AllCheckoutBrandingTypographyLetterCase named name.
+func LookupCheckoutBrandingTypographyLetterCase(name string) (CheckoutBrandingTypographyLetterCase, bool) {
+	return lookupEnum(AllCheckoutBrandingTypographyLetterCase, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingTypographyLetterCase, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingTypographyLetterCase) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingTypographyLetterCase, e)
+}
+
+func (e CheckoutBrandingTypographyLetterCase) GoString() string {
+	return enumGoString(AllCheckoutBrandingTypographyLetterCase, enumConstants["CheckoutBrandingTypographyLetterCase"], e, "model.CheckoutBrandingTypographyLetterCase")
+}
+
+func (e CheckoutBrandingTypographyLetterCase) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingTypographyLetterCase) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingTypographyLetterCase")
+}
+
+func (e *CheckoutBrandingTypographyLetterCase) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingTypographyLetterCase")
+}
+
+// LookupCheckoutBrandingTypographySize returns the value of AllCheckoutBrandingTypographySize named name.
+func LookupCheckoutBrandingTypographySize(name string) (CheckoutBrandingTypographySize, bool) {
+	return lookupEnum(AllCheckoutBrandingTypographySize, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingTypographySize, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingTypographySize) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingTypographySize, e)
+}
+
+func (e CheckoutBrandingTypographySize) GoString() string {
+	return enumGoString(AllCheckoutBrandingTypographySize, enumConstants["CheckoutBrandingTypographySize"], e, "model.CheckoutBrandingTypographySize")
+}
+
+func (e CheckoutBrandingTypographySize) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingTypographySize) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingTypographySize")
+}
+
+func (e *CheckoutBrandingTypographySize) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingTypographySize")
+}
+
+// LookupCheckoutBrandingTypographyWeight returns the value of AllCheckoutBrandingTypographyWeight named name.
+func LookupCheckoutBrandingTypographyWeight(name string) (CheckoutBrandingTypographyWeight, bool) {
+	return lookupEnum(AllCheckoutBrandingTypographyWeight, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingTypographyWeight, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingTypographyWeight) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingTypographyWeight, e)
+}
+
+func (e CheckoutBrandingTypographyWeight) GoString() string {
+	return enumGoString(AllCheckoutBrandingTypographyWeight, enumConstants["CheckoutBrandingTypographyWeight"], e, "model.CheckoutBrandingTypographyWeight")
+}
+
+func (e CheckoutBrandingTypographyWeight) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingTypographyWeight) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingTypographyWeight")
+}
+
+func (e *CheckoutBrandingTypographyWeight) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingTypographyWeight")
+}
+
+// LookupCheckoutBrandingUpsertUserErrorCode returns the value of AllCheckoutBrandingUpsertUserErrorCode named name.
+func LookupCheckoutBrandingUpsertUserErrorCode(name string) (CheckoutBrandingUpsertUserErrorCode, bool) {
+	return lookupEnum(AllCheckoutBrandingUpsertUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingUpsertUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingUpsertUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingUpsertUserErrorCode, e)
+}
+
+func (e CheckoutBrandingUpsertUserErrorCode) GoString() string {
+	return enumGoString(AllCheckoutBrandingUpsertUserErrorCode, enumConstants["CheckoutBrandingUpsertUserErrorCode"], e, "model.CheckoutBrandingUpsertUserErrorCode")
+}
+
+func (e CheckoutBrandingUpsertUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingUpsertUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingUpsertUserErrorCode")
+}
+
+func (e *CheckoutBrandingUpsertUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingUpsertUserErrorCode")
+}
+
+// LookupCheckoutBrandingVisibility returns the value of AllCheckoutBrandingVisibility named name.
+func LookupCheckoutBrandingVisibility(name string) (CheckoutBrandingVisibility, bool) {
+	return lookupEnum(AllCheckoutBrandingVisibility, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutBrandingVisibility, the values declared by the schema the models were
+// generated from.
+func (e CheckoutBrandingVisibility) IsKnown() bool {
+	return slices.Contains(AllCheckoutBrandingVisibility, e)
+}
+
+func (e CheckoutBrandingVisibility) GoString() string {
+	return enumGoString(AllCheckoutBrandingVisibility, enumConstants["CheckoutBrandingVisibility"], e, "model.CheckoutBrandingVisibility")
+}
+
+func (e CheckoutBrandingVisibility) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutBrandingVisibility) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutBrandingVisibility")
+}
+
+func (e *CheckoutBrandingVisibility) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutBrandingVisibility")
+}
+
+// LookupCheckoutProfileSortKeys returns the value of AllCheckoutProfileSortKeys named name.
+func LookupCheckoutProfileSortKeys(name string) (CheckoutProfileSortKeys, bool) {
+	return lookupEnum(AllCheckoutProfileSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCheckoutProfileSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CheckoutProfileSortKeys) IsKnown() bool {
+	return slices.Contains(AllCheckoutProfileSortKeys, e)
+}
+
+func (e CheckoutProfileSortKeys) GoString() string {
+	return enumGoString(AllCheckoutProfileSortKeys, enumConstants["CheckoutProfileSortKeys"], e, "model.CheckoutProfileSortKeys")
+}
+
+func (e CheckoutProfileSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CheckoutProfileSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CheckoutProfileSortKeys")
+}
+
+func (e *CheckoutProfileSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CheckoutProfileSortKeys")
+}
+
+// LookupCodeDiscountSortKeys returns the value of AllCodeDiscountSortKeys named name.
+func LookupCodeDiscountSortKeys(name string) (CodeDiscountSortKeys, bool) {
+	return lookupEnum(AllCodeDiscountSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCodeDiscountSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CodeDiscountSortKeys) IsKnown() bool {
+	return slices.Contains(AllCodeDiscountSortKeys, e)
+}
+
+func (e CodeDiscountSortKeys) GoString() string {
+	return enumGoString(AllCodeDiscountSortKeys, enumConstants["CodeDiscountSortKeys"], e, "model.CodeDiscountSortKeys")
+}
+
+func (e CodeDiscountSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CodeDiscountSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CodeDiscountSortKeys")
+}
+
+func (e *CodeDiscountSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CodeDiscountSortKeys")
+}
+
+// LookupCollectionAddProductsV2UserErrorCode returns the value of AllCollectionAddProductsV2UserErrorCode named name.
+func LookupCollectionAddProductsV2UserErrorCode(name string) (CollectionAddProductsV2UserErrorCode, bool) {
+	return lookupEnum(AllCollectionAddProductsV2UserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCollectionAddProductsV2UserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CollectionAddProductsV2UserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCollectionAddProductsV2UserErrorCode, e)
+}
+
+func (e CollectionAddProductsV2UserErrorCode) GoString() string {
+	return enumGoString(AllCollectionAddProductsV2UserErrorCode, enumConstants["CollectionAddProductsV2UserErrorCode"], e, "model.CollectionAddProductsV2UserErrorCode")
+}
+
+func (e CollectionAddProductsV2UserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CollectionAddProductsV2UserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CollectionAddProductsV2UserErrorCode")
+}
+
+func (e *CollectionAddProductsV2UserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CollectionAddProductsV2UserErrorCode")
+}
+
+// LookupCollectionRuleColumn returns the value of AllCollectionRuleColumn named name.
+func LookupCollectionRuleColumn(name string) (CollectionRuleColumn, bool) {
+	return lookupEnum(AllCollectionRuleColumn, name)
+}
+
+// IsKnown reports whether e is one of AllCollectionRuleColumn, the values declared by the schema the models were
+// generated from.
+func (e CollectionRuleColumn) IsKnown() bool {
+	return slices.Contains(AllCollectionRuleColumn, e)
+}
+
+func (e CollectionRuleColumn) GoString() string {
+	return enumGoString(AllCollectionRuleColumn, enumConstants["CollectionRuleColumn"], e, "model.CollectionRuleColumn")
+}
+
+func (e CollectionRuleColumn) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CollectionRuleColumn) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CollectionRuleColumn")
+}
+
+func (e *CollectionRuleColumn) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CollectionRuleColumn")
+}
+
+// LookupCollectionRuleRelation returns the value of AllCollectionRuleRelation named name.
+func LookupCollectionRuleRelation(name string) (CollectionRuleRelation, bool) {
+	return lookupEnum(AllCollectionRuleRelation, name)
+}
+
+// IsKnown reports whether e is one of AllCollectionRuleRelation, the values declared by the schema the models were
+// generated from.
+func (e CollectionRuleRelation) IsKnown() bool {
+	return slices.Contains(AllCollectionRuleRelation, e)
+}
+
+func (e CollectionRuleRelation) GoString() string {
+	return enumGoString(AllCollectionRuleRelation, enumConstants["CollectionRuleRelation"], e, "model.CollectionRuleRelation")
+}
+
+func (e CollectionRuleRelation) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CollectionRuleRelation) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CollectionRuleRelation")
+}
+
+func (e *CollectionRuleRelation) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CollectionRuleRelation")
+}
+
+// LookupCollectionSortKeys returns the value of AllCollectionSortKeys named name.
+func LookupCollectionSortKeys(name string) (CollectionSortKeys, bool) {
+	return lookupEnum(AllCollectionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCollectionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CollectionSortKeys) IsKnown() bool {
+	return slices.Contains(AllCollectionSortKeys, e)
+}
+
+func (e CollectionSortKeys) GoString() string {
+	return enumGoString(AllCollectionSortKeys, enumConstants["CollectionSortKeys"], e, "model.CollectionSortKeys")
+}
+
+func (e CollectionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CollectionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CollectionSortKeys")
+}
+
+func (e *CollectionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CollectionSortKeys")
+}
+
+// LookupCollectionSortOrder returns the value of AllCollectionSortOrder named name.
+func LookupCollectionSortOrder(name string) (CollectionSortOrder, bool) {
+	return lookupEnum(AllCollectionSortOrder, name)
+}
+
+// IsKnown reports whether e is one of AllCollectionSortOrder, the values declared by the schema the models were
+// generated from.
+func (e CollectionSortOrder) IsKnown() bool {
+	return slices.Contains(AllCollectionSortOrder, e)
+}
+
+func (e CollectionSortOrder) GoString() string {
+	return enumGoString(AllCollectionSortOrder, enumConstants["CollectionSortOrder"], e, "model.CollectionSortOrder")
+}
+
+func (e CollectionSortOrder) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CollectionSortOrder) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CollectionSortOrder")
+}
+
+func (e *CollectionSortOrder) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CollectionSortOrder")
+}
+
+// LookupCombinedListingUpdateUserErrorCode returns the value of AllCombinedListingUpdateUserErrorCode named name.
+func LookupCombinedListingUpdateUserErrorCode(name string) (CombinedListingUpdateUserErrorCode, bool) {
+	return lookupEnum(AllCombinedListingUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCombinedListingUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CombinedListingUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCombinedListingUpdateUserErrorCode, e)
+}
+
+func (e CombinedListingUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllCombinedListingUpdateUserErrorCode, enumConstants["CombinedListingUpdateUserErrorCode"], e, "model.CombinedListingUpdateUserErrorCode")
+}
+
+func (e CombinedListingUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CombinedListingUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CombinedListingUpdateUserErrorCode")
+}
+
+func (e *CombinedListingUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CombinedListingUpdateUserErrorCode")
+}
+
+// LookupCombinedListingsRole returns the value of AllCombinedListingsRole named name.
+func LookupCombinedListingsRole(name string) (CombinedListingsRole, bool) {
+	return lookupEnum(AllCombinedListingsRole, name)
+}
+
+// IsKnown reports whether e is one of AllCombinedListingsRole, the values declared by the schema the models were
+// generated from.
+func (e CombinedListingsRole) IsKnown() bool {
+	return slices.Contains(AllCombinedListingsRole, e)
+}
+
+func (e CombinedListingsRole) GoString() string {
+	return enumGoString(AllCombinedListingsRole, enumConstants["CombinedListingsRole"], e, "model.CombinedListingsRole")
+}
+
+func (e CombinedListingsRole) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CombinedListingsRole) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CombinedListingsRole")
+}
+
+func (e *CombinedListingsRole) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CombinedListingsRole")
+}
+
+// LookupCommentApproveUserErrorCode returns the value of AllCommentApproveUserErrorCode named name.
+func LookupCommentApproveUserErrorCode(name string) (CommentApproveUserErrorCode, bool) {
+	return lookupEnum(AllCommentApproveUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCommentApproveUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CommentApproveUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCommentApproveUserErrorCode, e)
+}
+
+func (e CommentApproveUserErrorCode) GoString() string {
+	return enumGoString(AllCommentApproveUserErrorCode, enumConstants["CommentApproveUserErrorCode"], e, "model.CommentApproveUserErrorCode")
+}
+
+func (e CommentApproveUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentApproveUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentApproveUserErrorCode")
+}
+
+func (e *CommentApproveUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentApproveUserErrorCode")
+}
+
+// LookupCommentDeleteUserErrorCode returns the value of AllCommentDeleteUserErrorCode named name.
+func LookupCommentDeleteUserErrorCode(name string) (CommentDeleteUserErrorCode, bool) {
+	return lookupEnum(AllCommentDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCommentDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CommentDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCommentDeleteUserErrorCode, e)
+}
+
+func (e CommentDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllCommentDeleteUserErrorCode, enumConstants["CommentDeleteUserErrorCode"], e, "model.CommentDeleteUserErrorCode")
+}
+
+func (e CommentDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentDeleteUserErrorCode")
+}
+
+func (e *CommentDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentDeleteUserErrorCode")
+}
+
+// LookupCommentNotSpamUserErrorCode returns the value of AllCommentNotSpamUserErrorCode named name.
+func LookupCommentNotSpamUserErrorCode(name string) (CommentNotSpamUserErrorCode, bool) {
+	return lookupEnum(AllCommentNotSpamUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCommentNotSpamUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CommentNotSpamUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCommentNotSpamUserErrorCode, e)
+}
+
+func (e CommentNotSpamUserErrorCode) GoString() string {
+	return enumGoString(AllCommentNotSpamUserErrorCode, enumConstants["CommentNotSpamUserErrorCode"], e, "model.CommentNotSpamUserErrorCode")
+}
+
+func (e CommentNotSpamUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentNotSpamUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentNotSpamUserErrorCode")
+}
+
+func (e *CommentNotSpamUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentNotSpamUserErrorCode")
+}
+
+// LookupCommentPolicy returns the value of AllCommentPolicy named name.
+func LookupCommentPolicy(name string) (CommentPolicy, bool) {
+	return lookupEnum(AllCommentPolicy, name)
+}
+
+// IsKnown reports whether e is one of AllCommentPolicy, the values declared by the schema the models were
+// generated from.
+func (e CommentPolicy) IsKnown() bool {
+	return slices.Contains(AllCommentPolicy, e)
+}
+
+func (e CommentPolicy) GoString() string {
+	return enumGoString(AllCommentPolicy, enumConstants["CommentPolicy"], e, "model.CommentPolicy")
+}
+
+func (e CommentPolicy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentPolicy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentPolicy")
+}
+
+func (e *CommentPolicy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentPolicy")
+}
+
+// LookupCommentSortKeys returns the value of AllCommentSortKeys named name.
+func LookupCommentSortKeys(name string) (CommentSortKeys, bool) {
+	return lookupEnum(AllCommentSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCommentSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CommentSortKeys) IsKnown() bool {
+	return slices.Contains(AllCommentSortKeys, e)
+}
+
+func (e CommentSortKeys) GoString() string {
+	return enumGoString(AllCommentSortKeys, enumConstants["CommentSortKeys"], e, "model.CommentSortKeys")
+}
+
+func (e CommentSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentSortKeys")
+}
+
+func (e *CommentSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentSortKeys")
+}
+
+// LookupCommentSpamUserErrorCode returns the value of AllCommentSpamUserErrorCode named name.
+func LookupCommentSpamUserErrorCode(name string) (CommentSpamUserErrorCode, bool) {
+	return lookupEnum(AllCommentSpamUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCommentSpamUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CommentSpamUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCommentSpamUserErrorCode, e)
+}
+
+func (e CommentSpamUserErrorCode) GoString() string {
+	return enumGoString(AllCommentSpamUserErrorCode, enumConstants["CommentSpamUserErrorCode"], e, "model.CommentSpamUserErrorCode")
+}
+
+func (e CommentSpamUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentSpamUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentSpamUserErrorCode")
+}
+
+func (e *CommentSpamUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentSpamUserErrorCode")
+}
+
+// LookupCommentStatus returns the value of AllCommentStatus named name.
+func LookupCommentStatus(name string) (CommentStatus, bool) {
+	return lookupEnum(AllCommentStatus, name)
+}
+
+// IsKnown reports whether e is one of AllCommentStatus, the values declared by the schema the models were
+// generated from.
+func (e CommentStatus) IsKnown() bool {
+	return slices.Contains(AllCommentStatus, e)
+}
+
+func (e CommentStatus) GoString() string {
+	return enumGoString(AllCommentStatus, enumConstants["CommentStatus"], e, "model.CommentStatus")
+}
+
+func (e CommentStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CommentStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CommentStatus")
+}
+
+func (e *CommentStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CommentStatus")
+}
+
+// LookupCompanyAddressType returns the value of AllCompanyAddressType named name.
+func LookupCompanyAddressType(name string) (CompanyAddressType, bool) {
+	return lookupEnum(AllCompanyAddressType, name)
+}
+
+// IsKnown reports whether e is one of AllCompanyAddressType, the values declared by the schema the models were
+// generated from.
+func (e CompanyAddressType) IsKnown() bool {
+	return slices.Contains(AllCompanyAddressType, e)
+}
+
+func (e CompanyAddressType) GoString() string {
+	return enumGoString(AllCompanyAddressType, enumConstants["CompanyAddressType"], e, "model.CompanyAddressType")
+}
+
+func (e CompanyAddressType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanyAddressType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanyAddressType")
+}
+
+func (e *CompanyAddressType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanyAddressType")
+}
+
+// LookupCompanyContactRoleAssignmentSortKeys returns the value of AllCompanyContactRoleAssignmentSortKeys named name.
+func LookupCompanyContactRoleAssignmentSortKeys(name string) (CompanyContactRoleAssignmentSortKeys, bool) {
+	return lookupEnum(AllCompanyContactRoleAssignmentSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCompanyContactRoleAssignmentSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CompanyContactRoleAssignmentSortKeys) IsKnown() bool {
+	return slices.Contains(AllCompanyContactRoleAssignmentSortKeys, e)
+}
+
+func (e CompanyContactRoleAssignmentSortKeys) GoString() string {
+	return enumGoString(AllCompanyContactRoleAssignmentSortKeys, enumConstants["CompanyContactRoleAssignmentSortKeys"], e, "model.CompanyContactRoleAssignmentSortKeys")
+}
+
+func (e CompanyContactRoleAssignmentSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanyContactRoleAssignmentSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanyContactRoleAssignmentSortKeys")
+}
+
+func (e *CompanyContactRoleAssignmentSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanyContactRoleAssignmentSortKeys")
+}
+
+// LookupCompanyContactRoleSortKeys returns the value of AllCompanyContactRoleSortKeys named name.
+func LookupCompanyContactRoleSortKeys(name string) (CompanyContactRoleSortKeys, bool) {
+	return lookupEnum(AllCompanyContactRoleSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCompanyContactRoleSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CompanyContactRoleSortKeys) IsKnown() bool {
+	return slices.Contains(AllCompanyContactRoleSortKeys, e)
+}
+
+func (e CompanyContactRoleSortKeys) GoString() string {
+	return enumGoString(AllCompanyContactRoleSortKeys, enumConstants["CompanyContactRoleSortKeys"], e, "model.CompanyContactRoleSortKeys")
+}
+
+func (e CompanyContactRoleSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanyContactRoleSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanyContactRoleSortKeys")
+}
+
+func (e *CompanyContactRoleSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanyContactRoleSortKeys")
+}
+
+// LookupCompanyContactSortKeys returns the value of AllCompanyContactSortKeys named name.
+func LookupCompanyContactSortKeys(name string) (CompanyContactSortKeys, bool) {
+	return lookupEnum(AllCompanyContactSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCompanyContactSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CompanyContactSortKeys) IsKnown() bool {
+	return slices.Contains(AllCompanyContactSortKeys, e)
+}
+
+func (e CompanyContactSortKeys) GoString() string {
+	return enumGoString(AllCompanyContactSortKeys, enumConstants["CompanyContactSortKeys"], e, "model.CompanyContactSortKeys")
+}
+
+func (e CompanyContactSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanyContactSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanyContactSortKeys")
+}
+
+func (e *CompanyContactSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanyContactSortKeys")
+}
+
+// LookupCompanyLocationSortKeys returns the value of AllCompanyLocationSortKeys named name.
+func LookupCompanyLocationSortKeys(name string) (CompanyLocationSortKeys, bool) {
+	return lookupEnum(AllCompanyLocationSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCompanyLocationSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CompanyLocationSortKeys) IsKnown() bool {
+	return slices.Contains(AllCompanyLocationSortKeys, e)
+}
+
+func (e CompanyLocationSortKeys) GoString() string {
+	return enumGoString(AllCompanyLocationSortKeys, enumConstants["CompanyLocationSortKeys"], e, "model.CompanyLocationSortKeys")
+}
+
+func (e CompanyLocationSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanyLocationSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanyLocationSortKeys")
+}
+
+func (e *CompanyLocationSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanyLocationSortKeys")
+}
+
+// LookupCompanyLocationStaffMemberAssignmentSortKeys returns the value of AllCompanyLocationStaffMemberAssignmentSortKeys named name.
+func LookupCompanyLocationStaffMemberAssignmentSortKeys(name string) (CompanyLocationStaffMemberAssignmentSortKeys, bool) {
+	return lookupEnum(AllCompanyLocationStaffMemberAssignmentSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCompanyLocationStaffMemberAssignmentSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CompanyLocationStaffMemberAssignmentSortKeys) IsKnown() bool {
+	return slices.Contains(AllCompanyLocationStaffMemberAssignmentSortKeys, e)
+}
+
+func (e CompanyLocationStaffMemberAssignmentSortKeys) GoString() string {
+	return enumGoString(AllCompanyLocationStaffMemberAssignmentSortKeys, enumConstants["CompanyLocationStaffMemberAssignmentSortKeys"], e, "model.CompanyLocationStaffMemberAssignmentSortKeys")
+}
+
+func (e CompanyLocationStaffMemberAssignmentSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanyLocationStaffMemberAssignmentSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanyLocationStaffMemberAssignmentSortKeys")
+}
+
+func (e *CompanyLocationStaffMemberAssignmentSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanyLocationStaffMemberAssignmentSortKeys")
+}
+
+// LookupCompanySortKeys returns the value of AllCompanySortKeys named name.
+func LookupCompanySortKeys(name string) (CompanySortKeys, bool) {
+	return lookupEnum(AllCompanySortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCompanySortKeys, the values declared by the schema the models were
+// generated from.
+func (e CompanySortKeys) IsKnown() bool {
+	return slices.Contains(AllCompanySortKeys, e)
+}
+
+func (e CompanySortKeys) GoString() string {
+	return enumGoString(AllCompanySortKeys, enumConstants["CompanySortKeys"], e, "model.CompanySortKeys")
+}
+
+func (e CompanySortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CompanySortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CompanySortKeys")
+}
+
+func (e *CompanySortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CompanySortKeys")
+}
+
+// LookupCountPrecision returns the value of AllCountPrecision named name.
+func LookupCountPrecision(name string) (CountPrecision, bool) {
+	return lookupEnum(AllCountPrecision, name)
+}
+
+// IsKnown reports whether e is one of AllCountPrecision, the values declared by the schema the models were
+// generated from.
+func (e CountPrecision) IsKnown() bool {
+	return slices.Contains(AllCountPrecision, e)
+}
+
+func (e CountPrecision) GoString() string {
+	return enumGoString(AllCountPrecision, enumConstants["CountPrecision"], e, "model.CountPrecision")
+}
+
+func (e CountPrecision) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CountPrecision) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CountPrecision")
+}
+
+func (e *CountPrecision) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CountPrecision")
+}
+
+// LookupCountryCode returns the value of AllCountryCode named name.
+func LookupCountryCode(name string) (CountryCode, bool) {
+	return lookupEnum(AllCountryCode, name)
+}
+
+// IsKnown reports whether e is one of AllCountryCode, the values declared by the schema the models were
+// generated from.
+func (e CountryCode) IsKnown() bool {
+	return slices.Contains(AllCountryCode, e)
+}
+
+func (e CountryCode) GoString() string {
+	return enumGoString(AllCountryCode, enumConstants["CountryCode"], e, "model.CountryCode")
+}
+
+func (e CountryCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CountryCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CountryCode")
+}
+
+func (e *CountryCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CountryCode")
+}
+
+// LookupCropRegion returns the value of AllCropRegion named name.
+func LookupCropRegion(name string) (CropRegion, bool) {
+	return lookupEnum(AllCropRegion, name)
+}
+
+// IsKnown reports whether e is one of AllCropRegion, the values declared by the schema the models were
+// generated from.
+func (e CropRegion) IsKnown() bool {
+	return slices.Contains(AllCropRegion, e)
+}
+
+func (e CropRegion) GoString() string {
+	return enumGoString(AllCropRegion, enumConstants["CropRegion"], e, "model.CropRegion")
+}
+
+func (e CropRegion) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CropRegion) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CropRegion")
+}
+
+func (e *CropRegion) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CropRegion")
+}
+
+// LookupCurrencyCode returns the value of AllCurrencyCode named name.
+func LookupCurrencyCode(name string) (CurrencyCode, bool) {
+	return lookupEnum(AllCurrencyCode, name)
+}
+
+// IsKnown reports whether e is one of AllCurrencyCode, the values declared by the schema the models were
+// generated from.
+func (e CurrencyCode) IsKnown() bool {
+	return slices.Contains(AllCurrencyCode, e)
+}
+
+func (e CurrencyCode) GoString() string {
+	return enumGoString(AllCurrencyCode, enumConstants["CurrencyCode"], e, "model.CurrencyCode")
+}
+
+func (e CurrencyCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CurrencyCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CurrencyCode")
+}
+
+func (e *CurrencyCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CurrencyCode")
+}
+
+// LookupCustomerAccountNativePagePageType returns the value of AllCustomerAccountNativePagePageType named name.
+func LookupCustomerAccountNativePagePageType(name string) (CustomerAccountNativePagePageType, bool) {
+	return lookupEnum(AllCustomerAccountNativePagePageType, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerAccountNativePagePageType, the values declared by the schema the models were
+// generated from.
+func (e CustomerAccountNativePagePageType) IsKnown() bool {
+	return slices.Contains(AllCustomerAccountNativePagePageType, e)
+}
+
+func (e CustomerAccountNativePagePageType) GoString() string {
+	return enumGoString(AllCustomerAccountNativePagePageType, enumConstants["CustomerAccountNativePagePageType"], e, "model.CustomerAccountNativePagePageType")
+}
+
+func (e CustomerAccountNativePagePageType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerAccountNativePagePageType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerAccountNativePagePageType")
+}
+
+func (e *CustomerAccountNativePagePageType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerAccountNativePagePageType")
+}
+
+// LookupCustomerAccountsVersion returns the value of AllCustomerAccountsVersion named name.
+func LookupCustomerAccountsVersion(name string) (CustomerAccountsVersion, bool) {
+	return lookupEnum(AllCustomerAccountsVersion, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerAccountsVersion, the values declared by the schema the models were
+// generated from.
+func (e CustomerAccountsVersion) IsKnown() bool {
+	return slices.Contains(AllCustomerAccountsVersion, e)
+}
+
+func (e CustomerAccountsVersion) GoString() string {
+	return enumGoString(AllCustomerAccountsVersion, enumConstants["CustomerAccountsVersion"], e, "model.CustomerAccountsVersion")
+}
+
+func (e CustomerAccountsVersion) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerAccountsVersion) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerAccountsVersion")
+}
+
+func (e *CustomerAccountsVersion) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerAccountsVersion")
+}
+
+// LookupCustomerCancelDataErasureErrorCode returns the value of AllCustomerCancelDataErasureErrorCode named name.
+func LookupCustomerCancelDataErasureErrorCode(name string) (CustomerCancelDataErasureErrorCode, bool) {
+	return lookupEnum(AllCustomerCancelDataErasureErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerCancelDataErasureErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerCancelDataErasureErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerCancelDataErasureErrorCode, e)
+}
+
+func (e CustomerCancelDataErasureErrorCode) GoString() string {
+	return enumGoString(AllCustomerCancelDataErasureErrorCode, enumConstants["CustomerCancelDataErasureErrorCode"], e, "model.CustomerCancelDataErasureErrorCode")
+}
+
+func (e CustomerCancelDataErasureErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerCancelDataErasureErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerCancelDataErasureErrorCode")
+}
+
+func (e *CustomerCancelDataErasureErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerCancelDataErasureErrorCode")
+}
+
+// LookupCustomerConsentCollectedFrom returns the value of AllCustomerConsentCollectedFrom named name.
+func LookupCustomerConsentCollectedFrom(name string) (CustomerConsentCollectedFrom, bool) {
+	return lookupEnum(AllCustomerConsentCollectedFrom, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerConsentCollectedFrom, the values declared by the schema the models were
+// generated from.
+func (e CustomerConsentCollectedFrom) IsKnown() bool {
+	return slices.Contains(AllCustomerConsentCollectedFrom, e)
+}
+
+func (e CustomerConsentCollectedFrom) GoString() string {
+	return enumGoString(AllCustomerConsentCollectedFrom, enumConstants["CustomerConsentCollectedFrom"], e, "model.CustomerConsentCollectedFrom")
+}
+
+func (e CustomerConsentCollectedFrom) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerConsentCollectedFrom) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerConsentCollectedFrom")
+}
+
+func (e *CustomerConsentCollectedFrom) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerConsentCollectedFrom")
+}
+
+// LookupCustomerEmailAddressMarketingState returns the value of AllCustomerEmailAddressMarketingState named name.
+func LookupCustomerEmailAddressMarketingState(name string) (CustomerEmailAddressMarketingState, bool) {
+	return lookupEnum(AllCustomerEmailAddressMarketingState, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerEmailAddressMarketingState, the values declared by the schema the models were
+// generated from.
+func (e CustomerEmailAddressMarketingState) IsKnown() bool {
+	return slices.Contains(AllCustomerEmailAddressMarketingState, e)
+}
+
+func (e CustomerEmailAddressMarketingState) GoString() string {
+	return enumGoString(AllCustomerEmailAddressMarketingState, enumConstants["CustomerEmailAddressMarketingState"], e, "model.CustomerEmailAddressMarketingState")
+}
+
+func (e CustomerEmailAddressMarketingState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerEmailAddressMarketingState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerEmailAddressMarketingState")
+}
+
+func (e *CustomerEmailAddressMarketingState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerEmailAddressMarketingState")
+}
+
+// LookupCustomerEmailAddressOpenTrackingLevel returns the value of AllCustomerEmailAddressOpenTrackingLevel named name.
+func LookupCustomerEmailAddressOpenTrackingLevel(name string) (CustomerEmailAddressOpenTrackingLevel, bool) {
+	return lookupEnum(AllCustomerEmailAddressOpenTrackingLevel, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerEmailAddressOpenTrackingLevel, the values declared by the schema the models were
+// generated from.
+func (e CustomerEmailAddressOpenTrackingLevel) IsKnown() bool {
+	return slices.Contains(AllCustomerEmailAddressOpenTrackingLevel, e)
+}
+
+func (e CustomerEmailAddressOpenTrackingLevel) GoString() string {
+	return enumGoString(AllCustomerEmailAddressOpenTrackingLevel, enumConstants["CustomerEmailAddressOpenTrackingLevel"], e, "model.CustomerEmailAddressOpenTrackingLevel")
+}
+
+func (e CustomerEmailAddressOpenTrackingLevel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerEmailAddressOpenTrackingLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerEmailAddressOpenTrackingLevel")
+}
+
+func (e *CustomerEmailAddressOpenTrackingLevel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerEmailAddressOpenTrackingLevel")
+}
+
+// LookupCustomerEmailMarketingConsentUpdateUserErrorCode returns the value of AllCustomerEmailMarketingConsentUpdateUserErrorCode named name.
+func LookupCustomerEmailMarketingConsentUpdateUserErrorCode(name string) (CustomerEmailMarketingConsentUpdateUserErrorCode, bool) {
+	return lookupEnum(AllCustomerEmailMarketingConsentUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerEmailMarketingConsentUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerEmailMarketingConsentUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerEmailMarketingConsentUpdateUserErrorCode, e)
+}
+
+func (e CustomerEmailMarketingConsentUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerEmailMarketingConsentUpdateUserErrorCode, enumConstants["CustomerEmailMarketingConsentUpdateUserErrorCode"], e, "model.CustomerEmailMarketingConsentUpdateUserErrorCode")
+}
+
+func (e CustomerEmailMarketingConsentUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerEmailMarketingConsentUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerEmailMarketingConsentUpdateUserErrorCode")
+}
+
+func (e *CustomerEmailMarketingConsentUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerEmailMarketingConsentUpdateUserErrorCode")
+}
+
+// LookupCustomerEmailMarketingState returns the value of AllCustomerEmailMarketingState named name.
+func LookupCustomerEmailMarketingState(name string) (CustomerEmailMarketingState, bool) {
+	return lookupEnum(AllCustomerEmailMarketingState, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerEmailMarketingState, the values declared by the schema the models were
+// generated from.
+func (e CustomerEmailMarketingState) IsKnown() bool {
+	return slices.Contains(AllCustomerEmailMarketingState, e)
+}
+
+func (e CustomerEmailMarketingState) GoString() string {
+	return enumGoString(AllCustomerEmailMarketingState, enumConstants["CustomerEmailMarketingState"], e, "model.CustomerEmailMarketingState")
+}
+
+func (e CustomerEmailMarketingState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerEmailMarketingState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerEmailMarketingState")
+}
+
+func (e *CustomerEmailMarketingState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerEmailMarketingState")
+}
+
+// LookupCustomerMarketingOptInLevel returns the value of AllCustomerMarketingOptInLevel named name.
+func LookupCustomerMarketingOptInLevel(name string) (CustomerMarketingOptInLevel, bool) {
+	return lookupEnum(AllCustomerMarketingOptInLevel, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerMarketingOptInLevel, the values declared by the schema the models were
+// generated from.
+func (e CustomerMarketingOptInLevel) IsKnown() bool {
+	return slices.Contains(AllCustomerMarketingOptInLevel, e)
+}
+
+func (e CustomerMarketingOptInLevel) GoString() string {
+	return enumGoString(AllCustomerMarketingOptInLevel, enumConstants["CustomerMarketingOptInLevel"], e, "model.CustomerMarketingOptInLevel")
+}
+
+func (e CustomerMarketingOptInLevel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerMarketingOptInLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerMarketingOptInLevel")
+}
+
+func (e *CustomerMarketingOptInLevel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerMarketingOptInLevel")
+}
+
+// LookupCustomerMergeErrorCode returns the value of AllCustomerMergeErrorCode named name.
+func LookupCustomerMergeErrorCode(name string) (CustomerMergeErrorCode, bool) {
+	return lookupEnum(AllCustomerMergeErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerMergeErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerMergeErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerMergeErrorCode, e)
+}
+
+func (e CustomerMergeErrorCode) GoString() string {
+	return enumGoString(AllCustomerMergeErrorCode, enumConstants["CustomerMergeErrorCode"], e, "model.CustomerMergeErrorCode")
+}
+
+func (e CustomerMergeErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerMergeErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerMergeErrorCode")
+}
+
+func (e *CustomerMergeErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerMergeErrorCode")
+}
+
+// LookupCustomerMergeErrorFieldType returns the value of AllCustomerMergeErrorFieldType named name.
+func LookupCustomerMergeErrorFieldType(name string) (CustomerMergeErrorFieldType, bool) {
+	return lookupEnum(AllCustomerMergeErrorFieldType, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerMergeErrorFieldType, the values declared by the schema the models were
+// generated from.
+func (e CustomerMergeErrorFieldType) IsKnown() bool {
+	return slices.Contains(AllCustomerMergeErrorFieldType, e)
+}
+
+func (e CustomerMergeErrorFieldType) GoString() string {
+	return enumGoString(AllCustomerMergeErrorFieldType, enumConstants["CustomerMergeErrorFieldType"], e, "model.CustomerMergeErrorFieldType")
+}
+
+func (e CustomerMergeErrorFieldType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerMergeErrorFieldType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerMergeErrorFieldType")
+}
+
+func (e *CustomerMergeErrorFieldType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerMergeErrorFieldType")
+}
+
+// LookupCustomerMergeRequestStatus returns the value of AllCustomerMergeRequestStatus named name.
+func LookupCustomerMergeRequestStatus(name string) (CustomerMergeRequestStatus, bool) {
+	return lookupEnum(AllCustomerMergeRequestStatus, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerMergeRequestStatus, the values declared by the schema the models were
+// generated from.
+func (e CustomerMergeRequestStatus) IsKnown() bool {
+	return slices.Contains(AllCustomerMergeRequestStatus, e)
+}
+
+func (e CustomerMergeRequestStatus) GoString() string {
+	return enumGoString(AllCustomerMergeRequestStatus, enumConstants["CustomerMergeRequestStatus"], e, "model.CustomerMergeRequestStatus")
+}
+
+func (e CustomerMergeRequestStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerMergeRequestStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerMergeRequestStatus")
+}
+
+func (e *CustomerMergeRequestStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerMergeRequestStatus")
+}
+
+// LookupCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode returns the value of AllCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode named name.
+func LookupCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode(name string) (CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode, bool) {
+	return lookupEnum(AllCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode, e)
+}
+
+func (e CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerPaymentMethodCreateFromDuplicationDataUserErrorCode, enumConstants["CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode"], e, "model.CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode")
+}
+
+func (e CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode")
+}
+
+func (e *CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode")
+}
+
+// LookupCustomerPaymentMethodGetDuplicationDataUserErrorCode returns the value of AllCustomerPaymentMethodGetDuplicationDataUserErrorCode named name.
+func LookupCustomerPaymentMethodGetDuplicationDataUserErrorCode(name string) (CustomerPaymentMethodGetDuplicationDataUserErrorCode, bool) {
+	return lookupEnum(AllCustomerPaymentMethodGetDuplicationDataUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPaymentMethodGetDuplicationDataUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerPaymentMethodGetDuplicationDataUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerPaymentMethodGetDuplicationDataUserErrorCode, e)
+}
+
+func (e CustomerPaymentMethodGetDuplicationDataUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerPaymentMethodGetDuplicationDataUserErrorCode, enumConstants["CustomerPaymentMethodGetDuplicationDataUserErrorCode"], e, "model.CustomerPaymentMethodGetDuplicationDataUserErrorCode")
+}
+
+func (e CustomerPaymentMethodGetDuplicationDataUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPaymentMethodGetDuplicationDataUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPaymentMethodGetDuplicationDataUserErrorCode")
+}
+
+func (e *CustomerPaymentMethodGetDuplicationDataUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPaymentMethodGetDuplicationDataUserErrorCode")
+}
+
+// LookupCustomerPaymentMethodGetUpdateURLUserErrorCode returns the value of AllCustomerPaymentMethodGetUpdateURLUserErrorCode named name.
+func LookupCustomerPaymentMethodGetUpdateURLUserErrorCode(name string) (CustomerPaymentMethodGetUpdateURLUserErrorCode, bool) {
+	return lookupEnum(AllCustomerPaymentMethodGetUpdateURLUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPaymentMethodGetUpdateURLUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerPaymentMethodGetUpdateURLUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerPaymentMethodGetUpdateURLUserErrorCode, e)
+}
+
+func (e CustomerPaymentMethodGetUpdateURLUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerPaymentMethodGetUpdateURLUserErrorCode, enumConstants["CustomerPaymentMethodGetUpdateURLUserErrorCode"], e, "model.CustomerPaymentMethodGetUpdateURLUserErrorCode")
+}
+
+func (e CustomerPaymentMethodGetUpdateURLUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPaymentMethodGetUpdateURLUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPaymentMethodGetUpdateURLUserErrorCode")
+}
+
+func (e *CustomerPaymentMethodGetUpdateURLUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPaymentMethodGetUpdateURLUserErrorCode")
+}
+
+// LookupCustomerPaymentMethodRemoteUserErrorCode returns the value of AllCustomerPaymentMethodRemoteUserErrorCode named name.
+func LookupCustomerPaymentMethodRemoteUserErrorCode(name string) (CustomerPaymentMethodRemoteUserErrorCode, bool) {
+	return lookupEnum(AllCustomerPaymentMethodRemoteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPaymentMethodRemoteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerPaymentMethodRemoteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerPaymentMethodRemoteUserErrorCode, e)
+}
+
+func (e CustomerPaymentMethodRemoteUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerPaymentMethodRemoteUserErrorCode, enumConstants["CustomerPaymentMethodRemoteUserErrorCode"], e, "model.CustomerPaymentMethodRemoteUserErrorCode")
+}
+
+func (e CustomerPaymentMethodRemoteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPaymentMethodRemoteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPaymentMethodRemoteUserErrorCode")
+}
+
+func (e *CustomerPaymentMethodRemoteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPaymentMethodRemoteUserErrorCode")
+}
+
+// LookupCustomerPaymentMethodRevocationReason returns the value of AllCustomerPaymentMethodRevocationReason named name.
+func LookupCustomerPaymentMethodRevocationReason(name string) (CustomerPaymentMethodRevocationReason, bool) {
+	return lookupEnum(AllCustomerPaymentMethodRevocationReason, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPaymentMethodRevocationReason, the values declared by the schema the models were
+// generated from.
+func (e CustomerPaymentMethodRevocationReason) IsKnown() bool {
+	return slices.Contains(AllCustomerPaymentMethodRevocationReason, e)
+}
+
+func (e CustomerPaymentMethodRevocationReason) GoString() string {
+	return enumGoString(AllCustomerPaymentMethodRevocationReason, enumConstants["CustomerPaymentMethodRevocationReason"], e, "model.CustomerPaymentMethodRevocationReason")
+}
+
+func (e CustomerPaymentMethodRevocationReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPaymentMethodRevocationReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPaymentMethodRevocationReason")
+}
+
+func (e *CustomerPaymentMethodRevocationReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPaymentMethodRevocationReason")
+}
+
+// LookupCustomerPaymentMethodUserErrorCode returns the value of AllCustomerPaymentMethodUserErrorCode named name.
+func LookupCustomerPaymentMethodUserErrorCode(name string) (CustomerPaymentMethodUserErrorCode, bool) {
+	return lookupEnum(AllCustomerPaymentMethodUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPaymentMethodUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerPaymentMethodUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerPaymentMethodUserErrorCode, e)
+}
+
+func (e CustomerPaymentMethodUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerPaymentMethodUserErrorCode, enumConstants["CustomerPaymentMethodUserErrorCode"], e, "model.CustomerPaymentMethodUserErrorCode")
+}
+
+func (e CustomerPaymentMethodUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPaymentMethodUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPaymentMethodUserErrorCode")
+}
+
+func (e *CustomerPaymentMethodUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPaymentMethodUserErrorCode")
+}
+
+// LookupCustomerPredictedSpendTier returns the value of AllCustomerPredictedSpendTier named name.
+func LookupCustomerPredictedSpendTier(name string) (CustomerPredictedSpendTier, bool) {
+	return lookupEnum(AllCustomerPredictedSpendTier, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerPredictedSpendTier, the values declared by the schema the models were
+// generated from.
+func (e CustomerPredictedSpendTier) IsKnown() bool {
+	return slices.Contains(AllCustomerPredictedSpendTier, e)
+}
+
+func (e CustomerPredictedSpendTier) GoString() string {
+	return enumGoString(AllCustomerPredictedSpendTier, enumConstants["CustomerPredictedSpendTier"], e, "model.CustomerPredictedSpendTier")
+}
+
+func (e CustomerPredictedSpendTier) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerPredictedSpendTier) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerPredictedSpendTier")
+}
+
+func (e *CustomerPredictedSpendTier) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerPredictedSpendTier")
+}
+
+// LookupCustomerProductSubscriberStatus returns the value of AllCustomerProductSubscriberStatus named name.
+func LookupCustomerProductSubscriberStatus(name string) (CustomerProductSubscriberStatus, bool) {
+	return lookupEnum(AllCustomerProductSubscriberStatus, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerProductSubscriberStatus, the values declared by the schema the models were
+// generated from.
+func (e CustomerProductSubscriberStatus) IsKnown() bool {
+	return slices.Contains(AllCustomerProductSubscriberStatus, e)
+}
+
+func (e CustomerProductSubscriberStatus) GoString() string {
+	return enumGoString(AllCustomerProductSubscriberStatus, enumConstants["CustomerProductSubscriberStatus"], e, "model.CustomerProductSubscriberStatus")
+}
+
+func (e CustomerProductSubscriberStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerProductSubscriberStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerProductSubscriberStatus")
+}
+
+func (e *CustomerProductSubscriberStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerProductSubscriberStatus")
+}
+
+// LookupCustomerRequestDataErasureErrorCode returns the value of AllCustomerRequestDataErasureErrorCode named name.
+func LookupCustomerRequestDataErasureErrorCode(name string) (CustomerRequestDataErasureErrorCode, bool) {
+	return lookupEnum(AllCustomerRequestDataErasureErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerRequestDataErasureErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerRequestDataErasureErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerRequestDataErasureErrorCode, e)
+}
+
+func (e CustomerRequestDataErasureErrorCode) GoString() string {
+	return enumGoString(AllCustomerRequestDataErasureErrorCode, enumConstants["CustomerRequestDataErasureErrorCode"], e, "model.CustomerRequestDataErasureErrorCode")
+}
+
+func (e CustomerRequestDataErasureErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerRequestDataErasureErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerRequestDataErasureErrorCode")
+}
+
+func (e *CustomerRequestDataErasureErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerRequestDataErasureErrorCode")
+}
+
+// LookupCustomerSavedSearchSortKeys returns the value of AllCustomerSavedSearchSortKeys named name.
+func LookupCustomerSavedSearchSortKeys(name string) (CustomerSavedSearchSortKeys, bool) {
+	return lookupEnum(AllCustomerSavedSearchSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerSavedSearchSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CustomerSavedSearchSortKeys) IsKnown() bool {
+	return slices.Contains(AllCustomerSavedSearchSortKeys, e)
+}
+
+func (e CustomerSavedSearchSortKeys) GoString() string {
+	return enumGoString(AllCustomerSavedSearchSortKeys, enumConstants["CustomerSavedSearchSortKeys"], e, "model.CustomerSavedSearchSortKeys")
+}
+
+func (e CustomerSavedSearchSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerSavedSearchSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerSavedSearchSortKeys")
+}
+
+func (e *CustomerSavedSearchSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerSavedSearchSortKeys")
+}
+
+// LookupCustomerSegmentMembersQueryUserErrorCode returns the value of AllCustomerSegmentMembersQueryUserErrorCode named name.
+func LookupCustomerSegmentMembersQueryUserErrorCode(name string) (CustomerSegmentMembersQueryUserErrorCode, bool) {
+	return lookupEnum(AllCustomerSegmentMembersQueryUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerSegmentMembersQueryUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerSegmentMembersQueryUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerSegmentMembersQueryUserErrorCode, e)
+}
+
+func (e CustomerSegmentMembersQueryUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerSegmentMembersQueryUserErrorCode, enumConstants["CustomerSegmentMembersQueryUserErrorCode"], e, "model.CustomerSegmentMembersQueryUserErrorCode")
+}
+
+func (e CustomerSegmentMembersQueryUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerSegmentMembersQueryUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerSegmentMembersQueryUserErrorCode")
+}
+
+func (e *CustomerSegmentMembersQueryUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerSegmentMembersQueryUserErrorCode")
+}
+
+// LookupCustomerSendAccountInviteEmailUserErrorCode returns the value of AllCustomerSendAccountInviteEmailUserErrorCode named name.
+func LookupCustomerSendAccountInviteEmailUserErrorCode(name string) (CustomerSendAccountInviteEmailUserErrorCode, bool) {
+	return lookupEnum(AllCustomerSendAccountInviteEmailUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerSendAccountInviteEmailUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerSendAccountInviteEmailUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerSendAccountInviteEmailUserErrorCode, e)
+}
+
+func (e CustomerSendAccountInviteEmailUserErrorCode) GoString() string {
+	return enumGoString(AllCustomerSendAccountInviteEmailUserErrorCode, enumConstants["CustomerSendAccountInviteEmailUserErrorCode"], e, "model.CustomerSendAccountInviteEmailUserErrorCode")
+}
+
+func (e CustomerSendAccountInviteEmailUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerSendAccountInviteEmailUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerSendAccountInviteEmailUserErrorCode")
+}
+
+func (e *CustomerSendAccountInviteEmailUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerSendAccountInviteEmailUserErrorCode")
+}
+
+// LookupCustomerSmsMarketingConsentErrorCode returns the value of AllCustomerSmsMarketingConsentErrorCode named name.
+func LookupCustomerSmsMarketingConsentErrorCode(name string) (CustomerSmsMarketingConsentErrorCode, bool) {
+	return lookupEnum(AllCustomerSmsMarketingConsentErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerSmsMarketingConsentErrorCode, the values declared by the schema the models were
+// generated from.
+func (e CustomerSmsMarketingConsentErrorCode) IsKnown() bool {
+	return slices.Contains(AllCustomerSmsMarketingConsentErrorCode, e)
+}
+
+func (e CustomerSmsMarketingConsentErrorCode) GoString() string {
+	return enumGoString(AllCustomerSmsMarketingConsentErrorCode, enumConstants["CustomerSmsMarketingConsentErrorCode"], e, "model.CustomerSmsMarketingConsentErrorCode")
+}
+
+func (e CustomerSmsMarketingConsentErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerSmsMarketingConsentErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerSmsMarketingConsentErrorCode")
+}
+
+func (e *CustomerSmsMarketingConsentErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerSmsMarketingConsentErrorCode")
+}
+
+// LookupCustomerSmsMarketingState returns the value of AllCustomerSmsMarketingState named name.
+func LookupCustomerSmsMarketingState(name string) (CustomerSmsMarketingState, bool) {
+	return lookupEnum(AllCustomerSmsMarketingState, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerSmsMarketingState, the values declared by the schema the models were
+// generated from.
+func (e CustomerSmsMarketingState) IsKnown() bool {
+	return slices.Contains(AllCustomerSmsMarketingState, e)
+}
+
+func (e CustomerSmsMarketingState) GoString() string {
+	return enumGoString(AllCustomerSmsMarketingState, enumConstants["CustomerSmsMarketingState"], e, "model.CustomerSmsMarketingState")
+}
+
+func (e CustomerSmsMarketingState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerSmsMarketingState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerSmsMarketingState")
+}
+
+func (e *CustomerSmsMarketingState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerSmsMarketingState")
+}
+
+// LookupCustomerSortKeys returns the value of AllCustomerSortKeys named name.
+func LookupCustomerSortKeys(name string) (CustomerSortKeys, bool) {
+	return lookupEnum(AllCustomerSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerSortKeys, the values declared by the schema the models were
+// generated from.
+func (e CustomerSortKeys) IsKnown() bool {
+	return slices.Contains(AllCustomerSortKeys, e)
+}
+
+func (e CustomerSortKeys) GoString() string {
+	return enumGoString(AllCustomerSortKeys, enumConstants["CustomerSortKeys"], e, "model.CustomerSortKeys")
+}
+
+func (e CustomerSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerSortKeys")
+}
+
+func (e *CustomerSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerSortKeys")
+}
+
+// LookupCustomerState returns the value of AllCustomerState named name.
+func LookupCustomerState(name string) (CustomerState, bool) {
+	return lookupEnum(AllCustomerState, name)
+}
+
+// IsKnown reports whether e is one of AllCustomerState, the values declared by the schema the models were
+// generated from.
+func (e CustomerState) IsKnown() bool {
+	return slices.Contains(AllCustomerState, e)
+}
+
+func (e CustomerState) GoString() string {
+	return enumGoString(AllCustomerState, enumConstants["CustomerState"], e, "model.CustomerState")
+}
+
+func (e CustomerState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *CustomerState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "CustomerState")
+}
+
+func (e *CustomerState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "CustomerState")
+}
+
+// LookupDataSaleOptOutUserErrorCode returns the value of AllDataSaleOptOutUserErrorCode named name.
+func LookupDataSaleOptOutUserErrorCode(name string) (DataSaleOptOutUserErrorCode, bool) {
+	return lookupEnum(AllDataSaleOptOutUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDataSaleOptOutUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DataSaleOptOutUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllDataSaleOptOutUserErrorCode, e)
+}
+
+func (e DataSaleOptOutUserErrorCode) GoString() string {
+	return enumGoString(AllDataSaleOptOutUserErrorCode, enumConstants["DataSaleOptOutUserErrorCode"], e, "model.DataSaleOptOutUserErrorCode")
+}
+
+func (e DataSaleOptOutUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DataSaleOptOutUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DataSaleOptOutUserErrorCode")
+}
+
+func (e *DataSaleOptOutUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DataSaleOptOutUserErrorCode")
+}
+
+// LookupDayOfTheWeek returns the value of AllDayOfTheWeek named name.
+func LookupDayOfTheWeek(name string) (DayOfTheWeek, bool) {
+	return lookupEnum(AllDayOfTheWeek, name)
+}
+
+// IsKnown reports whether e is one of AllDayOfTheWeek, the values declared by the schema the models were
+// generated from.
+func (e DayOfTheWeek) IsKnown() bool {
+	return slices.Contains(AllDayOfTheWeek, e)
+}
+
+func (e DayOfTheWeek) GoString() string {
+	return enumGoString(AllDayOfTheWeek, enumConstants["DayOfTheWeek"], e, "model.DayOfTheWeek")
+}
+
+func (e DayOfTheWeek) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DayOfTheWeek) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DayOfTheWeek")
+}
+
+func (e *DayOfTheWeek) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DayOfTheWeek")
+}
+
+// LookupDelegateAccessTokenCreateUserErrorCode returns the value of AllDelegateAccessTokenCreateUserErrorCode named name.
+func LookupDelegateAccessTokenCreateUserErrorCode(name string) (DelegateAccessTokenCreateUserErrorCode, bool) {
+	return lookupEnum(AllDelegateAccessTokenCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDelegateAccessTokenCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DelegateAccessTokenCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllDelegateAccessTokenCreateUserErrorCode, e)
+}
+
+func (e DelegateAccessTokenCreateUserErrorCode) GoString() string {
+	return enumGoString(AllDelegateAccessTokenCreateUserErrorCode, enumConstants["DelegateAccessTokenCreateUserErrorCode"], e, "model.DelegateAccessTokenCreateUserErrorCode")
+}
+
+func (e DelegateAccessTokenCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DelegateAccessTokenCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DelegateAccessTokenCreateUserErrorCode")
+}
+
+func (e *DelegateAccessTokenCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DelegateAccessTokenCreateUserErrorCode")
+}
+
+// LookupDelegateAccessTokenDestroyUserErrorCode returns the value of AllDelegateAccessTokenDestroyUserErrorCode named name.
+func LookupDelegateAccessTokenDestroyUserErrorCode(name string) (DelegateAccessTokenDestroyUserErrorCode, bool) {
+	return lookupEnum(AllDelegateAccessTokenDestroyUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDelegateAccessTokenDestroyUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DelegateAccessTokenDestroyUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllDelegateAccessTokenDestroyUserErrorCode, e)
+}
+
+func (e DelegateAccessTokenDestroyUserErrorCode) GoString() string {
+	return enumGoString(AllDelegateAccessTokenDestroyUserErrorCode, enumConstants["DelegateAccessTokenDestroyUserErrorCode"], e, "model.DelegateAccessTokenDestroyUserErrorCode")
+}
+
+func (e DelegateAccessTokenDestroyUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DelegateAccessTokenDestroyUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DelegateAccessTokenDestroyUserErrorCode")
+}
+
+func (e *DelegateAccessTokenDestroyUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DelegateAccessTokenDestroyUserErrorCode")
+}
+
+// LookupDeletionEventSortKeys returns the value of AllDeletionEventSortKeys named name.
+func LookupDeletionEventSortKeys(name string) (DeletionEventSortKeys, bool) {
+	return lookupEnum(AllDeletionEventSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllDeletionEventSortKeys, the values declared by the schema the models were
+// generated from.
+func (e DeletionEventSortKeys) IsKnown() bool {
+	return slices.Contains(AllDeletionEventSortKeys, e)
+}
+
+func (e DeletionEventSortKeys) GoString() string {
+	return enumGoString(AllDeletionEventSortKeys, enumConstants["DeletionEventSortKeys"], e, "model.DeletionEventSortKeys")
+}
+
+func (e DeletionEventSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeletionEventSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeletionEventSortKeys")
+}
+
+func (e *DeletionEventSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeletionEventSortKeys")
+}
+
+// LookupDeletionEventSubjectType returns the value of AllDeletionEventSubjectType named name.
+func LookupDeletionEventSubjectType(name string) (DeletionEventSubjectType, bool) {
+	return lookupEnum(AllDeletionEventSubjectType, name)
+}
+
+// IsKnown reports whether e is one of AllDeletionEventSubjectType, the values declared by the schema the models were
+// generated from.
+func (e DeletionEventSubjectType) IsKnown() bool {
+	return slices.Contains(AllDeletionEventSubjectType, e)
+}
+
+func (e DeletionEventSubjectType) GoString() string {
+	return enumGoString(AllDeletionEventSubjectType, enumConstants["DeletionEventSubjectType"], e, "model.DeletionEventSubjectType")
+}
+
+func (e DeletionEventSubjectType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeletionEventSubjectType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeletionEventSubjectType")
+}
+
+func (e *DeletionEventSubjectType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeletionEventSubjectType")
+}
+
+// LookupDeliveryConditionField returns the value of AllDeliveryConditionField named name.
+func LookupDeliveryConditionField(name string) (DeliveryConditionField, bool) {
+	return lookupEnum(AllDeliveryConditionField, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryConditionField, the values declared by the schema the models were
+// generated from.
+func (e DeliveryConditionField) IsKnown() bool {
+	return slices.Contains(AllDeliveryConditionField, e)
+}
+
+func (e DeliveryConditionField) GoString() string {
+	return enumGoString(AllDeliveryConditionField, enumConstants["DeliveryConditionField"], e, "model.DeliveryConditionField")
+}
+
+func (e DeliveryConditionField) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryConditionField) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryConditionField")
+}
+
+func (e *DeliveryConditionField) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryConditionField")
+}
+
+// LookupDeliveryConditionOperator returns the value of AllDeliveryConditionOperator named name.
+func LookupDeliveryConditionOperator(name string) (DeliveryConditionOperator, bool) {
+	return lookupEnum(AllDeliveryConditionOperator, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryConditionOperator, the values declared by the schema the models were
+// generated from.
+func (e DeliveryConditionOperator) IsKnown() bool {
+	return slices.Contains(AllDeliveryConditionOperator, e)
+}
+
+func (e DeliveryConditionOperator) GoString() string {
+	return enumGoString(AllDeliveryConditionOperator, enumConstants["DeliveryConditionOperator"], e, "model.DeliveryConditionOperator")
+}
+
+func (e DeliveryConditionOperator) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryConditionOperator) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryConditionOperator")
+}
+
+func (e *DeliveryConditionOperator) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryConditionOperator")
+}
+
+// LookupDeliveryCustomizationErrorCode returns the value of AllDeliveryCustomizationErrorCode named name.
+func LookupDeliveryCustomizationErrorCode(name string) (DeliveryCustomizationErrorCode, bool) {
+	return lookupEnum(AllDeliveryCustomizationErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryCustomizationErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DeliveryCustomizationErrorCode) IsKnown() bool {
+	return slices.Contains(AllDeliveryCustomizationErrorCode, e)
+}
+
+func (e DeliveryCustomizationErrorCode) GoString() string {
+	return enumGoString(AllDeliveryCustomizationErrorCode, enumConstants["DeliveryCustomizationErrorCode"], e, "model.DeliveryCustomizationErrorCode")
+}
+
+func (e DeliveryCustomizationErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryCustomizationErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryCustomizationErrorCode")
+}
+
+func (e *DeliveryCustomizationErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryCustomizationErrorCode")
+}
+
+// LookupDeliveryLegacyModeBlockedReason returns the value of AllDeliveryLegacyModeBlockedReason named name.
+func LookupDeliveryLegacyModeBlockedReason(name string) (DeliveryLegacyModeBlockedReason, bool) {
+	return lookupEnum(AllDeliveryLegacyModeBlockedReason, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryLegacyModeBlockedReason, the values declared by the schema the models were
+// generated from.
+func (e DeliveryLegacyModeBlockedReason) IsKnown() bool {
+	return slices.Contains(AllDeliveryLegacyModeBlockedReason, e)
+}
+
+func (e DeliveryLegacyModeBlockedReason) GoString() string {
+	return enumGoString(AllDeliveryLegacyModeBlockedReason, enumConstants["DeliveryLegacyModeBlockedReason"], e, "model.DeliveryLegacyModeBlockedReason")
+}
+
+func (e DeliveryLegacyModeBlockedReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryLegacyModeBlockedReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryLegacyModeBlockedReason")
+}
+
+func (e *DeliveryLegacyModeBlockedReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryLegacyModeBlockedReason")
+}
+
+// LookupDeliveryLocalPickupTime returns the value of AllDeliveryLocalPickupTime named name.
+func LookupDeliveryLocalPickupTime(name string) (DeliveryLocalPickupTime, bool) {
+	return lookupEnum(AllDeliveryLocalPickupTime, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryLocalPickupTime, the values declared by the schema the models were
+// generated from.
+func (e DeliveryLocalPickupTime) IsKnown() bool {
+	return slices.Contains(AllDeliveryLocalPickupTime, e)
+}
+
+func (e DeliveryLocalPickupTime) GoString() string {
+	return enumGoString(AllDeliveryLocalPickupTime, enumConstants["DeliveryLocalPickupTime"], e, "model.DeliveryLocalPickupTime")
+}
+
+func (e DeliveryLocalPickupTime) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryLocalPickupTime) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryLocalPickupTime")
+}
+
+func (e *DeliveryLocalPickupTime) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryLocalPickupTime")
+}
+
+// LookupDeliveryLocationLocalPickupSettingsErrorCode returns the value of AllDeliveryLocationLocalPickupSettingsErrorCode named name.
+func LookupDeliveryLocationLocalPickupSettingsErrorCode(name string) (DeliveryLocationLocalPickupSettingsErrorCode, bool) {
+	return lookupEnum(AllDeliveryLocationLocalPickupSettingsErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryLocationLocalPickupSettingsErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DeliveryLocationLocalPickupSettingsErrorCode) IsKnown() bool {
+	return slices.Contains(AllDeliveryLocationLocalPickupSettingsErrorCode, e)
+}
+
+func (e DeliveryLocationLocalPickupSettingsErrorCode) GoString() string {
+	return enumGoString(AllDeliveryLocationLocalPickupSettingsErrorCode, enumConstants["DeliveryLocationLocalPickupSettingsErrorCode"], e, "model.DeliveryLocationLocalPickupSettingsErrorCode")
+}
+
+func (e DeliveryLocationLocalPickupSettingsErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryLocationLocalPickupSettingsErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryLocationLocalPickupSettingsErrorCode")
+}
+
+func (e *DeliveryLocationLocalPickupSettingsErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryLocationLocalPickupSettingsErrorCode")
+}
+
+// LookupDeliveryMethodDefinitionType returns the value of AllDeliveryMethodDefinitionType named name.
+func LookupDeliveryMethodDefinitionType(name string) (DeliveryMethodDefinitionType, bool) {
+	return lookupEnum(AllDeliveryMethodDefinitionType, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryMethodDefinitionType, the values declared by the schema the models were
+// generated from.
+func (e DeliveryMethodDefinitionType) IsKnown() bool {
+	return slices.Contains(AllDeliveryMethodDefinitionType, e)
+}
+
+func (e DeliveryMethodDefinitionType) GoString() string {
+	return enumGoString(AllDeliveryMethodDefinitionType, enumConstants["DeliveryMethodDefinitionType"], e, "model.DeliveryMethodDefinitionType")
+}
+
+func (e DeliveryMethodDefinitionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryMethodDefinitionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryMethodDefinitionType")
+}
+
+func (e *DeliveryMethodDefinitionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryMethodDefinitionType")
+}
+
+// LookupDeliveryMethodType returns the value of AllDeliveryMethodType named name.
+func LookupDeliveryMethodType(name string) (DeliveryMethodType, bool) {
+	return lookupEnum(AllDeliveryMethodType, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryMethodType, the values declared by the schema the models were
+// generated from.
+func (e DeliveryMethodType) IsKnown() bool {
+	return slices.Contains(AllDeliveryMethodType, e)
+}
+
+func (e DeliveryMethodType) GoString() string {
+	return enumGoString(AllDeliveryMethodType, enumConstants["DeliveryMethodType"], e, "model.DeliveryMethodType")
+}
+
+func (e DeliveryMethodType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryMethodType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryMethodType")
+}
+
+func (e *DeliveryMethodType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryMethodType")
+}
+
+// LookupDeliveryPromiseProviderUpsertUserErrorCode returns the value of AllDeliveryPromiseProviderUpsertUserErrorCode named name.
+func LookupDeliveryPromiseProviderUpsertUserErrorCode(name string) (DeliveryPromiseProviderUpsertUserErrorCode, bool) {
+	return lookupEnum(AllDeliveryPromiseProviderUpsertUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDeliveryPromiseProviderUpsertUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DeliveryPromiseProviderUpsertUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllDeliveryPromiseProviderUpsertUserErrorCode, e)
+}
+
+func (e DeliveryPromiseProviderUpsertUserErrorCode) GoString() string {
+	return enumGoString(AllDeliveryPromiseProviderUpsertUserErrorCode, enumConstants["DeliveryPromiseProviderUpsertUserErrorCode"], e, "model.DeliveryPromiseProviderUpsertUserErrorCode")
+}
+
+func (e DeliveryPromiseProviderUpsertUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DeliveryPromiseProviderUpsertUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DeliveryPromiseProviderUpsertUserErrorCode")
+}
+
+func (e *DeliveryPromiseProviderUpsertUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DeliveryPromiseProviderUpsertUserErrorCode")
+}
+
+// LookupDigitalWallet returns the value of AllDigitalWallet named name.
+func LookupDigitalWallet(name string) (DigitalWallet, bool) {
+	return lookupEnum(AllDigitalWallet, name)
+}
+
+// IsKnown reports whether e is one of AllDigitalWallet, the values declared by the schema the models were
+// generated from.
+func (e DigitalWallet) IsKnown() bool {
+	return slices.Contains(AllDigitalWallet, e)
+}
+
+func (e DigitalWallet) GoString() string {
+	return enumGoString(AllDigitalWallet, enumConstants["DigitalWallet"], e, "model.DigitalWallet")
+}
+
+func (e DigitalWallet) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DigitalWallet) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DigitalWallet")
+}
+
+func (e *DigitalWallet) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DigitalWallet")
+}
+
+// LookupDiscountApplicationAllocationMethod returns the value of AllDiscountApplicationAllocationMethod named name.
+func LookupDiscountApplicationAllocationMethod(name string) (DiscountApplicationAllocationMethod, bool) {
+	return lookupEnum(AllDiscountApplicationAllocationMethod, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountApplicationAllocationMethod, the values declared by the schema the models were
+// generated from.
+func (e DiscountApplicationAllocationMethod) IsKnown() bool {
+	return slices.Contains(AllDiscountApplicationAllocationMethod, e)
+}
+
+func (e DiscountApplicationAllocationMethod) GoString() string {
+	return enumGoString(AllDiscountApplicationAllocationMethod, enumConstants["DiscountApplicationAllocationMethod"], e, "model.DiscountApplicationAllocationMethod")
+}
+
+func (e DiscountApplicationAllocationMethod) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountApplicationAllocationMethod) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountApplicationAllocationMethod")
+}
+
+func (e *DiscountApplicationAllocationMethod) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountApplicationAllocationMethod")
+}
+
+// LookupDiscountApplicationLevel returns the value of AllDiscountApplicationLevel named name.
+func LookupDiscountApplicationLevel(name string) (DiscountApplicationLevel, bool) {
+	return lookupEnum(AllDiscountApplicationLevel, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountApplicationLevel, the values declared by the schema the models were
+// generated from.
+func (e DiscountApplicationLevel) IsKnown() bool {
+	return slices.Contains(AllDiscountApplicationLevel, e)
+}
+
+func (e DiscountApplicationLevel) GoString() string {
+	return enumGoString(AllDiscountApplicationLevel, enumConstants["DiscountApplicationLevel"], e, "model.DiscountApplicationLevel")
+}
+
+func (e DiscountApplicationLevel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountApplicationLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountApplicationLevel")
+}
+
+func (e *DiscountApplicationLevel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountApplicationLevel")
+}
+
+// LookupDiscountApplicationTargetSelection returns the value of AllDiscountApplicationTargetSelection named name.
+func LookupDiscountApplicationTargetSelection(name string) (DiscountApplicationTargetSelection, bool) {
+	return lookupEnum(AllDiscountApplicationTargetSelection, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountApplicationTargetSelection, the values declared by the schema the models were
+// generated from.
+func (e DiscountApplicationTargetSelection) IsKnown() bool {
+	return slices.Contains(AllDiscountApplicationTargetSelection, e)
+}
+
+func (e DiscountApplicationTargetSelection) GoString() string {
+	return enumGoString(AllDiscountApplicationTargetSelection, enumConstants["DiscountApplicationTargetSelection"], e, "model.DiscountApplicationTargetSelection")
+}
+
+func (e DiscountApplicationTargetSelection) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountApplicationTargetSelection) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountApplicationTargetSelection")
+}
+
+func (e *DiscountApplicationTargetSelection) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountApplicationTargetSelection")
+}
+
+// LookupDiscountApplicationTargetType returns the value of AllDiscountApplicationTargetType named name.
+func LookupDiscountApplicationTargetType(name string) (DiscountApplicationTargetType, bool) {
+	return lookupEnum(AllDiscountApplicationTargetType, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountApplicationTargetType, the values declared by the schema the models were
+// generated from.
+func (e DiscountApplicationTargetType) IsKnown() bool {
+	return slices.Contains(AllDiscountApplicationTargetType, e)
+}
+
+func (e DiscountApplicationTargetType) GoString() string {
+	return enumGoString(AllDiscountApplicationTargetType, enumConstants["DiscountApplicationTargetType"], e, "model.DiscountApplicationTargetType")
+}
+
+func (e DiscountApplicationTargetType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountApplicationTargetType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountApplicationTargetType")
+}
+
+func (e *DiscountApplicationTargetType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountApplicationTargetType")
+}
+
+// LookupDiscountClass returns the value of AllDiscountClass named name.
+func LookupDiscountClass(name string) (DiscountClass, bool) {
+	return lookupEnum(AllDiscountClass, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountClass, the values declared by the schema the models were
+// generated from.
+func (e DiscountClass) IsKnown() bool {
+	return slices.Contains(AllDiscountClass, e)
+}
+
+func (e DiscountClass) GoString() string {
+	return enumGoString(AllDiscountClass, enumConstants["DiscountClass"], e, "model.DiscountClass")
+}
+
+func (e DiscountClass) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountClass) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountClass")
+}
+
+func (e *DiscountClass) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountClass")
+}
+
+// LookupDiscountCodeSortKeys returns the value of AllDiscountCodeSortKeys named name.
+func LookupDiscountCodeSortKeys(name string) (DiscountCodeSortKeys, bool) {
+	return lookupEnum(AllDiscountCodeSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountCodeSortKeys, the values declared by the schema the models were
+// generated from.
+func (e DiscountCodeSortKeys) IsKnown() bool {
+	return slices.Contains(AllDiscountCodeSortKeys, e)
+}
+
+func (e DiscountCodeSortKeys) GoString() string {
+	return enumGoString(AllDiscountCodeSortKeys, enumConstants["DiscountCodeSortKeys"], e, "model.DiscountCodeSortKeys")
+}
+
+func (e DiscountCodeSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountCodeSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountCodeSortKeys")
+}
+
+func (e *DiscountCodeSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountCodeSortKeys")
+}
+
+// LookupDiscountErrorCode returns the value of AllDiscountErrorCode named name.
+func LookupDiscountErrorCode(name string) (DiscountErrorCode, bool) {
+	return lookupEnum(AllDiscountErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DiscountErrorCode) IsKnown() bool {
+	return slices.Contains(AllDiscountErrorCode, e)
+}
+
+func (e DiscountErrorCode) GoString() string {
+	return enumGoString(AllDiscountErrorCode, enumConstants["DiscountErrorCode"], e, "model.DiscountErrorCode")
+}
+
+func (e DiscountErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountErrorCode")
+}
+
+func (e *DiscountErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountErrorCode")
+}
+
+// LookupDiscountShareableURLTargetType returns the value of AllDiscountShareableURLTargetType named name.
+func LookupDiscountShareableURLTargetType(name string) (DiscountShareableURLTargetType, bool) {
+	return lookupEnum(AllDiscountShareableURLTargetType, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountShareableURLTargetType, the values declared by the schema the models were
+// generated from.
+func (e DiscountShareableURLTargetType) IsKnown() bool {
+	return slices.Contains(AllDiscountShareableURLTargetType, e)
+}
+
+func (e DiscountShareableURLTargetType) GoString() string {
+	return enumGoString(AllDiscountShareableURLTargetType, enumConstants["DiscountShareableURLTargetType"], e, "model.DiscountShareableURLTargetType")
+}
+
+func (e DiscountShareableURLTargetType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountShareableURLTargetType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountShareableURLTargetType")
+}
+
+func (e *DiscountShareableURLTargetType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountShareableURLTargetType")
+}
+
+// LookupDiscountSortKeys returns the value of AllDiscountSortKeys named name.
+func LookupDiscountSortKeys(name string) (DiscountSortKeys, bool) {
+	return lookupEnum(AllDiscountSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountSortKeys, the values declared by the schema the models were
+// generated from.
+func (e DiscountSortKeys) IsKnown() bool {
+	return slices.Contains(AllDiscountSortKeys, e)
+}
+
+func (e DiscountSortKeys) GoString() string {
+	return enumGoString(AllDiscountSortKeys, enumConstants["DiscountSortKeys"], e, "model.DiscountSortKeys")
+}
+
+func (e DiscountSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountSortKeys")
+}
+
+func (e *DiscountSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountSortKeys")
+}
+
+// LookupDiscountStatus returns the value of AllDiscountStatus named name.
+func LookupDiscountStatus(name string) (DiscountStatus, bool) {
+	return lookupEnum(AllDiscountStatus, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountStatus, the values declared by the schema the models were
+// generated from.
+func (e DiscountStatus) IsKnown() bool {
+	return slices.Contains(AllDiscountStatus, e)
+}
+
+func (e DiscountStatus) GoString() string {
+	return enumGoString(AllDiscountStatus, enumConstants["DiscountStatus"], e, "model.DiscountStatus")
+}
+
+func (e DiscountStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountStatus")
+}
+
+func (e *DiscountStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountStatus")
+}
+
+// LookupDiscountTargetType returns the value of AllDiscountTargetType named name.
+func LookupDiscountTargetType(name string) (DiscountTargetType, bool) {
+	return lookupEnum(AllDiscountTargetType, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountTargetType, the values declared by the schema the models were
+// generated from.
+func (e DiscountTargetType) IsKnown() bool {
+	return slices.Contains(AllDiscountTargetType, e)
+}
+
+func (e DiscountTargetType) GoString() string {
+	return enumGoString(AllDiscountTargetType, enumConstants["DiscountTargetType"], e, "model.DiscountTargetType")
+}
+
+func (e DiscountTargetType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountTargetType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountTargetType")
+}
+
+func (e *DiscountTargetType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountTargetType")
+}
+
+// LookupDiscountType returns the value of AllDiscountType named name.
+func LookupDiscountType(name string) (DiscountType, bool) {
+	return lookupEnum(AllDiscountType, name)
+}
+
+// IsKnown reports whether e is one of AllDiscountType, the values declared by the schema the models were
+// generated from.
+func (e DiscountType) IsKnown() bool {
+	return slices.Contains(AllDiscountType, e)
+}
+
+func (e DiscountType) GoString() string {
+	return enumGoString(AllDiscountType, enumConstants["DiscountType"], e, "model.DiscountType")
+}
+
+func (e DiscountType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DiscountType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DiscountType")
+}
+
+func (e *DiscountType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DiscountType")
+}
+
+// LookupDisputeEvidenceUpdateUserErrorCode returns the value of AllDisputeEvidenceUpdateUserErrorCode named name.
+func LookupDisputeEvidenceUpdateUserErrorCode(name string) (DisputeEvidenceUpdateUserErrorCode, bool) {
+	return lookupEnum(AllDisputeEvidenceUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllDisputeEvidenceUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e DisputeEvidenceUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllDisputeEvidenceUpdateUserErrorCode, e)
+}
+
+func (e DisputeEvidenceUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllDisputeEvidenceUpdateUserErrorCode, enumConstants["DisputeEvidenceUpdateUserErrorCode"], e, "model.DisputeEvidenceUpdateUserErrorCode")
+}
+
+func (e DisputeEvidenceUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DisputeEvidenceUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DisputeEvidenceUpdateUserErrorCode")
+}
+
+func (e *DisputeEvidenceUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DisputeEvidenceUpdateUserErrorCode")
+}
+
+// LookupDisputeStatus returns the value of AllDisputeStatus named name.
+func LookupDisputeStatus(name string) (DisputeStatus, bool) {
+	return lookupEnum(AllDisputeStatus, name)
+}
+
+// IsKnown reports whether e is one of AllDisputeStatus, the values declared by the schema the models were
+// generated from.
+func (e DisputeStatus) IsKnown() bool {
+	return slices.Contains(AllDisputeStatus, e)
+}
+
+func (e DisputeStatus) GoString() string {
+	return enumGoString(AllDisputeStatus, enumConstants["DisputeStatus"], e, "model.DisputeStatus")
+}
+
+func (e DisputeStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DisputeStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DisputeStatus")
+}
+
+func (e *DisputeStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DisputeStatus")
+}
+
+// LookupDisputeType returns the value of AllDisputeType named name.
+func LookupDisputeType(name string) (DisputeType, bool) {
+	return lookupEnum(AllDisputeType, name)
+}
+
+// IsKnown reports whether e is one of AllDisputeType, the values declared by the schema the models were
+// generated from.
+func (e DisputeType) IsKnown() bool {
+	return slices.Contains(AllDisputeType, e)
+}
+
+func (e DisputeType) GoString() string {
+	return enumGoString(AllDisputeType, enumConstants["DisputeType"], e, "model.DisputeType")
+}
+
+func (e DisputeType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DisputeType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DisputeType")
+}
+
+func (e *DisputeType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DisputeType")
+}
+
+// LookupDraftOrderAppliedDiscountType returns the value of AllDraftOrderAppliedDiscountType named name.
+func LookupDraftOrderAppliedDiscountType(name string) (DraftOrderAppliedDiscountType, bool) {
+	return lookupEnum(AllDraftOrderAppliedDiscountType, name)
+}
+
+// IsKnown reports whether e is one of AllDraftOrderAppliedDiscountType, the values declared by the schema the models were
+// generated from.
+func (e DraftOrderAppliedDiscountType) IsKnown() bool {
+	return slices.Contains(AllDraftOrderAppliedDiscountType, e)
+}
+
+func (e DraftOrderAppliedDiscountType) GoString() string {
+	return enumGoString(AllDraftOrderAppliedDiscountType, enumConstants["DraftOrderAppliedDiscountType"], e, "model.DraftOrderAppliedDiscountType")
+}
+
+func (e DraftOrderAppliedDiscountType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DraftOrderAppliedDiscountType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DraftOrderAppliedDiscountType")
+}
+
+func (e *DraftOrderAppliedDiscountType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DraftOrderAppliedDiscountType")
+}
+
+// LookupDraftOrderSortKeys returns the value of AllDraftOrderSortKeys named name.
+func LookupDraftOrderSortKeys(name string) (DraftOrderSortKeys, bool) {
+	return lookupEnum(AllDraftOrderSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllDraftOrderSortKeys, the values declared by the schema the models were
+// generated from.
+func (e DraftOrderSortKeys) IsKnown() bool {
+	return slices.Contains(AllDraftOrderSortKeys, e)
+}
+
+func (e DraftOrderSortKeys) GoString() string {
+	return enumGoString(AllDraftOrderSortKeys, enumConstants["DraftOrderSortKeys"], e, "model.DraftOrderSortKeys")
+}
+
+func (e DraftOrderSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DraftOrderSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DraftOrderSortKeys")
+}
+
+func (e *DraftOrderSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DraftOrderSortKeys")
+}
+
+// LookupDraftOrderStatus returns the value of AllDraftOrderStatus named name.
+func LookupDraftOrderStatus(name string) (DraftOrderStatus, bool) {
+	return lookupEnum(AllDraftOrderStatus, name)
+}
+
+// IsKnown reports whether e is one of AllDraftOrderStatus, the values declared by the schema the models were
+// generated from.
+func (e DraftOrderStatus) IsKnown() bool {
+	return slices.Contains(AllDraftOrderStatus, e)
+}
+
+func (e DraftOrderStatus) GoString() string {
+	return enumGoString(AllDraftOrderStatus, enumConstants["DraftOrderStatus"], e, "model.DraftOrderStatus")
+}
+
+func (e DraftOrderStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *DraftOrderStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "DraftOrderStatus")
+}
+
+func (e *DraftOrderStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "DraftOrderStatus")
+}
+
+// LookupErrorsServerPixelUserErrorCode returns the value of AllErrorsServerPixelUserErrorCode named name.
+func LookupErrorsServerPixelUserErrorCode(name string) (ErrorsServerPixelUserErrorCode, bool) {
+	return lookupEnum(AllErrorsServerPixelUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllErrorsServerPixelUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ErrorsServerPixelUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllErrorsServerPixelUserErrorCode, e)
+}
+
+func (e ErrorsServerPixelUserErrorCode) GoString() string {
+	return enumGoString(AllErrorsServerPixelUserErrorCode, enumConstants["ErrorsServerPixelUserErrorCode"], e, "model.ErrorsServerPixelUserErrorCode")
+}
+
+func (e ErrorsServerPixelUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ErrorsServerPixelUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ErrorsServerPixelUserErrorCode")
+}
+
+func (e *ErrorsServerPixelUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ErrorsServerPixelUserErrorCode")
+}
+
+// LookupErrorsWebPixelUserErrorCode returns the value of AllErrorsWebPixelUserErrorCode named name.
+func LookupErrorsWebPixelUserErrorCode(name string) (ErrorsWebPixelUserErrorCode, bool) {
+	return lookupEnum(AllErrorsWebPixelUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllErrorsWebPixelUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ErrorsWebPixelUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllErrorsWebPixelUserErrorCode, e)
+}
+
+func (e ErrorsWebPixelUserErrorCode) GoString() string {
+	return enumGoString(AllErrorsWebPixelUserErrorCode, enumConstants["ErrorsWebPixelUserErrorCode"], e, "model.ErrorsWebPixelUserErrorCode")
+}
+
+func (e ErrorsWebPixelUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ErrorsWebPixelUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ErrorsWebPixelUserErrorCode")
+}
+
+func (e *ErrorsWebPixelUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ErrorsWebPixelUserErrorCode")
+}
+
+// LookupEventSortKeys returns the value of AllEventSortKeys named name.
+func LookupEventSortKeys(name string) (EventSortKeys, bool) {
+	return lookupEnum(AllEventSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllEventSortKeys, the values declared by the schema the models were
+// generated from.
+func (e EventSortKeys) IsKnown() bool {
+	return slices.Contains(AllEventSortKeys, e)
+}
+
+func (e EventSortKeys) GoString() string {
+	return enumGoString(AllEventSortKeys, enumConstants["EventSortKeys"], e, "model.EventSortKeys")
+}
+
+func (e EventSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *EventSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "EventSortKeys")
+}
+
+func (e *EventSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "EventSortKeys")
+}
+
+// LookupEventSubjectType returns the value of AllEventSubjectType named name.
+func LookupEventSubjectType(name string) (EventSubjectType, bool) {
+	return lookupEnum(AllEventSubjectType, name)
+}
+
+// IsKnown reports whether e is one of AllEventSubjectType, the values declared by the schema the models were
+// generated from.
+func (e EventSubjectType) IsKnown() bool {
+	return slices.Contains(AllEventSubjectType, e)
+}
+
+func (e EventSubjectType) GoString() string {
+	return enumGoString(AllEventSubjectType, enumConstants["EventSubjectType"], e, "model.EventSubjectType")
+}
+
+func (e EventSubjectType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *EventSubjectType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "EventSubjectType")
+}
+
+func (e *EventSubjectType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "EventSubjectType")
+}
+
+// LookupFileContentType returns the value of AllFileContentType named name.
+func LookupFileContentType(name string) (FileContentType, bool) {
+	return lookupEnum(AllFileContentType, name)
+}
+
+// IsKnown reports whether e is one of AllFileContentType, the values declared by the schema the models were
+// generated from.
+func (e FileContentType) IsKnown() bool {
+	return slices.Contains(AllFileContentType, e)
+}
+
+func (e FileContentType) GoString() string {
+	return enumGoString(AllFileContentType, enumConstants["FileContentType"], e, "model.FileContentType")
+}
+
+func (e FileContentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FileContentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FileContentType")
+}
+
+func (e *FileContentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FileContentType")
+}
+
+// LookupFileCreateInputDuplicateResolutionMode returns the value of AllFileCreateInputDuplicateResolutionMode named name.
+func LookupFileCreateInputDuplicateResolutionMode(name string) (FileCreateInputDuplicateResolutionMode, bool) {
+	return lookupEnum(AllFileCreateInputDuplicateResolutionMode, name)
+}
+
+// IsKnown reports whether e is one of AllFileCreateInputDuplicateResolutionMode, the values declared by the schema the models were
+// generated from.
+func (e FileCreateInputDuplicateResolutionMode) IsKnown() bool {
+	return slices.Contains(AllFileCreateInputDuplicateResolutionMode, e)
+}
+
+func (e FileCreateInputDuplicateResolutionMode) GoString() string {
+	return enumGoString(AllFileCreateInputDuplicateResolutionMode, enumConstants["FileCreateInputDuplicateResolutionMode"], e, "model.FileCreateInputDuplicateResolutionMode")
+}
+
+func (e FileCreateInputDuplicateResolutionMode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FileCreateInputDuplicateResolutionMode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FileCreateInputDuplicateResolutionMode")
+}
+
+func (e *FileCreateInputDuplicateResolutionMode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FileCreateInputDuplicateResolutionMode")
+}
+
+// LookupFileErrorCode returns the value of AllFileErrorCode named name.
+func LookupFileErrorCode(name string) (FileErrorCode, bool) {
+	return lookupEnum(AllFileErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFileErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FileErrorCode) IsKnown() bool {
+	return slices.Contains(AllFileErrorCode, e)
+}
+
+func (e FileErrorCode) GoString() string {
+	return enumGoString(AllFileErrorCode, enumConstants["FileErrorCode"], e, "model.FileErrorCode")
+}
+
+func (e FileErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FileErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FileErrorCode")
+}
+
+func (e *FileErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FileErrorCode")
+}
+
+// LookupFileSortKeys returns the value of AllFileSortKeys named name.
+func LookupFileSortKeys(name string) (FileSortKeys, bool) {
+	return lookupEnum(AllFileSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllFileSortKeys, the values declared by the schema the models were
+// generated from.
+func (e FileSortKeys) IsKnown() bool {
+	return slices.Contains(AllFileSortKeys, e)
+}
+
+func (e FileSortKeys) GoString() string {
+	return enumGoString(AllFileSortKeys, enumConstants["FileSortKeys"], e, "model.FileSortKeys")
+}
+
+func (e FileSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FileSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FileSortKeys")
+}
+
+func (e *FileSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FileSortKeys")
+}
+
+// LookupFileStatus returns the value of AllFileStatus named name.
+func LookupFileStatus(name string) (FileStatus, bool) {
+	return lookupEnum(AllFileStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFileStatus, the values declared by the schema the models were
+// generated from.
+func (e FileStatus) IsKnown() bool {
+	return slices.Contains(AllFileStatus, e)
+}
+
+func (e FileStatus) GoString() string {
+	return enumGoString(AllFileStatus, enumConstants["FileStatus"], e, "model.FileStatus")
+}
+
+func (e FileStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FileStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FileStatus")
+}
+
+func (e *FileStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FileStatus")
+}
+
+// LookupFilesErrorCode returns the value of AllFilesErrorCode named name.
+func LookupFilesErrorCode(name string) (FilesErrorCode, bool) {
+	return lookupEnum(AllFilesErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFilesErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FilesErrorCode) IsKnown() bool {
+	return slices.Contains(AllFilesErrorCode, e)
+}
+
+func (e FilesErrorCode) GoString() string {
+	return enumGoString(AllFilesErrorCode, enumConstants["FilesErrorCode"], e, "model.FilesErrorCode")
+}
+
+func (e FilesErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FilesErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FilesErrorCode")
+}
+
+func (e *FilesErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FilesErrorCode")
+}
+
+// LookupFulfillmentConstraintRuleCreateUserErrorCode returns the value of AllFulfillmentConstraintRuleCreateUserErrorCode named name.
+func LookupFulfillmentConstraintRuleCreateUserErrorCode(name string) (FulfillmentConstraintRuleCreateUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentConstraintRuleCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentConstraintRuleCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentConstraintRuleCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentConstraintRuleCreateUserErrorCode, e)
+}
+
+func (e FulfillmentConstraintRuleCreateUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentConstraintRuleCreateUserErrorCode, enumConstants["FulfillmentConstraintRuleCreateUserErrorCode"], e, "model.FulfillmentConstraintRuleCreateUserErrorCode")
+}
+
+func (e FulfillmentConstraintRuleCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentConstraintRuleCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentConstraintRuleCreateUserErrorCode")
+}
+
+func (e *FulfillmentConstraintRuleCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentConstraintRuleCreateUserErrorCode")
+}
+
+// LookupFulfillmentConstraintRuleDeleteUserErrorCode returns the value of AllFulfillmentConstraintRuleDeleteUserErrorCode named name.
+func LookupFulfillmentConstraintRuleDeleteUserErrorCode(name string) (FulfillmentConstraintRuleDeleteUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentConstraintRuleDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentConstraintRuleDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentConstraintRuleDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentConstraintRuleDeleteUserErrorCode, e)
+}
+
+func (e FulfillmentConstraintRuleDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentConstraintRuleDeleteUserErrorCode, enumConstants["FulfillmentConstraintRuleDeleteUserErrorCode"], e, "model.FulfillmentConstraintRuleDeleteUserErrorCode")
+}
+
+func (e FulfillmentConstraintRuleDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentConstraintRuleDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentConstraintRuleDeleteUserErrorCode")
+}
+
+func (e *FulfillmentConstraintRuleDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentConstraintRuleDeleteUserErrorCode")
+}
+
+// LookupFulfillmentConstraintRuleUpdateUserErrorCode returns the value of AllFulfillmentConstraintRuleUpdateUserErrorCode named name.
+func LookupFulfillmentConstraintRuleUpdateUserErrorCode(name string) (FulfillmentConstraintRuleUpdateUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentConstraintRuleUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentConstraintRuleUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentConstraintRuleUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentConstraintRuleUpdateUserErrorCode, e)
+}
+
+func (e FulfillmentConstraintRuleUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentConstraintRuleUpdateUserErrorCode, enumConstants["FulfillmentConstraintRuleUpdateUserErrorCode"], e, "model.FulfillmentConstraintRuleUpdateUserErrorCode")
+}
+
+func (e FulfillmentConstraintRuleUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentConstraintRuleUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentConstraintRuleUpdateUserErrorCode")
+}
+
+func (e *FulfillmentConstraintRuleUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentConstraintRuleUpdateUserErrorCode")
+}
+
+// LookupFulfillmentDisplayStatus returns the value of AllFulfillmentDisplayStatus named name.
+func LookupFulfillmentDisplayStatus(name string) (FulfillmentDisplayStatus, bool) {
+	return lookupEnum(AllFulfillmentDisplayStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentDisplayStatus, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentDisplayStatus) IsKnown() bool {
+	return slices.Contains(AllFulfillmentDisplayStatus, e)
+}
+
+func (e FulfillmentDisplayStatus) GoString() string {
+	return enumGoString(AllFulfillmentDisplayStatus, enumConstants["FulfillmentDisplayStatus"], e, "model.FulfillmentDisplayStatus")
+}
+
+func (e FulfillmentDisplayStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentDisplayStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentDisplayStatus")
+}
+
+func (e *FulfillmentDisplayStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentDisplayStatus")
+}
+
+// LookupFulfillmentEventSortKeys returns the value of AllFulfillmentEventSortKeys named name.
+func LookupFulfillmentEventSortKeys(name string) (FulfillmentEventSortKeys, bool) {
+	return lookupEnum(AllFulfillmentEventSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentEventSortKeys, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentEventSortKeys) IsKnown() bool {
+	return slices.Contains(AllFulfillmentEventSortKeys, e)
+}
+
+func (e FulfillmentEventSortKeys) GoString() string {
+	return enumGoString(AllFulfillmentEventSortKeys, enumConstants["FulfillmentEventSortKeys"], e, "model.FulfillmentEventSortKeys")
+}
+
+func (e FulfillmentEventSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentEventSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentEventSortKeys")
+}
+
+func (e *FulfillmentEventSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentEventSortKeys")
+}
+
+// LookupFulfillmentEventStatus returns the value of AllFulfillmentEventStatus named name.
+func LookupFulfillmentEventStatus(name string) (FulfillmentEventStatus, bool) {
+	return lookupEnum(AllFulfillmentEventStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentEventStatus, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentEventStatus) IsKnown() bool {
+	return slices.Contains(AllFulfillmentEventStatus, e)
+}
+
+func (e FulfillmentEventStatus) GoString() string {
+	return enumGoString(AllFulfillmentEventStatus, enumConstants["FulfillmentEventStatus"], e, "model.FulfillmentEventStatus")
+}
+
+func (e FulfillmentEventStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentEventStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentEventStatus")
+}
+
+func (e *FulfillmentEventStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentEventStatus")
+}
+
+// LookupFulfillmentHoldReason returns the value of AllFulfillmentHoldReason named name.
+func LookupFulfillmentHoldReason(name string) (FulfillmentHoldReason, bool) {
+	return lookupEnum(AllFulfillmentHoldReason, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentHoldReason, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentHoldReason) IsKnown() bool {
+	return slices.Contains(AllFulfillmentHoldReason, e)
+}
+
+func (e FulfillmentHoldReason) GoString() string {
+	return enumGoString(AllFulfillmentHoldReason, enumConstants["FulfillmentHoldReason"], e, "model.FulfillmentHoldReason")
+}
+
+func (e FulfillmentHoldReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentHoldReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentHoldReason")
+}
+
+func (e *FulfillmentHoldReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentHoldReason")
+}
+
+// LookupFulfillmentOrderAction returns the value of AllFulfillmentOrderAction named name.
+func LookupFulfillmentOrderAction(name string) (FulfillmentOrderAction, bool) {
+	return lookupEnum(AllFulfillmentOrderAction, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderAction, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderAction) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderAction, e)
+}
+
+func (e FulfillmentOrderAction) GoString() string {
+	return enumGoString(AllFulfillmentOrderAction, enumConstants["FulfillmentOrderAction"], e, "model.FulfillmentOrderAction")
+}
+
+func (e FulfillmentOrderAction) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderAction) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderAction")
+}
+
+func (e *FulfillmentOrderAction) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderAction")
+}
+
+// LookupFulfillmentOrderAssignmentStatus returns the value of AllFulfillmentOrderAssignmentStatus named name.
+func LookupFulfillmentOrderAssignmentStatus(name string) (FulfillmentOrderAssignmentStatus, bool) {
+	return lookupEnum(AllFulfillmentOrderAssignmentStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderAssignmentStatus, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderAssignmentStatus) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderAssignmentStatus, e)
+}
+
+func (e FulfillmentOrderAssignmentStatus) GoString() string {
+	return enumGoString(AllFulfillmentOrderAssignmentStatus, enumConstants["FulfillmentOrderAssignmentStatus"], e, "model.FulfillmentOrderAssignmentStatus")
+}
+
+func (e FulfillmentOrderAssignmentStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderAssignmentStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderAssignmentStatus")
+}
+
+func (e *FulfillmentOrderAssignmentStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderAssignmentStatus")
+}
+
+// LookupFulfillmentOrderHoldUserErrorCode returns the value of AllFulfillmentOrderHoldUserErrorCode named name.
+func LookupFulfillmentOrderHoldUserErrorCode(name string) (FulfillmentOrderHoldUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrderHoldUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderHoldUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderHoldUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderHoldUserErrorCode, e)
+}
+
+func (e FulfillmentOrderHoldUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrderHoldUserErrorCode, enumConstants["FulfillmentOrderHoldUserErrorCode"], e, "model.FulfillmentOrderHoldUserErrorCode")
+}
+
+func (e FulfillmentOrderHoldUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderHoldUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderHoldUserErrorCode")
+}
+
+func (e *FulfillmentOrderHoldUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderHoldUserErrorCode")
+}
+
+// LookupFulfillmentOrderLineItemsPreparedForPickupUserErrorCode returns the value of AllFulfillmentOrderLineItemsPreparedForPickupUserErrorCode named name.
+func LookupFulfillmentOrderLineItemsPreparedForPickupUserErrorCode(name string) (FulfillmentOrderLineItemsPreparedForPickupUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrderLineItemsPreparedForPickupUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderLineItemsPreparedForPickupUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderLineItemsPreparedForPickupUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderLineItemsPreparedForPickupUserErrorCode, e)
+}
+
+func (e FulfillmentOrderLineItemsPreparedForPickupUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrderLineItemsPreparedForPickupUserErrorCode, enumConstants["FulfillmentOrderLineItemsPreparedForPickupUserErrorCode"], e, "model.FulfillmentOrderLineItemsPreparedForPickupUserErrorCode")
+}
+
+func (e FulfillmentOrderLineItemsPreparedForPickupUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderLineItemsPreparedForPickupUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderLineItemsPreparedForPickupUserErrorCode")
+}
+
+func (e *FulfillmentOrderLineItemsPreparedForPickupUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderLineItemsPreparedForPickupUserErrorCode")
+}
+
+// LookupFulfillmentOrderMerchantRequestKind returns the value of AllFulfillmentOrderMerchantRequestKind named name.
+func LookupFulfillmentOrderMerchantRequestKind(name string) (FulfillmentOrderMerchantRequestKind, bool) {
+	return lookupEnum(AllFulfillmentOrderMerchantRequestKind, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderMerchantRequestKind, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderMerchantRequestKind) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderMerchantRequestKind, e)
+}
+
+func (e FulfillmentOrderMerchantRequestKind) GoString() string {
+	return enumGoString(AllFulfillmentOrderMerchantRequestKind, enumConstants["FulfillmentOrderMerchantRequestKind"], e, "model.FulfillmentOrderMerchantRequestKind")
+}
+
+func (e FulfillmentOrderMerchantRequestKind) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderMerchantRequestKind) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderMerchantRequestKind")
+}
+
+func (e *FulfillmentOrderMerchantRequestKind) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderMerchantRequestKind")
+}
+
+// LookupFulfillmentOrderMergeUserErrorCode returns the value of AllFulfillmentOrderMergeUserErrorCode named name.
+func LookupFulfillmentOrderMergeUserErrorCode(name string) (FulfillmentOrderMergeUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrderMergeUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderMergeUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderMergeUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderMergeUserErrorCode, e)
+}
+
+func (e FulfillmentOrderMergeUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrderMergeUserErrorCode, enumConstants["FulfillmentOrderMergeUserErrorCode"], e, "model.FulfillmentOrderMergeUserErrorCode")
+}
+
+func (e FulfillmentOrderMergeUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderMergeUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderMergeUserErrorCode")
+}
+
+func (e *FulfillmentOrderMergeUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderMergeUserErrorCode")
+}
+
+// LookupFulfillmentOrderRejectionReason returns the value of AllFulfillmentOrderRejectionReason named name.
+func LookupFulfillmentOrderRejectionReason(name string) (FulfillmentOrderRejectionReason, bool) {
+	return lookupEnum(AllFulfillmentOrderRejectionReason, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderRejectionReason, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderRejectionReason) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderRejectionReason, e)
+}
+
+func (e FulfillmentOrderRejectionReason) GoString() string {
+	return enumGoString(AllFulfillmentOrderRejectionReason, enumConstants["FulfillmentOrderRejectionReason"], e, "model.FulfillmentOrderRejectionReason")
+}
+
+func (e FulfillmentOrderRejectionReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderRejectionReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderRejectionReason")
+}
+
+func (e *FulfillmentOrderRejectionReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderRejectionReason")
+}
+
+// LookupFulfillmentOrderReleaseHoldUserErrorCode returns the value of AllFulfillmentOrderReleaseHoldUserErrorCode named name.
+func LookupFulfillmentOrderReleaseHoldUserErrorCode(name string) (FulfillmentOrderReleaseHoldUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrderReleaseHoldUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderReleaseHoldUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderReleaseHoldUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderReleaseHoldUserErrorCode, e)
+}
+
+func (e FulfillmentOrderReleaseHoldUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrderReleaseHoldUserErrorCode, enumConstants["FulfillmentOrderReleaseHoldUserErrorCode"], e, "model.FulfillmentOrderReleaseHoldUserErrorCode")
+}
+
+func (e FulfillmentOrderReleaseHoldUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderReleaseHoldUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderReleaseHoldUserErrorCode")
+}
+
+func (e *FulfillmentOrderReleaseHoldUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderReleaseHoldUserErrorCode")
+}
+
+// LookupFulfillmentOrderRequestStatus returns the value of AllFulfillmentOrderRequestStatus named name.
+func LookupFulfillmentOrderRequestStatus(name string) (FulfillmentOrderRequestStatus, bool) {
+	return lookupEnum(AllFulfillmentOrderRequestStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderRequestStatus, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderRequestStatus) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderRequestStatus, e)
+}
+
+func (e FulfillmentOrderRequestStatus) GoString() string {
+	return enumGoString(AllFulfillmentOrderRequestStatus, enumConstants["FulfillmentOrderRequestStatus"], e, "model.FulfillmentOrderRequestStatus")
+}
+
+func (e FulfillmentOrderRequestStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderRequestStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderRequestStatus")
+}
+
+func (e *FulfillmentOrderRequestStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderRequestStatus")
+}
+
+// LookupFulfillmentOrderRescheduleUserErrorCode returns the value of AllFulfillmentOrderRescheduleUserErrorCode named name.
+func LookupFulfillmentOrderRescheduleUserErrorCode(name string) (FulfillmentOrderRescheduleUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrderRescheduleUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderRescheduleUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderRescheduleUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderRescheduleUserErrorCode, e)
+}
+
+func (e FulfillmentOrderRescheduleUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrderRescheduleUserErrorCode, enumConstants["FulfillmentOrderRescheduleUserErrorCode"], e, "model.FulfillmentOrderRescheduleUserErrorCode")
+}
+
+func (e FulfillmentOrderRescheduleUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderRescheduleUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderRescheduleUserErrorCode")
+}
+
+func (e *FulfillmentOrderRescheduleUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderRescheduleUserErrorCode")
+}
+
+// LookupFulfillmentOrderSortKeys returns the value of AllFulfillmentOrderSortKeys named name.
+func LookupFulfillmentOrderSortKeys(name string) (FulfillmentOrderSortKeys, bool) {
+	return lookupEnum(AllFulfillmentOrderSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderSortKeys, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderSortKeys) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderSortKeys, e)
+}
+
+func (e FulfillmentOrderSortKeys) GoString() string {
+	return enumGoString(AllFulfillmentOrderSortKeys, enumConstants["FulfillmentOrderSortKeys"], e, "model.FulfillmentOrderSortKeys")
+}
+
+func (e FulfillmentOrderSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderSortKeys")
+}
+
+func (e *FulfillmentOrderSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderSortKeys")
+}
+
+// LookupFulfillmentOrderSplitUserErrorCode returns the value of AllFulfillmentOrderSplitUserErrorCode named name.
+func LookupFulfillmentOrderSplitUserErrorCode(name string) (FulfillmentOrderSplitUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrderSplitUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderSplitUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderSplitUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderSplitUserErrorCode, e)
+}
+
+func (e FulfillmentOrderSplitUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrderSplitUserErrorCode, enumConstants["FulfillmentOrderSplitUserErrorCode"], e, "model.FulfillmentOrderSplitUserErrorCode")
+}
+
+func (e FulfillmentOrderSplitUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderSplitUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderSplitUserErrorCode")
+}
+
+func (e *FulfillmentOrderSplitUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderSplitUserErrorCode")
+}
+
+// LookupFulfillmentOrderStatus returns the value of AllFulfillmentOrderStatus named name.
+func LookupFulfillmentOrderStatus(name string) (FulfillmentOrderStatus, bool) {
+	return lookupEnum(AllFulfillmentOrderStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrderStatus, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrderStatus) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrderStatus, e)
+}
+
+func (e FulfillmentOrderStatus) GoString() string {
+	return enumGoString(AllFulfillmentOrderStatus, enumConstants["FulfillmentOrderStatus"], e, "model.FulfillmentOrderStatus")
+}
+
+func (e FulfillmentOrderStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrderStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrderStatus")
+}
+
+func (e *FulfillmentOrderStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrderStatus")
+}
+
+// LookupFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode returns the value of AllFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode named name.
+func LookupFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode(name string) (FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode, bool) {
+	return lookupEnum(AllFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode, e)
+}
+
+func (e FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode) GoString() string {
+	return enumGoString(AllFulfillmentOrdersSetFulfillmentDeadlineUserErrorCode, enumConstants["FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode"], e, "model.FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode")
+}
+
+func (e FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode")
+}
+
+func (e *FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode")
+}
+
+// LookupFulfillmentServiceDeleteInventoryAction returns the value of AllFulfillmentServiceDeleteInventoryAction named name.
+func LookupFulfillmentServiceDeleteInventoryAction(name string) (FulfillmentServiceDeleteInventoryAction, bool) {
+	return lookupEnum(AllFulfillmentServiceDeleteInventoryAction, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentServiceDeleteInventoryAction, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentServiceDeleteInventoryAction) IsKnown() bool {
+	return slices.Contains(AllFulfillmentServiceDeleteInventoryAction, e)
+}
+
+func (e FulfillmentServiceDeleteInventoryAction) GoString() string {
+	return enumGoString(AllFulfillmentServiceDeleteInventoryAction, enumConstants["FulfillmentServiceDeleteInventoryAction"], e, "model.FulfillmentServiceDeleteInventoryAction")
+}
+
+func (e FulfillmentServiceDeleteInventoryAction) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentServiceDeleteInventoryAction) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentServiceDeleteInventoryAction")
+}
+
+func (e *FulfillmentServiceDeleteInventoryAction) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentServiceDeleteInventoryAction")
+}
+
+// LookupFulfillmentServiceType returns the value of AllFulfillmentServiceType named name.
+func LookupFulfillmentServiceType(name string) (FulfillmentServiceType, bool) {
+	return lookupEnum(AllFulfillmentServiceType, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentServiceType, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentServiceType) IsKnown() bool {
+	return slices.Contains(AllFulfillmentServiceType, e)
+}
+
+func (e FulfillmentServiceType) GoString() string {
+	return enumGoString(AllFulfillmentServiceType, enumConstants["FulfillmentServiceType"], e, "model.FulfillmentServiceType")
+}
+
+func (e FulfillmentServiceType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentServiceType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentServiceType")
+}
+
+func (e *FulfillmentServiceType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentServiceType")
+}
+
+// LookupFulfillmentStatus returns the value of AllFulfillmentStatus named name.
+func LookupFulfillmentStatus(name string) (FulfillmentStatus, bool) {
+	return lookupEnum(AllFulfillmentStatus, name)
+}
+
+// IsKnown reports whether e is one of AllFulfillmentStatus, the values declared by the schema the models were
+// generated from.
+func (e FulfillmentStatus) IsKnown() bool {
+	return slices.Contains(AllFulfillmentStatus, e)
+}
+
+func (e FulfillmentStatus) GoString() string {
+	return enumGoString(AllFulfillmentStatus, enumConstants["FulfillmentStatus"], e, "model.FulfillmentStatus")
+}
+
+func (e FulfillmentStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *FulfillmentStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "FulfillmentStatus")
+}
+
+func (e *FulfillmentStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "FulfillmentStatus")
+}
+
+// LookupGiftCardDeactivateUserErrorCode returns the value of AllGiftCardDeactivateUserErrorCode named name.
+func LookupGiftCardDeactivateUserErrorCode(name string) (GiftCardDeactivateUserErrorCode, bool) {
+	return lookupEnum(AllGiftCardDeactivateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllGiftCardDeactivateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e GiftCardDeactivateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllGiftCardDeactivateUserErrorCode, e)
+}
+
+func (e GiftCardDeactivateUserErrorCode) GoString() string {
+	return enumGoString(AllGiftCardDeactivateUserErrorCode, enumConstants["GiftCardDeactivateUserErrorCode"], e, "model.GiftCardDeactivateUserErrorCode")
+}
+
+func (e GiftCardDeactivateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *GiftCardDeactivateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "GiftCardDeactivateUserErrorCode")
+}
+
+func (e *GiftCardDeactivateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "GiftCardDeactivateUserErrorCode")
+}
+
+// LookupGiftCardErrorCode returns the value of AllGiftCardErrorCode named name.
+func LookupGiftCardErrorCode(name string) (GiftCardErrorCode, bool) {
+	return lookupEnum(AllGiftCardErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllGiftCardErrorCode, the values declared by the schema the models were
+// generated from.
+func (e GiftCardErrorCode) IsKnown() bool {
+	return slices.Contains(AllGiftCardErrorCode, e)
+}
+
+func (e GiftCardErrorCode) GoString() string {
+	return enumGoString(AllGiftCardErrorCode, enumConstants["GiftCardErrorCode"], e, "model.GiftCardErrorCode")
+}
+
+func (e GiftCardErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *GiftCardErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "GiftCardErrorCode")
+}
+
+func (e *GiftCardErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "GiftCardErrorCode")
+}
+
+// LookupGiftCardSendNotificationToCustomerUserErrorCode returns the value of AllGiftCardSendNotificationToCustomerUserErrorCode named name.
+func LookupGiftCardSendNotificationToCustomerUserErrorCode(name string) (GiftCardSendNotificationToCustomerUserErrorCode, bool) {
+	return lookupEnum(AllGiftCardSendNotificationToCustomerUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllGiftCardSendNotificationToCustomerUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e GiftCardSendNotificationToCustomerUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllGiftCardSendNotificationToCustomerUserErrorCode, e)
+}
+
+func (e GiftCardSendNotificationToCustomerUserErrorCode) GoString() string {
+	return enumGoString(AllGiftCardSendNotificationToCustomerUserErrorCode, enumConstants["GiftCardSendNotificationToCustomerUserErrorCode"], e, "model.GiftCardSendNotificationToCustomerUserErrorCode")
+}
+
+func (e GiftCardSendNotificationToCustomerUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *GiftCardSendNotificationToCustomerUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "GiftCardSendNotificationToCustomerUserErrorCode")
+}
+
+func (e *GiftCardSendNotificationToCustomerUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "GiftCardSendNotificationToCustomerUserErrorCode")
+}
+
+// LookupGiftCardSendNotificationToRecipientUserErrorCode returns the value of AllGiftCardSendNotificationToRecipientUserErrorCode named name.
+func LookupGiftCardSendNotificationToRecipientUserErrorCode(name string) (GiftCardSendNotificationToRecipientUserErrorCode, bool) {
+	return lookupEnum(AllGiftCardSendNotificationToRecipientUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllGiftCardSendNotificationToRecipientUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e GiftCardSendNotificationToRecipientUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllGiftCardSendNotificationToRecipientUserErrorCode, e)
+}
+
+func (e GiftCardSendNotificationToRecipientUserErrorCode) GoString() string {
+	return enumGoString(AllGiftCardSendNotificationToRecipientUserErrorCode, enumConstants["GiftCardSendNotificationToRecipientUserErrorCode"], e, "model.GiftCardSendNotificationToRecipientUserErrorCode")
+}
+
+func (e GiftCardSendNotificationToRecipientUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *GiftCardSendNotificationToRecipientUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "GiftCardSendNotificationToRecipientUserErrorCode")
+}
+
+func (e *GiftCardSendNotificationToRecipientUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "GiftCardSendNotificationToRecipientUserErrorCode")
+}
+
+// LookupGiftCardSortKeys returns the value of AllGiftCardSortKeys named name.
+func LookupGiftCardSortKeys(name string) (GiftCardSortKeys, bool) {
+	return lookupEnum(AllGiftCardSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllGiftCardSortKeys, the values declared by the schema the models were
+// generated from.
+func (e GiftCardSortKeys) IsKnown() bool {
+	return slices.Contains(AllGiftCardSortKeys, e)
+}
+
+func (e GiftCardSortKeys) GoString() string {
+	return enumGoString(AllGiftCardSortKeys, enumConstants["GiftCardSortKeys"], e, "model.GiftCardSortKeys")
+}
+
+func (e GiftCardSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *GiftCardSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "GiftCardSortKeys")
+}
+
+func (e *GiftCardSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "GiftCardSortKeys")
+}
+
+// LookupGiftCardTransactionUserErrorCode returns the value of AllGiftCardTransactionUserErrorCode named name.
+func LookupGiftCardTransactionUserErrorCode(name string) (GiftCardTransactionUserErrorCode, bool) {
+	return lookupEnum(AllGiftCardTransactionUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllGiftCardTransactionUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e GiftCardTransactionUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllGiftCardTransactionUserErrorCode, e)
+}
+
+func (e GiftCardTransactionUserErrorCode) GoString() string {
+	return enumGoString(AllGiftCardTransactionUserErrorCode, enumConstants["GiftCardTransactionUserErrorCode"], e, "model.GiftCardTransactionUserErrorCode")
+}
+
+func (e GiftCardTransactionUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *GiftCardTransactionUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "GiftCardTransactionUserErrorCode")
+}
+
+func (e *GiftCardTransactionUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "GiftCardTransactionUserErrorCode")
+}
+
+// LookupImageContentType returns the value of AllImageContentType named name.
+func LookupImageContentType(name string) (ImageContentType, bool) {
+	return lookupEnum(AllImageContentType, name)
+}
+
+// IsKnown reports whether e is one of AllImageContentType, the values declared by the schema the models were
+// generated from.
+func (e ImageContentType) IsKnown() bool {
+	return slices.Contains(AllImageContentType, e)
+}
+
+func (e ImageContentType) GoString() string {
+	return enumGoString(AllImageContentType, enumConstants["ImageContentType"], e, "model.ImageContentType")
+}
+
+func (e ImageContentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ImageContentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ImageContentType")
+}
+
+func (e *ImageContentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ImageContentType")
+}
+
+// LookupInventoryAdjustQuantitiesUserErrorCode returns the value of AllInventoryAdjustQuantitiesUserErrorCode named name.
+func LookupInventoryAdjustQuantitiesUserErrorCode(name string) (InventoryAdjustQuantitiesUserErrorCode, bool) {
+	return lookupEnum(AllInventoryAdjustQuantitiesUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllInventoryAdjustQuantitiesUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e InventoryAdjustQuantitiesUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllInventoryAdjustQuantitiesUserErrorCode, e)
+}
+
+func (e InventoryAdjustQuantitiesUserErrorCode) GoString() string {
+	return enumGoString(AllInventoryAdjustQuantitiesUserErrorCode, enumConstants["InventoryAdjustQuantitiesUserErrorCode"], e, "model.InventoryAdjustQuantitiesUserErrorCode")
+}
+
+func (e InventoryAdjustQuantitiesUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InventoryAdjustQuantitiesUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "InventoryAdjustQuantitiesUserErrorCode")
+}
+
+func (e *InventoryAdjustQuantitiesUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "InventoryAdjustQuantitiesUserErrorCode")
+}
+
+// LookupInventoryBulkToggleActivationUserErrorCode returns the value of AllInventoryBulkToggleActivationUserErrorCode named name.
+func LookupInventoryBulkToggleActivationUserErrorCode(name string) (InventoryBulkToggleActivationUserErrorCode, bool) {
+	return lookupEnum(AllInventoryBulkToggleActivationUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllInventoryBulkToggleActivationUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e InventoryBulkToggleActivationUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllInventoryBulkToggleActivationUserErrorCode, e)
+}
+
+func (e InventoryBulkToggleActivationUserErrorCode) GoString() string {
+	return enumGoString(AllInventoryBulkToggleActivationUserErrorCode, enumConstants["InventoryBulkToggleActivationUserErrorCode"], e, "model.InventoryBulkToggleActivationUserErrorCode")
+}
+
+func (e InventoryBulkToggleActivationUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InventoryBulkToggleActivationUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "InventoryBulkToggleActivationUserErrorCode")
+}
+
+func (e *InventoryBulkToggleActivationUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "InventoryBulkToggleActivationUserErrorCode")
+}
+
+// LookupInventoryMoveQuantitiesUserErrorCode returns the value of AllInventoryMoveQuantitiesUserErrorCode named name.
+func LookupInventoryMoveQuantitiesUserErrorCode(name string) (InventoryMoveQuantitiesUserErrorCode, bool) {
+	return lookupEnum(AllInventoryMoveQuantitiesUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllInventoryMoveQuantitiesUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e InventoryMoveQuantitiesUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllInventoryMoveQuantitiesUserErrorCode, e)
+}
+
+func (e InventoryMoveQuantitiesUserErrorCode) GoString() string {
+	return enumGoString(AllInventoryMoveQuantitiesUserErrorCode, enumConstants["InventoryMoveQuantitiesUserErrorCode"], e, "model.InventoryMoveQuantitiesUserErrorCode")
+}
+
+func (e InventoryMoveQuantitiesUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InventoryMoveQuantitiesUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "InventoryMoveQuantitiesUserErrorCode")
+}
+
+func (e *InventoryMoveQuantitiesUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "InventoryMoveQuantitiesUserErrorCode")
+}
+
+// LookupInventorySetOnHandQuantitiesUserErrorCode returns the value of AllInventorySetOnHandQuantitiesUserErrorCode named name.
+func LookupInventorySetOnHandQuantitiesUserErrorCode(name string) (InventorySetOnHandQuantitiesUserErrorCode, bool) {
+	return lookupEnum(AllInventorySetOnHandQuantitiesUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllInventorySetOnHandQuantitiesUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e InventorySetOnHandQuantitiesUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllInventorySetOnHandQuantitiesUserErrorCode, e)
+}
+
+func (e InventorySetOnHandQuantitiesUserErrorCode) GoString() string {
+	return enumGoString(AllInventorySetOnHandQuantitiesUserErrorCode, enumConstants["InventorySetOnHandQuantitiesUserErrorCode"], e, "model.InventorySetOnHandQuantitiesUserErrorCode")
+}
+
+func (e InventorySetOnHandQuantitiesUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InventorySetOnHandQuantitiesUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "InventorySetOnHandQuantitiesUserErrorCode")
+}
+
+func (e *InventorySetOnHandQuantitiesUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "InventorySetOnHandQuantitiesUserErrorCode")
+}
+
+// LookupInventorySetQuantitiesUserErrorCode returns the value of AllInventorySetQuantitiesUserErrorCode named name.
+func LookupInventorySetQuantitiesUserErrorCode(name string) (InventorySetQuantitiesUserErrorCode, bool) {
+	return lookupEnum(AllInventorySetQuantitiesUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllInventorySetQuantitiesUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e InventorySetQuantitiesUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllInventorySetQuantitiesUserErrorCode, e)
+}
+
+func (e InventorySetQuantitiesUserErrorCode) GoString() string {
+	return enumGoString(AllInventorySetQuantitiesUserErrorCode, enumConstants["InventorySetQuantitiesUserErrorCode"], e, "model.InventorySetQuantitiesUserErrorCode")
+}
+
+func (e InventorySetQuantitiesUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InventorySetQuantitiesUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "InventorySetQuantitiesUserErrorCode")
+}
+
+func (e *InventorySetQuantitiesUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "InventorySetQuantitiesUserErrorCode")
+}
+
+// LookupInventorySetScheduledChangesUserErrorCode returns the value of AllInventorySetScheduledChangesUserErrorCode named name.
+func LookupInventorySetScheduledChangesUserErrorCode(name string) (InventorySetScheduledChangesUserErrorCode, bool) {
+	return lookupEnum(AllInventorySetScheduledChangesUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllInventorySetScheduledChangesUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e InventorySetScheduledChangesUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllInventorySetScheduledChangesUserErrorCode, e)
+}
+
+func (e InventorySetScheduledChangesUserErrorCode) GoString() string {
+	return enumGoString(AllInventorySetScheduledChangesUserErrorCode, enumConstants["InventorySetScheduledChangesUserErrorCode"], e, "model.InventorySetScheduledChangesUserErrorCode")
+}
+
+func (e InventorySetScheduledChangesUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *InventorySetScheduledChangesUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "InventorySetScheduledChangesUserErrorCode")
+}
+
+func (e *InventorySetScheduledChangesUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "InventorySetScheduledChangesUserErrorCode")
+}
+
+// LookupLanguageCode returns the value of AllLanguageCode named name.
+func LookupLanguageCode(name string) (LanguageCode, bool) {
+	return lookupEnum(AllLanguageCode, name)
+}
+
+// IsKnown reports whether e is one of AllLanguageCode, the values declared by the schema the models were
+// generated from.
+func (e LanguageCode) IsKnown() bool {
+	return slices.Contains(AllLanguageCode, e)
+}
+
+func (e LanguageCode) GoString() string {
+	return enumGoString(AllLanguageCode, enumConstants["LanguageCode"], e, "model.LanguageCode")
+}
+
+func (e LanguageCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LanguageCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LanguageCode")
+}
+
+func (e *LanguageCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LanguageCode")
+}
+
+// LookupLengthUnit returns the value of AllLengthUnit named name.
+func LookupLengthUnit(name string) (LengthUnit, bool) {
+	return lookupEnum(AllLengthUnit, name)
+}
+
+// IsKnown reports whether e is one of AllLengthUnit, the values declared by the schema the models were
+// generated from.
+func (e LengthUnit) IsKnown() bool {
+	return slices.Contains(AllLengthUnit, e)
+}
+
+func (e LengthUnit) GoString() string {
+	return enumGoString(AllLengthUnit, enumConstants["LengthUnit"], e, "model.LengthUnit")
+}
+
+func (e LengthUnit) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LengthUnit) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LengthUnit")
+}
+
+func (e *LengthUnit) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LengthUnit")
+}
+
+// LookupLocalizableContentType returns the value of AllLocalizableContentType named name.
+func LookupLocalizableContentType(name string) (LocalizableContentType, bool) {
+	return lookupEnum(AllLocalizableContentType, name)
+}
+
+// IsKnown reports whether e is one of AllLocalizableContentType, the values declared by the schema the models were
+// generated from.
+func (e LocalizableContentType) IsKnown() bool {
+	return slices.Contains(AllLocalizableContentType, e)
+}
+
+func (e LocalizableContentType) GoString() string {
+	return enumGoString(AllLocalizableContentType, enumConstants["LocalizableContentType"], e, "model.LocalizableContentType")
+}
+
+func (e LocalizableContentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocalizableContentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocalizableContentType")
+}
+
+func (e *LocalizableContentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocalizableContentType")
+}
+
+// LookupLocalizationExtensionKey returns the value of AllLocalizationExtensionKey named name.
+func LookupLocalizationExtensionKey(name string) (LocalizationExtensionKey, bool) {
+	return lookupEnum(AllLocalizationExtensionKey, name)
+}
+
+// IsKnown reports whether e is one of AllLocalizationExtensionKey, the values declared by the schema the models were
+// generated from.
+func (e LocalizationExtensionKey) IsKnown() bool {
+	return slices.Contains(AllLocalizationExtensionKey, e)
+}
+
+func (e LocalizationExtensionKey) GoString() string {
+	return enumGoString(AllLocalizationExtensionKey, enumConstants["LocalizationExtensionKey"], e, "model.LocalizationExtensionKey")
+}
+
+func (e LocalizationExtensionKey) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocalizationExtensionKey) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocalizationExtensionKey")
+}
+
+func (e *LocalizationExtensionKey) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocalizationExtensionKey")
+}
+
+// LookupLocalizationExtensionPurpose returns the value of AllLocalizationExtensionPurpose named name.
+func LookupLocalizationExtensionPurpose(name string) (LocalizationExtensionPurpose, bool) {
+	return lookupEnum(AllLocalizationExtensionPurpose, name)
+}
+
+// IsKnown reports whether e is one of AllLocalizationExtensionPurpose, the values declared by the schema the models were
+// generated from.
+func (e LocalizationExtensionPurpose) IsKnown() bool {
+	return slices.Contains(AllLocalizationExtensionPurpose, e)
+}
+
+func (e LocalizationExtensionPurpose) GoString() string {
+	return enumGoString(AllLocalizationExtensionPurpose, enumConstants["LocalizationExtensionPurpose"], e, "model.LocalizationExtensionPurpose")
+}
+
+func (e LocalizationExtensionPurpose) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocalizationExtensionPurpose) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocalizationExtensionPurpose")
+}
+
+func (e *LocalizationExtensionPurpose) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocalizationExtensionPurpose")
+}
+
+// LookupLocationActivateUserErrorCode returns the value of AllLocationActivateUserErrorCode named name.
+func LookupLocationActivateUserErrorCode(name string) (LocationActivateUserErrorCode, bool) {
+	return lookupEnum(AllLocationActivateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllLocationActivateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e LocationActivateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllLocationActivateUserErrorCode, e)
+}
+
+func (e LocationActivateUserErrorCode) GoString() string {
+	return enumGoString(AllLocationActivateUserErrorCode, enumConstants["LocationActivateUserErrorCode"], e, "model.LocationActivateUserErrorCode")
+}
+
+func (e LocationActivateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocationActivateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocationActivateUserErrorCode")
+}
+
+func (e *LocationActivateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocationActivateUserErrorCode")
+}
+
+// LookupLocationAddUserErrorCode returns the value of AllLocationAddUserErrorCode named name.
+func LookupLocationAddUserErrorCode(name string) (LocationAddUserErrorCode, bool) {
+	return lookupEnum(AllLocationAddUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllLocationAddUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e LocationAddUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllLocationAddUserErrorCode, e)
+}
+
+func (e LocationAddUserErrorCode) GoString() string {
+	return enumGoString(AllLocationAddUserErrorCode, enumConstants["LocationAddUserErrorCode"], e, "model.LocationAddUserErrorCode")
+}
+
+func (e LocationAddUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocationAddUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocationAddUserErrorCode")
+}
+
+func (e *LocationAddUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocationAddUserErrorCode")
+}
+
+// LookupLocationDeactivateUserErrorCode returns the value of AllLocationDeactivateUserErrorCode named name.
+func LookupLocationDeactivateUserErrorCode(name string) (LocationDeactivateUserErrorCode, bool) {
+	return lookupEnum(AllLocationDeactivateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllLocationDeactivateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e LocationDeactivateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllLocationDeactivateUserErrorCode, e)
+}
+
+func (e LocationDeactivateUserErrorCode) GoString() string {
+	return enumGoString(AllLocationDeactivateUserErrorCode, enumConstants["LocationDeactivateUserErrorCode"], e, "model.LocationDeactivateUserErrorCode")
+}
+
+func (e LocationDeactivateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocationDeactivateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocationDeactivateUserErrorCode")
+}
+
+func (e *LocationDeactivateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocationDeactivateUserErrorCode")
+}
+
+// LookupLocationDeleteUserErrorCode returns the value of AllLocationDeleteUserErrorCode named name.
+func LookupLocationDeleteUserErrorCode(name string) (LocationDeleteUserErrorCode, bool) {
+	return lookupEnum(AllLocationDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllLocationDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e LocationDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllLocationDeleteUserErrorCode, e)
+}
+
+func (e LocationDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllLocationDeleteUserErrorCode, enumConstants["LocationDeleteUserErrorCode"], e, "model.LocationDeleteUserErrorCode")
+}
+
+func (e LocationDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocationDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocationDeleteUserErrorCode")
+}
+
+func (e *LocationDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocationDeleteUserErrorCode")
+}
+
+// LookupLocationEditUserErrorCode returns the value of AllLocationEditUserErrorCode named name.
+func LookupLocationEditUserErrorCode(name string) (LocationEditUserErrorCode, bool) {
+	return lookupEnum(AllLocationEditUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllLocationEditUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e LocationEditUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllLocationEditUserErrorCode, e)
+}
+
+func (e LocationEditUserErrorCode) GoString() string {
+	return enumGoString(AllLocationEditUserErrorCode, enumConstants["LocationEditUserErrorCode"], e, "model.LocationEditUserErrorCode")
+}
+
+func (e LocationEditUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocationEditUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocationEditUserErrorCode")
+}
+
+func (e *LocationEditUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocationEditUserErrorCode")
+}
+
+// LookupLocationSortKeys returns the value of AllLocationSortKeys named name.
+func LookupLocationSortKeys(name string) (LocationSortKeys, bool) {
+	return lookupEnum(AllLocationSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllLocationSortKeys, the values declared by the schema the models were
+// generated from.
+func (e LocationSortKeys) IsKnown() bool {
+	return slices.Contains(AllLocationSortKeys, e)
+}
+
+func (e LocationSortKeys) GoString() string {
+	return enumGoString(AllLocationSortKeys, enumConstants["LocationSortKeys"], e, "model.LocationSortKeys")
+}
+
+func (e LocationSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *LocationSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "LocationSortKeys")
+}
+
+func (e *LocationSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "LocationSortKeys")
+}
+
+// LookupMailingAddressValidationResult returns the value of AllMailingAddressValidationResult named name.
+func LookupMailingAddressValidationResult(name string) (MailingAddressValidationResult, bool) {
+	return lookupEnum(AllMailingAddressValidationResult, name)
+}
+
+// IsKnown reports whether e is one of AllMailingAddressValidationResult, the values declared by the schema the models were
+// generated from.
+func (e MailingAddressValidationResult) IsKnown() bool {
+	return slices.Contains(AllMailingAddressValidationResult, e)
+}
+
+func (e MailingAddressValidationResult) GoString() string {
+	return enumGoString(AllMailingAddressValidationResult, enumConstants["MailingAddressValidationResult"], e, "model.MailingAddressValidationResult")
+}
+
+func (e MailingAddressValidationResult) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MailingAddressValidationResult) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MailingAddressValidationResult")
+}
+
+func (e *MailingAddressValidationResult) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MailingAddressValidationResult")
+}
+
+// LookupMarketCurrencySettingsUserErrorCode returns the value of AllMarketCurrencySettingsUserErrorCode named name.
+func LookupMarketCurrencySettingsUserErrorCode(name string) (MarketCurrencySettingsUserErrorCode, bool) {
+	return lookupEnum(AllMarketCurrencySettingsUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMarketCurrencySettingsUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MarketCurrencySettingsUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMarketCurrencySettingsUserErrorCode, e)
+}
+
+func (e MarketCurrencySettingsUserErrorCode) GoString() string {
+	return enumGoString(AllMarketCurrencySettingsUserErrorCode, enumConstants["MarketCurrencySettingsUserErrorCode"], e, "model.MarketCurrencySettingsUserErrorCode")
+}
+
+func (e MarketCurrencySettingsUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketCurrencySettingsUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketCurrencySettingsUserErrorCode")
+}
+
+func (e *MarketCurrencySettingsUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketCurrencySettingsUserErrorCode")
+}
+
+// LookupMarketLocalizableResourceType returns the value of AllMarketLocalizableResourceType named name.
+func LookupMarketLocalizableResourceType(name string) (MarketLocalizableResourceType, bool) {
+	return lookupEnum(AllMarketLocalizableResourceType, name)
+}
+
+// IsKnown reports whether e is one of AllMarketLocalizableResourceType, the values declared by the schema the models were
+// generated from.
+func (e MarketLocalizableResourceType) IsKnown() bool {
+	return slices.Contains(AllMarketLocalizableResourceType, e)
+}
+
+func (e MarketLocalizableResourceType) GoString() string {
+	return enumGoString(AllMarketLocalizableResourceType, enumConstants["MarketLocalizableResourceType"], e, "model.MarketLocalizableResourceType")
+}
+
+func (e MarketLocalizableResourceType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketLocalizableResourceType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketLocalizableResourceType")
+}
+
+func (e *MarketLocalizableResourceType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketLocalizableResourceType")
+}
+
+// LookupMarketUserErrorCode returns the value of AllMarketUserErrorCode named name.
+func LookupMarketUserErrorCode(name string) (MarketUserErrorCode, bool) {
+	return lookupEnum(AllMarketUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMarketUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MarketUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMarketUserErrorCode, e)
+}
+
+func (e MarketUserErrorCode) GoString() string {
+	return enumGoString(AllMarketUserErrorCode, enumConstants["MarketUserErrorCode"], e, "model.MarketUserErrorCode")
+}
+
+func (e MarketUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketUserErrorCode")
+}
+
+func (e *MarketUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketUserErrorCode")
+}
+
+// LookupMarketingActivityExtensionAppErrorCode returns the value of AllMarketingActivityExtensionAppErrorCode named name.
+func LookupMarketingActivityExtensionAppErrorCode(name string) (MarketingActivityExtensionAppErrorCode, bool) {
+	return lookupEnum(AllMarketingActivityExtensionAppErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivityExtensionAppErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivityExtensionAppErrorCode) IsKnown() bool {
+	return slices.Contains(AllMarketingActivityExtensionAppErrorCode, e)
+}
+
+func (e MarketingActivityExtensionAppErrorCode) GoString() string {
+	return enumGoString(AllMarketingActivityExtensionAppErrorCode, enumConstants["MarketingActivityExtensionAppErrorCode"], e, "model.MarketingActivityExtensionAppErrorCode")
+}
+
+func (e MarketingActivityExtensionAppErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivityExtensionAppErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivityExtensionAppErrorCode")
+}
+
+func (e *MarketingActivityExtensionAppErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivityExtensionAppErrorCode")
+}
+
+// LookupMarketingActivityExternalStatus returns the value of AllMarketingActivityExternalStatus named name.
+func LookupMarketingActivityExternalStatus(name string) (MarketingActivityExternalStatus, bool) {
+	return lookupEnum(AllMarketingActivityExternalStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivityExternalStatus, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivityExternalStatus) IsKnown() bool {
+	return slices.Contains(AllMarketingActivityExternalStatus, e)
+}
+
+func (e MarketingActivityExternalStatus) GoString() string {
+	return enumGoString(AllMarketingActivityExternalStatus, enumConstants["MarketingActivityExternalStatus"], e, "model.MarketingActivityExternalStatus")
+}
+
+func (e MarketingActivityExternalStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivityExternalStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivityExternalStatus")
+}
+
+func (e *MarketingActivityExternalStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivityExternalStatus")
+}
+
+// LookupMarketingActivityHierarchyLevel returns the value of AllMarketingActivityHierarchyLevel named name.
+func LookupMarketingActivityHierarchyLevel(name string) (MarketingActivityHierarchyLevel, bool) {
+	return lookupEnum(AllMarketingActivityHierarchyLevel, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivityHierarchyLevel, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivityHierarchyLevel) IsKnown() bool {
+	return slices.Contains(AllMarketingActivityHierarchyLevel, e)
+}
+
+func (e MarketingActivityHierarchyLevel) GoString() string {
+	return enumGoString(AllMarketingActivityHierarchyLevel, enumConstants["MarketingActivityHierarchyLevel"], e, "model.MarketingActivityHierarchyLevel")
+}
+
+func (e MarketingActivityHierarchyLevel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivityHierarchyLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivityHierarchyLevel")
+}
+
+func (e *MarketingActivityHierarchyLevel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivityHierarchyLevel")
+}
+
+// LookupMarketingActivitySortKeys returns the value of AllMarketingActivitySortKeys named name.
+func LookupMarketingActivitySortKeys(name string) (MarketingActivitySortKeys, bool) {
+	return lookupEnum(AllMarketingActivitySortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivitySortKeys, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivitySortKeys) IsKnown() bool {
+	return slices.Contains(AllMarketingActivitySortKeys, e)
+}
+
+func (e MarketingActivitySortKeys) GoString() string {
+	return enumGoString(AllMarketingActivitySortKeys, enumConstants["MarketingActivitySortKeys"], e, "model.MarketingActivitySortKeys")
+}
+
+func (e MarketingActivitySortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivitySortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivitySortKeys")
+}
+
+func (e *MarketingActivitySortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivitySortKeys")
+}
+
+// LookupMarketingActivityStatus returns the value of AllMarketingActivityStatus named name.
+func LookupMarketingActivityStatus(name string) (MarketingActivityStatus, bool) {
+	return lookupEnum(AllMarketingActivityStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivityStatus, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivityStatus) IsKnown() bool {
+	return slices.Contains(AllMarketingActivityStatus, e)
+}
+
+func (e MarketingActivityStatus) GoString() string {
+	return enumGoString(AllMarketingActivityStatus, enumConstants["MarketingActivityStatus"], e, "model.MarketingActivityStatus")
+}
+
+func (e MarketingActivityStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivityStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivityStatus")
+}
+
+func (e *MarketingActivityStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivityStatus")
+}
+
+// LookupMarketingActivityStatusBadgeType returns the value of AllMarketingActivityStatusBadgeType named name.
+func LookupMarketingActivityStatusBadgeType(name string) (MarketingActivityStatusBadgeType, bool) {
+	return lookupEnum(AllMarketingActivityStatusBadgeType, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivityStatusBadgeType, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivityStatusBadgeType) IsKnown() bool {
+	return slices.Contains(AllMarketingActivityStatusBadgeType, e)
+}
+
+func (e MarketingActivityStatusBadgeType) GoString() string {
+	return enumGoString(AllMarketingActivityStatusBadgeType, enumConstants["MarketingActivityStatusBadgeType"], e, "model.MarketingActivityStatusBadgeType")
+}
+
+func (e MarketingActivityStatusBadgeType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivityStatusBadgeType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivityStatusBadgeType")
+}
+
+func (e *MarketingActivityStatusBadgeType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivityStatusBadgeType")
+}
+
+// LookupMarketingActivityUserErrorCode returns the value of AllMarketingActivityUserErrorCode named name.
+func LookupMarketingActivityUserErrorCode(name string) (MarketingActivityUserErrorCode, bool) {
+	return lookupEnum(AllMarketingActivityUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingActivityUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MarketingActivityUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMarketingActivityUserErrorCode, e)
+}
+
+func (e MarketingActivityUserErrorCode) GoString() string {
+	return enumGoString(AllMarketingActivityUserErrorCode, enumConstants["MarketingActivityUserErrorCode"], e, "model.MarketingActivityUserErrorCode")
+}
+
+func (e MarketingActivityUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingActivityUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingActivityUserErrorCode")
+}
+
+func (e *MarketingActivityUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingActivityUserErrorCode")
+}
+
+// LookupMarketingBudgetBudgetType returns the value of AllMarketingBudgetBudgetType named name.
+func LookupMarketingBudgetBudgetType(name string) (MarketingBudgetBudgetType, bool) {
+	return lookupEnum(AllMarketingBudgetBudgetType, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingBudgetBudgetType, the values declared by the schema the models were
+// generated from.
+func (e MarketingBudgetBudgetType) IsKnown() bool {
+	return slices.Contains(AllMarketingBudgetBudgetType, e)
+}
+
+func (e MarketingBudgetBudgetType) GoString() string {
+	return enumGoString(AllMarketingBudgetBudgetType, enumConstants["MarketingBudgetBudgetType"], e, "model.MarketingBudgetBudgetType")
+}
+
+func (e MarketingBudgetBudgetType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingBudgetBudgetType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingBudgetBudgetType")
+}
+
+func (e *MarketingBudgetBudgetType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingBudgetBudgetType")
+}
+
+// LookupMarketingChannel returns the value of AllMarketingChannel named name.
+func LookupMarketingChannel(name string) (MarketingChannel, bool) {
+	return lookupEnum(AllMarketingChannel, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingChannel, the values declared by the schema the models were
+// generated from.
+func (e MarketingChannel) IsKnown() bool {
+	return slices.Contains(AllMarketingChannel, e)
+}
+
+func (e MarketingChannel) GoString() string {
+	return enumGoString(AllMarketingChannel, enumConstants["MarketingChannel"], e, "model.MarketingChannel")
+}
+
+func (e MarketingChannel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingChannel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingChannel")
+}
+
+func (e *MarketingChannel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingChannel")
+}
+
+// LookupMarketingEventSortKeys returns the value of AllMarketingEventSortKeys named name.
+func LookupMarketingEventSortKeys(name string) (MarketingEventSortKeys, bool) {
+	return lookupEnum(AllMarketingEventSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingEventSortKeys, the values declared by the schema the models were
+// generated from.
+func (e MarketingEventSortKeys) IsKnown() bool {
+	return slices.Contains(AllMarketingEventSortKeys, e)
+}
+
+func (e MarketingEventSortKeys) GoString() string {
+	return enumGoString(AllMarketingEventSortKeys, enumConstants["MarketingEventSortKeys"], e, "model.MarketingEventSortKeys")
+}
+
+func (e MarketingEventSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingEventSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingEventSortKeys")
+}
+
+func (e *MarketingEventSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingEventSortKeys")
+}
+
+// LookupMarketingTactic returns the value of AllMarketingTactic named name.
+func LookupMarketingTactic(name string) (MarketingTactic, bool) {
+	return lookupEnum(AllMarketingTactic, name)
+}
+
+// IsKnown reports whether e is one of AllMarketingTactic, the values declared by the schema the models were
+// generated from.
+func (e MarketingTactic) IsKnown() bool {
+	return slices.Contains(AllMarketingTactic, e)
+}
+
+func (e MarketingTactic) GoString() string {
+	return enumGoString(AllMarketingTactic, enumConstants["MarketingTactic"], e, "model.MarketingTactic")
+}
+
+func (e MarketingTactic) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MarketingTactic) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MarketingTactic")
+}
+
+func (e *MarketingTactic) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MarketingTactic")
+}
+
+// LookupMediaContentType returns the value of AllMediaContentType named name.
+func LookupMediaContentType(name string) (MediaContentType, bool) {
+	return lookupEnum(AllMediaContentType, name)
+}
+
+// IsKnown reports whether e is one of AllMediaContentType, the values declared by the schema the models were
+// generated from.
+func (e MediaContentType) IsKnown() bool {
+	return slices.Contains(AllMediaContentType, e)
+}
+
+func (e MediaContentType) GoString() string {
+	return enumGoString(AllMediaContentType, enumConstants["MediaContentType"], e, "model.MediaContentType")
+}
+
+func (e MediaContentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaContentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaContentType")
+}
+
+func (e *MediaContentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaContentType")
+}
+
+// LookupMediaErrorCode returns the value of AllMediaErrorCode named name.
+func LookupMediaErrorCode(name string) (MediaErrorCode, bool) {
+	return lookupEnum(AllMediaErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMediaErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MediaErrorCode) IsKnown() bool {
+	return slices.Contains(AllMediaErrorCode, e)
+}
+
+func (e MediaErrorCode) GoString() string {
+	return enumGoString(AllMediaErrorCode, enumConstants["MediaErrorCode"], e, "model.MediaErrorCode")
+}
+
+func (e MediaErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaErrorCode")
+}
+
+func (e *MediaErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaErrorCode")
+}
+
+// LookupMediaHost returns the value of AllMediaHost named name.
+func LookupMediaHost(name string) (MediaHost, bool) {
+	return lookupEnum(AllMediaHost, name)
+}
+
+// IsKnown reports whether e is one of AllMediaHost, the values declared by the schema the models were
+// generated from.
+func (e MediaHost) IsKnown() bool {
+	return slices.Contains(AllMediaHost, e)
+}
+
+func (e MediaHost) GoString() string {
+	return enumGoString(AllMediaHost, enumConstants["MediaHost"], e, "model.MediaHost")
+}
+
+func (e MediaHost) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaHost) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaHost")
+}
+
+func (e *MediaHost) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaHost")
+}
+
+// LookupMediaPreviewImageStatus returns the value of AllMediaPreviewImageStatus named name.
+func LookupMediaPreviewImageStatus(name string) (MediaPreviewImageStatus, bool) {
+	return lookupEnum(AllMediaPreviewImageStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMediaPreviewImageStatus, the values declared by the schema the models were
+// generated from.
+func (e MediaPreviewImageStatus) IsKnown() bool {
+	return slices.Contains(AllMediaPreviewImageStatus, e)
+}
+
+func (e MediaPreviewImageStatus) GoString() string {
+	return enumGoString(AllMediaPreviewImageStatus, enumConstants["MediaPreviewImageStatus"], e, "model.MediaPreviewImageStatus")
+}
+
+func (e MediaPreviewImageStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaPreviewImageStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaPreviewImageStatus")
+}
+
+func (e *MediaPreviewImageStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaPreviewImageStatus")
+}
+
+// LookupMediaStatus returns the value of AllMediaStatus named name.
+func LookupMediaStatus(name string) (MediaStatus, bool) {
+	return lookupEnum(AllMediaStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMediaStatus, the values declared by the schema the models were
+// generated from.
+func (e MediaStatus) IsKnown() bool {
+	return slices.Contains(AllMediaStatus, e)
+}
+
+func (e MediaStatus) GoString() string {
+	return enumGoString(AllMediaStatus, enumConstants["MediaStatus"], e, "model.MediaStatus")
+}
+
+func (e MediaStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaStatus")
+}
+
+func (e *MediaStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaStatus")
+}
+
+// LookupMediaUserErrorCode returns the value of AllMediaUserErrorCode named name.
+func LookupMediaUserErrorCode(name string) (MediaUserErrorCode, bool) {
+	return lookupEnum(AllMediaUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMediaUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MediaUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMediaUserErrorCode, e)
+}
+
+func (e MediaUserErrorCode) GoString() string {
+	return enumGoString(AllMediaUserErrorCode, enumConstants["MediaUserErrorCode"], e, "model.MediaUserErrorCode")
+}
+
+func (e MediaUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaUserErrorCode")
+}
+
+func (e *MediaUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaUserErrorCode")
+}
+
+// LookupMediaWarningCode returns the value of AllMediaWarningCode named name.
+func LookupMediaWarningCode(name string) (MediaWarningCode, bool) {
+	return lookupEnum(AllMediaWarningCode, name)
+}
+
+// IsKnown reports whether e is one of AllMediaWarningCode, the values declared by the schema the models were
+// generated from.
+func (e MediaWarningCode) IsKnown() bool {
+	return slices.Contains(AllMediaWarningCode, e)
+}
+
+func (e MediaWarningCode) GoString() string {
+	return enumGoString(AllMediaWarningCode, enumConstants["MediaWarningCode"], e, "model.MediaWarningCode")
+}
+
+func (e MediaWarningCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MediaWarningCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MediaWarningCode")
+}
+
+func (e *MediaWarningCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MediaWarningCode")
+}
+
+// LookupMenuCreateUserErrorCode returns the value of AllMenuCreateUserErrorCode named name.
+func LookupMenuCreateUserErrorCode(name string) (MenuCreateUserErrorCode, bool) {
+	return lookupEnum(AllMenuCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMenuCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MenuCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMenuCreateUserErrorCode, e)
+}
+
+func (e MenuCreateUserErrorCode) GoString() string {
+	return enumGoString(AllMenuCreateUserErrorCode, enumConstants["MenuCreateUserErrorCode"], e, "model.MenuCreateUserErrorCode")
+}
+
+func (e MenuCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MenuCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MenuCreateUserErrorCode")
+}
+
+func (e *MenuCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MenuCreateUserErrorCode")
+}
+
+// LookupMenuDeleteUserErrorCode returns the value of AllMenuDeleteUserErrorCode named name.
+func LookupMenuDeleteUserErrorCode(name string) (MenuDeleteUserErrorCode, bool) {
+	return lookupEnum(AllMenuDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMenuDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MenuDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMenuDeleteUserErrorCode, e)
+}
+
+func (e MenuDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllMenuDeleteUserErrorCode, enumConstants["MenuDeleteUserErrorCode"], e, "model.MenuDeleteUserErrorCode")
+}
+
+func (e MenuDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MenuDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MenuDeleteUserErrorCode")
+}
+
+func (e *MenuDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MenuDeleteUserErrorCode")
+}
+
+// LookupMenuItemType returns the value of AllMenuItemType named name.
+func LookupMenuItemType(name string) (MenuItemType, bool) {
+	return lookupEnum(AllMenuItemType, name)
+}
+
+// IsKnown reports whether e is one of AllMenuItemType, the values declared by the schema the models were
+// generated from.
+func (e MenuItemType) IsKnown() bool {
+	return slices.Contains(AllMenuItemType, e)
+}
+
+func (e MenuItemType) GoString() string {
+	return enumGoString(AllMenuItemType, enumConstants["MenuItemType"], e, "model.MenuItemType")
+}
+
+func (e MenuItemType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MenuItemType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MenuItemType")
+}
+
+func (e *MenuItemType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MenuItemType")
+}
+
+// LookupMenuSortKeys returns the value of AllMenuSortKeys named name.
+func LookupMenuSortKeys(name string) (MenuSortKeys, bool) {
+	return lookupEnum(AllMenuSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllMenuSortKeys, the values declared by the schema the models were
+// generated from.
+func (e MenuSortKeys) IsKnown() bool {
+	return slices.Contains(AllMenuSortKeys, e)
+}
+
+func (e MenuSortKeys) GoString() string {
+	return enumGoString(AllMenuSortKeys, enumConstants["MenuSortKeys"], e, "model.MenuSortKeys")
+}
+
+func (e MenuSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MenuSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MenuSortKeys")
+}
+
+func (e *MenuSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MenuSortKeys")
+}
+
+// LookupMenuUpdateUserErrorCode returns the value of AllMenuUpdateUserErrorCode named name.
+func LookupMenuUpdateUserErrorCode(name string) (MenuUpdateUserErrorCode, bool) {
+	return lookupEnum(AllMenuUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMenuUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MenuUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMenuUpdateUserErrorCode, e)
+}
+
+func (e MenuUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllMenuUpdateUserErrorCode, enumConstants["MenuUpdateUserErrorCode"], e, "model.MenuUpdateUserErrorCode")
+}
+
+func (e MenuUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MenuUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MenuUpdateUserErrorCode")
+}
+
+func (e *MenuUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MenuUpdateUserErrorCode")
+}
+
+// LookupMerchandiseDiscountClass returns the value of AllMerchandiseDiscountClass named name.
+func LookupMerchandiseDiscountClass(name string) (MerchandiseDiscountClass, bool) {
+	return lookupEnum(AllMerchandiseDiscountClass, name)
+}
+
+// IsKnown reports whether e is one of AllMerchandiseDiscountClass, the values declared by the schema the models were
+// generated from.
+func (e MerchandiseDiscountClass) IsKnown() bool {
+	return slices.Contains(AllMerchandiseDiscountClass, e)
+}
+
+func (e MerchandiseDiscountClass) GoString() string {
+	return enumGoString(AllMerchandiseDiscountClass, enumConstants["MerchandiseDiscountClass"], e, "model.MerchandiseDiscountClass")
+}
+
+func (e MerchandiseDiscountClass) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MerchandiseDiscountClass) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MerchandiseDiscountClass")
+}
+
+func (e *MerchandiseDiscountClass) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MerchandiseDiscountClass")
+}
+
+// LookupMetafieldAdminAccess returns the value of AllMetafieldAdminAccess named name.
+func LookupMetafieldAdminAccess(name string) (MetafieldAdminAccess, bool) {
+	return lookupEnum(AllMetafieldAdminAccess, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldAdminAccess, the values declared by the schema the models were
+// generated from.
+func (e MetafieldAdminAccess) IsKnown() bool {
+	return slices.Contains(AllMetafieldAdminAccess, e)
+}
+
+func (e MetafieldAdminAccess) GoString() string {
+	return enumGoString(AllMetafieldAdminAccess, enumConstants["MetafieldAdminAccess"], e, "model.MetafieldAdminAccess")
+}
+
+func (e MetafieldAdminAccess) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldAdminAccess) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldAdminAccess")
+}
+
+func (e *MetafieldAdminAccess) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldAdminAccess")
+}
+
+// LookupMetafieldAdminAccessInput returns the value of AllMetafieldAdminAccessInput named name.
+func LookupMetafieldAdminAccessInput(name string) (MetafieldAdminAccessInput, bool) {
+	return lookupEnum(AllMetafieldAdminAccessInput, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldAdminAccessInput, the values declared by the schema the models were
+// generated from.
+func (e MetafieldAdminAccessInput) IsKnown() bool {
+	return slices.Contains(AllMetafieldAdminAccessInput, e)
+}
+
+func (e MetafieldAdminAccessInput) GoString() string {
+	return enumGoString(AllMetafieldAdminAccessInput, enumConstants["MetafieldAdminAccessInput"], e, "model.MetafieldAdminAccessInput")
+}
+
+func (e MetafieldAdminAccessInput) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldAdminAccessInput) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldAdminAccessInput")
+}
+
+func (e *MetafieldAdminAccessInput) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldAdminAccessInput")
+}
+
+// LookupMetafieldCustomerAccountAccess returns the value of AllMetafieldCustomerAccountAccess named name.
+func LookupMetafieldCustomerAccountAccess(name string) (MetafieldCustomerAccountAccess, bool) {
+	return lookupEnum(AllMetafieldCustomerAccountAccess, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldCustomerAccountAccess, the values declared by the schema the models were
+// generated from.
+func (e MetafieldCustomerAccountAccess) IsKnown() bool {
+	return slices.Contains(AllMetafieldCustomerAccountAccess, e)
+}
+
+func (e MetafieldCustomerAccountAccess) GoString() string {
+	return enumGoString(AllMetafieldCustomerAccountAccess, enumConstants["MetafieldCustomerAccountAccess"], e, "model.MetafieldCustomerAccountAccess")
+}
+
+func (e MetafieldCustomerAccountAccess) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldCustomerAccountAccess) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldCustomerAccountAccess")
+}
+
+func (e *MetafieldCustomerAccountAccess) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldCustomerAccountAccess")
+}
+
+// LookupMetafieldCustomerAccountAccessInput returns the value of AllMetafieldCustomerAccountAccessInput named name.
+func LookupMetafieldCustomerAccountAccessInput(name string) (MetafieldCustomerAccountAccessInput, bool) {
+	return lookupEnum(AllMetafieldCustomerAccountAccessInput, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldCustomerAccountAccessInput, the values declared by the schema the models were
+// generated from.
+func (e MetafieldCustomerAccountAccessInput) IsKnown() bool {
+	return slices.Contains(AllMetafieldCustomerAccountAccessInput, e)
+}
+
+func (e MetafieldCustomerAccountAccessInput) GoString() string {
+	return enumGoString(AllMetafieldCustomerAccountAccessInput, enumConstants["MetafieldCustomerAccountAccessInput"], e, "model.MetafieldCustomerAccountAccessInput")
+}
+
+func (e MetafieldCustomerAccountAccessInput) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldCustomerAccountAccessInput) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldCustomerAccountAccessInput")
+}
+
+func (e *MetafieldCustomerAccountAccessInput) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldCustomerAccountAccessInput")
+}
+
+// LookupMetafieldDefinitionAdminFilterStatus returns the value of AllMetafieldDefinitionAdminFilterStatus named name.
+func LookupMetafieldDefinitionAdminFilterStatus(name string) (MetafieldDefinitionAdminFilterStatus, bool) {
+	return lookupEnum(AllMetafieldDefinitionAdminFilterStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionAdminFilterStatus, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionAdminFilterStatus) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionAdminFilterStatus, e)
+}
+
+func (e MetafieldDefinitionAdminFilterStatus) GoString() string {
+	return enumGoString(AllMetafieldDefinitionAdminFilterStatus, enumConstants["MetafieldDefinitionAdminFilterStatus"], e, "model.MetafieldDefinitionAdminFilterStatus")
+}
+
+func (e MetafieldDefinitionAdminFilterStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionAdminFilterStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionAdminFilterStatus")
+}
+
+func (e *MetafieldDefinitionAdminFilterStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionAdminFilterStatus")
+}
+
+// LookupMetafieldDefinitionConstraintStatus returns the value of AllMetafieldDefinitionConstraintStatus named name.
+func LookupMetafieldDefinitionConstraintStatus(name string) (MetafieldDefinitionConstraintStatus, bool) {
+	return lookupEnum(AllMetafieldDefinitionConstraintStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionConstraintStatus, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionConstraintStatus) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionConstraintStatus, e)
+}
+
+func (e MetafieldDefinitionConstraintStatus) GoString() string {
+	return enumGoString(AllMetafieldDefinitionConstraintStatus, enumConstants["MetafieldDefinitionConstraintStatus"], e, "model.MetafieldDefinitionConstraintStatus")
+}
+
+func (e MetafieldDefinitionConstraintStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionConstraintStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionConstraintStatus")
+}
+
+func (e *MetafieldDefinitionConstraintStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionConstraintStatus")
+}
+
+// LookupMetafieldDefinitionCreateUserErrorCode returns the value of AllMetafieldDefinitionCreateUserErrorCode named name.
+func LookupMetafieldDefinitionCreateUserErrorCode(name string) (MetafieldDefinitionCreateUserErrorCode, bool) {
+	return lookupEnum(AllMetafieldDefinitionCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionCreateUserErrorCode, e)
+}
+
+func (e MetafieldDefinitionCreateUserErrorCode) GoString() string {
+	return enumGoString(AllMetafieldDefinitionCreateUserErrorCode, enumConstants["MetafieldDefinitionCreateUserErrorCode"], e, "model.MetafieldDefinitionCreateUserErrorCode")
+}
+
+func (e MetafieldDefinitionCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionCreateUserErrorCode")
+}
+
+func (e *MetafieldDefinitionCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionCreateUserErrorCode")
+}
+
+// LookupMetafieldDefinitionDeleteUserErrorCode returns the value of AllMetafieldDefinitionDeleteUserErrorCode named name.
+func LookupMetafieldDefinitionDeleteUserErrorCode(name string) (MetafieldDefinitionDeleteUserErrorCode, bool) {
+	return lookupEnum(AllMetafieldDefinitionDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionDeleteUserErrorCode, e)
+}
+
+func (e MetafieldDefinitionDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllMetafieldDefinitionDeleteUserErrorCode, enumConstants["MetafieldDefinitionDeleteUserErrorCode"], e, "model.MetafieldDefinitionDeleteUserErrorCode")
+}
+
+func (e MetafieldDefinitionDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionDeleteUserErrorCode")
+}
+
+func (e *MetafieldDefinitionDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionDeleteUserErrorCode")
+}
+
+// LookupMetafieldDefinitionPinUserErrorCode returns the value of AllMetafieldDefinitionPinUserErrorCode named name.
+func LookupMetafieldDefinitionPinUserErrorCode(name string) (MetafieldDefinitionPinUserErrorCode, bool) {
+	return lookupEnum(AllMetafieldDefinitionPinUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionPinUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionPinUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionPinUserErrorCode, e)
+}
+
+func (e MetafieldDefinitionPinUserErrorCode) GoString() string {
+	return enumGoString(AllMetafieldDefinitionPinUserErrorCode, enumConstants["MetafieldDefinitionPinUserErrorCode"], e, "model.MetafieldDefinitionPinUserErrorCode")
+}
+
+func (e MetafieldDefinitionPinUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionPinUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionPinUserErrorCode")
+}
+
+func (e *MetafieldDefinitionPinUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionPinUserErrorCode")
+}
+
+// LookupMetafieldDefinitionPinnedStatus returns the value of AllMetafieldDefinitionPinnedStatus named name.
+func LookupMetafieldDefinitionPinnedStatus(name string) (MetafieldDefinitionPinnedStatus, bool) {
+	return lookupEnum(AllMetafieldDefinitionPinnedStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionPinnedStatus, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionPinnedStatus) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionPinnedStatus, e)
+}
+
+func (e MetafieldDefinitionPinnedStatus) GoString() string {
+	return enumGoString(AllMetafieldDefinitionPinnedStatus, enumConstants["MetafieldDefinitionPinnedStatus"], e, "model.MetafieldDefinitionPinnedStatus")
+}
+
+func (e MetafieldDefinitionPinnedStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionPinnedStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionPinnedStatus")
+}
+
+func (e *MetafieldDefinitionPinnedStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionPinnedStatus")
+}
+
+// LookupMetafieldDefinitionSortKeys returns the value of AllMetafieldDefinitionSortKeys named name.
+func LookupMetafieldDefinitionSortKeys(name string) (MetafieldDefinitionSortKeys, bool) {
+	return lookupEnum(AllMetafieldDefinitionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionSortKeys) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionSortKeys, e)
+}
+
+func (e MetafieldDefinitionSortKeys) GoString() string {
+	return enumGoString(AllMetafieldDefinitionSortKeys, enumConstants["MetafieldDefinitionSortKeys"], e, "model.MetafieldDefinitionSortKeys")
+}
+
+func (e MetafieldDefinitionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionSortKeys")
+}
+
+func (e *MetafieldDefinitionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionSortKeys")
+}
+
+// LookupMetafieldDefinitionUnpinUserErrorCode returns the value of AllMetafieldDefinitionUnpinUserErrorCode named name.
+func LookupMetafieldDefinitionUnpinUserErrorCode(name string) (MetafieldDefinitionUnpinUserErrorCode, bool) {
+	return lookupEnum(AllMetafieldDefinitionUnpinUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionUnpinUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionUnpinUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionUnpinUserErrorCode, e)
+}
+
+func (e MetafieldDefinitionUnpinUserErrorCode) GoString() string {
+	return enumGoString(AllMetafieldDefinitionUnpinUserErrorCode, enumConstants["MetafieldDefinitionUnpinUserErrorCode"], e, "model.MetafieldDefinitionUnpinUserErrorCode")
+}
+
+func (e MetafieldDefinitionUnpinUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionUnpinUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionUnpinUserErrorCode")
+}
+
+func (e *MetafieldDefinitionUnpinUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionUnpinUserErrorCode")
+}
+
+// LookupMetafieldDefinitionUpdateUserErrorCode returns the value of AllMetafieldDefinitionUpdateUserErrorCode named name.
+func LookupMetafieldDefinitionUpdateUserErrorCode(name string) (MetafieldDefinitionUpdateUserErrorCode, bool) {
+	return lookupEnum(AllMetafieldDefinitionUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionUpdateUserErrorCode, e)
+}
+
+func (e MetafieldDefinitionUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllMetafieldDefinitionUpdateUserErrorCode, enumConstants["MetafieldDefinitionUpdateUserErrorCode"], e, "model.MetafieldDefinitionUpdateUserErrorCode")
+}
+
+func (e MetafieldDefinitionUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionUpdateUserErrorCode")
+}
+
+func (e *MetafieldDefinitionUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionUpdateUserErrorCode")
+}
+
+// LookupMetafieldDefinitionValidationStatus returns the value of AllMetafieldDefinitionValidationStatus named name.
+func LookupMetafieldDefinitionValidationStatus(name string) (MetafieldDefinitionValidationStatus, bool) {
+	return lookupEnum(AllMetafieldDefinitionValidationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldDefinitionValidationStatus, the values declared by the schema the models were
+// generated from.
+func (e MetafieldDefinitionValidationStatus) IsKnown() bool {
+	return slices.Contains(AllMetafieldDefinitionValidationStatus, e)
+}
+
+func (e MetafieldDefinitionValidationStatus) GoString() string {
+	return enumGoString(AllMetafieldDefinitionValidationStatus, enumConstants["MetafieldDefinitionValidationStatus"], e, "model.MetafieldDefinitionValidationStatus")
+}
+
+func (e MetafieldDefinitionValidationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldDefinitionValidationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldDefinitionValidationStatus")
+}
+
+func (e *MetafieldDefinitionValidationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldDefinitionValidationStatus")
+}
+
+// LookupMetafieldGrantAccessLevel returns the value of AllMetafieldGrantAccessLevel named name.
+func LookupMetafieldGrantAccessLevel(name string) (MetafieldGrantAccessLevel, bool) {
+	return lookupEnum(AllMetafieldGrantAccessLevel, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldGrantAccessLevel, the values declared by the schema the models were
+// generated from.
+func (e MetafieldGrantAccessLevel) IsKnown() bool {
+	return slices.Contains(AllMetafieldGrantAccessLevel, e)
+}
+
+func (e MetafieldGrantAccessLevel) GoString() string {
+	return enumGoString(AllMetafieldGrantAccessLevel, enumConstants["MetafieldGrantAccessLevel"], e, "model.MetafieldGrantAccessLevel")
+}
+
+func (e MetafieldGrantAccessLevel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldGrantAccessLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldGrantAccessLevel")
+}
+
+func (e *MetafieldGrantAccessLevel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldGrantAccessLevel")
+}
+
+// LookupMetafieldOwnerType returns the value of AllMetafieldOwnerType named name.
+func LookupMetafieldOwnerType(name string) (MetafieldOwnerType, bool) {
+	return lookupEnum(AllMetafieldOwnerType, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldOwnerType, the values declared by the schema the models were
+// generated from.
+func (e MetafieldOwnerType) IsKnown() bool {
+	return slices.Contains(AllMetafieldOwnerType, e)
+}
+
+func (e MetafieldOwnerType) GoString() string {
+	return enumGoString(AllMetafieldOwnerType, enumConstants["MetafieldOwnerType"], e, "model.MetafieldOwnerType")
+}
+
+func (e MetafieldOwnerType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldOwnerType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldOwnerType")
+}
+
+func (e *MetafieldOwnerType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldOwnerType")
+}
+
+// LookupMetafieldStorefrontAccess returns the value of AllMetafieldStorefrontAccess named name.
+func LookupMetafieldStorefrontAccess(name string) (MetafieldStorefrontAccess, bool) {
+	return lookupEnum(AllMetafieldStorefrontAccess, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldStorefrontAccess, the values declared by the schema the models were
+// generated from.
+func (e MetafieldStorefrontAccess) IsKnown() bool {
+	return slices.Contains(AllMetafieldStorefrontAccess, e)
+}
+
+func (e MetafieldStorefrontAccess) GoString() string {
+	return enumGoString(AllMetafieldStorefrontAccess, enumConstants["MetafieldStorefrontAccess"], e, "model.MetafieldStorefrontAccess")
+}
+
+func (e MetafieldStorefrontAccess) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldStorefrontAccess) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldStorefrontAccess")
+}
+
+func (e *MetafieldStorefrontAccess) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldStorefrontAccess")
+}
+
+// LookupMetafieldStorefrontAccessInput returns the value of AllMetafieldStorefrontAccessInput named name.
+func LookupMetafieldStorefrontAccessInput(name string) (MetafieldStorefrontAccessInput, bool) {
+	return lookupEnum(AllMetafieldStorefrontAccessInput, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldStorefrontAccessInput, the values declared by the schema the models were
+// generated from.
+func (e MetafieldStorefrontAccessInput) IsKnown() bool {
+	return slices.Contains(AllMetafieldStorefrontAccessInput, e)
+}
+
+func (e MetafieldStorefrontAccessInput) GoString() string {
+	return enumGoString(AllMetafieldStorefrontAccessInput, enumConstants["MetafieldStorefrontAccessInput"], e, "model.MetafieldStorefrontAccessInput")
+}
+
+func (e MetafieldStorefrontAccessInput) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldStorefrontAccessInput) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldStorefrontAccessInput")
+}
+
+func (e *MetafieldStorefrontAccessInput) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldStorefrontAccessInput")
+}
+
+// LookupMetafieldValidationStatus returns the value of AllMetafieldValidationStatus named name.
+func LookupMetafieldValidationStatus(name string) (MetafieldValidationStatus, bool) {
+	return lookupEnum(AllMetafieldValidationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldValidationStatus, the values declared by the schema the models were
+// generated from.
+func (e MetafieldValidationStatus) IsKnown() bool {
+	return slices.Contains(AllMetafieldValidationStatus, e)
+}
+
+func (e MetafieldValidationStatus) GoString() string {
+	return enumGoString(AllMetafieldValidationStatus, enumConstants["MetafieldValidationStatus"], e, "model.MetafieldValidationStatus")
+}
+
+func (e MetafieldValidationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldValidationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldValidationStatus")
+}
+
+func (e *MetafieldValidationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldValidationStatus")
+}
+
+// LookupMetafieldValueType returns the value of AllMetafieldValueType named name.
+func LookupMetafieldValueType(name string) (MetafieldValueType, bool) {
+	return lookupEnum(AllMetafieldValueType, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldValueType, the values declared by the schema the models were
+// generated from.
+func (e MetafieldValueType) IsKnown() bool {
+	return slices.Contains(AllMetafieldValueType, e)
+}
+
+func (e MetafieldValueType) GoString() string {
+	return enumGoString(AllMetafieldValueType, enumConstants["MetafieldValueType"], e, "model.MetafieldValueType")
+}
+
+func (e MetafieldValueType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldValueType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldValueType")
+}
+
+func (e *MetafieldValueType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldValueType")
+}
+
+// LookupMetafieldsSetUserErrorCode returns the value of AllMetafieldsSetUserErrorCode named name.
+func LookupMetafieldsSetUserErrorCode(name string) (MetafieldsSetUserErrorCode, bool) {
+	return lookupEnum(AllMetafieldsSetUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetafieldsSetUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetafieldsSetUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetafieldsSetUserErrorCode, e)
+}
+
+func (e MetafieldsSetUserErrorCode) GoString() string {
+	return enumGoString(AllMetafieldsSetUserErrorCode, enumConstants["MetafieldsSetUserErrorCode"], e, "model.MetafieldsSetUserErrorCode")
+}
+
+func (e MetafieldsSetUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetafieldsSetUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetafieldsSetUserErrorCode")
+}
+
+func (e *MetafieldsSetUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetafieldsSetUserErrorCode")
+}
+
+// LookupMetaobjectAdminAccess returns the value of AllMetaobjectAdminAccess named name.
+func LookupMetaobjectAdminAccess(name string) (MetaobjectAdminAccess, bool) {
+	return lookupEnum(AllMetaobjectAdminAccess, name)
+}
+
+// IsKnown reports whether e is one of AllMetaobjectAdminAccess, the values declared by the schema the models were
+// generated from.
+func (e MetaobjectAdminAccess) IsKnown() bool {
+	return slices.Contains(AllMetaobjectAdminAccess, e)
+}
+
+func (e MetaobjectAdminAccess) GoString() string {
+	return enumGoString(AllMetaobjectAdminAccess, enumConstants["MetaobjectAdminAccess"], e, "model.MetaobjectAdminAccess")
+}
+
+func (e MetaobjectAdminAccess) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetaobjectAdminAccess) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetaobjectAdminAccess")
+}
+
+func (e *MetaobjectAdminAccess) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetaobjectAdminAccess")
+}
+
+// LookupMetaobjectStatus returns the value of AllMetaobjectStatus named name.
+func LookupMetaobjectStatus(name string) (MetaobjectStatus, bool) {
+	return lookupEnum(AllMetaobjectStatus, name)
+}
+
+// IsKnown reports whether e is one of AllMetaobjectStatus, the values declared by the schema the models were
+// generated from.
+func (e MetaobjectStatus) IsKnown() bool {
+	return slices.Contains(AllMetaobjectStatus, e)
+}
+
+func (e MetaobjectStatus) GoString() string {
+	return enumGoString(AllMetaobjectStatus, enumConstants["MetaobjectStatus"], e, "model.MetaobjectStatus")
+}
+
+func (e MetaobjectStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetaobjectStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetaobjectStatus")
+}
+
+func (e *MetaobjectStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetaobjectStatus")
+}
+
+// LookupMetaobjectStorefrontAccess returns the value of AllMetaobjectStorefrontAccess named name.
+func LookupMetaobjectStorefrontAccess(name string) (MetaobjectStorefrontAccess, bool) {
+	return lookupEnum(AllMetaobjectStorefrontAccess, name)
+}
+
+// IsKnown reports whether e is one of AllMetaobjectStorefrontAccess, the values declared by the schema the models were
+// generated from.
+func (e MetaobjectStorefrontAccess) IsKnown() bool {
+	return slices.Contains(AllMetaobjectStorefrontAccess, e)
+}
+
+func (e MetaobjectStorefrontAccess) GoString() string {
+	return enumGoString(AllMetaobjectStorefrontAccess, enumConstants["MetaobjectStorefrontAccess"], e, "model.MetaobjectStorefrontAccess")
+}
+
+func (e MetaobjectStorefrontAccess) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetaobjectStorefrontAccess) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetaobjectStorefrontAccess")
+}
+
+func (e *MetaobjectStorefrontAccess) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetaobjectStorefrontAccess")
+}
+
+// LookupMetaobjectUserErrorCode returns the value of AllMetaobjectUserErrorCode named name.
+func LookupMetaobjectUserErrorCode(name string) (MetaobjectUserErrorCode, bool) {
+	return lookupEnum(AllMetaobjectUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMetaobjectUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MetaobjectUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMetaobjectUserErrorCode, e)
+}
+
+func (e MetaobjectUserErrorCode) GoString() string {
+	return enumGoString(AllMetaobjectUserErrorCode, enumConstants["MetaobjectUserErrorCode"], e, "model.MetaobjectUserErrorCode")
+}
+
+func (e MetaobjectUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MetaobjectUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MetaobjectUserErrorCode")
+}
+
+func (e *MetaobjectUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MetaobjectUserErrorCode")
+}
+
+// LookupMethodDefinitionSortKeys returns the value of AllMethodDefinitionSortKeys named name.
+func LookupMethodDefinitionSortKeys(name string) (MethodDefinitionSortKeys, bool) {
+	return lookupEnum(AllMethodDefinitionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllMethodDefinitionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e MethodDefinitionSortKeys) IsKnown() bool {
+	return slices.Contains(AllMethodDefinitionSortKeys, e)
+}
+
+func (e MethodDefinitionSortKeys) GoString() string {
+	return enumGoString(AllMethodDefinitionSortKeys, enumConstants["MethodDefinitionSortKeys"], e, "model.MethodDefinitionSortKeys")
+}
+
+func (e MethodDefinitionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MethodDefinitionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MethodDefinitionSortKeys")
+}
+
+func (e *MethodDefinitionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MethodDefinitionSortKeys")
+}
+
+// LookupMobilePlatformApplicationUserErrorCode returns the value of AllMobilePlatformApplicationUserErrorCode named name.
+func LookupMobilePlatformApplicationUserErrorCode(name string) (MobilePlatformApplicationUserErrorCode, bool) {
+	return lookupEnum(AllMobilePlatformApplicationUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllMobilePlatformApplicationUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e MobilePlatformApplicationUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllMobilePlatformApplicationUserErrorCode, e)
+}
+
+func (e MobilePlatformApplicationUserErrorCode) GoString() string {
+	return enumGoString(AllMobilePlatformApplicationUserErrorCode, enumConstants["MobilePlatformApplicationUserErrorCode"], e, "model.MobilePlatformApplicationUserErrorCode")
+}
+
+func (e MobilePlatformApplicationUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *MobilePlatformApplicationUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "MobilePlatformApplicationUserErrorCode")
+}
+
+func (e *MobilePlatformApplicationUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "MobilePlatformApplicationUserErrorCode")
+}
+
+// LookupOnlineStoreThemeFileBodyInputType returns the value of AllOnlineStoreThemeFileBodyInputType named name.
+func LookupOnlineStoreThemeFileBodyInputType(name string) (OnlineStoreThemeFileBodyInputType, bool) {
+	return lookupEnum(AllOnlineStoreThemeFileBodyInputType, name)
+}
+
+// IsKnown reports whether e is one of AllOnlineStoreThemeFileBodyInputType, the values declared by the schema the models were
+// generated from.
+func (e OnlineStoreThemeFileBodyInputType) IsKnown() bool {
+	return slices.Contains(AllOnlineStoreThemeFileBodyInputType, e)
+}
+
+func (e OnlineStoreThemeFileBodyInputType) GoString() string {
+	return enumGoString(AllOnlineStoreThemeFileBodyInputType, enumConstants["OnlineStoreThemeFileBodyInputType"], e, "model.OnlineStoreThemeFileBodyInputType")
+}
+
+func (e OnlineStoreThemeFileBodyInputType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OnlineStoreThemeFileBodyInputType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OnlineStoreThemeFileBodyInputType")
+}
+
+func (e *OnlineStoreThemeFileBodyInputType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OnlineStoreThemeFileBodyInputType")
+}
+
+// LookupOnlineStoreThemeFileResultType returns the value of AllOnlineStoreThemeFileResultType named name.
+func LookupOnlineStoreThemeFileResultType(name string) (OnlineStoreThemeFileResultType, bool) {
+	return lookupEnum(AllOnlineStoreThemeFileResultType, name)
+}
+
+// IsKnown reports whether e is one of AllOnlineStoreThemeFileResultType, the values declared by the schema the models were
+// generated from.
+func (e OnlineStoreThemeFileResultType) IsKnown() bool {
+	return slices.Contains(AllOnlineStoreThemeFileResultType, e)
+}
+
+func (e OnlineStoreThemeFileResultType) GoString() string {
+	return enumGoString(AllOnlineStoreThemeFileResultType, enumConstants["OnlineStoreThemeFileResultType"], e, "model.OnlineStoreThemeFileResultType")
+}
+
+func (e OnlineStoreThemeFileResultType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OnlineStoreThemeFileResultType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OnlineStoreThemeFileResultType")
+}
+
+func (e *OnlineStoreThemeFileResultType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OnlineStoreThemeFileResultType")
+}
+
+// LookupOnlineStoreThemeFilesUserErrorsCode returns the value of AllOnlineStoreThemeFilesUserErrorsCode named name.
+func LookupOnlineStoreThemeFilesUserErrorsCode(name string) (OnlineStoreThemeFilesUserErrorsCode, bool) {
+	return lookupEnum(AllOnlineStoreThemeFilesUserErrorsCode, name)
+}
+
+// IsKnown reports whether e is one of AllOnlineStoreThemeFilesUserErrorsCode, the values declared by the schema the models were
+// generated from.
+func (e OnlineStoreThemeFilesUserErrorsCode) IsKnown() bool {
+	return slices.Contains(AllOnlineStoreThemeFilesUserErrorsCode, e)
+}
+
+func (e OnlineStoreThemeFilesUserErrorsCode) GoString() string {
+	return enumGoString(AllOnlineStoreThemeFilesUserErrorsCode, enumConstants["OnlineStoreThemeFilesUserErrorsCode"], e, "model.OnlineStoreThemeFilesUserErrorsCode")
+}
+
+func (e OnlineStoreThemeFilesUserErrorsCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OnlineStoreThemeFilesUserErrorsCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OnlineStoreThemeFilesUserErrorsCode")
+}
+
+func (e *OnlineStoreThemeFilesUserErrorsCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OnlineStoreThemeFilesUserErrorsCode")
+}
+
+// LookupOrderActionType returns the value of AllOrderActionType named name.
+func LookupOrderActionType(name string) (OrderActionType, bool) {
+	return lookupEnum(AllOrderActionType, name)
+}
+
+// IsKnown reports whether e is one of AllOrderActionType, the values declared by the schema the models were
+// generated from.
+func (e OrderActionType) IsKnown() bool {
+	return slices.Contains(AllOrderActionType, e)
+}
+
+func (e OrderActionType) GoString() string {
+	return enumGoString(AllOrderActionType, enumConstants["OrderActionType"], e, "model.OrderActionType")
+}
+
+func (e OrderActionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderActionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderActionType")
+}
+
+func (e *OrderActionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderActionType")
+}
+
+// LookupOrderAdjustmentDiscrepancyReason returns the value of AllOrderAdjustmentDiscrepancyReason named name.
+func LookupOrderAdjustmentDiscrepancyReason(name string) (OrderAdjustmentDiscrepancyReason, bool) {
+	return lookupEnum(AllOrderAdjustmentDiscrepancyReason, name)
+}
+
+// IsKnown reports whether e is one of AllOrderAdjustmentDiscrepancyReason, the values declared by the schema the models were
+// generated from.
+func (e OrderAdjustmentDiscrepancyReason) IsKnown() bool {
+	return slices.Contains(AllOrderAdjustmentDiscrepancyReason, e)
+}
+
+func (e OrderAdjustmentDiscrepancyReason) GoString() string {
+	return enumGoString(AllOrderAdjustmentDiscrepancyReason, enumConstants["OrderAdjustmentDiscrepancyReason"], e, "model.OrderAdjustmentDiscrepancyReason")
+}
+
+func (e OrderAdjustmentDiscrepancyReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderAdjustmentDiscrepancyReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderAdjustmentDiscrepancyReason")
+}
+
+func (e *OrderAdjustmentDiscrepancyReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderAdjustmentDiscrepancyReason")
+}
+
+// LookupOrderAdjustmentInputDiscrepancyReason returns the value of AllOrderAdjustmentInputDiscrepancyReason named name.
+func LookupOrderAdjustmentInputDiscrepancyReason(name string) (OrderAdjustmentInputDiscrepancyReason, bool) {
+	return lookupEnum(AllOrderAdjustmentInputDiscrepancyReason, name)
+}
+
+// IsKnown reports whether e is one of AllOrderAdjustmentInputDiscrepancyReason, the values declared by the schema the models were
+// generated from.
+func (e OrderAdjustmentInputDiscrepancyReason) IsKnown() bool {
+	return slices.Contains(AllOrderAdjustmentInputDiscrepancyReason, e)
+}
+
+func (e OrderAdjustmentInputDiscrepancyReason) GoString() string {
+	return enumGoString(AllOrderAdjustmentInputDiscrepancyReason, enumConstants["OrderAdjustmentInputDiscrepancyReason"], e, "model.OrderAdjustmentInputDiscrepancyReason")
+}
+
+func (e OrderAdjustmentInputDiscrepancyReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderAdjustmentInputDiscrepancyReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderAdjustmentInputDiscrepancyReason")
+}
+
+func (e *OrderAdjustmentInputDiscrepancyReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderAdjustmentInputDiscrepancyReason")
+}
+
+// LookupOrderCancelReason returns the value of AllOrderCancelReason named name.
+func LookupOrderCancelReason(name string) (OrderCancelReason, bool) {
+	return lookupEnum(AllOrderCancelReason, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCancelReason, the values declared by the schema the models were
+// generated from.
+func (e OrderCancelReason) IsKnown() bool {
+	return slices.Contains(AllOrderCancelReason, e)
+}
+
+func (e OrderCancelReason) GoString() string {
+	return enumGoString(AllOrderCancelReason, enumConstants["OrderCancelReason"], e, "model.OrderCancelReason")
+}
+
+func (e OrderCancelReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCancelReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCancelReason")
+}
+
+func (e *OrderCancelReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCancelReason")
+}
+
+// LookupOrderCancelUserErrorCode returns the value of AllOrderCancelUserErrorCode named name.
+func LookupOrderCancelUserErrorCode(name string) (OrderCancelUserErrorCode, bool) {
+	return lookupEnum(AllOrderCancelUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCancelUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderCancelUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderCancelUserErrorCode, e)
+}
+
+func (e OrderCancelUserErrorCode) GoString() string {
+	return enumGoString(AllOrderCancelUserErrorCode, enumConstants["OrderCancelUserErrorCode"], e, "model.OrderCancelUserErrorCode")
+}
+
+func (e OrderCancelUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCancelUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCancelUserErrorCode")
+}
+
+func (e *OrderCancelUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCancelUserErrorCode")
+}
+
+// LookupOrderCreateFinancialStatus returns the value of AllOrderCreateFinancialStatus named name.
+func LookupOrderCreateFinancialStatus(name string) (OrderCreateFinancialStatus, bool) {
+	return lookupEnum(AllOrderCreateFinancialStatus, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCreateFinancialStatus, the values declared by the schema the models were
+// generated from.
+func (e OrderCreateFinancialStatus) IsKnown() bool {
+	return slices.Contains(AllOrderCreateFinancialStatus, e)
+}
+
+func (e OrderCreateFinancialStatus) GoString() string {
+	return enumGoString(AllOrderCreateFinancialStatus, enumConstants["OrderCreateFinancialStatus"], e, "model.OrderCreateFinancialStatus")
+}
+
+func (e OrderCreateFinancialStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCreateFinancialStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCreateFinancialStatus")
+}
+
+func (e *OrderCreateFinancialStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCreateFinancialStatus")
+}
+
+// LookupOrderCreateFulfillmentStatus returns the value of AllOrderCreateFulfillmentStatus named name.
+func LookupOrderCreateFulfillmentStatus(name string) (OrderCreateFulfillmentStatus, bool) {
+	return lookupEnum(AllOrderCreateFulfillmentStatus, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCreateFulfillmentStatus, the values declared by the schema the models were
+// generated from.
+func (e OrderCreateFulfillmentStatus) IsKnown() bool {
+	return slices.Contains(AllOrderCreateFulfillmentStatus, e)
+}
+
+func (e OrderCreateFulfillmentStatus) GoString() string {
+	return enumGoString(AllOrderCreateFulfillmentStatus, enumConstants["OrderCreateFulfillmentStatus"], e, "model.OrderCreateFulfillmentStatus")
+}
+
+func (e OrderCreateFulfillmentStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCreateFulfillmentStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCreateFulfillmentStatus")
+}
+
+func (e *OrderCreateFulfillmentStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCreateFulfillmentStatus")
+}
+
+// LookupOrderCreateInputsInventoryBehavior returns the value of AllOrderCreateInputsInventoryBehavior named name.
+func LookupOrderCreateInputsInventoryBehavior(name string) (OrderCreateInputsInventoryBehavior, bool) {
+	return lookupEnum(AllOrderCreateInputsInventoryBehavior, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCreateInputsInventoryBehavior, the values declared by the schema the models were
+// generated from.
+func (e OrderCreateInputsInventoryBehavior) IsKnown() bool {
+	return slices.Contains(AllOrderCreateInputsInventoryBehavior, e)
+}
+
+func (e OrderCreateInputsInventoryBehavior) GoString() string {
+	return enumGoString(AllOrderCreateInputsInventoryBehavior, enumConstants["OrderCreateInputsInventoryBehavior"], e, "model.OrderCreateInputsInventoryBehavior")
+}
+
+func (e OrderCreateInputsInventoryBehavior) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCreateInputsInventoryBehavior) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCreateInputsInventoryBehavior")
+}
+
+func (e *OrderCreateInputsInventoryBehavior) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCreateInputsInventoryBehavior")
+}
+
+// LookupOrderCreateMandatePaymentUserErrorCode returns the value of AllOrderCreateMandatePaymentUserErrorCode named name.
+func LookupOrderCreateMandatePaymentUserErrorCode(name string) (OrderCreateMandatePaymentUserErrorCode, bool) {
+	return lookupEnum(AllOrderCreateMandatePaymentUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCreateMandatePaymentUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderCreateMandatePaymentUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderCreateMandatePaymentUserErrorCode, e)
+}
+
+func (e OrderCreateMandatePaymentUserErrorCode) GoString() string {
+	return enumGoString(AllOrderCreateMandatePaymentUserErrorCode, enumConstants["OrderCreateMandatePaymentUserErrorCode"], e, "model.OrderCreateMandatePaymentUserErrorCode")
+}
+
+func (e OrderCreateMandatePaymentUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCreateMandatePaymentUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCreateMandatePaymentUserErrorCode")
+}
+
+func (e *OrderCreateMandatePaymentUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCreateMandatePaymentUserErrorCode")
+}
+
+// LookupOrderCreateUserErrorCode returns the value of AllOrderCreateUserErrorCode named name.
+func LookupOrderCreateUserErrorCode(name string) (OrderCreateUserErrorCode, bool) {
+	return lookupEnum(AllOrderCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderCreateUserErrorCode, e)
+}
+
+func (e OrderCreateUserErrorCode) GoString() string {
+	return enumGoString(AllOrderCreateUserErrorCode, enumConstants["OrderCreateUserErrorCode"], e, "model.OrderCreateUserErrorCode")
+}
+
+func (e OrderCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderCreateUserErrorCode")
+}
+
+func (e *OrderCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderCreateUserErrorCode")
+}
+
+// LookupOrderDeleteUserErrorCode returns the value of AllOrderDeleteUserErrorCode named name.
+func LookupOrderDeleteUserErrorCode(name string) (OrderDeleteUserErrorCode, bool) {
+	return lookupEnum(AllOrderDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderDeleteUserErrorCode, e)
+}
+
+func (e OrderDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllOrderDeleteUserErrorCode, enumConstants["OrderDeleteUserErrorCode"], e, "model.OrderDeleteUserErrorCode")
+}
+
+func (e OrderDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderDeleteUserErrorCode")
+}
+
+func (e *OrderDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderDeleteUserErrorCode")
+}
+
+// LookupOrderDisplayFinancialStatus returns the value of AllOrderDisplayFinancialStatus named name.
+func LookupOrderDisplayFinancialStatus(name string) (OrderDisplayFinancialStatus, bool) {
+	return lookupEnum(AllOrderDisplayFinancialStatus, name)
+}
+
+// IsKnown reports whether e is one of AllOrderDisplayFinancialStatus, the values declared by the schema the models were
+// generated from.
+func (e OrderDisplayFinancialStatus) IsKnown() bool {
+	return slices.Contains(AllOrderDisplayFinancialStatus, e)
+}
+
+func (e OrderDisplayFinancialStatus) GoString() string {
+	return enumGoString(AllOrderDisplayFinancialStatus, enumConstants["OrderDisplayFinancialStatus"], e, "model.OrderDisplayFinancialStatus")
+}
+
+func (e OrderDisplayFinancialStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderDisplayFinancialStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderDisplayFinancialStatus")
+}
+
+func (e *OrderDisplayFinancialStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderDisplayFinancialStatus")
+}
+
+// LookupOrderDisplayFulfillmentStatus returns the value of AllOrderDisplayFulfillmentStatus named name.
+func LookupOrderDisplayFulfillmentStatus(name string) (OrderDisplayFulfillmentStatus, bool) {
+	return lookupEnum(AllOrderDisplayFulfillmentStatus, name)
+}
+
+// IsKnown reports whether e is one of AllOrderDisplayFulfillmentStatus, the values declared by the schema the models were
+// generated from.
+func (e OrderDisplayFulfillmentStatus) IsKnown() bool {
+	return slices.Contains(AllOrderDisplayFulfillmentStatus, e)
+}
+
+func (e OrderDisplayFulfillmentStatus) GoString() string {
+	return enumGoString(AllOrderDisplayFulfillmentStatus, enumConstants["OrderDisplayFulfillmentStatus"], e, "model.OrderDisplayFulfillmentStatus")
+}
+
+func (e OrderDisplayFulfillmentStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderDisplayFulfillmentStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderDisplayFulfillmentStatus")
+}
+
+func (e *OrderDisplayFulfillmentStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderDisplayFulfillmentStatus")
+}
+
+// LookupOrderEditAddShippingLineUserErrorCode returns the value of AllOrderEditAddShippingLineUserErrorCode named name.
+func LookupOrderEditAddShippingLineUserErrorCode(name string) (OrderEditAddShippingLineUserErrorCode, bool) {
+	return lookupEnum(AllOrderEditAddShippingLineUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderEditAddShippingLineUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderEditAddShippingLineUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderEditAddShippingLineUserErrorCode, e)
+}
+
+func (e OrderEditAddShippingLineUserErrorCode) GoString() string {
+	return enumGoString(AllOrderEditAddShippingLineUserErrorCode, enumConstants["OrderEditAddShippingLineUserErrorCode"], e, "model.OrderEditAddShippingLineUserErrorCode")
+}
+
+func (e OrderEditAddShippingLineUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderEditAddShippingLineUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderEditAddShippingLineUserErrorCode")
+}
+
+func (e *OrderEditAddShippingLineUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderEditAddShippingLineUserErrorCode")
+}
+
+// LookupOrderEditRemoveDiscountUserErrorCode returns the value of AllOrderEditRemoveDiscountUserErrorCode named name.
+func LookupOrderEditRemoveDiscountUserErrorCode(name string) (OrderEditRemoveDiscountUserErrorCode, bool) {
+	return lookupEnum(AllOrderEditRemoveDiscountUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderEditRemoveDiscountUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderEditRemoveDiscountUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderEditRemoveDiscountUserErrorCode, e)
+}
+
+func (e OrderEditRemoveDiscountUserErrorCode) GoString() string {
+	return enumGoString(AllOrderEditRemoveDiscountUserErrorCode, enumConstants["OrderEditRemoveDiscountUserErrorCode"], e, "model.OrderEditRemoveDiscountUserErrorCode")
+}
+
+func (e OrderEditRemoveDiscountUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderEditRemoveDiscountUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderEditRemoveDiscountUserErrorCode")
+}
+
+func (e *OrderEditRemoveDiscountUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderEditRemoveDiscountUserErrorCode")
+}
+
+// LookupOrderEditRemoveShippingLineUserErrorCode returns the value of AllOrderEditRemoveShippingLineUserErrorCode named name.
+func LookupOrderEditRemoveShippingLineUserErrorCode(name string) (OrderEditRemoveShippingLineUserErrorCode, bool) {
+	return lookupEnum(AllOrderEditRemoveShippingLineUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderEditRemoveShippingLineUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderEditRemoveShippingLineUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderEditRemoveShippingLineUserErrorCode, e)
+}
+
+func (e OrderEditRemoveShippingLineUserErrorCode) GoString() string {
+	return enumGoString(AllOrderEditRemoveShippingLineUserErrorCode, enumConstants["OrderEditRemoveShippingLineUserErrorCode"], e, "model.OrderEditRemoveShippingLineUserErrorCode")
+}
+
+func (e OrderEditRemoveShippingLineUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderEditRemoveShippingLineUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderEditRemoveShippingLineUserErrorCode")
+}
+
+func (e *OrderEditRemoveShippingLineUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderEditRemoveShippingLineUserErrorCode")
+}
+
+// LookupOrderEditUpdateDiscountUserErrorCode returns the value of AllOrderEditUpdateDiscountUserErrorCode named name.
+func LookupOrderEditUpdateDiscountUserErrorCode(name string) (OrderEditUpdateDiscountUserErrorCode, bool) {
+	return lookupEnum(AllOrderEditUpdateDiscountUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderEditUpdateDiscountUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderEditUpdateDiscountUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderEditUpdateDiscountUserErrorCode, e)
+}
+
+func (e OrderEditUpdateDiscountUserErrorCode) GoString() string {
+	return enumGoString(AllOrderEditUpdateDiscountUserErrorCode, enumConstants["OrderEditUpdateDiscountUserErrorCode"], e, "model.OrderEditUpdateDiscountUserErrorCode")
+}
+
+func (e OrderEditUpdateDiscountUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderEditUpdateDiscountUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderEditUpdateDiscountUserErrorCode")
+}
+
+func (e *OrderEditUpdateDiscountUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderEditUpdateDiscountUserErrorCode")
+}
+
+// LookupOrderEditUpdateShippingLineUserErrorCode returns the value of AllOrderEditUpdateShippingLineUserErrorCode named name.
+func LookupOrderEditUpdateShippingLineUserErrorCode(name string) (OrderEditUpdateShippingLineUserErrorCode, bool) {
+	return lookupEnum(AllOrderEditUpdateShippingLineUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderEditUpdateShippingLineUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderEditUpdateShippingLineUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderEditUpdateShippingLineUserErrorCode, e)
+}
+
+func (e OrderEditUpdateShippingLineUserErrorCode) GoString() string {
+	return enumGoString(AllOrderEditUpdateShippingLineUserErrorCode, enumConstants["OrderEditUpdateShippingLineUserErrorCode"], e, "model.OrderEditUpdateShippingLineUserErrorCode")
+}
+
+func (e OrderEditUpdateShippingLineUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderEditUpdateShippingLineUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderEditUpdateShippingLineUserErrorCode")
+}
+
+func (e *OrderEditUpdateShippingLineUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderEditUpdateShippingLineUserErrorCode")
+}
+
+// LookupOrderInvoiceSendUserErrorCode returns the value of AllOrderInvoiceSendUserErrorCode named name.
+func LookupOrderInvoiceSendUserErrorCode(name string) (OrderInvoiceSendUserErrorCode, bool) {
+	return lookupEnum(AllOrderInvoiceSendUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderInvoiceSendUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderInvoiceSendUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderInvoiceSendUserErrorCode, e)
+}
+
+func (e OrderInvoiceSendUserErrorCode) GoString() string {
+	return enumGoString(AllOrderInvoiceSendUserErrorCode, enumConstants["OrderInvoiceSendUserErrorCode"], e, "model.OrderInvoiceSendUserErrorCode")
+}
+
+func (e OrderInvoiceSendUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderInvoiceSendUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderInvoiceSendUserErrorCode")
+}
+
+func (e *OrderInvoiceSendUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderInvoiceSendUserErrorCode")
+}
+
+// LookupOrderPaymentStatusResult returns the value of AllOrderPaymentStatusResult named name.
+func LookupOrderPaymentStatusResult(name string) (OrderPaymentStatusResult, bool) {
+	return lookupEnum(AllOrderPaymentStatusResult, name)
+}
+
+// IsKnown reports whether e is one of AllOrderPaymentStatusResult, the values declared by the schema the models were
+// generated from.
+func (e OrderPaymentStatusResult) IsKnown() bool {
+	return slices.Contains(AllOrderPaymentStatusResult, e)
+}
+
+func (e OrderPaymentStatusResult) GoString() string {
+	return enumGoString(AllOrderPaymentStatusResult, enumConstants["OrderPaymentStatusResult"], e, "model.OrderPaymentStatusResult")
+}
+
+func (e OrderPaymentStatusResult) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderPaymentStatusResult) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderPaymentStatusResult")
+}
+
+func (e *OrderPaymentStatusResult) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderPaymentStatusResult")
+}
+
+// LookupOrderReturnStatus returns the value of AllOrderReturnStatus named name.
+func LookupOrderReturnStatus(name string) (OrderReturnStatus, bool) {
+	return lookupEnum(AllOrderReturnStatus, name)
+}
+
+// IsKnown reports whether e is one of AllOrderReturnStatus, the values declared by the schema the models were
+// generated from.
+func (e OrderReturnStatus) IsKnown() bool {
+	return slices.Contains(AllOrderReturnStatus, e)
+}
+
+func (e OrderReturnStatus) GoString() string {
+	return enumGoString(AllOrderReturnStatus, enumConstants["OrderReturnStatus"], e, "model.OrderReturnStatus")
+}
+
+func (e OrderReturnStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderReturnStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderReturnStatus")
+}
+
+func (e *OrderReturnStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderReturnStatus")
+}
+
+// LookupOrderRiskAssessmentCreateUserErrorCode returns the value of AllOrderRiskAssessmentCreateUserErrorCode named name.
+func LookupOrderRiskAssessmentCreateUserErrorCode(name string) (OrderRiskAssessmentCreateUserErrorCode, bool) {
+	return lookupEnum(AllOrderRiskAssessmentCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderRiskAssessmentCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderRiskAssessmentCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderRiskAssessmentCreateUserErrorCode, e)
+}
+
+func (e OrderRiskAssessmentCreateUserErrorCode) GoString() string {
+	return enumGoString(AllOrderRiskAssessmentCreateUserErrorCode, enumConstants["OrderRiskAssessmentCreateUserErrorCode"], e, "model.OrderRiskAssessmentCreateUserErrorCode")
+}
+
+func (e OrderRiskAssessmentCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderRiskAssessmentCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderRiskAssessmentCreateUserErrorCode")
+}
+
+func (e *OrderRiskAssessmentCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderRiskAssessmentCreateUserErrorCode")
+}
+
+// LookupOrderRiskLevel returns the value of AllOrderRiskLevel named name.
+func LookupOrderRiskLevel(name string) (OrderRiskLevel, bool) {
+	return lookupEnum(AllOrderRiskLevel, name)
+}
+
+// IsKnown reports whether e is one of AllOrderRiskLevel, the values declared by the schema the models were
+// generated from.
+func (e OrderRiskLevel) IsKnown() bool {
+	return slices.Contains(AllOrderRiskLevel, e)
+}
+
+func (e OrderRiskLevel) GoString() string {
+	return enumGoString(AllOrderRiskLevel, enumConstants["OrderRiskLevel"], e, "model.OrderRiskLevel")
+}
+
+func (e OrderRiskLevel) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderRiskLevel) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderRiskLevel")
+}
+
+func (e *OrderRiskLevel) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderRiskLevel")
+}
+
+// LookupOrderRiskRecommendationResult returns the value of AllOrderRiskRecommendationResult named name.
+func LookupOrderRiskRecommendationResult(name string) (OrderRiskRecommendationResult, bool) {
+	return lookupEnum(AllOrderRiskRecommendationResult, name)
+}
+
+// IsKnown reports whether e is one of AllOrderRiskRecommendationResult, the values declared by the schema the models were
+// generated from.
+func (e OrderRiskRecommendationResult) IsKnown() bool {
+	return slices.Contains(AllOrderRiskRecommendationResult, e)
+}
+
+func (e OrderRiskRecommendationResult) GoString() string {
+	return enumGoString(AllOrderRiskRecommendationResult, enumConstants["OrderRiskRecommendationResult"], e, "model.OrderRiskRecommendationResult")
+}
+
+func (e OrderRiskRecommendationResult) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderRiskRecommendationResult) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderRiskRecommendationResult")
+}
+
+func (e *OrderRiskRecommendationResult) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderRiskRecommendationResult")
+}
+
+// LookupOrderSortKeys returns the value of AllOrderSortKeys named name.
+func LookupOrderSortKeys(name string) (OrderSortKeys, bool) {
+	return lookupEnum(AllOrderSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllOrderSortKeys, the values declared by the schema the models were
+// generated from.
+func (e OrderSortKeys) IsKnown() bool {
+	return slices.Contains(AllOrderSortKeys, e)
+}
+
+func (e OrderSortKeys) GoString() string {
+	return enumGoString(AllOrderSortKeys, enumConstants["OrderSortKeys"], e, "model.OrderSortKeys")
+}
+
+func (e OrderSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderSortKeys")
+}
+
+func (e *OrderSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderSortKeys")
+}
+
+// LookupOrderTransactionErrorCode returns the value of AllOrderTransactionErrorCode named name.
+func LookupOrderTransactionErrorCode(name string) (OrderTransactionErrorCode, bool) {
+	return lookupEnum(AllOrderTransactionErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllOrderTransactionErrorCode, the values declared by the schema the models were
+// generated from.
+func (e OrderTransactionErrorCode) IsKnown() bool {
+	return slices.Contains(AllOrderTransactionErrorCode, e)
+}
+
+func (e OrderTransactionErrorCode) GoString() string {
+	return enumGoString(AllOrderTransactionErrorCode, enumConstants["OrderTransactionErrorCode"], e, "model.OrderTransactionErrorCode")
+}
+
+func (e OrderTransactionErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderTransactionErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderTransactionErrorCode")
+}
+
+func (e *OrderTransactionErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderTransactionErrorCode")
+}
+
+// LookupOrderTransactionKind returns the value of AllOrderTransactionKind named name.
+func LookupOrderTransactionKind(name string) (OrderTransactionKind, bool) {
+	return lookupEnum(AllOrderTransactionKind, name)
+}
+
+// IsKnown reports whether e is one of AllOrderTransactionKind, the values declared by the schema the models were
+// generated from.
+func (e OrderTransactionKind) IsKnown() bool {
+	return slices.Contains(AllOrderTransactionKind, e)
+}
+
+func (e OrderTransactionKind) GoString() string {
+	return enumGoString(AllOrderTransactionKind, enumConstants["OrderTransactionKind"], e, "model.OrderTransactionKind")
+}
+
+func (e OrderTransactionKind) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderTransactionKind) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderTransactionKind")
+}
+
+func (e *OrderTransactionKind) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderTransactionKind")
+}
+
+// LookupOrderTransactionStatus returns the value of AllOrderTransactionStatus named name.
+func LookupOrderTransactionStatus(name string) (OrderTransactionStatus, bool) {
+	return lookupEnum(AllOrderTransactionStatus, name)
+}
+
+// IsKnown reports whether e is one of AllOrderTransactionStatus, the values declared by the schema the models were
+// generated from.
+func (e OrderTransactionStatus) IsKnown() bool {
+	return slices.Contains(AllOrderTransactionStatus, e)
+}
+
+func (e OrderTransactionStatus) GoString() string {
+	return enumGoString(AllOrderTransactionStatus, enumConstants["OrderTransactionStatus"], e, "model.OrderTransactionStatus")
+}
+
+func (e OrderTransactionStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *OrderTransactionStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "OrderTransactionStatus")
+}
+
+func (e *OrderTransactionStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "OrderTransactionStatus")
+}
+
+// LookupPageCreateUserErrorCode returns the value of AllPageCreateUserErrorCode named name.
+func LookupPageCreateUserErrorCode(name string) (PageCreateUserErrorCode, bool) {
+	return lookupEnum(AllPageCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPageCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PageCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPageCreateUserErrorCode, e)
+}
+
+func (e PageCreateUserErrorCode) GoString() string {
+	return enumGoString(AllPageCreateUserErrorCode, enumConstants["PageCreateUserErrorCode"], e, "model.PageCreateUserErrorCode")
+}
+
+func (e PageCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PageCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PageCreateUserErrorCode")
+}
+
+func (e *PageCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PageCreateUserErrorCode")
+}
+
+// LookupPageDeleteUserErrorCode returns the value of AllPageDeleteUserErrorCode named name.
+func LookupPageDeleteUserErrorCode(name string) (PageDeleteUserErrorCode, bool) {
+	return lookupEnum(AllPageDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPageDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PageDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPageDeleteUserErrorCode, e)
+}
+
+func (e PageDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllPageDeleteUserErrorCode, enumConstants["PageDeleteUserErrorCode"], e, "model.PageDeleteUserErrorCode")
+}
+
+func (e PageDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PageDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PageDeleteUserErrorCode")
+}
+
+func (e *PageDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PageDeleteUserErrorCode")
+}
+
+// LookupPageUpdateUserErrorCode returns the value of AllPageUpdateUserErrorCode named name.
+func LookupPageUpdateUserErrorCode(name string) (PageUpdateUserErrorCode, bool) {
+	return lookupEnum(AllPageUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPageUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PageUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPageUpdateUserErrorCode, e)
+}
+
+func (e PageUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllPageUpdateUserErrorCode, enumConstants["PageUpdateUserErrorCode"], e, "model.PageUpdateUserErrorCode")
+}
+
+func (e PageUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PageUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PageUpdateUserErrorCode")
+}
+
+func (e *PageUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PageUpdateUserErrorCode")
+}
+
+// LookupPaymentCustomizationErrorCode returns the value of AllPaymentCustomizationErrorCode named name.
+func LookupPaymentCustomizationErrorCode(name string) (PaymentCustomizationErrorCode, bool) {
+	return lookupEnum(AllPaymentCustomizationErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentCustomizationErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PaymentCustomizationErrorCode) IsKnown() bool {
+	return slices.Contains(AllPaymentCustomizationErrorCode, e)
+}
+
+func (e PaymentCustomizationErrorCode) GoString() string {
+	return enumGoString(AllPaymentCustomizationErrorCode, enumConstants["PaymentCustomizationErrorCode"], e, "model.PaymentCustomizationErrorCode")
+}
+
+func (e PaymentCustomizationErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentCustomizationErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentCustomizationErrorCode")
+}
+
+func (e *PaymentCustomizationErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentCustomizationErrorCode")
+}
+
+// LookupPaymentMethods returns the value of AllPaymentMethods named name.
+func LookupPaymentMethods(name string) (PaymentMethods, bool) {
+	return lookupEnum(AllPaymentMethods, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentMethods, the values declared by the schema the models were
+// generated from.
+func (e PaymentMethods) IsKnown() bool {
+	return slices.Contains(AllPaymentMethods, e)
+}
+
+func (e PaymentMethods) GoString() string {
+	return enumGoString(AllPaymentMethods, enumConstants["PaymentMethods"], e, "model.PaymentMethods")
+}
+
+func (e PaymentMethods) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentMethods) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentMethods")
+}
+
+func (e *PaymentMethods) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentMethods")
+}
+
+// LookupPaymentReminderSendUserErrorCode returns the value of AllPaymentReminderSendUserErrorCode named name.
+func LookupPaymentReminderSendUserErrorCode(name string) (PaymentReminderSendUserErrorCode, bool) {
+	return lookupEnum(AllPaymentReminderSendUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentReminderSendUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PaymentReminderSendUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPaymentReminderSendUserErrorCode, e)
+}
+
+func (e PaymentReminderSendUserErrorCode) GoString() string {
+	return enumGoString(AllPaymentReminderSendUserErrorCode, enumConstants["PaymentReminderSendUserErrorCode"], e, "model.PaymentReminderSendUserErrorCode")
+}
+
+func (e PaymentReminderSendUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentReminderSendUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentReminderSendUserErrorCode")
+}
+
+func (e *PaymentReminderSendUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentReminderSendUserErrorCode")
+}
+
+// LookupPaymentTermsCreateUserErrorCode returns the value of AllPaymentTermsCreateUserErrorCode named name.
+func LookupPaymentTermsCreateUserErrorCode(name string) (PaymentTermsCreateUserErrorCode, bool) {
+	return lookupEnum(AllPaymentTermsCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentTermsCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PaymentTermsCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPaymentTermsCreateUserErrorCode, e)
+}
+
+func (e PaymentTermsCreateUserErrorCode) GoString() string {
+	return enumGoString(AllPaymentTermsCreateUserErrorCode, enumConstants["PaymentTermsCreateUserErrorCode"], e, "model.PaymentTermsCreateUserErrorCode")
+}
+
+func (e PaymentTermsCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentTermsCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentTermsCreateUserErrorCode")
+}
+
+func (e *PaymentTermsCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentTermsCreateUserErrorCode")
+}
+
+// LookupPaymentTermsDeleteUserErrorCode returns the value of AllPaymentTermsDeleteUserErrorCode named name.
+func LookupPaymentTermsDeleteUserErrorCode(name string) (PaymentTermsDeleteUserErrorCode, bool) {
+	return lookupEnum(AllPaymentTermsDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentTermsDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PaymentTermsDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPaymentTermsDeleteUserErrorCode, e)
+}
+
+func (e PaymentTermsDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllPaymentTermsDeleteUserErrorCode, enumConstants["PaymentTermsDeleteUserErrorCode"], e, "model.PaymentTermsDeleteUserErrorCode")
+}
+
+func (e PaymentTermsDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentTermsDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentTermsDeleteUserErrorCode")
+}
+
+func (e *PaymentTermsDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentTermsDeleteUserErrorCode")
+}
+
+// LookupPaymentTermsType returns the value of AllPaymentTermsType named name.
+func LookupPaymentTermsType(name string) (PaymentTermsType, bool) {
+	return lookupEnum(AllPaymentTermsType, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentTermsType, the values declared by the schema the models were
+// generated from.
+func (e PaymentTermsType) IsKnown() bool {
+	return slices.Contains(AllPaymentTermsType, e)
+}
+
+func (e PaymentTermsType) GoString() string {
+	return enumGoString(AllPaymentTermsType, enumConstants["PaymentTermsType"], e, "model.PaymentTermsType")
+}
+
+func (e PaymentTermsType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentTermsType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentTermsType")
+}
+
+func (e *PaymentTermsType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentTermsType")
+}
+
+// LookupPaymentTermsUpdateUserErrorCode returns the value of AllPaymentTermsUpdateUserErrorCode named name.
+func LookupPaymentTermsUpdateUserErrorCode(name string) (PaymentTermsUpdateUserErrorCode, bool) {
+	return lookupEnum(AllPaymentTermsUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPaymentTermsUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PaymentTermsUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPaymentTermsUpdateUserErrorCode, e)
+}
+
+func (e PaymentTermsUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllPaymentTermsUpdateUserErrorCode, enumConstants["PaymentTermsUpdateUserErrorCode"], e, "model.PaymentTermsUpdateUserErrorCode")
+}
+
+func (e PaymentTermsUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaymentTermsUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaymentTermsUpdateUserErrorCode")
+}
+
+func (e *PaymentTermsUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaymentTermsUpdateUserErrorCode")
+}
+
+// LookupPayoutSortKeys returns the value of AllPayoutSortKeys named name.
+func LookupPayoutSortKeys(name string) (PayoutSortKeys, bool) {
+	return lookupEnum(AllPayoutSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllPayoutSortKeys, the values declared by the schema the models were
+// generated from.
+func (e PayoutSortKeys) IsKnown() bool {
+	return slices.Contains(AllPayoutSortKeys, e)
+}
+
+func (e PayoutSortKeys) GoString() string {
+	return enumGoString(AllPayoutSortKeys, enumConstants["PayoutSortKeys"], e, "model.PayoutSortKeys")
+}
+
+func (e PayoutSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PayoutSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PayoutSortKeys")
+}
+
+func (e *PayoutSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PayoutSortKeys")
+}
+
+// LookupPaypalExpressSubscriptionsGatewayStatus returns the value of AllPaypalExpressSubscriptionsGatewayStatus named name.
+func LookupPaypalExpressSubscriptionsGatewayStatus(name string) (PaypalExpressSubscriptionsGatewayStatus, bool) {
+	return lookupEnum(AllPaypalExpressSubscriptionsGatewayStatus, name)
+}
+
+// IsKnown reports whether e is one of AllPaypalExpressSubscriptionsGatewayStatus, the values declared by the schema the models were
+// generated from.
+func (e PaypalExpressSubscriptionsGatewayStatus) IsKnown() bool {
+	return slices.Contains(AllPaypalExpressSubscriptionsGatewayStatus, e)
+}
+
+func (e PaypalExpressSubscriptionsGatewayStatus) GoString() string {
+	return enumGoString(AllPaypalExpressSubscriptionsGatewayStatus, enumConstants["PaypalExpressSubscriptionsGatewayStatus"], e, "model.PaypalExpressSubscriptionsGatewayStatus")
+}
+
+func (e PaypalExpressSubscriptionsGatewayStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PaypalExpressSubscriptionsGatewayStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PaypalExpressSubscriptionsGatewayStatus")
+}
+
+func (e *PaypalExpressSubscriptionsGatewayStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PaypalExpressSubscriptionsGatewayStatus")
+}
+
+// LookupPriceCalculationType returns the value of AllPriceCalculationType named name.
+func LookupPriceCalculationType(name string) (PriceCalculationType, bool) {
+	return lookupEnum(AllPriceCalculationType, name)
+}
+
+// IsKnown reports whether e is one of AllPriceCalculationType, the values declared by the schema the models were
+// generated from.
+func (e PriceCalculationType) IsKnown() bool {
+	return slices.Contains(AllPriceCalculationType, e)
+}
+
+func (e PriceCalculationType) GoString() string {
+	return enumGoString(AllPriceCalculationType, enumConstants["PriceCalculationType"], e, "model.PriceCalculationType")
+}
+
+func (e PriceCalculationType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceCalculationType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceCalculationType")
+}
+
+func (e *PriceCalculationType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceCalculationType")
+}
+
+// LookupPriceListAdjustmentType returns the value of AllPriceListAdjustmentType named name.
+func LookupPriceListAdjustmentType(name string) (PriceListAdjustmentType, bool) {
+	return lookupEnum(AllPriceListAdjustmentType, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListAdjustmentType, the values declared by the schema the models were
+// generated from.
+func (e PriceListAdjustmentType) IsKnown() bool {
+	return slices.Contains(AllPriceListAdjustmentType, e)
+}
+
+func (e PriceListAdjustmentType) GoString() string {
+	return enumGoString(AllPriceListAdjustmentType, enumConstants["PriceListAdjustmentType"], e, "model.PriceListAdjustmentType")
+}
+
+func (e PriceListAdjustmentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListAdjustmentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListAdjustmentType")
+}
+
+func (e *PriceListAdjustmentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListAdjustmentType")
+}
+
+// LookupPriceListCompareAtMode returns the value of AllPriceListCompareAtMode named name.
+func LookupPriceListCompareAtMode(name string) (PriceListCompareAtMode, bool) {
+	return lookupEnum(AllPriceListCompareAtMode, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListCompareAtMode, the values declared by the schema the models were
+// generated from.
+func (e PriceListCompareAtMode) IsKnown() bool {
+	return slices.Contains(AllPriceListCompareAtMode, e)
+}
+
+func (e PriceListCompareAtMode) GoString() string {
+	return enumGoString(AllPriceListCompareAtMode, enumConstants["PriceListCompareAtMode"], e, "model.PriceListCompareAtMode")
+}
+
+func (e PriceListCompareAtMode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListCompareAtMode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListCompareAtMode")
+}
+
+func (e *PriceListCompareAtMode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListCompareAtMode")
+}
+
+// LookupPriceListFixedPricesByProductBulkUpdateUserErrorCode returns the value of AllPriceListFixedPricesByProductBulkUpdateUserErrorCode named name.
+func LookupPriceListFixedPricesByProductBulkUpdateUserErrorCode(name string) (PriceListFixedPricesByProductBulkUpdateUserErrorCode, bool) {
+	return lookupEnum(AllPriceListFixedPricesByProductBulkUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListFixedPricesByProductBulkUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PriceListFixedPricesByProductBulkUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPriceListFixedPricesByProductBulkUpdateUserErrorCode, e)
+}
+
+func (e PriceListFixedPricesByProductBulkUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllPriceListFixedPricesByProductBulkUpdateUserErrorCode, enumConstants["PriceListFixedPricesByProductBulkUpdateUserErrorCode"], e, "model.PriceListFixedPricesByProductBulkUpdateUserErrorCode")
+}
+
+func (e PriceListFixedPricesByProductBulkUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListFixedPricesByProductBulkUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListFixedPricesByProductBulkUpdateUserErrorCode")
+}
+
+func (e *PriceListFixedPricesByProductBulkUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListFixedPricesByProductBulkUpdateUserErrorCode")
+}
+
+// LookupPriceListPriceOriginType returns the value of AllPriceListPriceOriginType named name.
+func LookupPriceListPriceOriginType(name string) (PriceListPriceOriginType, bool) {
+	return lookupEnum(AllPriceListPriceOriginType, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListPriceOriginType, the values declared by the schema the models were
+// generated from.
+func (e PriceListPriceOriginType) IsKnown() bool {
+	return slices.Contains(AllPriceListPriceOriginType, e)
+}
+
+func (e PriceListPriceOriginType) GoString() string {
+	return enumGoString(AllPriceListPriceOriginType, enumConstants["PriceListPriceOriginType"], e, "model.PriceListPriceOriginType")
+}
+
+func (e PriceListPriceOriginType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListPriceOriginType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListPriceOriginType")
+}
+
+func (e *PriceListPriceOriginType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListPriceOriginType")
+}
+
+// LookupPriceListPriceUserErrorCode returns the value of AllPriceListPriceUserErrorCode named name.
+func LookupPriceListPriceUserErrorCode(name string) (PriceListPriceUserErrorCode, bool) {
+	return lookupEnum(AllPriceListPriceUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListPriceUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PriceListPriceUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPriceListPriceUserErrorCode, e)
+}
+
+func (e PriceListPriceUserErrorCode) GoString() string {
+	return enumGoString(AllPriceListPriceUserErrorCode, enumConstants["PriceListPriceUserErrorCode"], e, "model.PriceListPriceUserErrorCode")
+}
+
+func (e PriceListPriceUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListPriceUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListPriceUserErrorCode")
+}
+
+func (e *PriceListPriceUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListPriceUserErrorCode")
+}
+
+// LookupPriceListSortKeys returns the value of AllPriceListSortKeys named name.
+func LookupPriceListSortKeys(name string) (PriceListSortKeys, bool) {
+	return lookupEnum(AllPriceListSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListSortKeys, the values declared by the schema the models were
+// generated from.
+func (e PriceListSortKeys) IsKnown() bool {
+	return slices.Contains(AllPriceListSortKeys, e)
+}
+
+func (e PriceListSortKeys) GoString() string {
+	return enumGoString(AllPriceListSortKeys, enumConstants["PriceListSortKeys"], e, "model.PriceListSortKeys")
+}
+
+func (e PriceListSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListSortKeys")
+}
+
+func (e *PriceListSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListSortKeys")
+}
+
+// LookupPriceListUserErrorCode returns the value of AllPriceListUserErrorCode named name.
+func LookupPriceListUserErrorCode(name string) (PriceListUserErrorCode, bool) {
+	return lookupEnum(AllPriceListUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPriceListUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PriceListUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPriceListUserErrorCode, e)
+}
+
+func (e PriceListUserErrorCode) GoString() string {
+	return enumGoString(AllPriceListUserErrorCode, enumConstants["PriceListUserErrorCode"], e, "model.PriceListUserErrorCode")
+}
+
+func (e PriceListUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceListUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceListUserErrorCode")
+}
+
+func (e *PriceListUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceListUserErrorCode")
+}
+
+// LookupPriceRuleAllocationMethod returns the value of AllPriceRuleAllocationMethod named name.
+func LookupPriceRuleAllocationMethod(name string) (PriceRuleAllocationMethod, bool) {
+	return lookupEnum(AllPriceRuleAllocationMethod, name)
+}
+
+// IsKnown reports whether e is one of AllPriceRuleAllocationMethod, the values declared by the schema the models were
+// generated from.
+func (e PriceRuleAllocationMethod) IsKnown() bool {
+	return slices.Contains(AllPriceRuleAllocationMethod, e)
+}
+
+func (e PriceRuleAllocationMethod) GoString() string {
+	return enumGoString(AllPriceRuleAllocationMethod, enumConstants["PriceRuleAllocationMethod"], e, "model.PriceRuleAllocationMethod")
+}
+
+func (e PriceRuleAllocationMethod) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceRuleAllocationMethod) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceRuleAllocationMethod")
+}
+
+func (e *PriceRuleAllocationMethod) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceRuleAllocationMethod")
+}
+
+// LookupPriceRuleFeature returns the value of AllPriceRuleFeature named name.
+func LookupPriceRuleFeature(name string) (PriceRuleFeature, bool) {
+	return lookupEnum(AllPriceRuleFeature, name)
+}
+
+// IsKnown reports whether e is one of AllPriceRuleFeature, the values declared by the schema the models were
+// generated from.
+func (e PriceRuleFeature) IsKnown() bool {
+	return slices.Contains(AllPriceRuleFeature, e)
+}
+
+func (e PriceRuleFeature) GoString() string {
+	return enumGoString(AllPriceRuleFeature, enumConstants["PriceRuleFeature"], e, "model.PriceRuleFeature")
+}
+
+func (e PriceRuleFeature) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceRuleFeature) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceRuleFeature")
+}
+
+func (e *PriceRuleFeature) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceRuleFeature")
+}
+
+// LookupPriceRuleShareableURLTargetType returns the value of AllPriceRuleShareableURLTargetType named name.
+func LookupPriceRuleShareableURLTargetType(name string) (PriceRuleShareableURLTargetType, bool) {
+	return lookupEnum(AllPriceRuleShareableURLTargetType, name)
+}
+
+// IsKnown reports whether e is one of AllPriceRuleShareableURLTargetType, the values declared by the schema the models were
+// generated from.
+func (e PriceRuleShareableURLTargetType) IsKnown() bool {
+	return slices.Contains(AllPriceRuleShareableURLTargetType, e)
+}
+
+func (e PriceRuleShareableURLTargetType) GoString() string {
+	return enumGoString(AllPriceRuleShareableURLTargetType, enumConstants["PriceRuleShareableURLTargetType"], e, "model.PriceRuleShareableURLTargetType")
+}
+
+func (e PriceRuleShareableURLTargetType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceRuleShareableURLTargetType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceRuleShareableURLTargetType")
+}
+
+func (e *PriceRuleShareableURLTargetType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceRuleShareableURLTargetType")
+}
+
+// LookupPriceRuleStatus returns the value of AllPriceRuleStatus named name.
+func LookupPriceRuleStatus(name string) (PriceRuleStatus, bool) {
+	return lookupEnum(AllPriceRuleStatus, name)
+}
+
+// IsKnown reports whether e is one of AllPriceRuleStatus, the values declared by the schema the models were
+// generated from.
+func (e PriceRuleStatus) IsKnown() bool {
+	return slices.Contains(AllPriceRuleStatus, e)
+}
+
+func (e PriceRuleStatus) GoString() string {
+	return enumGoString(AllPriceRuleStatus, enumConstants["PriceRuleStatus"], e, "model.PriceRuleStatus")
+}
+
+func (e PriceRuleStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceRuleStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceRuleStatus")
+}
+
+func (e *PriceRuleStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceRuleStatus")
+}
+
+// LookupPriceRuleTarget returns the value of AllPriceRuleTarget named name.
+func LookupPriceRuleTarget(name string) (PriceRuleTarget, bool) {
+	return lookupEnum(AllPriceRuleTarget, name)
+}
+
+// IsKnown reports whether e is one of AllPriceRuleTarget, the values declared by the schema the models were
+// generated from.
+func (e PriceRuleTarget) IsKnown() bool {
+	return slices.Contains(AllPriceRuleTarget, e)
+}
+
+func (e PriceRuleTarget) GoString() string {
+	return enumGoString(AllPriceRuleTarget, enumConstants["PriceRuleTarget"], e, "model.PriceRuleTarget")
+}
+
+func (e PriceRuleTarget) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceRuleTarget) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceRuleTarget")
+}
+
+func (e *PriceRuleTarget) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceRuleTarget")
+}
+
+// LookupPriceRuleTrait returns the value of AllPriceRuleTrait named name.
+func LookupPriceRuleTrait(name string) (PriceRuleTrait, bool) {
+	return lookupEnum(AllPriceRuleTrait, name)
+}
+
+// IsKnown reports whether e is one of AllPriceRuleTrait, the values declared by the schema the models were
+// generated from.
+func (e PriceRuleTrait) IsKnown() bool {
+	return slices.Contains(AllPriceRuleTrait, e)
+}
+
+func (e PriceRuleTrait) GoString() string {
+	return enumGoString(AllPriceRuleTrait, enumConstants["PriceRuleTrait"], e, "model.PriceRuleTrait")
+}
+
+func (e PriceRuleTrait) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PriceRuleTrait) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PriceRuleTrait")
+}
+
+func (e *PriceRuleTrait) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PriceRuleTrait")
+}
+
+// LookupPrivateMetafieldValueType returns the value of AllPrivateMetafieldValueType named name.
+func LookupPrivateMetafieldValueType(name string) (PrivateMetafieldValueType, bool) {
+	return lookupEnum(AllPrivateMetafieldValueType, name)
+}
+
+// IsKnown reports whether e is one of AllPrivateMetafieldValueType, the values declared by the schema the models were
+// generated from.
+func (e PrivateMetafieldValueType) IsKnown() bool {
+	return slices.Contains(AllPrivateMetafieldValueType, e)
+}
+
+func (e PrivateMetafieldValueType) GoString() string {
+	return enumGoString(AllPrivateMetafieldValueType, enumConstants["PrivateMetafieldValueType"], e, "model.PrivateMetafieldValueType")
+}
+
+func (e PrivateMetafieldValueType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PrivateMetafieldValueType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PrivateMetafieldValueType")
+}
+
+func (e *PrivateMetafieldValueType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PrivateMetafieldValueType")
+}
+
+// LookupProductBundleComponentOptionSelectionStatus returns the value of AllProductBundleComponentOptionSelectionStatus named name.
+func LookupProductBundleComponentOptionSelectionStatus(name string) (ProductBundleComponentOptionSelectionStatus, bool) {
+	return lookupEnum(AllProductBundleComponentOptionSelectionStatus, name)
+}
+
+// IsKnown reports whether e is one of AllProductBundleComponentOptionSelectionStatus, the values declared by the schema the models were
+// generated from.
+func (e ProductBundleComponentOptionSelectionStatus) IsKnown() bool {
+	return slices.Contains(AllProductBundleComponentOptionSelectionStatus, e)
+}
+
+func (e ProductBundleComponentOptionSelectionStatus) GoString() string {
+	return enumGoString(AllProductBundleComponentOptionSelectionStatus, enumConstants["ProductBundleComponentOptionSelectionStatus"], e, "model.ProductBundleComponentOptionSelectionStatus")
+}
+
+func (e ProductBundleComponentOptionSelectionStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductBundleComponentOptionSelectionStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductBundleComponentOptionSelectionStatus")
+}
+
+func (e *ProductBundleComponentOptionSelectionStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductBundleComponentOptionSelectionStatus")
+}
+
+// LookupProductBundleMutationUserErrorCode returns the value of AllProductBundleMutationUserErrorCode named name.
+func LookupProductBundleMutationUserErrorCode(name string) (ProductBundleMutationUserErrorCode, bool) {
+	return lookupEnum(AllProductBundleMutationUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductBundleMutationUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductBundleMutationUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductBundleMutationUserErrorCode, e)
+}
+
+func (e ProductBundleMutationUserErrorCode) GoString() string {
+	return enumGoString(AllProductBundleMutationUserErrorCode, enumConstants["ProductBundleMutationUserErrorCode"], e, "model.ProductBundleMutationUserErrorCode")
+}
+
+func (e ProductBundleMutationUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductBundleMutationUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductBundleMutationUserErrorCode")
+}
+
+func (e *ProductBundleMutationUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductBundleMutationUserErrorCode")
+}
+
+// LookupProductChangeStatusUserErrorCode returns the value of AllProductChangeStatusUserErrorCode named name.
+func LookupProductChangeStatusUserErrorCode(name string) (ProductChangeStatusUserErrorCode, bool) {
+	return lookupEnum(AllProductChangeStatusUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductChangeStatusUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductChangeStatusUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductChangeStatusUserErrorCode, e)
+}
+
+func (e ProductChangeStatusUserErrorCode) GoString() string {
+	return enumGoString(AllProductChangeStatusUserErrorCode, enumConstants["ProductChangeStatusUserErrorCode"], e, "model.ProductChangeStatusUserErrorCode")
+}
+
+func (e ProductChangeStatusUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductChangeStatusUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductChangeStatusUserErrorCode")
+}
+
+func (e *ProductChangeStatusUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductChangeStatusUserErrorCode")
+}
+
+// LookupProductCollectionSortKeys returns the value of AllProductCollectionSortKeys named name.
+func LookupProductCollectionSortKeys(name string) (ProductCollectionSortKeys, bool) {
+	return lookupEnum(AllProductCollectionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllProductCollectionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ProductCollectionSortKeys) IsKnown() bool {
+	return slices.Contains(AllProductCollectionSortKeys, e)
+}
+
+func (e ProductCollectionSortKeys) GoString() string {
+	return enumGoString(AllProductCollectionSortKeys, enumConstants["ProductCollectionSortKeys"], e, "model.ProductCollectionSortKeys")
+}
+
+func (e ProductCollectionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductCollectionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductCollectionSortKeys")
+}
+
+func (e *ProductCollectionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductCollectionSortKeys")
+}
+
+// LookupProductFeedCreateUserErrorCode returns the value of AllProductFeedCreateUserErrorCode named name.
+func LookupProductFeedCreateUserErrorCode(name string) (ProductFeedCreateUserErrorCode, bool) {
+	return lookupEnum(AllProductFeedCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductFeedCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductFeedCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductFeedCreateUserErrorCode, e)
+}
+
+func (e ProductFeedCreateUserErrorCode) GoString() string {
+	return enumGoString(AllProductFeedCreateUserErrorCode, enumConstants["ProductFeedCreateUserErrorCode"], e, "model.ProductFeedCreateUserErrorCode")
+}
+
+func (e ProductFeedCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductFeedCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductFeedCreateUserErrorCode")
+}
+
+func (e *ProductFeedCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductFeedCreateUserErrorCode")
+}
+
+// LookupProductFeedDeleteUserErrorCode returns the value of AllProductFeedDeleteUserErrorCode named name.
+func LookupProductFeedDeleteUserErrorCode(name string) (ProductFeedDeleteUserErrorCode, bool) {
+	return lookupEnum(AllProductFeedDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductFeedDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductFeedDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductFeedDeleteUserErrorCode, e)
+}
+
+func (e ProductFeedDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllProductFeedDeleteUserErrorCode, enumConstants["ProductFeedDeleteUserErrorCode"], e, "model.ProductFeedDeleteUserErrorCode")
+}
+
+func (e ProductFeedDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductFeedDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductFeedDeleteUserErrorCode")
+}
+
+func (e *ProductFeedDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductFeedDeleteUserErrorCode")
+}
+
+// LookupProductFeedStatus returns the value of AllProductFeedStatus named name.
+func LookupProductFeedStatus(name string) (ProductFeedStatus, bool) {
+	return lookupEnum(AllProductFeedStatus, name)
+}
+
+// IsKnown reports whether e is one of AllProductFeedStatus, the values declared by the schema the models were
+// generated from.
+func (e ProductFeedStatus) IsKnown() bool {
+	return slices.Contains(AllProductFeedStatus, e)
+}
+
+func (e ProductFeedStatus) GoString() string {
+	return enumGoString(AllProductFeedStatus, enumConstants["ProductFeedStatus"], e, "model.ProductFeedStatus")
+}
+
+func (e ProductFeedStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductFeedStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductFeedStatus")
+}
+
+func (e *ProductFeedStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductFeedStatus")
+}
+
+// LookupProductFullSyncUserErrorCode returns the value of AllProductFullSyncUserErrorCode named name.
+func LookupProductFullSyncUserErrorCode(name string) (ProductFullSyncUserErrorCode, bool) {
+	return lookupEnum(AllProductFullSyncUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductFullSyncUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductFullSyncUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductFullSyncUserErrorCode, e)
+}
+
+func (e ProductFullSyncUserErrorCode) GoString() string {
+	return enumGoString(AllProductFullSyncUserErrorCode, enumConstants["ProductFullSyncUserErrorCode"], e, "model.ProductFullSyncUserErrorCode")
+}
+
+func (e ProductFullSyncUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductFullSyncUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductFullSyncUserErrorCode")
+}
+
+func (e *ProductFullSyncUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductFullSyncUserErrorCode")
+}
+
+// LookupProductImageSortKeys returns the value of AllProductImageSortKeys named name.
+func LookupProductImageSortKeys(name string) (ProductImageSortKeys, bool) {
+	return lookupEnum(AllProductImageSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllProductImageSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ProductImageSortKeys) IsKnown() bool {
+	return slices.Contains(AllProductImageSortKeys, e)
+}
+
+func (e ProductImageSortKeys) GoString() string {
+	return enumGoString(AllProductImageSortKeys, enumConstants["ProductImageSortKeys"], e, "model.ProductImageSortKeys")
+}
+
+func (e ProductImageSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductImageSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductImageSortKeys")
+}
+
+func (e *ProductImageSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductImageSortKeys")
+}
+
+// LookupProductMediaSortKeys returns the value of AllProductMediaSortKeys named name.
+func LookupProductMediaSortKeys(name string) (ProductMediaSortKeys, bool) {
+	return lookupEnum(AllProductMediaSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllProductMediaSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ProductMediaSortKeys) IsKnown() bool {
+	return slices.Contains(AllProductMediaSortKeys, e)
+}
+
+func (e ProductMediaSortKeys) GoString() string {
+	return enumGoString(AllProductMediaSortKeys, enumConstants["ProductMediaSortKeys"], e, "model.ProductMediaSortKeys")
+}
+
+func (e ProductMediaSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductMediaSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductMediaSortKeys")
+}
+
+func (e *ProductMediaSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductMediaSortKeys")
+}
+
+// LookupProductOperationStatus returns the value of AllProductOperationStatus named name.
+func LookupProductOperationStatus(name string) (ProductOperationStatus, bool) {
+	return lookupEnum(AllProductOperationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllProductOperationStatus, the values declared by the schema the models were
+// generated from.
+func (e ProductOperationStatus) IsKnown() bool {
+	return slices.Contains(AllProductOperationStatus, e)
+}
+
+func (e ProductOperationStatus) GoString() string {
+	return enumGoString(AllProductOperationStatus, enumConstants["ProductOperationStatus"], e, "model.ProductOperationStatus")
+}
+
+func (e ProductOperationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOperationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOperationStatus")
+}
+
+func (e *ProductOperationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOperationStatus")
+}
+
+// LookupProductOptionCreateVariantStrategy returns the value of AllProductOptionCreateVariantStrategy named name.
+func LookupProductOptionCreateVariantStrategy(name string) (ProductOptionCreateVariantStrategy, bool) {
+	return lookupEnum(AllProductOptionCreateVariantStrategy, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionCreateVariantStrategy, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionCreateVariantStrategy) IsKnown() bool {
+	return slices.Contains(AllProductOptionCreateVariantStrategy, e)
+}
+
+func (e ProductOptionCreateVariantStrategy) GoString() string {
+	return enumGoString(AllProductOptionCreateVariantStrategy, enumConstants["ProductOptionCreateVariantStrategy"], e, "model.ProductOptionCreateVariantStrategy")
+}
+
+func (e ProductOptionCreateVariantStrategy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionCreateVariantStrategy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionCreateVariantStrategy")
+}
+
+func (e *ProductOptionCreateVariantStrategy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionCreateVariantStrategy")
+}
+
+// LookupProductOptionDeleteStrategy returns the value of AllProductOptionDeleteStrategy named name.
+func LookupProductOptionDeleteStrategy(name string) (ProductOptionDeleteStrategy, bool) {
+	return lookupEnum(AllProductOptionDeleteStrategy, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionDeleteStrategy, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionDeleteStrategy) IsKnown() bool {
+	return slices.Contains(AllProductOptionDeleteStrategy, e)
+}
+
+func (e ProductOptionDeleteStrategy) GoString() string {
+	return enumGoString(AllProductOptionDeleteStrategy, enumConstants["ProductOptionDeleteStrategy"], e, "model.ProductOptionDeleteStrategy")
+}
+
+func (e ProductOptionDeleteStrategy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionDeleteStrategy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionDeleteStrategy")
+}
+
+func (e *ProductOptionDeleteStrategy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionDeleteStrategy")
+}
+
+// LookupProductOptionUpdateUserErrorCode returns the value of AllProductOptionUpdateUserErrorCode named name.
+func LookupProductOptionUpdateUserErrorCode(name string) (ProductOptionUpdateUserErrorCode, bool) {
+	return lookupEnum(AllProductOptionUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductOptionUpdateUserErrorCode, e)
+}
+
+func (e ProductOptionUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllProductOptionUpdateUserErrorCode, enumConstants["ProductOptionUpdateUserErrorCode"], e, "model.ProductOptionUpdateUserErrorCode")
+}
+
+func (e ProductOptionUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionUpdateUserErrorCode")
+}
+
+func (e *ProductOptionUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionUpdateUserErrorCode")
+}
+
+// LookupProductOptionUpdateVariantStrategy returns the value of AllProductOptionUpdateVariantStrategy named name.
+func LookupProductOptionUpdateVariantStrategy(name string) (ProductOptionUpdateVariantStrategy, bool) {
+	return lookupEnum(AllProductOptionUpdateVariantStrategy, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionUpdateVariantStrategy, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionUpdateVariantStrategy) IsKnown() bool {
+	return slices.Contains(AllProductOptionUpdateVariantStrategy, e)
+}
+
+func (e ProductOptionUpdateVariantStrategy) GoString() string {
+	return enumGoString(AllProductOptionUpdateVariantStrategy, enumConstants["ProductOptionUpdateVariantStrategy"], e, "model.ProductOptionUpdateVariantStrategy")
+}
+
+func (e ProductOptionUpdateVariantStrategy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionUpdateVariantStrategy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionUpdateVariantStrategy")
+}
+
+func (e *ProductOptionUpdateVariantStrategy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionUpdateVariantStrategy")
+}
+
+// LookupProductOptionsCreateUserErrorCode returns the value of AllProductOptionsCreateUserErrorCode named name.
+func LookupProductOptionsCreateUserErrorCode(name string) (ProductOptionsCreateUserErrorCode, bool) {
+	return lookupEnum(AllProductOptionsCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionsCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionsCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductOptionsCreateUserErrorCode, e)
+}
+
+func (e ProductOptionsCreateUserErrorCode) GoString() string {
+	return enumGoString(AllProductOptionsCreateUserErrorCode, enumConstants["ProductOptionsCreateUserErrorCode"], e, "model.ProductOptionsCreateUserErrorCode")
+}
+
+func (e ProductOptionsCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionsCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionsCreateUserErrorCode")
+}
+
+func (e *ProductOptionsCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionsCreateUserErrorCode")
+}
+
+// LookupProductOptionsDeleteUserErrorCode returns the value of AllProductOptionsDeleteUserErrorCode named name.
+func LookupProductOptionsDeleteUserErrorCode(name string) (ProductOptionsDeleteUserErrorCode, bool) {
+	return lookupEnum(AllProductOptionsDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionsDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionsDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductOptionsDeleteUserErrorCode, e)
+}
+
+func (e ProductOptionsDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllProductOptionsDeleteUserErrorCode, enumConstants["ProductOptionsDeleteUserErrorCode"], e, "model.ProductOptionsDeleteUserErrorCode")
+}
+
+func (e ProductOptionsDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionsDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionsDeleteUserErrorCode")
+}
+
+func (e *ProductOptionsDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionsDeleteUserErrorCode")
+}
+
+// LookupProductOptionsReorderUserErrorCode returns the value of AllProductOptionsReorderUserErrorCode named name.
+func LookupProductOptionsReorderUserErrorCode(name string) (ProductOptionsReorderUserErrorCode, bool) {
+	return lookupEnum(AllProductOptionsReorderUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductOptionsReorderUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductOptionsReorderUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductOptionsReorderUserErrorCode, e)
+}
+
+func (e ProductOptionsReorderUserErrorCode) GoString() string {
+	return enumGoString(AllProductOptionsReorderUserErrorCode, enumConstants["ProductOptionsReorderUserErrorCode"], e, "model.ProductOptionsReorderUserErrorCode")
+}
+
+func (e ProductOptionsReorderUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductOptionsReorderUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductOptionsReorderUserErrorCode")
+}
+
+func (e *ProductOptionsReorderUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductOptionsReorderUserErrorCode")
+}
+
+// LookupProductSetUserErrorCode returns the value of AllProductSetUserErrorCode named name.
+func LookupProductSetUserErrorCode(name string) (ProductSetUserErrorCode, bool) {
+	return lookupEnum(AllProductSetUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductSetUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductSetUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductSetUserErrorCode, e)
+}
+
+func (e ProductSetUserErrorCode) GoString() string {
+	return enumGoString(AllProductSetUserErrorCode, enumConstants["ProductSetUserErrorCode"], e, "model.ProductSetUserErrorCode")
+}
+
+func (e ProductSetUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductSetUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductSetUserErrorCode")
+}
+
+func (e *ProductSetUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductSetUserErrorCode")
+}
+
+// LookupProductSortKeys returns the value of AllProductSortKeys named name.
+func LookupProductSortKeys(name string) (ProductSortKeys, bool) {
+	return lookupEnum(AllProductSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllProductSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ProductSortKeys) IsKnown() bool {
+	return slices.Contains(AllProductSortKeys, e)
+}
+
+func (e ProductSortKeys) GoString() string {
+	return enumGoString(AllProductSortKeys, enumConstants["ProductSortKeys"], e, "model.ProductSortKeys")
+}
+
+func (e ProductSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductSortKeys")
+}
+
+func (e *ProductSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductSortKeys")
+}
+
+// LookupProductStatus returns the value of AllProductStatus named name.
+func LookupProductStatus(name string) (ProductStatus, bool) {
+	return lookupEnum(AllProductStatus, name)
+}
+
+// IsKnown reports whether e is one of AllProductStatus, the values declared by the schema the models were
+// generated from.
+func (e ProductStatus) IsKnown() bool {
+	return slices.Contains(AllProductStatus, e)
+}
+
+func (e ProductStatus) GoString() string {
+	return enumGoString(AllProductStatus, enumConstants["ProductStatus"], e, "model.ProductStatus")
+}
+
+func (e ProductStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductStatus")
+}
+
+func (e *ProductStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductStatus")
+}
+
+// LookupProductVariantInventoryPolicy returns the value of AllProductVariantInventoryPolicy named name.
+func LookupProductVariantInventoryPolicy(name string) (ProductVariantInventoryPolicy, bool) {
+	return lookupEnum(AllProductVariantInventoryPolicy, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantInventoryPolicy, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantInventoryPolicy) IsKnown() bool {
+	return slices.Contains(AllProductVariantInventoryPolicy, e)
+}
+
+func (e ProductVariantInventoryPolicy) GoString() string {
+	return enumGoString(AllProductVariantInventoryPolicy, enumConstants["ProductVariantInventoryPolicy"], e, "model.ProductVariantInventoryPolicy")
+}
+
+func (e ProductVariantInventoryPolicy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantInventoryPolicy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantInventoryPolicy")
+}
+
+func (e *ProductVariantInventoryPolicy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantInventoryPolicy")
+}
+
+// LookupProductVariantRelationshipBulkUpdateUserErrorCode returns the value of AllProductVariantRelationshipBulkUpdateUserErrorCode named name.
+func LookupProductVariantRelationshipBulkUpdateUserErrorCode(name string) (ProductVariantRelationshipBulkUpdateUserErrorCode, bool) {
+	return lookupEnum(AllProductVariantRelationshipBulkUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantRelationshipBulkUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantRelationshipBulkUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductVariantRelationshipBulkUpdateUserErrorCode, e)
+}
+
+func (e ProductVariantRelationshipBulkUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllProductVariantRelationshipBulkUpdateUserErrorCode, enumConstants["ProductVariantRelationshipBulkUpdateUserErrorCode"], e, "model.ProductVariantRelationshipBulkUpdateUserErrorCode")
+}
+
+func (e ProductVariantRelationshipBulkUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantRelationshipBulkUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantRelationshipBulkUpdateUserErrorCode")
+}
+
+func (e *ProductVariantRelationshipBulkUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantRelationshipBulkUpdateUserErrorCode")
+}
+
+// LookupProductVariantSortKeys returns the value of AllProductVariantSortKeys named name.
+func LookupProductVariantSortKeys(name string) (ProductVariantSortKeys, bool) {
+	return lookupEnum(AllProductVariantSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantSortKeys) IsKnown() bool {
+	return slices.Contains(AllProductVariantSortKeys, e)
+}
+
+func (e ProductVariantSortKeys) GoString() string {
+	return enumGoString(AllProductVariantSortKeys, enumConstants["ProductVariantSortKeys"], e, "model.ProductVariantSortKeys")
+}
+
+func (e ProductVariantSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantSortKeys")
+}
+
+func (e *ProductVariantSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantSortKeys")
+}
+
+// LookupProductVariantsBulkCreateStrategy returns the value of AllProductVariantsBulkCreateStrategy named name.
+func LookupProductVariantsBulkCreateStrategy(name string) (ProductVariantsBulkCreateStrategy, bool) {
+	return lookupEnum(AllProductVariantsBulkCreateStrategy, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantsBulkCreateStrategy, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantsBulkCreateStrategy) IsKnown() bool {
+	return slices.Contains(AllProductVariantsBulkCreateStrategy, e)
+}
+
+func (e ProductVariantsBulkCreateStrategy) GoString() string {
+	return enumGoString(AllProductVariantsBulkCreateStrategy, enumConstants["ProductVariantsBulkCreateStrategy"], e, "model.ProductVariantsBulkCreateStrategy")
+}
+
+func (e ProductVariantsBulkCreateStrategy) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantsBulkCreateStrategy) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantsBulkCreateStrategy")
+}
+
+func (e *ProductVariantsBulkCreateStrategy) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantsBulkCreateStrategy")
+}
+
+// LookupProductVariantsBulkCreateUserErrorCode returns the value of AllProductVariantsBulkCreateUserErrorCode named name.
+func LookupProductVariantsBulkCreateUserErrorCode(name string) (ProductVariantsBulkCreateUserErrorCode, bool) {
+	return lookupEnum(AllProductVariantsBulkCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantsBulkCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantsBulkCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductVariantsBulkCreateUserErrorCode, e)
+}
+
+func (e ProductVariantsBulkCreateUserErrorCode) GoString() string {
+	return enumGoString(AllProductVariantsBulkCreateUserErrorCode, enumConstants["ProductVariantsBulkCreateUserErrorCode"], e, "model.ProductVariantsBulkCreateUserErrorCode")
+}
+
+func (e ProductVariantsBulkCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantsBulkCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantsBulkCreateUserErrorCode")
+}
+
+func (e *ProductVariantsBulkCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantsBulkCreateUserErrorCode")
+}
+
+// LookupProductVariantsBulkDeleteUserErrorCode returns the value of AllProductVariantsBulkDeleteUserErrorCode named name.
+func LookupProductVariantsBulkDeleteUserErrorCode(name string) (ProductVariantsBulkDeleteUserErrorCode, bool) {
+	return lookupEnum(AllProductVariantsBulkDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantsBulkDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantsBulkDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductVariantsBulkDeleteUserErrorCode, e)
+}
+
+func (e ProductVariantsBulkDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllProductVariantsBulkDeleteUserErrorCode, enumConstants["ProductVariantsBulkDeleteUserErrorCode"], e, "model.ProductVariantsBulkDeleteUserErrorCode")
+}
+
+func (e ProductVariantsBulkDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantsBulkDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantsBulkDeleteUserErrorCode")
+}
+
+func (e *ProductVariantsBulkDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantsBulkDeleteUserErrorCode")
+}
+
+// LookupProductVariantsBulkReorderUserErrorCode returns the value of AllProductVariantsBulkReorderUserErrorCode named name.
+func LookupProductVariantsBulkReorderUserErrorCode(name string) (ProductVariantsBulkReorderUserErrorCode, bool) {
+	return lookupEnum(AllProductVariantsBulkReorderUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantsBulkReorderUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantsBulkReorderUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductVariantsBulkReorderUserErrorCode, e)
+}
+
+func (e ProductVariantsBulkReorderUserErrorCode) GoString() string {
+	return enumGoString(AllProductVariantsBulkReorderUserErrorCode, enumConstants["ProductVariantsBulkReorderUserErrorCode"], e, "model.ProductVariantsBulkReorderUserErrorCode")
+}
+
+func (e ProductVariantsBulkReorderUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantsBulkReorderUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantsBulkReorderUserErrorCode")
+}
+
+func (e *ProductVariantsBulkReorderUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantsBulkReorderUserErrorCode")
+}
+
+// LookupProductVariantsBulkUpdateUserErrorCode returns the value of AllProductVariantsBulkUpdateUserErrorCode named name.
+func LookupProductVariantsBulkUpdateUserErrorCode(name string) (ProductVariantsBulkUpdateUserErrorCode, bool) {
+	return lookupEnum(AllProductVariantsBulkUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllProductVariantsBulkUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ProductVariantsBulkUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllProductVariantsBulkUpdateUserErrorCode, e)
+}
+
+func (e ProductVariantsBulkUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllProductVariantsBulkUpdateUserErrorCode, enumConstants["ProductVariantsBulkUpdateUserErrorCode"], e, "model.ProductVariantsBulkUpdateUserErrorCode")
+}
+
+func (e ProductVariantsBulkUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProductVariantsBulkUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProductVariantsBulkUpdateUserErrorCode")
+}
+
+func (e *ProductVariantsBulkUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProductVariantsBulkUpdateUserErrorCode")
+}
+
+// LookupProfileItemSortKeys returns the value of AllProfileItemSortKeys named name.
+func LookupProfileItemSortKeys(name string) (ProfileItemSortKeys, bool) {
+	return lookupEnum(AllProfileItemSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllProfileItemSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ProfileItemSortKeys) IsKnown() bool {
+	return slices.Contains(AllProfileItemSortKeys, e)
+}
+
+func (e ProfileItemSortKeys) GoString() string {
+	return enumGoString(AllProfileItemSortKeys, enumConstants["ProfileItemSortKeys"], e, "model.ProfileItemSortKeys")
+}
+
+func (e ProfileItemSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ProfileItemSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ProfileItemSortKeys")
+}
+
+func (e *ProfileItemSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ProfileItemSortKeys")
+}
+
+// LookupPubSubWebhookSubscriptionCreateUserErrorCode returns the value of AllPubSubWebhookSubscriptionCreateUserErrorCode named name.
+func LookupPubSubWebhookSubscriptionCreateUserErrorCode(name string) (PubSubWebhookSubscriptionCreateUserErrorCode, bool) {
+	return lookupEnum(AllPubSubWebhookSubscriptionCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPubSubWebhookSubscriptionCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PubSubWebhookSubscriptionCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPubSubWebhookSubscriptionCreateUserErrorCode, e)
+}
+
+func (e PubSubWebhookSubscriptionCreateUserErrorCode) GoString() string {
+	return enumGoString(AllPubSubWebhookSubscriptionCreateUserErrorCode, enumConstants["PubSubWebhookSubscriptionCreateUserErrorCode"], e, "model.PubSubWebhookSubscriptionCreateUserErrorCode")
+}
+
+func (e PubSubWebhookSubscriptionCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PubSubWebhookSubscriptionCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PubSubWebhookSubscriptionCreateUserErrorCode")
+}
+
+func (e *PubSubWebhookSubscriptionCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PubSubWebhookSubscriptionCreateUserErrorCode")
+}
+
+// LookupPubSubWebhookSubscriptionUpdateUserErrorCode returns the value of AllPubSubWebhookSubscriptionUpdateUserErrorCode named name.
+func LookupPubSubWebhookSubscriptionUpdateUserErrorCode(name string) (PubSubWebhookSubscriptionUpdateUserErrorCode, bool) {
+	return lookupEnum(AllPubSubWebhookSubscriptionUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPubSubWebhookSubscriptionUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PubSubWebhookSubscriptionUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPubSubWebhookSubscriptionUpdateUserErrorCode, e)
+}
+
+func (e PubSubWebhookSubscriptionUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllPubSubWebhookSubscriptionUpdateUserErrorCode, enumConstants["PubSubWebhookSubscriptionUpdateUserErrorCode"], e, "model.PubSubWebhookSubscriptionUpdateUserErrorCode")
+}
+
+func (e PubSubWebhookSubscriptionUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PubSubWebhookSubscriptionUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PubSubWebhookSubscriptionUpdateUserErrorCode")
+}
+
+func (e *PubSubWebhookSubscriptionUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PubSubWebhookSubscriptionUpdateUserErrorCode")
+}
+
+// LookupPublicationCreateInputPublicationDefaultState returns the value of AllPublicationCreateInputPublicationDefaultState named name.
+func LookupPublicationCreateInputPublicationDefaultState(name string) (PublicationCreateInputPublicationDefaultState, bool) {
+	return lookupEnum(AllPublicationCreateInputPublicationDefaultState, name)
+}
+
+// IsKnown reports whether e is one of AllPublicationCreateInputPublicationDefaultState, the values declared by the schema the models were
+// generated from.
+func (e PublicationCreateInputPublicationDefaultState) IsKnown() bool {
+	return slices.Contains(AllPublicationCreateInputPublicationDefaultState, e)
+}
+
+func (e PublicationCreateInputPublicationDefaultState) GoString() string {
+	return enumGoString(AllPublicationCreateInputPublicationDefaultState, enumConstants["PublicationCreateInputPublicationDefaultState"], e, "model.PublicationCreateInputPublicationDefaultState")
+}
+
+func (e PublicationCreateInputPublicationDefaultState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PublicationCreateInputPublicationDefaultState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PublicationCreateInputPublicationDefaultState")
+}
+
+func (e *PublicationCreateInputPublicationDefaultState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PublicationCreateInputPublicationDefaultState")
+}
+
+// LookupPublicationUserErrorCode returns the value of AllPublicationUserErrorCode named name.
+func LookupPublicationUserErrorCode(name string) (PublicationUserErrorCode, bool) {
+	return lookupEnum(AllPublicationUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllPublicationUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e PublicationUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllPublicationUserErrorCode, e)
+}
+
+func (e PublicationUserErrorCode) GoString() string {
+	return enumGoString(AllPublicationUserErrorCode, enumConstants["PublicationUserErrorCode"], e, "model.PublicationUserErrorCode")
+}
+
+func (e PublicationUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *PublicationUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "PublicationUserErrorCode")
+}
+
+func (e *PublicationUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "PublicationUserErrorCode")
+}
+
+// LookupQuantityPriceBreakSortKeys returns the value of AllQuantityPriceBreakSortKeys named name.
+func LookupQuantityPriceBreakSortKeys(name string) (QuantityPriceBreakSortKeys, bool) {
+	return lookupEnum(AllQuantityPriceBreakSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllQuantityPriceBreakSortKeys, the values declared by the schema the models were
+// generated from.
+func (e QuantityPriceBreakSortKeys) IsKnown() bool {
+	return slices.Contains(AllQuantityPriceBreakSortKeys, e)
+}
+
+func (e QuantityPriceBreakSortKeys) GoString() string {
+	return enumGoString(AllQuantityPriceBreakSortKeys, enumConstants["QuantityPriceBreakSortKeys"], e, "model.QuantityPriceBreakSortKeys")
+}
+
+func (e QuantityPriceBreakSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *QuantityPriceBreakSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "QuantityPriceBreakSortKeys")
+}
+
+func (e *QuantityPriceBreakSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "QuantityPriceBreakSortKeys")
+}
+
+// LookupQuantityPricingByVariantUserErrorCode returns the value of AllQuantityPricingByVariantUserErrorCode named name.
+func LookupQuantityPricingByVariantUserErrorCode(name string) (QuantityPricingByVariantUserErrorCode, bool) {
+	return lookupEnum(AllQuantityPricingByVariantUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllQuantityPricingByVariantUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e QuantityPricingByVariantUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllQuantityPricingByVariantUserErrorCode, e)
+}
+
+func (e QuantityPricingByVariantUserErrorCode) GoString() string {
+	return enumGoString(AllQuantityPricingByVariantUserErrorCode, enumConstants["QuantityPricingByVariantUserErrorCode"], e, "model.QuantityPricingByVariantUserErrorCode")
+}
+
+func (e QuantityPricingByVariantUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *QuantityPricingByVariantUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "QuantityPricingByVariantUserErrorCode")
+}
+
+func (e *QuantityPricingByVariantUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "QuantityPricingByVariantUserErrorCode")
+}
+
+// LookupQuantityRuleOriginType returns the value of AllQuantityRuleOriginType named name.
+func LookupQuantityRuleOriginType(name string) (QuantityRuleOriginType, bool) {
+	return lookupEnum(AllQuantityRuleOriginType, name)
+}
+
+// IsKnown reports whether e is one of AllQuantityRuleOriginType, the values declared by the schema the models were
+// generated from.
+func (e QuantityRuleOriginType) IsKnown() bool {
+	return slices.Contains(AllQuantityRuleOriginType, e)
+}
+
+func (e QuantityRuleOriginType) GoString() string {
+	return enumGoString(AllQuantityRuleOriginType, enumConstants["QuantityRuleOriginType"], e, "model.QuantityRuleOriginType")
+}
+
+func (e QuantityRuleOriginType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *QuantityRuleOriginType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "QuantityRuleOriginType")
+}
+
+func (e *QuantityRuleOriginType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "QuantityRuleOriginType")
+}
+
+// LookupQuantityRuleUserErrorCode returns the value of AllQuantityRuleUserErrorCode named name.
+func LookupQuantityRuleUserErrorCode(name string) (QuantityRuleUserErrorCode, bool) {
+	return lookupEnum(AllQuantityRuleUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllQuantityRuleUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e QuantityRuleUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllQuantityRuleUserErrorCode, e)
+}
+
+func (e QuantityRuleUserErrorCode) GoString() string {
+	return enumGoString(AllQuantityRuleUserErrorCode, enumConstants["QuantityRuleUserErrorCode"], e, "model.QuantityRuleUserErrorCode")
+}
+
+func (e QuantityRuleUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *QuantityRuleUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "QuantityRuleUserErrorCode")
+}
+
+func (e *QuantityRuleUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "QuantityRuleUserErrorCode")
+}
+
+// LookupRefundDutyRefundType returns the value of AllRefundDutyRefundType named name.
+func LookupRefundDutyRefundType(name string) (RefundDutyRefundType, bool) {
+	return lookupEnum(AllRefundDutyRefundType, name)
+}
+
+// IsKnown reports whether e is one of AllRefundDutyRefundType, the values declared by the schema the models were
+// generated from.
+func (e RefundDutyRefundType) IsKnown() bool {
+	return slices.Contains(AllRefundDutyRefundType, e)
+}
+
+func (e RefundDutyRefundType) GoString() string {
+	return enumGoString(AllRefundDutyRefundType, enumConstants["RefundDutyRefundType"], e, "model.RefundDutyRefundType")
+}
+
+func (e RefundDutyRefundType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *RefundDutyRefundType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "RefundDutyRefundType")
+}
+
+func (e *RefundDutyRefundType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "RefundDutyRefundType")
+}
+
+// LookupRefundLineItemRestockType returns the value of AllRefundLineItemRestockType named name.
+func LookupRefundLineItemRestockType(name string) (RefundLineItemRestockType, bool) {
+	return lookupEnum(AllRefundLineItemRestockType, name)
+}
+
+// IsKnown reports whether e is one of AllRefundLineItemRestockType, the values declared by the schema the models were
+// generated from.
+func (e RefundLineItemRestockType) IsKnown() bool {
+	return slices.Contains(AllRefundLineItemRestockType, e)
+}
+
+func (e RefundLineItemRestockType) GoString() string {
+	return enumGoString(AllRefundLineItemRestockType, enumConstants["RefundLineItemRestockType"], e, "model.RefundLineItemRestockType")
+}
+
+func (e RefundLineItemRestockType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *RefundLineItemRestockType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "RefundLineItemRestockType")
+}
+
+func (e *RefundLineItemRestockType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "RefundLineItemRestockType")
+}
+
+// LookupResourceAlertIcon returns the value of AllResourceAlertIcon named name.
+func LookupResourceAlertIcon(name string) (ResourceAlertIcon, bool) {
+	return lookupEnum(AllResourceAlertIcon, name)
+}
+
+// IsKnown reports whether e is one of AllResourceAlertIcon, the values declared by the schema the models were
+// generated from.
+func (e ResourceAlertIcon) IsKnown() bool {
+	return slices.Contains(AllResourceAlertIcon, e)
+}
+
+func (e ResourceAlertIcon) GoString() string {
+	return enumGoString(AllResourceAlertIcon, enumConstants["ResourceAlertIcon"], e, "model.ResourceAlertIcon")
+}
+
+func (e ResourceAlertIcon) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ResourceAlertIcon) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ResourceAlertIcon")
+}
+
+func (e *ResourceAlertIcon) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ResourceAlertIcon")
+}
+
+// LookupResourceAlertSeverity returns the value of AllResourceAlertSeverity named name.
+func LookupResourceAlertSeverity(name string) (ResourceAlertSeverity, bool) {
+	return lookupEnum(AllResourceAlertSeverity, name)
+}
+
+// IsKnown reports whether e is one of AllResourceAlertSeverity, the values declared by the schema the models were
+// generated from.
+func (e ResourceAlertSeverity) IsKnown() bool {
+	return slices.Contains(AllResourceAlertSeverity, e)
+}
+
+func (e ResourceAlertSeverity) GoString() string {
+	return enumGoString(AllResourceAlertSeverity, enumConstants["ResourceAlertSeverity"], e, "model.ResourceAlertSeverity")
+}
+
+func (e ResourceAlertSeverity) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ResourceAlertSeverity) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ResourceAlertSeverity")
+}
+
+func (e *ResourceAlertSeverity) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ResourceAlertSeverity")
+}
+
+// LookupResourceFeedbackState returns the value of AllResourceFeedbackState named name.
+func LookupResourceFeedbackState(name string) (ResourceFeedbackState, bool) {
+	return lookupEnum(AllResourceFeedbackState, name)
+}
+
+// IsKnown reports whether e is one of AllResourceFeedbackState, the values declared by the schema the models were
+// generated from.
+func (e ResourceFeedbackState) IsKnown() bool {
+	return slices.Contains(AllResourceFeedbackState, e)
+}
+
+func (e ResourceFeedbackState) GoString() string {
+	return enumGoString(AllResourceFeedbackState, enumConstants["ResourceFeedbackState"], e, "model.ResourceFeedbackState")
+}
+
+func (e ResourceFeedbackState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ResourceFeedbackState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ResourceFeedbackState")
+}
+
+func (e *ResourceFeedbackState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ResourceFeedbackState")
+}
+
+// LookupResourceOperationStatus returns the value of AllResourceOperationStatus named name.
+func LookupResourceOperationStatus(name string) (ResourceOperationStatus, bool) {
+	return lookupEnum(AllResourceOperationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllResourceOperationStatus, the values declared by the schema the models were
+// generated from.
+func (e ResourceOperationStatus) IsKnown() bool {
+	return slices.Contains(AllResourceOperationStatus, e)
+}
+
+func (e ResourceOperationStatus) GoString() string {
+	return enumGoString(AllResourceOperationStatus, enumConstants["ResourceOperationStatus"], e, "model.ResourceOperationStatus")
+}
+
+func (e ResourceOperationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ResourceOperationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ResourceOperationStatus")
+}
+
+func (e *ResourceOperationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ResourceOperationStatus")
+}
+
+// LookupReturnDeclineReason returns the value of AllReturnDeclineReason named name.
+func LookupReturnDeclineReason(name string) (ReturnDeclineReason, bool) {
+	return lookupEnum(AllReturnDeclineReason, name)
+}
+
+// IsKnown reports whether e is one of AllReturnDeclineReason, the values declared by the schema the models were
+// generated from.
+func (e ReturnDeclineReason) IsKnown() bool {
+	return slices.Contains(AllReturnDeclineReason, e)
+}
+
+func (e ReturnDeclineReason) GoString() string {
+	return enumGoString(AllReturnDeclineReason, enumConstants["ReturnDeclineReason"], e, "model.ReturnDeclineReason")
+}
+
+func (e ReturnDeclineReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReturnDeclineReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReturnDeclineReason")
+}
+
+func (e *ReturnDeclineReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReturnDeclineReason")
+}
+
+// LookupReturnErrorCode returns the value of AllReturnErrorCode named name.
+func LookupReturnErrorCode(name string) (ReturnErrorCode, bool) {
+	return lookupEnum(AllReturnErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllReturnErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ReturnErrorCode) IsKnown() bool {
+	return slices.Contains(AllReturnErrorCode, e)
+}
+
+func (e ReturnErrorCode) GoString() string {
+	return enumGoString(AllReturnErrorCode, enumConstants["ReturnErrorCode"], e, "model.ReturnErrorCode")
+}
+
+func (e ReturnErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReturnErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReturnErrorCode")
+}
+
+func (e *ReturnErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReturnErrorCode")
+}
+
+// LookupReturnReason returns the value of AllReturnReason named name.
+func LookupReturnReason(name string) (ReturnReason, bool) {
+	return lookupEnum(AllReturnReason, name)
+}
+
+// IsKnown reports whether e is one of AllReturnReason, the values declared by the schema the models were
+// generated from.
+func (e ReturnReason) IsKnown() bool {
+	return slices.Contains(AllReturnReason, e)
+}
+
+func (e ReturnReason) GoString() string {
+	return enumGoString(AllReturnReason, enumConstants["ReturnReason"], e, "model.ReturnReason")
+}
+
+func (e ReturnReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReturnReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReturnReason")
+}
+
+func (e *ReturnReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReturnReason")
+}
+
+// LookupReturnStatus returns the value of AllReturnStatus named name.
+func LookupReturnStatus(name string) (ReturnStatus, bool) {
+	return lookupEnum(AllReturnStatus, name)
+}
+
+// IsKnown reports whether e is one of AllReturnStatus, the values declared by the schema the models were
+// generated from.
+func (e ReturnStatus) IsKnown() bool {
+	return slices.Contains(AllReturnStatus, e)
+}
+
+func (e ReturnStatus) GoString() string {
+	return enumGoString(AllReturnStatus, enumConstants["ReturnStatus"], e, "model.ReturnStatus")
+}
+
+func (e ReturnStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReturnStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReturnStatus")
+}
+
+func (e *ReturnStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReturnStatus")
+}
+
+// LookupReverseFulfillmentOrderDispositionType returns the value of AllReverseFulfillmentOrderDispositionType named name.
+func LookupReverseFulfillmentOrderDispositionType(name string) (ReverseFulfillmentOrderDispositionType, bool) {
+	return lookupEnum(AllReverseFulfillmentOrderDispositionType, name)
+}
+
+// IsKnown reports whether e is one of AllReverseFulfillmentOrderDispositionType, the values declared by the schema the models were
+// generated from.
+func (e ReverseFulfillmentOrderDispositionType) IsKnown() bool {
+	return slices.Contains(AllReverseFulfillmentOrderDispositionType, e)
+}
+
+func (e ReverseFulfillmentOrderDispositionType) GoString() string {
+	return enumGoString(AllReverseFulfillmentOrderDispositionType, enumConstants["ReverseFulfillmentOrderDispositionType"], e, "model.ReverseFulfillmentOrderDispositionType")
+}
+
+func (e ReverseFulfillmentOrderDispositionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReverseFulfillmentOrderDispositionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReverseFulfillmentOrderDispositionType")
+}
+
+func (e *ReverseFulfillmentOrderDispositionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReverseFulfillmentOrderDispositionType")
+}
+
+// LookupReverseFulfillmentOrderStatus returns the value of AllReverseFulfillmentOrderStatus named name.
+func LookupReverseFulfillmentOrderStatus(name string) (ReverseFulfillmentOrderStatus, bool) {
+	return lookupEnum(AllReverseFulfillmentOrderStatus, name)
+}
+
+// IsKnown reports whether e is one of AllReverseFulfillmentOrderStatus, the values declared by the schema the models were
+// generated from.
+func (e ReverseFulfillmentOrderStatus) IsKnown() bool {
+	return slices.Contains(AllReverseFulfillmentOrderStatus, e)
+}
+
+func (e ReverseFulfillmentOrderStatus) GoString() string {
+	return enumGoString(AllReverseFulfillmentOrderStatus, enumConstants["ReverseFulfillmentOrderStatus"], e, "model.ReverseFulfillmentOrderStatus")
+}
+
+func (e ReverseFulfillmentOrderStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReverseFulfillmentOrderStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReverseFulfillmentOrderStatus")
+}
+
+func (e *ReverseFulfillmentOrderStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReverseFulfillmentOrderStatus")
+}
+
+// LookupReverseFulfillmentOrderThirdPartyConfirmationStatus returns the value of AllReverseFulfillmentOrderThirdPartyConfirmationStatus named name.
+func LookupReverseFulfillmentOrderThirdPartyConfirmationStatus(name string) (ReverseFulfillmentOrderThirdPartyConfirmationStatus, bool) {
+	return lookupEnum(AllReverseFulfillmentOrderThirdPartyConfirmationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllReverseFulfillmentOrderThirdPartyConfirmationStatus, the values declared by the schema the models were
+// generated from.
+func (e ReverseFulfillmentOrderThirdPartyConfirmationStatus) IsKnown() bool {
+	return slices.Contains(AllReverseFulfillmentOrderThirdPartyConfirmationStatus, e)
+}
+
+func (e ReverseFulfillmentOrderThirdPartyConfirmationStatus) GoString() string {
+	return enumGoString(AllReverseFulfillmentOrderThirdPartyConfirmationStatus, enumConstants["ReverseFulfillmentOrderThirdPartyConfirmationStatus"], e, "model.ReverseFulfillmentOrderThirdPartyConfirmationStatus")
+}
+
+func (e ReverseFulfillmentOrderThirdPartyConfirmationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ReverseFulfillmentOrderThirdPartyConfirmationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ReverseFulfillmentOrderThirdPartyConfirmationStatus")
+}
+
+func (e *ReverseFulfillmentOrderThirdPartyConfirmationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ReverseFulfillmentOrderThirdPartyConfirmationStatus")
+}
+
+// LookupRiskAssessmentResult returns the value of AllRiskAssessmentResult named name.
+func LookupRiskAssessmentResult(name string) (RiskAssessmentResult, bool) {
+	return lookupEnum(AllRiskAssessmentResult, name)
+}
+
+// IsKnown reports whether e is one of AllRiskAssessmentResult, the values declared by the schema the models were
+// generated from.
+func (e RiskAssessmentResult) IsKnown() bool {
+	return slices.Contains(AllRiskAssessmentResult, e)
+}
+
+func (e RiskAssessmentResult) GoString() string {
+	return enumGoString(AllRiskAssessmentResult, enumConstants["RiskAssessmentResult"], e, "model.RiskAssessmentResult")
+}
+
+func (e RiskAssessmentResult) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *RiskAssessmentResult) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "RiskAssessmentResult")
+}
+
+func (e *RiskAssessmentResult) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "RiskAssessmentResult")
+}
+
+// LookupRiskFactSentiment returns the value of AllRiskFactSentiment named name.
+func LookupRiskFactSentiment(name string) (RiskFactSentiment, bool) {
+	return lookupEnum(AllRiskFactSentiment, name)
+}
+
+// IsKnown reports whether e is one of AllRiskFactSentiment, the values declared by the schema the models were
+// generated from.
+func (e RiskFactSentiment) IsKnown() bool {
+	return slices.Contains(AllRiskFactSentiment, e)
+}
+
+func (e RiskFactSentiment) GoString() string {
+	return enumGoString(AllRiskFactSentiment, enumConstants["RiskFactSentiment"], e, "model.RiskFactSentiment")
+}
+
+func (e RiskFactSentiment) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *RiskFactSentiment) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "RiskFactSentiment")
+}
+
+func (e *RiskFactSentiment) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "RiskFactSentiment")
+}
+
+// LookupSaleActionType returns the value of AllSaleActionType named name.
+func LookupSaleActionType(name string) (SaleActionType, bool) {
+	return lookupEnum(AllSaleActionType, name)
+}
+
+// IsKnown reports whether e is one of AllSaleActionType, the values declared by the schema the models were
+// generated from.
+func (e SaleActionType) IsKnown() bool {
+	return slices.Contains(AllSaleActionType, e)
+}
+
+func (e SaleActionType) GoString() string {
+	return enumGoString(AllSaleActionType, enumConstants["SaleActionType"], e, "model.SaleActionType")
+}
+
+func (e SaleActionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SaleActionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SaleActionType")
+}
+
+func (e *SaleActionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SaleActionType")
+}
+
+// LookupSaleLineType returns the value of AllSaleLineType named name.
+func LookupSaleLineType(name string) (SaleLineType, bool) {
+	return lookupEnum(AllSaleLineType, name)
+}
+
+// IsKnown reports whether e is one of AllSaleLineType, the values declared by the schema the models were
+// generated from.
+func (e SaleLineType) IsKnown() bool {
+	return slices.Contains(AllSaleLineType, e)
+}
+
+func (e SaleLineType) GoString() string {
+	return enumGoString(AllSaleLineType, enumConstants["SaleLineType"], e, "model.SaleLineType")
+}
+
+func (e SaleLineType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SaleLineType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SaleLineType")
+}
+
+func (e *SaleLineType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SaleLineType")
+}
+
+// LookupScheduledChangeSortKeys returns the value of AllScheduledChangeSortKeys named name.
+func LookupScheduledChangeSortKeys(name string) (ScheduledChangeSortKeys, bool) {
+	return lookupEnum(AllScheduledChangeSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllScheduledChangeSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ScheduledChangeSortKeys) IsKnown() bool {
+	return slices.Contains(AllScheduledChangeSortKeys, e)
+}
+
+func (e ScheduledChangeSortKeys) GoString() string {
+	return enumGoString(AllScheduledChangeSortKeys, enumConstants["ScheduledChangeSortKeys"], e, "model.ScheduledChangeSortKeys")
+}
+
+func (e ScheduledChangeSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ScheduledChangeSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ScheduledChangeSortKeys")
+}
+
+func (e *ScheduledChangeSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ScheduledChangeSortKeys")
+}
+
+// LookupScriptTagDisplayScope returns the value of AllScriptTagDisplayScope named name.
+func LookupScriptTagDisplayScope(name string) (ScriptTagDisplayScope, bool) {
+	return lookupEnum(AllScriptTagDisplayScope, name)
+}
+
+// IsKnown reports whether e is one of AllScriptTagDisplayScope, the values declared by the schema the models were
+// generated from.
+func (e ScriptTagDisplayScope) IsKnown() bool {
+	return slices.Contains(AllScriptTagDisplayScope, e)
+}
+
+func (e ScriptTagDisplayScope) GoString() string {
+	return enumGoString(AllScriptTagDisplayScope, enumConstants["ScriptTagDisplayScope"], e, "model.ScriptTagDisplayScope")
+}
+
+func (e ScriptTagDisplayScope) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ScriptTagDisplayScope) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ScriptTagDisplayScope")
+}
+
+func (e *ScriptTagDisplayScope) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ScriptTagDisplayScope")
+}
+
+// LookupSearchResultType returns the value of AllSearchResultType named name.
+func LookupSearchResultType(name string) (SearchResultType, bool) {
+	return lookupEnum(AllSearchResultType, name)
+}
+
+// IsKnown reports whether e is one of AllSearchResultType, the values declared by the schema the models were
+// generated from.
+func (e SearchResultType) IsKnown() bool {
+	return slices.Contains(AllSearchResultType, e)
+}
+
+func (e SearchResultType) GoString() string {
+	return enumGoString(AllSearchResultType, enumConstants["SearchResultType"], e, "model.SearchResultType")
+}
+
+func (e SearchResultType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SearchResultType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SearchResultType")
+}
+
+func (e *SearchResultType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SearchResultType")
+}
+
+// LookupSegmentSortKeys returns the value of AllSegmentSortKeys named name.
+func LookupSegmentSortKeys(name string) (SegmentSortKeys, bool) {
+	return lookupEnum(AllSegmentSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllSegmentSortKeys, the values declared by the schema the models were
+// generated from.
+func (e SegmentSortKeys) IsKnown() bool {
+	return slices.Contains(AllSegmentSortKeys, e)
+}
+
+func (e SegmentSortKeys) GoString() string {
+	return enumGoString(AllSegmentSortKeys, enumConstants["SegmentSortKeys"], e, "model.SegmentSortKeys")
+}
+
+func (e SegmentSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SegmentSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SegmentSortKeys")
+}
+
+func (e *SegmentSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SegmentSortKeys")
+}
+
+// LookupSellingPlanAnchorType returns the value of AllSellingPlanAnchorType named name.
+func LookupSellingPlanAnchorType(name string) (SellingPlanAnchorType, bool) {
+	return lookupEnum(AllSellingPlanAnchorType, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanAnchorType, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanAnchorType) IsKnown() bool {
+	return slices.Contains(AllSellingPlanAnchorType, e)
+}
+
+func (e SellingPlanAnchorType) GoString() string {
+	return enumGoString(AllSellingPlanAnchorType, enumConstants["SellingPlanAnchorType"], e, "model.SellingPlanAnchorType")
+}
+
+func (e SellingPlanAnchorType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanAnchorType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanAnchorType")
+}
+
+func (e *SellingPlanAnchorType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanAnchorType")
+}
+
+// LookupSellingPlanCategory returns the value of AllSellingPlanCategory named name.
+func LookupSellingPlanCategory(name string) (SellingPlanCategory, bool) {
+	return lookupEnum(AllSellingPlanCategory, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanCategory, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanCategory) IsKnown() bool {
+	return slices.Contains(AllSellingPlanCategory, e)
+}
+
+func (e SellingPlanCategory) GoString() string {
+	return enumGoString(AllSellingPlanCategory, enumConstants["SellingPlanCategory"], e, "model.SellingPlanCategory")
+}
+
+func (e SellingPlanCategory) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanCategory) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanCategory")
+}
+
+func (e *SellingPlanCategory) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanCategory")
+}
+
+// LookupSellingPlanCheckoutChargeType returns the value of AllSellingPlanCheckoutChargeType named name.
+func LookupSellingPlanCheckoutChargeType(name string) (SellingPlanCheckoutChargeType, bool) {
+	return lookupEnum(AllSellingPlanCheckoutChargeType, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanCheckoutChargeType, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanCheckoutChargeType) IsKnown() bool {
+	return slices.Contains(AllSellingPlanCheckoutChargeType, e)
+}
+
+func (e SellingPlanCheckoutChargeType) GoString() string {
+	return enumGoString(AllSellingPlanCheckoutChargeType, enumConstants["SellingPlanCheckoutChargeType"], e, "model.SellingPlanCheckoutChargeType")
+}
+
+func (e SellingPlanCheckoutChargeType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanCheckoutChargeType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanCheckoutChargeType")
+}
+
+func (e *SellingPlanCheckoutChargeType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanCheckoutChargeType")
+}
+
+// LookupSellingPlanFixedDeliveryPolicyIntent returns the value of AllSellingPlanFixedDeliveryPolicyIntent named name.
+func LookupSellingPlanFixedDeliveryPolicyIntent(name string) (SellingPlanFixedDeliveryPolicyIntent, bool) {
+	return lookupEnum(AllSellingPlanFixedDeliveryPolicyIntent, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanFixedDeliveryPolicyIntent, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanFixedDeliveryPolicyIntent) IsKnown() bool {
+	return slices.Contains(AllSellingPlanFixedDeliveryPolicyIntent, e)
+}
+
+func (e SellingPlanFixedDeliveryPolicyIntent) GoString() string {
+	return enumGoString(AllSellingPlanFixedDeliveryPolicyIntent, enumConstants["SellingPlanFixedDeliveryPolicyIntent"], e, "model.SellingPlanFixedDeliveryPolicyIntent")
+}
+
+func (e SellingPlanFixedDeliveryPolicyIntent) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanFixedDeliveryPolicyIntent) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanFixedDeliveryPolicyIntent")
+}
+
+func (e *SellingPlanFixedDeliveryPolicyIntent) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanFixedDeliveryPolicyIntent")
+}
+
+// LookupSellingPlanFixedDeliveryPolicyPreAnchorBehavior returns the value of AllSellingPlanFixedDeliveryPolicyPreAnchorBehavior named name.
+func LookupSellingPlanFixedDeliveryPolicyPreAnchorBehavior(name string) (SellingPlanFixedDeliveryPolicyPreAnchorBehavior, bool) {
+	return lookupEnum(AllSellingPlanFixedDeliveryPolicyPreAnchorBehavior, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanFixedDeliveryPolicyPreAnchorBehavior, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanFixedDeliveryPolicyPreAnchorBehavior) IsKnown() bool {
+	return slices.Contains(AllSellingPlanFixedDeliveryPolicyPreAnchorBehavior, e)
+}
+
+func (e SellingPlanFixedDeliveryPolicyPreAnchorBehavior) GoString() string {
+	return enumGoString(AllSellingPlanFixedDeliveryPolicyPreAnchorBehavior, enumConstants["SellingPlanFixedDeliveryPolicyPreAnchorBehavior"], e, "model.SellingPlanFixedDeliveryPolicyPreAnchorBehavior")
+}
+
+func (e SellingPlanFixedDeliveryPolicyPreAnchorBehavior) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanFixedDeliveryPolicyPreAnchorBehavior) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanFixedDeliveryPolicyPreAnchorBehavior")
+}
+
+func (e *SellingPlanFixedDeliveryPolicyPreAnchorBehavior) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanFixedDeliveryPolicyPreAnchorBehavior")
+}
+
+// LookupSellingPlanFulfillmentTrigger returns the value of AllSellingPlanFulfillmentTrigger named name.
+func LookupSellingPlanFulfillmentTrigger(name string) (SellingPlanFulfillmentTrigger, bool) {
+	return lookupEnum(AllSellingPlanFulfillmentTrigger, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanFulfillmentTrigger, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanFulfillmentTrigger) IsKnown() bool {
+	return slices.Contains(AllSellingPlanFulfillmentTrigger, e)
+}
+
+func (e SellingPlanFulfillmentTrigger) GoString() string {
+	return enumGoString(AllSellingPlanFulfillmentTrigger, enumConstants["SellingPlanFulfillmentTrigger"], e, "model.SellingPlanFulfillmentTrigger")
+}
+
+func (e SellingPlanFulfillmentTrigger) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanFulfillmentTrigger) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanFulfillmentTrigger")
+}
+
+func (e *SellingPlanFulfillmentTrigger) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanFulfillmentTrigger")
+}
+
+// LookupSellingPlanGroupSortKeys returns the value of AllSellingPlanGroupSortKeys named name.
+func LookupSellingPlanGroupSortKeys(name string) (SellingPlanGroupSortKeys, bool) {
+	return lookupEnum(AllSellingPlanGroupSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanGroupSortKeys, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanGroupSortKeys) IsKnown() bool {
+	return slices.Contains(AllSellingPlanGroupSortKeys, e)
+}
+
+func (e SellingPlanGroupSortKeys) GoString() string {
+	return enumGoString(AllSellingPlanGroupSortKeys, enumConstants["SellingPlanGroupSortKeys"], e, "model.SellingPlanGroupSortKeys")
+}
+
+func (e SellingPlanGroupSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanGroupSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanGroupSortKeys")
+}
+
+func (e *SellingPlanGroupSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanGroupSortKeys")
+}
+
+// LookupSellingPlanGroupUserErrorCode returns the value of AllSellingPlanGroupUserErrorCode named name.
+func LookupSellingPlanGroupUserErrorCode(name string) (SellingPlanGroupUserErrorCode, bool) {
+	return lookupEnum(AllSellingPlanGroupUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanGroupUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanGroupUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllSellingPlanGroupUserErrorCode, e)
+}
+
+func (e SellingPlanGroupUserErrorCode) GoString() string {
+	return enumGoString(AllSellingPlanGroupUserErrorCode, enumConstants["SellingPlanGroupUserErrorCode"], e, "model.SellingPlanGroupUserErrorCode")
+}
+
+func (e SellingPlanGroupUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanGroupUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanGroupUserErrorCode")
+}
+
+func (e *SellingPlanGroupUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanGroupUserErrorCode")
+}
+
+// LookupSellingPlanInterval returns the value of AllSellingPlanInterval named name.
+func LookupSellingPlanInterval(name string) (SellingPlanInterval, bool) {
+	return lookupEnum(AllSellingPlanInterval, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanInterval, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanInterval) IsKnown() bool {
+	return slices.Contains(AllSellingPlanInterval, e)
+}
+
+func (e SellingPlanInterval) GoString() string {
+	return enumGoString(AllSellingPlanInterval, enumConstants["SellingPlanInterval"], e, "model.SellingPlanInterval")
+}
+
+func (e SellingPlanInterval) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanInterval) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanInterval")
+}
+
+func (e *SellingPlanInterval) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanInterval")
+}
+
+// LookupSellingPlanPricingPolicyAdjustmentType returns the value of AllSellingPlanPricingPolicyAdjustmentType named name.
+func LookupSellingPlanPricingPolicyAdjustmentType(name string) (SellingPlanPricingPolicyAdjustmentType, bool) {
+	return lookupEnum(AllSellingPlanPricingPolicyAdjustmentType, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanPricingPolicyAdjustmentType, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanPricingPolicyAdjustmentType) IsKnown() bool {
+	return slices.Contains(AllSellingPlanPricingPolicyAdjustmentType, e)
+}
+
+func (e SellingPlanPricingPolicyAdjustmentType) GoString() string {
+	return enumGoString(AllSellingPlanPricingPolicyAdjustmentType, enumConstants["SellingPlanPricingPolicyAdjustmentType"], e, "model.SellingPlanPricingPolicyAdjustmentType")
+}
+
+func (e SellingPlanPricingPolicyAdjustmentType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanPricingPolicyAdjustmentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanPricingPolicyAdjustmentType")
+}
+
+func (e *SellingPlanPricingPolicyAdjustmentType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanPricingPolicyAdjustmentType")
+}
+
+// LookupSellingPlanRecurringDeliveryPolicyIntent returns the value of AllSellingPlanRecurringDeliveryPolicyIntent named name.
+func LookupSellingPlanRecurringDeliveryPolicyIntent(name string) (SellingPlanRecurringDeliveryPolicyIntent, bool) {
+	return lookupEnum(AllSellingPlanRecurringDeliveryPolicyIntent, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanRecurringDeliveryPolicyIntent, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanRecurringDeliveryPolicyIntent) IsKnown() bool {
+	return slices.Contains(AllSellingPlanRecurringDeliveryPolicyIntent, e)
+}
+
+func (e SellingPlanRecurringDeliveryPolicyIntent) GoString() string {
+	return enumGoString(AllSellingPlanRecurringDeliveryPolicyIntent, enumConstants["SellingPlanRecurringDeliveryPolicyIntent"], e, "model.SellingPlanRecurringDeliveryPolicyIntent")
+}
+
+func (e SellingPlanRecurringDeliveryPolicyIntent) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanRecurringDeliveryPolicyIntent) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanRecurringDeliveryPolicyIntent")
+}
+
+func (e *SellingPlanRecurringDeliveryPolicyIntent) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanRecurringDeliveryPolicyIntent")
+}
+
+// LookupSellingPlanRecurringDeliveryPolicyPreAnchorBehavior returns the value of AllSellingPlanRecurringDeliveryPolicyPreAnchorBehavior named name.
+func LookupSellingPlanRecurringDeliveryPolicyPreAnchorBehavior(name string) (SellingPlanRecurringDeliveryPolicyPreAnchorBehavior, bool) {
+	return lookupEnum(AllSellingPlanRecurringDeliveryPolicyPreAnchorBehavior, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanRecurringDeliveryPolicyPreAnchorBehavior, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanRecurringDeliveryPolicyPreAnchorBehavior) IsKnown() bool {
+	return slices.Contains(AllSellingPlanRecurringDeliveryPolicyPreAnchorBehavior, e)
+}
+
+func (e SellingPlanRecurringDeliveryPolicyPreAnchorBehavior) GoString() string {
+	return enumGoString(AllSellingPlanRecurringDeliveryPolicyPreAnchorBehavior, enumConstants["SellingPlanRecurringDeliveryPolicyPreAnchorBehavior"], e, "model.SellingPlanRecurringDeliveryPolicyPreAnchorBehavior")
+}
+
+func (e SellingPlanRecurringDeliveryPolicyPreAnchorBehavior) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanRecurringDeliveryPolicyPreAnchorBehavior) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanRecurringDeliveryPolicyPreAnchorBehavior")
+}
+
+func (e *SellingPlanRecurringDeliveryPolicyPreAnchorBehavior) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanRecurringDeliveryPolicyPreAnchorBehavior")
+}
+
+// LookupSellingPlanRemainingBalanceChargeTrigger returns the value of AllSellingPlanRemainingBalanceChargeTrigger named name.
+func LookupSellingPlanRemainingBalanceChargeTrigger(name string) (SellingPlanRemainingBalanceChargeTrigger, bool) {
+	return lookupEnum(AllSellingPlanRemainingBalanceChargeTrigger, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanRemainingBalanceChargeTrigger, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanRemainingBalanceChargeTrigger) IsKnown() bool {
+	return slices.Contains(AllSellingPlanRemainingBalanceChargeTrigger, e)
+}
+
+func (e SellingPlanRemainingBalanceChargeTrigger) GoString() string {
+	return enumGoString(AllSellingPlanRemainingBalanceChargeTrigger, enumConstants["SellingPlanRemainingBalanceChargeTrigger"], e, "model.SellingPlanRemainingBalanceChargeTrigger")
+}
+
+func (e SellingPlanRemainingBalanceChargeTrigger) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanRemainingBalanceChargeTrigger) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanRemainingBalanceChargeTrigger")
+}
+
+func (e *SellingPlanRemainingBalanceChargeTrigger) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanRemainingBalanceChargeTrigger")
+}
+
+// LookupSellingPlanReserve returns the value of AllSellingPlanReserve named name.
+func LookupSellingPlanReserve(name string) (SellingPlanReserve, bool) {
+	return lookupEnum(AllSellingPlanReserve, name)
+}
+
+// IsKnown reports whether e is one of AllSellingPlanReserve, the values declared by the schema the models were
+// generated from.
+func (e SellingPlanReserve) IsKnown() bool {
+	return slices.Contains(AllSellingPlanReserve, e)
+}
+
+func (e SellingPlanReserve) GoString() string {
+	return enumGoString(AllSellingPlanReserve, enumConstants["SellingPlanReserve"], e, "model.SellingPlanReserve")
+}
+
+func (e SellingPlanReserve) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SellingPlanReserve) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SellingPlanReserve")
+}
+
+func (e *SellingPlanReserve) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SellingPlanReserve")
+}
+
+// LookupServerPixelStatus returns the value of AllServerPixelStatus named name.
+func LookupServerPixelStatus(name string) (ServerPixelStatus, bool) {
+	return lookupEnum(AllServerPixelStatus, name)
+}
+
+// IsKnown reports whether e is one of AllServerPixelStatus, the values declared by the schema the models were
+// generated from.
+func (e ServerPixelStatus) IsKnown() bool {
+	return slices.Contains(AllServerPixelStatus, e)
+}
+
+func (e ServerPixelStatus) GoString() string {
+	return enumGoString(AllServerPixelStatus, enumConstants["ServerPixelStatus"], e, "model.ServerPixelStatus")
+}
+
+func (e ServerPixelStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ServerPixelStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ServerPixelStatus")
+}
+
+func (e *ServerPixelStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ServerPixelStatus")
+}
+
+// LookupShippingDiscountClass returns the value of AllShippingDiscountClass named name.
+func LookupShippingDiscountClass(name string) (ShippingDiscountClass, bool) {
+	return lookupEnum(AllShippingDiscountClass, name)
+}
+
+// IsKnown reports whether e is one of AllShippingDiscountClass, the values declared by the schema the models were
+// generated from.
+func (e ShippingDiscountClass) IsKnown() bool {
+	return slices.Contains(AllShippingDiscountClass, e)
+}
+
+func (e ShippingDiscountClass) GoString() string {
+	return enumGoString(AllShippingDiscountClass, enumConstants["ShippingDiscountClass"], e, "model.ShippingDiscountClass")
+}
+
+func (e ShippingDiscountClass) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShippingDiscountClass) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShippingDiscountClass")
+}
+
+func (e *ShippingDiscountClass) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShippingDiscountClass")
+}
+
+// LookupShippingPackageType returns the value of AllShippingPackageType named name.
+func LookupShippingPackageType(name string) (ShippingPackageType, bool) {
+	return lookupEnum(AllShippingPackageType, name)
+}
+
+// IsKnown reports whether e is one of AllShippingPackageType, the values declared by the schema the models were
+// generated from.
+func (e ShippingPackageType) IsKnown() bool {
+	return slices.Contains(AllShippingPackageType, e)
+}
+
+func (e ShippingPackageType) GoString() string {
+	return enumGoString(AllShippingPackageType, enumConstants["ShippingPackageType"], e, "model.ShippingPackageType")
+}
+
+func (e ShippingPackageType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShippingPackageType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShippingPackageType")
+}
+
+func (e *ShippingPackageType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShippingPackageType")
+}
+
+// LookupShopBranding returns the value of AllShopBranding named name.
+func LookupShopBranding(name string) (ShopBranding, bool) {
+	return lookupEnum(AllShopBranding, name)
+}
+
+// IsKnown reports whether e is one of AllShopBranding, the values declared by the schema the models were
+// generated from.
+func (e ShopBranding) IsKnown() bool {
+	return slices.Contains(AllShopBranding, e)
+}
+
+func (e ShopBranding) GoString() string {
+	return enumGoString(AllShopBranding, enumConstants["ShopBranding"], e, "model.ShopBranding")
+}
+
+func (e ShopBranding) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopBranding) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopBranding")
+}
+
+func (e *ShopBranding) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopBranding")
+}
+
+// LookupShopCustomerAccountsSetting returns the value of AllShopCustomerAccountsSetting named name.
+func LookupShopCustomerAccountsSetting(name string) (ShopCustomerAccountsSetting, bool) {
+	return lookupEnum(AllShopCustomerAccountsSetting, name)
+}
+
+// IsKnown reports whether e is one of AllShopCustomerAccountsSetting, the values declared by the schema the models were
+// generated from.
+func (e ShopCustomerAccountsSetting) IsKnown() bool {
+	return slices.Contains(AllShopCustomerAccountsSetting, e)
+}
+
+func (e ShopCustomerAccountsSetting) GoString() string {
+	return enumGoString(AllShopCustomerAccountsSetting, enumConstants["ShopCustomerAccountsSetting"], e, "model.ShopCustomerAccountsSetting")
+}
+
+func (e ShopCustomerAccountsSetting) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopCustomerAccountsSetting) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopCustomerAccountsSetting")
+}
+
+func (e *ShopCustomerAccountsSetting) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopCustomerAccountsSetting")
+}
+
+// LookupShopPolicyErrorCode returns the value of AllShopPolicyErrorCode named name.
+func LookupShopPolicyErrorCode(name string) (ShopPolicyErrorCode, bool) {
+	return lookupEnum(AllShopPolicyErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllShopPolicyErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ShopPolicyErrorCode) IsKnown() bool {
+	return slices.Contains(AllShopPolicyErrorCode, e)
+}
+
+func (e ShopPolicyErrorCode) GoString() string {
+	return enumGoString(AllShopPolicyErrorCode, enumConstants["ShopPolicyErrorCode"], e, "model.ShopPolicyErrorCode")
+}
+
+func (e ShopPolicyErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopPolicyErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopPolicyErrorCode")
+}
+
+func (e *ShopPolicyErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopPolicyErrorCode")
+}
+
+// LookupShopPolicyType returns the value of AllShopPolicyType named name.
+func LookupShopPolicyType(name string) (ShopPolicyType, bool) {
+	return lookupEnum(AllShopPolicyType, name)
+}
+
+// IsKnown reports whether e is one of AllShopPolicyType, the values declared by the schema the models were
+// generated from.
+func (e ShopPolicyType) IsKnown() bool {
+	return slices.Contains(AllShopPolicyType, e)
+}
+
+func (e ShopPolicyType) GoString() string {
+	return enumGoString(AllShopPolicyType, enumConstants["ShopPolicyType"], e, "model.ShopPolicyType")
+}
+
+func (e ShopPolicyType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopPolicyType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopPolicyType")
+}
+
+func (e *ShopPolicyType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopPolicyType")
+}
+
+// LookupShopResourceFeedbackCreateUserErrorCode returns the value of AllShopResourceFeedbackCreateUserErrorCode named name.
+func LookupShopResourceFeedbackCreateUserErrorCode(name string) (ShopResourceFeedbackCreateUserErrorCode, bool) {
+	return lookupEnum(AllShopResourceFeedbackCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllShopResourceFeedbackCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ShopResourceFeedbackCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllShopResourceFeedbackCreateUserErrorCode, e)
+}
+
+func (e ShopResourceFeedbackCreateUserErrorCode) GoString() string {
+	return enumGoString(AllShopResourceFeedbackCreateUserErrorCode, enumConstants["ShopResourceFeedbackCreateUserErrorCode"], e, "model.ShopResourceFeedbackCreateUserErrorCode")
+}
+
+func (e ShopResourceFeedbackCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopResourceFeedbackCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopResourceFeedbackCreateUserErrorCode")
+}
+
+func (e *ShopResourceFeedbackCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopResourceFeedbackCreateUserErrorCode")
+}
+
+// LookupShopTagSort returns the value of AllShopTagSort named name.
+func LookupShopTagSort(name string) (ShopTagSort, bool) {
+	return lookupEnum(AllShopTagSort, name)
+}
+
+// IsKnown reports whether e is one of AllShopTagSort, the values declared by the schema the models were
+// generated from.
+func (e ShopTagSort) IsKnown() bool {
+	return slices.Contains(AllShopTagSort, e)
+}
+
+func (e ShopTagSort) GoString() string {
+	return enumGoString(AllShopTagSort, enumConstants["ShopTagSort"], e, "model.ShopTagSort")
+}
+
+func (e ShopTagSort) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopTagSort) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopTagSort")
+}
+
+func (e *ShopTagSort) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopTagSort")
+}
+
+// LookupShopifyPaymentsBalanceTransactionPayoutStatus returns the value of AllShopifyPaymentsBalanceTransactionPayoutStatus named name.
+func LookupShopifyPaymentsBalanceTransactionPayoutStatus(name string) (ShopifyPaymentsBalanceTransactionPayoutStatus, bool) {
+	return lookupEnum(AllShopifyPaymentsBalanceTransactionPayoutStatus, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsBalanceTransactionPayoutStatus, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsBalanceTransactionPayoutStatus) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsBalanceTransactionPayoutStatus, e)
+}
+
+func (e ShopifyPaymentsBalanceTransactionPayoutStatus) GoString() string {
+	return enumGoString(AllShopifyPaymentsBalanceTransactionPayoutStatus, enumConstants["ShopifyPaymentsBalanceTransactionPayoutStatus"], e, "model.ShopifyPaymentsBalanceTransactionPayoutStatus")
+}
+
+func (e ShopifyPaymentsBalanceTransactionPayoutStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsBalanceTransactionPayoutStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsBalanceTransactionPayoutStatus")
+}
+
+func (e *ShopifyPaymentsBalanceTransactionPayoutStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsBalanceTransactionPayoutStatus")
+}
+
+// LookupShopifyPaymentsBankAccountStatus returns the value of AllShopifyPaymentsBankAccountStatus named name.
+func LookupShopifyPaymentsBankAccountStatus(name string) (ShopifyPaymentsBankAccountStatus, bool) {
+	return lookupEnum(AllShopifyPaymentsBankAccountStatus, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsBankAccountStatus, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsBankAccountStatus) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsBankAccountStatus, e)
+}
+
+func (e ShopifyPaymentsBankAccountStatus) GoString() string {
+	return enumGoString(AllShopifyPaymentsBankAccountStatus, enumConstants["ShopifyPaymentsBankAccountStatus"], e, "model.ShopifyPaymentsBankAccountStatus")
+}
+
+func (e ShopifyPaymentsBankAccountStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsBankAccountStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsBankAccountStatus")
+}
+
+func (e *ShopifyPaymentsBankAccountStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsBankAccountStatus")
+}
+
+// LookupShopifyPaymentsDisputeEvidenceFileType returns the value of AllShopifyPaymentsDisputeEvidenceFileType named name.
+func LookupShopifyPaymentsDisputeEvidenceFileType(name string) (ShopifyPaymentsDisputeEvidenceFileType, bool) {
+	return lookupEnum(AllShopifyPaymentsDisputeEvidenceFileType, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsDisputeEvidenceFileType, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsDisputeEvidenceFileType) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsDisputeEvidenceFileType, e)
+}
+
+func (e ShopifyPaymentsDisputeEvidenceFileType) GoString() string {
+	return enumGoString(AllShopifyPaymentsDisputeEvidenceFileType, enumConstants["ShopifyPaymentsDisputeEvidenceFileType"], e, "model.ShopifyPaymentsDisputeEvidenceFileType")
+}
+
+func (e ShopifyPaymentsDisputeEvidenceFileType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsDisputeEvidenceFileType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsDisputeEvidenceFileType")
+}
+
+func (e *ShopifyPaymentsDisputeEvidenceFileType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsDisputeEvidenceFileType")
+}
+
+// LookupShopifyPaymentsDisputeReason returns the value of AllShopifyPaymentsDisputeReason named name.
+func LookupShopifyPaymentsDisputeReason(name string) (ShopifyPaymentsDisputeReason, bool) {
+	return lookupEnum(AllShopifyPaymentsDisputeReason, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsDisputeReason, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsDisputeReason) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsDisputeReason, e)
+}
+
+func (e ShopifyPaymentsDisputeReason) GoString() string {
+	return enumGoString(AllShopifyPaymentsDisputeReason, enumConstants["ShopifyPaymentsDisputeReason"], e, "model.ShopifyPaymentsDisputeReason")
+}
+
+func (e ShopifyPaymentsDisputeReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsDisputeReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsDisputeReason")
+}
+
+func (e *ShopifyPaymentsDisputeReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsDisputeReason")
+}
+
+// LookupShopifyPaymentsPayoutInterval returns the value of AllShopifyPaymentsPayoutInterval named name.
+func LookupShopifyPaymentsPayoutInterval(name string) (ShopifyPaymentsPayoutInterval, bool) {
+	return lookupEnum(AllShopifyPaymentsPayoutInterval, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsPayoutInterval, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsPayoutInterval) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsPayoutInterval, e)
+}
+
+func (e ShopifyPaymentsPayoutInterval) GoString() string {
+	return enumGoString(AllShopifyPaymentsPayoutInterval, enumConstants["ShopifyPaymentsPayoutInterval"], e, "model.ShopifyPaymentsPayoutInterval")
+}
+
+func (e ShopifyPaymentsPayoutInterval) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsPayoutInterval) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsPayoutInterval")
+}
+
+func (e *ShopifyPaymentsPayoutInterval) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsPayoutInterval")
+}
+
+// LookupShopifyPaymentsPayoutStatus returns the value of AllShopifyPaymentsPayoutStatus named name.
+func LookupShopifyPaymentsPayoutStatus(name string) (ShopifyPaymentsPayoutStatus, bool) {
+	return lookupEnum(AllShopifyPaymentsPayoutStatus, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsPayoutStatus, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsPayoutStatus) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsPayoutStatus, e)
+}
+
+func (e ShopifyPaymentsPayoutStatus) GoString() string {
+	return enumGoString(AllShopifyPaymentsPayoutStatus, enumConstants["ShopifyPaymentsPayoutStatus"], e, "model.ShopifyPaymentsPayoutStatus")
+}
+
+func (e ShopifyPaymentsPayoutStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsPayoutStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsPayoutStatus")
+}
+
+func (e *ShopifyPaymentsPayoutStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsPayoutStatus")
+}
+
+// LookupShopifyPaymentsPayoutTransactionType returns the value of AllShopifyPaymentsPayoutTransactionType named name.
+func LookupShopifyPaymentsPayoutTransactionType(name string) (ShopifyPaymentsPayoutTransactionType, bool) {
+	return lookupEnum(AllShopifyPaymentsPayoutTransactionType, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsPayoutTransactionType, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsPayoutTransactionType) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsPayoutTransactionType, e)
+}
+
+func (e ShopifyPaymentsPayoutTransactionType) GoString() string {
+	return enumGoString(AllShopifyPaymentsPayoutTransactionType, enumConstants["ShopifyPaymentsPayoutTransactionType"], e, "model.ShopifyPaymentsPayoutTransactionType")
+}
+
+func (e ShopifyPaymentsPayoutTransactionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsPayoutTransactionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsPayoutTransactionType")
+}
+
+func (e *ShopifyPaymentsPayoutTransactionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsPayoutTransactionType")
+}
+
+// LookupShopifyPaymentsSourceType returns the value of AllShopifyPaymentsSourceType named name.
+func LookupShopifyPaymentsSourceType(name string) (ShopifyPaymentsSourceType, bool) {
+	return lookupEnum(AllShopifyPaymentsSourceType, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsSourceType, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsSourceType) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsSourceType, e)
+}
+
+func (e ShopifyPaymentsSourceType) GoString() string {
+	return enumGoString(AllShopifyPaymentsSourceType, enumConstants["ShopifyPaymentsSourceType"], e, "model.ShopifyPaymentsSourceType")
+}
+
+func (e ShopifyPaymentsSourceType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsSourceType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsSourceType")
+}
+
+func (e *ShopifyPaymentsSourceType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsSourceType")
+}
+
+// LookupShopifyPaymentsTransactionType returns the value of AllShopifyPaymentsTransactionType named name.
+func LookupShopifyPaymentsTransactionType(name string) (ShopifyPaymentsTransactionType, bool) {
+	return lookupEnum(AllShopifyPaymentsTransactionType, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsTransactionType, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsTransactionType) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsTransactionType, e)
+}
+
+func (e ShopifyPaymentsTransactionType) GoString() string {
+	return enumGoString(AllShopifyPaymentsTransactionType, enumConstants["ShopifyPaymentsTransactionType"], e, "model.ShopifyPaymentsTransactionType")
+}
+
+func (e ShopifyPaymentsTransactionType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsTransactionType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsTransactionType")
+}
+
+func (e *ShopifyPaymentsTransactionType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsTransactionType")
+}
+
+// LookupShopifyPaymentsVerificationStatus returns the value of AllShopifyPaymentsVerificationStatus named name.
+func LookupShopifyPaymentsVerificationStatus(name string) (ShopifyPaymentsVerificationStatus, bool) {
+	return lookupEnum(AllShopifyPaymentsVerificationStatus, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyPaymentsVerificationStatus, the values declared by the schema the models were
+// generated from.
+func (e ShopifyPaymentsVerificationStatus) IsKnown() bool {
+	return slices.Contains(AllShopifyPaymentsVerificationStatus, e)
+}
+
+func (e ShopifyPaymentsVerificationStatus) GoString() string {
+	return enumGoString(AllShopifyPaymentsVerificationStatus, enumConstants["ShopifyPaymentsVerificationStatus"], e, "model.ShopifyPaymentsVerificationStatus")
+}
+
+func (e ShopifyPaymentsVerificationStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyPaymentsVerificationStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyPaymentsVerificationStatus")
+}
+
+func (e *ShopifyPaymentsVerificationStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyPaymentsVerificationStatus")
+}
+
+// LookupShopifyProtectEligibilityStatus returns the value of AllShopifyProtectEligibilityStatus named name.
+func LookupShopifyProtectEligibilityStatus(name string) (ShopifyProtectEligibilityStatus, bool) {
+	return lookupEnum(AllShopifyProtectEligibilityStatus, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyProtectEligibilityStatus, the values declared by the schema the models were
+// generated from.
+func (e ShopifyProtectEligibilityStatus) IsKnown() bool {
+	return slices.Contains(AllShopifyProtectEligibilityStatus, e)
+}
+
+func (e ShopifyProtectEligibilityStatus) GoString() string {
+	return enumGoString(AllShopifyProtectEligibilityStatus, enumConstants["ShopifyProtectEligibilityStatus"], e, "model.ShopifyProtectEligibilityStatus")
+}
+
+func (e ShopifyProtectEligibilityStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyProtectEligibilityStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyProtectEligibilityStatus")
+}
+
+func (e *ShopifyProtectEligibilityStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyProtectEligibilityStatus")
+}
+
+// LookupShopifyProtectStatus returns the value of AllShopifyProtectStatus named name.
+func LookupShopifyProtectStatus(name string) (ShopifyProtectStatus, bool) {
+	return lookupEnum(AllShopifyProtectStatus, name)
+}
+
+// IsKnown reports whether e is one of AllShopifyProtectStatus, the values declared by the schema the models were
+// generated from.
+func (e ShopifyProtectStatus) IsKnown() bool {
+	return slices.Contains(AllShopifyProtectStatus, e)
+}
+
+func (e ShopifyProtectStatus) GoString() string {
+	return enumGoString(AllShopifyProtectStatus, enumConstants["ShopifyProtectStatus"], e, "model.ShopifyProtectStatus")
+}
+
+func (e ShopifyProtectStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ShopifyProtectStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ShopifyProtectStatus")
+}
+
+func (e *ShopifyProtectStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ShopifyProtectStatus")
+}
+
+// LookupStaffMemberDefaultImage returns the value of AllStaffMemberDefaultImage named name.
+func LookupStaffMemberDefaultImage(name string) (StaffMemberDefaultImage, bool) {
+	return lookupEnum(AllStaffMemberDefaultImage, name)
+}
+
+// IsKnown reports whether e is one of AllStaffMemberDefaultImage, the values declared by the schema the models were
+// generated from.
+func (e StaffMemberDefaultImage) IsKnown() bool {
+	return slices.Contains(AllStaffMemberDefaultImage, e)
+}
+
+func (e StaffMemberDefaultImage) GoString() string {
+	return enumGoString(AllStaffMemberDefaultImage, enumConstants["StaffMemberDefaultImage"], e, "model.StaffMemberDefaultImage")
+}
+
+func (e StaffMemberDefaultImage) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StaffMemberDefaultImage) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StaffMemberDefaultImage")
+}
+
+func (e *StaffMemberDefaultImage) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StaffMemberDefaultImage")
+}
+
+// LookupStaffMemberPermission returns the value of AllStaffMemberPermission named name.
+func LookupStaffMemberPermission(name string) (StaffMemberPermission, bool) {
+	return lookupEnum(AllStaffMemberPermission, name)
+}
+
+// IsKnown reports whether e is one of AllStaffMemberPermission, the values declared by the schema the models were
+// generated from.
+func (e StaffMemberPermission) IsKnown() bool {
+	return slices.Contains(AllStaffMemberPermission, e)
+}
+
+func (e StaffMemberPermission) GoString() string {
+	return enumGoString(AllStaffMemberPermission, enumConstants["StaffMemberPermission"], e, "model.StaffMemberPermission")
+}
+
+func (e StaffMemberPermission) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StaffMemberPermission) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StaffMemberPermission")
+}
+
+func (e *StaffMemberPermission) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StaffMemberPermission")
+}
+
+// LookupStaffMembersSortKeys returns the value of AllStaffMembersSortKeys named name.
+func LookupStaffMembersSortKeys(name string) (StaffMembersSortKeys, bool) {
+	return lookupEnum(AllStaffMembersSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllStaffMembersSortKeys, the values declared by the schema the models were
+// generated from.
+func (e StaffMembersSortKeys) IsKnown() bool {
+	return slices.Contains(AllStaffMembersSortKeys, e)
+}
+
+func (e StaffMembersSortKeys) GoString() string {
+	return enumGoString(AllStaffMembersSortKeys, enumConstants["StaffMembersSortKeys"], e, "model.StaffMembersSortKeys")
+}
+
+func (e StaffMembersSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StaffMembersSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StaffMembersSortKeys")
+}
+
+func (e *StaffMembersSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StaffMembersSortKeys")
+}
+
+// LookupStagedUploadHTTPMethodType returns the value of AllStagedUploadHTTPMethodType named name.
+func LookupStagedUploadHTTPMethodType(name string) (StagedUploadHTTPMethodType, bool) {
+	return lookupEnum(AllStagedUploadHTTPMethodType, name)
+}
+
+// IsKnown reports whether e is one of AllStagedUploadHTTPMethodType, the values declared by the schema the models were
+// generated from.
+func (e StagedUploadHTTPMethodType) IsKnown() bool {
+	return slices.Contains(AllStagedUploadHTTPMethodType, e)
+}
+
+func (e StagedUploadHTTPMethodType) GoString() string {
+	return enumGoString(AllStagedUploadHTTPMethodType, enumConstants["StagedUploadHTTPMethodType"], e, "model.StagedUploadHTTPMethodType")
+}
+
+func (e StagedUploadHTTPMethodType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StagedUploadHTTPMethodType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StagedUploadHTTPMethodType")
+}
+
+func (e *StagedUploadHTTPMethodType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StagedUploadHTTPMethodType")
+}
+
+// LookupStagedUploadTargetGenerateUploadResource returns the value of AllStagedUploadTargetGenerateUploadResource named name.
+func LookupStagedUploadTargetGenerateUploadResource(name string) (StagedUploadTargetGenerateUploadResource, bool) {
+	return lookupEnum(AllStagedUploadTargetGenerateUploadResource, name)
+}
+
+// IsKnown reports whether e is one of AllStagedUploadTargetGenerateUploadResource, the values declared by the schema the models were
+// generated from.
+func (e StagedUploadTargetGenerateUploadResource) IsKnown() bool {
+	return slices.Contains(AllStagedUploadTargetGenerateUploadResource, e)
+}
+
+func (e StagedUploadTargetGenerateUploadResource) GoString() string {
+	return enumGoString(AllStagedUploadTargetGenerateUploadResource, enumConstants["StagedUploadTargetGenerateUploadResource"], e, "model.StagedUploadTargetGenerateUploadResource")
+}
+
+func (e StagedUploadTargetGenerateUploadResource) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StagedUploadTargetGenerateUploadResource) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StagedUploadTargetGenerateUploadResource")
+}
+
+func (e *StagedUploadTargetGenerateUploadResource) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StagedUploadTargetGenerateUploadResource")
+}
+
+// LookupStandardMetafieldDefinitionEnableUserErrorCode returns the value of AllStandardMetafieldDefinitionEnableUserErrorCode named name.
+func LookupStandardMetafieldDefinitionEnableUserErrorCode(name string) (StandardMetafieldDefinitionEnableUserErrorCode, bool) {
+	return lookupEnum(AllStandardMetafieldDefinitionEnableUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllStandardMetafieldDefinitionEnableUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e StandardMetafieldDefinitionEnableUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllStandardMetafieldDefinitionEnableUserErrorCode, e)
+}
+
+func (e StandardMetafieldDefinitionEnableUserErrorCode) GoString() string {
+	return enumGoString(AllStandardMetafieldDefinitionEnableUserErrorCode, enumConstants["StandardMetafieldDefinitionEnableUserErrorCode"], e, "model.StandardMetafieldDefinitionEnableUserErrorCode")
+}
+
+func (e StandardMetafieldDefinitionEnableUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StandardMetafieldDefinitionEnableUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StandardMetafieldDefinitionEnableUserErrorCode")
+}
+
+func (e *StandardMetafieldDefinitionEnableUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StandardMetafieldDefinitionEnableUserErrorCode")
+}
+
+// LookupStoreCreditAccountCreditUserErrorCode returns the value of AllStoreCreditAccountCreditUserErrorCode named name.
+func LookupStoreCreditAccountCreditUserErrorCode(name string) (StoreCreditAccountCreditUserErrorCode, bool) {
+	return lookupEnum(AllStoreCreditAccountCreditUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllStoreCreditAccountCreditUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e StoreCreditAccountCreditUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllStoreCreditAccountCreditUserErrorCode, e)
+}
+
+func (e StoreCreditAccountCreditUserErrorCode) GoString() string {
+	return enumGoString(AllStoreCreditAccountCreditUserErrorCode, enumConstants["StoreCreditAccountCreditUserErrorCode"], e, "model.StoreCreditAccountCreditUserErrorCode")
+}
+
+func (e StoreCreditAccountCreditUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StoreCreditAccountCreditUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StoreCreditAccountCreditUserErrorCode")
+}
+
+func (e *StoreCreditAccountCreditUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StoreCreditAccountCreditUserErrorCode")
+}
+
+// LookupStoreCreditAccountDebitUserErrorCode returns the value of AllStoreCreditAccountDebitUserErrorCode named name.
+func LookupStoreCreditAccountDebitUserErrorCode(name string) (StoreCreditAccountDebitUserErrorCode, bool) {
+	return lookupEnum(AllStoreCreditAccountDebitUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllStoreCreditAccountDebitUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e StoreCreditAccountDebitUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllStoreCreditAccountDebitUserErrorCode, e)
+}
+
+func (e StoreCreditAccountDebitUserErrorCode) GoString() string {
+	return enumGoString(AllStoreCreditAccountDebitUserErrorCode, enumConstants["StoreCreditAccountDebitUserErrorCode"], e, "model.StoreCreditAccountDebitUserErrorCode")
+}
+
+func (e StoreCreditAccountDebitUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *StoreCreditAccountDebitUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "StoreCreditAccountDebitUserErrorCode")
+}
+
+func (e *StoreCreditAccountDebitUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "StoreCreditAccountDebitUserErrorCode")
+}
+
+// LookupSubscriptionBillingAttemptErrorCode returns the value of AllSubscriptionBillingAttemptErrorCode named name.
+func LookupSubscriptionBillingAttemptErrorCode(name string) (SubscriptionBillingAttemptErrorCode, bool) {
+	return lookupEnum(AllSubscriptionBillingAttemptErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingAttemptErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingAttemptErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingAttemptErrorCode, e)
+}
+
+func (e SubscriptionBillingAttemptErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionBillingAttemptErrorCode, enumConstants["SubscriptionBillingAttemptErrorCode"], e, "model.SubscriptionBillingAttemptErrorCode")
+}
+
+func (e SubscriptionBillingAttemptErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingAttemptErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingAttemptErrorCode")
+}
+
+func (e *SubscriptionBillingAttemptErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingAttemptErrorCode")
+}
+
+// LookupSubscriptionBillingAttemptsSortKeys returns the value of AllSubscriptionBillingAttemptsSortKeys named name.
+func LookupSubscriptionBillingAttemptsSortKeys(name string) (SubscriptionBillingAttemptsSortKeys, bool) {
+	return lookupEnum(AllSubscriptionBillingAttemptsSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingAttemptsSortKeys, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingAttemptsSortKeys) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingAttemptsSortKeys, e)
+}
+
+func (e SubscriptionBillingAttemptsSortKeys) GoString() string {
+	return enumGoString(AllSubscriptionBillingAttemptsSortKeys, enumConstants["SubscriptionBillingAttemptsSortKeys"], e, "model.SubscriptionBillingAttemptsSortKeys")
+}
+
+func (e SubscriptionBillingAttemptsSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingAttemptsSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingAttemptsSortKeys")
+}
+
+func (e *SubscriptionBillingAttemptsSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingAttemptsSortKeys")
+}
+
+// LookupSubscriptionBillingCycleBillingAttemptStatus returns the value of AllSubscriptionBillingCycleBillingAttemptStatus named name.
+func LookupSubscriptionBillingCycleBillingAttemptStatus(name string) (SubscriptionBillingCycleBillingAttemptStatus, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleBillingAttemptStatus, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleBillingAttemptStatus, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleBillingAttemptStatus) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleBillingAttemptStatus, e)
+}
+
+func (e SubscriptionBillingCycleBillingAttemptStatus) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleBillingAttemptStatus, enumConstants["SubscriptionBillingCycleBillingAttemptStatus"], e, "model.SubscriptionBillingCycleBillingAttemptStatus")
+}
+
+func (e SubscriptionBillingCycleBillingAttemptStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleBillingAttemptStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleBillingAttemptStatus")
+}
+
+func (e *SubscriptionBillingCycleBillingAttemptStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleBillingAttemptStatus")
+}
+
+// LookupSubscriptionBillingCycleBillingCycleStatus returns the value of AllSubscriptionBillingCycleBillingCycleStatus named name.
+func LookupSubscriptionBillingCycleBillingCycleStatus(name string) (SubscriptionBillingCycleBillingCycleStatus, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleBillingCycleStatus, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleBillingCycleStatus, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleBillingCycleStatus) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleBillingCycleStatus, e)
+}
+
+func (e SubscriptionBillingCycleBillingCycleStatus) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleBillingCycleStatus, enumConstants["SubscriptionBillingCycleBillingCycleStatus"], e, "model.SubscriptionBillingCycleBillingCycleStatus")
+}
+
+func (e SubscriptionBillingCycleBillingCycleStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleBillingCycleStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleBillingCycleStatus")
+}
+
+func (e *SubscriptionBillingCycleBillingCycleStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleBillingCycleStatus")
+}
+
+// LookupSubscriptionBillingCycleBulkUserErrorCode returns the value of AllSubscriptionBillingCycleBulkUserErrorCode named name.
+func LookupSubscriptionBillingCycleBulkUserErrorCode(name string) (SubscriptionBillingCycleBulkUserErrorCode, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleBulkUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleBulkUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleBulkUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleBulkUserErrorCode, e)
+}
+
+func (e SubscriptionBillingCycleBulkUserErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleBulkUserErrorCode, enumConstants["SubscriptionBillingCycleBulkUserErrorCode"], e, "model.SubscriptionBillingCycleBulkUserErrorCode")
+}
+
+func (e SubscriptionBillingCycleBulkUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleBulkUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleBulkUserErrorCode")
+}
+
+func (e *SubscriptionBillingCycleBulkUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleBulkUserErrorCode")
+}
+
+// LookupSubscriptionBillingCycleErrorCode returns the value of AllSubscriptionBillingCycleErrorCode named name.
+func LookupSubscriptionBillingCycleErrorCode(name string) (SubscriptionBillingCycleErrorCode, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleErrorCode, e)
+}
+
+func (e SubscriptionBillingCycleErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleErrorCode, enumConstants["SubscriptionBillingCycleErrorCode"], e, "model.SubscriptionBillingCycleErrorCode")
+}
+
+func (e SubscriptionBillingCycleErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleErrorCode")
+}
+
+func (e *SubscriptionBillingCycleErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleErrorCode")
+}
+
+// LookupSubscriptionBillingCycleScheduleEditInputScheduleEditReason returns the value of AllSubscriptionBillingCycleScheduleEditInputScheduleEditReason named name.
+func LookupSubscriptionBillingCycleScheduleEditInputScheduleEditReason(name string) (SubscriptionBillingCycleScheduleEditInputScheduleEditReason, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleScheduleEditInputScheduleEditReason, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleScheduleEditInputScheduleEditReason, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleScheduleEditInputScheduleEditReason) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleScheduleEditInputScheduleEditReason, e)
+}
+
+func (e SubscriptionBillingCycleScheduleEditInputScheduleEditReason) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleScheduleEditInputScheduleEditReason, enumConstants["SubscriptionBillingCycleScheduleEditInputScheduleEditReason"], e, "model.SubscriptionBillingCycleScheduleEditInputScheduleEditReason")
+}
+
+func (e SubscriptionBillingCycleScheduleEditInputScheduleEditReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleScheduleEditInputScheduleEditReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleScheduleEditInputScheduleEditReason")
+}
+
+func (e *SubscriptionBillingCycleScheduleEditInputScheduleEditReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleScheduleEditInputScheduleEditReason")
+}
+
+// LookupSubscriptionBillingCycleSkipUserErrorCode returns the value of AllSubscriptionBillingCycleSkipUserErrorCode named name.
+func LookupSubscriptionBillingCycleSkipUserErrorCode(name string) (SubscriptionBillingCycleSkipUserErrorCode, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleSkipUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleSkipUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleSkipUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleSkipUserErrorCode, e)
+}
+
+func (e SubscriptionBillingCycleSkipUserErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleSkipUserErrorCode, enumConstants["SubscriptionBillingCycleSkipUserErrorCode"], e, "model.SubscriptionBillingCycleSkipUserErrorCode")
+}
+
+func (e SubscriptionBillingCycleSkipUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleSkipUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleSkipUserErrorCode")
+}
+
+func (e *SubscriptionBillingCycleSkipUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleSkipUserErrorCode")
+}
+
+// LookupSubscriptionBillingCycleUnskipUserErrorCode returns the value of AllSubscriptionBillingCycleUnskipUserErrorCode named name.
+func LookupSubscriptionBillingCycleUnskipUserErrorCode(name string) (SubscriptionBillingCycleUnskipUserErrorCode, bool) {
+	return lookupEnum(AllSubscriptionBillingCycleUnskipUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCycleUnskipUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCycleUnskipUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCycleUnskipUserErrorCode, e)
+}
+
+func (e SubscriptionBillingCycleUnskipUserErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionBillingCycleUnskipUserErrorCode, enumConstants["SubscriptionBillingCycleUnskipUserErrorCode"], e, "model.SubscriptionBillingCycleUnskipUserErrorCode")
+}
+
+func (e SubscriptionBillingCycleUnskipUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCycleUnskipUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCycleUnskipUserErrorCode")
+}
+
+func (e *SubscriptionBillingCycleUnskipUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCycleUnskipUserErrorCode")
+}
+
+// LookupSubscriptionBillingCyclesSortKeys returns the value of AllSubscriptionBillingCyclesSortKeys named name.
+func LookupSubscriptionBillingCyclesSortKeys(name string) (SubscriptionBillingCyclesSortKeys, bool) {
+	return lookupEnum(AllSubscriptionBillingCyclesSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCyclesSortKeys, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCyclesSortKeys) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCyclesSortKeys, e)
+}
+
+func (e SubscriptionBillingCyclesSortKeys) GoString() string {
+	return enumGoString(AllSubscriptionBillingCyclesSortKeys, enumConstants["SubscriptionBillingCyclesSortKeys"], e, "model.SubscriptionBillingCyclesSortKeys")
+}
+
+func (e SubscriptionBillingCyclesSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCyclesSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCyclesSortKeys")
+}
+
+func (e *SubscriptionBillingCyclesSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCyclesSortKeys")
+}
+
+// LookupSubscriptionBillingCyclesTargetSelection returns the value of AllSubscriptionBillingCyclesTargetSelection named name.
+func LookupSubscriptionBillingCyclesTargetSelection(name string) (SubscriptionBillingCyclesTargetSelection, bool) {
+	return lookupEnum(AllSubscriptionBillingCyclesTargetSelection, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionBillingCyclesTargetSelection, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionBillingCyclesTargetSelection) IsKnown() bool {
+	return slices.Contains(AllSubscriptionBillingCyclesTargetSelection, e)
+}
+
+func (e SubscriptionBillingCyclesTargetSelection) GoString() string {
+	return enumGoString(AllSubscriptionBillingCyclesTargetSelection, enumConstants["SubscriptionBillingCyclesTargetSelection"], e, "model.SubscriptionBillingCyclesTargetSelection")
+}
+
+func (e SubscriptionBillingCyclesTargetSelection) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionBillingCyclesTargetSelection) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionBillingCyclesTargetSelection")
+}
+
+func (e *SubscriptionBillingCyclesTargetSelection) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionBillingCyclesTargetSelection")
+}
+
+// LookupSubscriptionContractErrorCode returns the value of AllSubscriptionContractErrorCode named name.
+func LookupSubscriptionContractErrorCode(name string) (SubscriptionContractErrorCode, bool) {
+	return lookupEnum(AllSubscriptionContractErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionContractErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionContractErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionContractErrorCode, e)
+}
+
+func (e SubscriptionContractErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionContractErrorCode, enumConstants["SubscriptionContractErrorCode"], e, "model.SubscriptionContractErrorCode")
+}
+
+func (e SubscriptionContractErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionContractErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionContractErrorCode")
+}
+
+func (e *SubscriptionContractErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionContractErrorCode")
+}
+
+// LookupSubscriptionContractLastBillingErrorType returns the value of AllSubscriptionContractLastBillingErrorType named name.
+func LookupSubscriptionContractLastBillingErrorType(name string) (SubscriptionContractLastBillingErrorType, bool) {
+	return lookupEnum(AllSubscriptionContractLastBillingErrorType, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionContractLastBillingErrorType, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionContractLastBillingErrorType) IsKnown() bool {
+	return slices.Contains(AllSubscriptionContractLastBillingErrorType, e)
+}
+
+func (e SubscriptionContractLastBillingErrorType) GoString() string {
+	return enumGoString(AllSubscriptionContractLastBillingErrorType, enumConstants["SubscriptionContractLastBillingErrorType"], e, "model.SubscriptionContractLastBillingErrorType")
+}
+
+func (e SubscriptionContractLastBillingErrorType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionContractLastBillingErrorType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionContractLastBillingErrorType")
+}
+
+func (e *SubscriptionContractLastBillingErrorType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionContractLastBillingErrorType")
+}
+
+// LookupSubscriptionContractLastPaymentStatus returns the value of AllSubscriptionContractLastPaymentStatus named name.
+func LookupSubscriptionContractLastPaymentStatus(name string) (SubscriptionContractLastPaymentStatus, bool) {
+	return lookupEnum(AllSubscriptionContractLastPaymentStatus, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionContractLastPaymentStatus, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionContractLastPaymentStatus) IsKnown() bool {
+	return slices.Contains(AllSubscriptionContractLastPaymentStatus, e)
+}
+
+func (e SubscriptionContractLastPaymentStatus) GoString() string {
+	return enumGoString(AllSubscriptionContractLastPaymentStatus, enumConstants["SubscriptionContractLastPaymentStatus"], e, "model.SubscriptionContractLastPaymentStatus")
+}
+
+func (e SubscriptionContractLastPaymentStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionContractLastPaymentStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionContractLastPaymentStatus")
+}
+
+func (e *SubscriptionContractLastPaymentStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionContractLastPaymentStatus")
+}
+
+// LookupSubscriptionContractStatusUpdateErrorCode returns the value of AllSubscriptionContractStatusUpdateErrorCode named name.
+func LookupSubscriptionContractStatusUpdateErrorCode(name string) (SubscriptionContractStatusUpdateErrorCode, bool) {
+	return lookupEnum(AllSubscriptionContractStatusUpdateErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionContractStatusUpdateErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionContractStatusUpdateErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionContractStatusUpdateErrorCode, e)
+}
+
+func (e SubscriptionContractStatusUpdateErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionContractStatusUpdateErrorCode, enumConstants["SubscriptionContractStatusUpdateErrorCode"], e, "model.SubscriptionContractStatusUpdateErrorCode")
+}
+
+func (e SubscriptionContractStatusUpdateErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionContractStatusUpdateErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionContractStatusUpdateErrorCode")
+}
+
+func (e *SubscriptionContractStatusUpdateErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionContractStatusUpdateErrorCode")
+}
+
+// LookupSubscriptionContractSubscriptionStatus returns the value of AllSubscriptionContractSubscriptionStatus named name.
+func LookupSubscriptionContractSubscriptionStatus(name string) (SubscriptionContractSubscriptionStatus, bool) {
+	return lookupEnum(AllSubscriptionContractSubscriptionStatus, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionContractSubscriptionStatus, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionContractSubscriptionStatus) IsKnown() bool {
+	return slices.Contains(AllSubscriptionContractSubscriptionStatus, e)
+}
+
+func (e SubscriptionContractSubscriptionStatus) GoString() string {
+	return enumGoString(AllSubscriptionContractSubscriptionStatus, enumConstants["SubscriptionContractSubscriptionStatus"], e, "model.SubscriptionContractSubscriptionStatus")
+}
+
+func (e SubscriptionContractSubscriptionStatus) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionContractSubscriptionStatus) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionContractSubscriptionStatus")
+}
+
+func (e *SubscriptionContractSubscriptionStatus) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionContractSubscriptionStatus")
+}
+
+// LookupSubscriptionDiscountRejectionReason returns the value of AllSubscriptionDiscountRejectionReason named name.
+func LookupSubscriptionDiscountRejectionReason(name string) (SubscriptionDiscountRejectionReason, bool) {
+	return lookupEnum(AllSubscriptionDiscountRejectionReason, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionDiscountRejectionReason, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionDiscountRejectionReason) IsKnown() bool {
+	return slices.Contains(AllSubscriptionDiscountRejectionReason, e)
+}
+
+func (e SubscriptionDiscountRejectionReason) GoString() string {
+	return enumGoString(AllSubscriptionDiscountRejectionReason, enumConstants["SubscriptionDiscountRejectionReason"], e, "model.SubscriptionDiscountRejectionReason")
+}
+
+func (e SubscriptionDiscountRejectionReason) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionDiscountRejectionReason) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionDiscountRejectionReason")
+}
+
+func (e *SubscriptionDiscountRejectionReason) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionDiscountRejectionReason")
+}
+
+// LookupSubscriptionDraftErrorCode returns the value of AllSubscriptionDraftErrorCode named name.
+func LookupSubscriptionDraftErrorCode(name string) (SubscriptionDraftErrorCode, bool) {
+	return lookupEnum(AllSubscriptionDraftErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllSubscriptionDraftErrorCode, the values declared by the schema the models were
+// generated from.
+func (e SubscriptionDraftErrorCode) IsKnown() bool {
+	return slices.Contains(AllSubscriptionDraftErrorCode, e)
+}
+
+func (e SubscriptionDraftErrorCode) GoString() string {
+	return enumGoString(AllSubscriptionDraftErrorCode, enumConstants["SubscriptionDraftErrorCode"], e, "model.SubscriptionDraftErrorCode")
+}
+
+func (e SubscriptionDraftErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SubscriptionDraftErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SubscriptionDraftErrorCode")
+}
+
+func (e *SubscriptionDraftErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SubscriptionDraftErrorCode")
+}
+
+// LookupSuggestedOrderTransactionKind returns the value of AllSuggestedOrderTransactionKind named name.
+func LookupSuggestedOrderTransactionKind(name string) (SuggestedOrderTransactionKind, bool) {
+	return lookupEnum(AllSuggestedOrderTransactionKind, name)
+}
+
+// IsKnown reports whether e is one of AllSuggestedOrderTransactionKind, the values declared by the schema the models were
+// generated from.
+func (e SuggestedOrderTransactionKind) IsKnown() bool {
+	return slices.Contains(AllSuggestedOrderTransactionKind, e)
+}
+
+func (e SuggestedOrderTransactionKind) GoString() string {
+	return enumGoString(AllSuggestedOrderTransactionKind, enumConstants["SuggestedOrderTransactionKind"], e, "model.SuggestedOrderTransactionKind")
+}
+
+func (e SuggestedOrderTransactionKind) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *SuggestedOrderTransactionKind) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "SuggestedOrderTransactionKind")
+}
+
+func (e *SuggestedOrderTransactionKind) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "SuggestedOrderTransactionKind")
+}
+
+// LookupTaxAppConfigureUserErrorCode returns the value of AllTaxAppConfigureUserErrorCode named name.
+func LookupTaxAppConfigureUserErrorCode(name string) (TaxAppConfigureUserErrorCode, bool) {
+	return lookupEnum(AllTaxAppConfigureUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllTaxAppConfigureUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e TaxAppConfigureUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllTaxAppConfigureUserErrorCode, e)
+}
+
+func (e TaxAppConfigureUserErrorCode) GoString() string {
+	return enumGoString(AllTaxAppConfigureUserErrorCode, enumConstants["TaxAppConfigureUserErrorCode"], e, "model.TaxAppConfigureUserErrorCode")
+}
+
+func (e TaxAppConfigureUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TaxAppConfigureUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TaxAppConfigureUserErrorCode")
+}
+
+func (e *TaxAppConfigureUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TaxAppConfigureUserErrorCode")
+}
+
+// LookupTaxExemption returns the value of AllTaxExemption named name.
+func LookupTaxExemption(name string) (TaxExemption, bool) {
+	return lookupEnum(AllTaxExemption, name)
+}
+
+// IsKnown reports whether e is one of AllTaxExemption, the values declared by the schema the models were
+// generated from.
+func (e TaxExemption) IsKnown() bool {
+	return slices.Contains(AllTaxExemption, e)
+}
+
+func (e TaxExemption) GoString() string {
+	return enumGoString(AllTaxExemption, enumConstants["TaxExemption"], e, "model.TaxExemption")
+}
+
+func (e TaxExemption) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TaxExemption) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TaxExemption")
+}
+
+func (e *TaxExemption) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TaxExemption")
+}
+
+// LookupTaxPartnerState returns the value of AllTaxPartnerState named name.
+func LookupTaxPartnerState(name string) (TaxPartnerState, bool) {
+	return lookupEnum(AllTaxPartnerState, name)
+}
+
+// IsKnown reports whether e is one of AllTaxPartnerState, the values declared by the schema the models were
+// generated from.
+func (e TaxPartnerState) IsKnown() bool {
+	return slices.Contains(AllTaxPartnerState, e)
+}
+
+func (e TaxPartnerState) GoString() string {
+	return enumGoString(AllTaxPartnerState, enumConstants["TaxPartnerState"], e, "model.TaxPartnerState")
+}
+
+func (e TaxPartnerState) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TaxPartnerState) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TaxPartnerState")
+}
+
+func (e *TaxPartnerState) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TaxPartnerState")
+}
+
+// LookupThemeCreateUserErrorCode returns the value of AllThemeCreateUserErrorCode named name.
+func LookupThemeCreateUserErrorCode(name string) (ThemeCreateUserErrorCode, bool) {
+	return lookupEnum(AllThemeCreateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllThemeCreateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ThemeCreateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllThemeCreateUserErrorCode, e)
+}
+
+func (e ThemeCreateUserErrorCode) GoString() string {
+	return enumGoString(AllThemeCreateUserErrorCode, enumConstants["ThemeCreateUserErrorCode"], e, "model.ThemeCreateUserErrorCode")
+}
+
+func (e ThemeCreateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ThemeCreateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ThemeCreateUserErrorCode")
+}
+
+func (e *ThemeCreateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ThemeCreateUserErrorCode")
+}
+
+// LookupThemeDeleteUserErrorCode returns the value of AllThemeDeleteUserErrorCode named name.
+func LookupThemeDeleteUserErrorCode(name string) (ThemeDeleteUserErrorCode, bool) {
+	return lookupEnum(AllThemeDeleteUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllThemeDeleteUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ThemeDeleteUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllThemeDeleteUserErrorCode, e)
+}
+
+func (e ThemeDeleteUserErrorCode) GoString() string {
+	return enumGoString(AllThemeDeleteUserErrorCode, enumConstants["ThemeDeleteUserErrorCode"], e, "model.ThemeDeleteUserErrorCode")
+}
+
+func (e ThemeDeleteUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ThemeDeleteUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ThemeDeleteUserErrorCode")
+}
+
+func (e *ThemeDeleteUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ThemeDeleteUserErrorCode")
+}
+
+// LookupThemePublishUserErrorCode returns the value of AllThemePublishUserErrorCode named name.
+func LookupThemePublishUserErrorCode(name string) (ThemePublishUserErrorCode, bool) {
+	return lookupEnum(AllThemePublishUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllThemePublishUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ThemePublishUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllThemePublishUserErrorCode, e)
+}
+
+func (e ThemePublishUserErrorCode) GoString() string {
+	return enumGoString(AllThemePublishUserErrorCode, enumConstants["ThemePublishUserErrorCode"], e, "model.ThemePublishUserErrorCode")
+}
+
+func (e ThemePublishUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ThemePublishUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ThemePublishUserErrorCode")
+}
+
+func (e *ThemePublishUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ThemePublishUserErrorCode")
+}
+
+// LookupThemeRole returns the value of AllThemeRole named name.
+func LookupThemeRole(name string) (ThemeRole, bool) {
+	return lookupEnum(AllThemeRole, name)
+}
+
+// IsKnown reports whether e is one of AllThemeRole, the values declared by the schema the models were
+// generated from.
+func (e ThemeRole) IsKnown() bool {
+	return slices.Contains(AllThemeRole, e)
+}
+
+func (e ThemeRole) GoString() string {
+	return enumGoString(AllThemeRole, enumConstants["ThemeRole"], e, "model.ThemeRole")
+}
+
+func (e ThemeRole) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ThemeRole) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ThemeRole")
+}
+
+func (e *ThemeRole) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ThemeRole")
+}
+
+// LookupThemeUpdateUserErrorCode returns the value of AllThemeUpdateUserErrorCode named name.
+func LookupThemeUpdateUserErrorCode(name string) (ThemeUpdateUserErrorCode, bool) {
+	return lookupEnum(AllThemeUpdateUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllThemeUpdateUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ThemeUpdateUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllThemeUpdateUserErrorCode, e)
+}
+
+func (e ThemeUpdateUserErrorCode) GoString() string {
+	return enumGoString(AllThemeUpdateUserErrorCode, enumConstants["ThemeUpdateUserErrorCode"], e, "model.ThemeUpdateUserErrorCode")
+}
+
+func (e ThemeUpdateUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ThemeUpdateUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ThemeUpdateUserErrorCode")
+}
+
+func (e *ThemeUpdateUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ThemeUpdateUserErrorCode")
+}
+
+// LookupTransactionSortKeys returns the value of AllTransactionSortKeys named name.
+func LookupTransactionSortKeys(name string) (TransactionSortKeys, bool) {
+	return lookupEnum(AllTransactionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllTransactionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e TransactionSortKeys) IsKnown() bool {
+	return slices.Contains(AllTransactionSortKeys, e)
+}
+
+func (e TransactionSortKeys) GoString() string {
+	return enumGoString(AllTransactionSortKeys, enumConstants["TransactionSortKeys"], e, "model.TransactionSortKeys")
+}
+
+func (e TransactionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TransactionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TransactionSortKeys")
+}
+
+func (e *TransactionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TransactionSortKeys")
+}
+
+// LookupTransactionVoidUserErrorCode returns the value of AllTransactionVoidUserErrorCode named name.
+func LookupTransactionVoidUserErrorCode(name string) (TransactionVoidUserErrorCode, bool) {
+	return lookupEnum(AllTransactionVoidUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllTransactionVoidUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e TransactionVoidUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllTransactionVoidUserErrorCode, e)
+}
+
+func (e TransactionVoidUserErrorCode) GoString() string {
+	return enumGoString(AllTransactionVoidUserErrorCode, enumConstants["TransactionVoidUserErrorCode"], e, "model.TransactionVoidUserErrorCode")
+}
+
+func (e TransactionVoidUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TransactionVoidUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TransactionVoidUserErrorCode")
+}
+
+func (e *TransactionVoidUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TransactionVoidUserErrorCode")
+}
+
+// LookupTranslatableResourceType returns the value of AllTranslatableResourceType named name.
+func LookupTranslatableResourceType(name string) (TranslatableResourceType, bool) {
+	return lookupEnum(AllTranslatableResourceType, name)
+}
+
+// IsKnown reports whether e is one of AllTranslatableResourceType, the values declared by the schema the models were
+// generated from.
+func (e TranslatableResourceType) IsKnown() bool {
+	return slices.Contains(AllTranslatableResourceType, e)
+}
+
+func (e TranslatableResourceType) GoString() string {
+	return enumGoString(AllTranslatableResourceType, enumConstants["TranslatableResourceType"], e, "model.TranslatableResourceType")
+}
+
+func (e TranslatableResourceType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TranslatableResourceType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TranslatableResourceType")
+}
+
+func (e *TranslatableResourceType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TranslatableResourceType")
+}
+
+// LookupTranslationErrorCode returns the value of AllTranslationErrorCode named name.
+func LookupTranslationErrorCode(name string) (TranslationErrorCode, bool) {
+	return lookupEnum(AllTranslationErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllTranslationErrorCode, the values declared by the schema the models were
+// generated from.
+func (e TranslationErrorCode) IsKnown() bool {
+	return slices.Contains(AllTranslationErrorCode, e)
+}
+
+func (e TranslationErrorCode) GoString() string {
+	return enumGoString(AllTranslationErrorCode, enumConstants["TranslationErrorCode"], e, "model.TranslationErrorCode")
+}
+
+func (e TranslationErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *TranslationErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "TranslationErrorCode")
+}
+
+func (e *TranslationErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "TranslationErrorCode")
+}
+
+// LookupURLRedirectBulkDeleteByIdsUserErrorCode returns the value of AllURLRedirectBulkDeleteByIdsUserErrorCode named name.
+func LookupURLRedirectBulkDeleteByIdsUserErrorCode(name string) (URLRedirectBulkDeleteByIdsUserErrorCode, bool) {
+	return lookupEnum(AllURLRedirectBulkDeleteByIdsUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllURLRedirectBulkDeleteByIdsUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e URLRedirectBulkDeleteByIdsUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllURLRedirectBulkDeleteByIdsUserErrorCode, e)
+}
+
+func (e URLRedirectBulkDeleteByIdsUserErrorCode) GoString() string {
+	return enumGoString(AllURLRedirectBulkDeleteByIdsUserErrorCode, enumConstants["URLRedirectBulkDeleteByIdsUserErrorCode"], e, "model.URLRedirectBulkDeleteByIdsUserErrorCode")
+}
+
+func (e URLRedirectBulkDeleteByIdsUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *URLRedirectBulkDeleteByIdsUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "URLRedirectBulkDeleteByIdsUserErrorCode")
+}
+
+func (e *URLRedirectBulkDeleteByIdsUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "URLRedirectBulkDeleteByIdsUserErrorCode")
+}
+
+// LookupURLRedirectBulkDeleteBySavedSearchUserErrorCode returns the value of AllURLRedirectBulkDeleteBySavedSearchUserErrorCode named name.
+func LookupURLRedirectBulkDeleteBySavedSearchUserErrorCode(name string) (URLRedirectBulkDeleteBySavedSearchUserErrorCode, bool) {
+	return lookupEnum(AllURLRedirectBulkDeleteBySavedSearchUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllURLRedirectBulkDeleteBySavedSearchUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e URLRedirectBulkDeleteBySavedSearchUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllURLRedirectBulkDeleteBySavedSearchUserErrorCode, e)
+}
+
+func (e URLRedirectBulkDeleteBySavedSearchUserErrorCode) GoString() string {
+	return enumGoString(AllURLRedirectBulkDeleteBySavedSearchUserErrorCode, enumConstants["URLRedirectBulkDeleteBySavedSearchUserErrorCode"], e, "model.URLRedirectBulkDeleteBySavedSearchUserErrorCode")
+}
+
+func (e URLRedirectBulkDeleteBySavedSearchUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *URLRedirectBulkDeleteBySavedSearchUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "URLRedirectBulkDeleteBySavedSearchUserErrorCode")
+}
+
+func (e *URLRedirectBulkDeleteBySavedSearchUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "URLRedirectBulkDeleteBySavedSearchUserErrorCode")
+}
+
+// LookupURLRedirectBulkDeleteBySearchUserErrorCode returns the value of AllURLRedirectBulkDeleteBySearchUserErrorCode named name.
+func LookupURLRedirectBulkDeleteBySearchUserErrorCode(name string) (URLRedirectBulkDeleteBySearchUserErrorCode, bool) {
+	return lookupEnum(AllURLRedirectBulkDeleteBySearchUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllURLRedirectBulkDeleteBySearchUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e URLRedirectBulkDeleteBySearchUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllURLRedirectBulkDeleteBySearchUserErrorCode, e)
+}
+
+func (e URLRedirectBulkDeleteBySearchUserErrorCode) GoString() string {
+	return enumGoString(AllURLRedirectBulkDeleteBySearchUserErrorCode, enumConstants["URLRedirectBulkDeleteBySearchUserErrorCode"], e, "model.URLRedirectBulkDeleteBySearchUserErrorCode")
+}
+
+func (e URLRedirectBulkDeleteBySearchUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *URLRedirectBulkDeleteBySearchUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "URLRedirectBulkDeleteBySearchUserErrorCode")
+}
+
+func (e *URLRedirectBulkDeleteBySearchUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "URLRedirectBulkDeleteBySearchUserErrorCode")
+}
+
+// LookupURLRedirectErrorCode returns the value of AllURLRedirectErrorCode named name.
+func LookupURLRedirectErrorCode(name string) (URLRedirectErrorCode, bool) {
+	return lookupEnum(AllURLRedirectErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllURLRedirectErrorCode, the values declared by the schema the models were
+// generated from.
+func (e URLRedirectErrorCode) IsKnown() bool {
+	return slices.Contains(AllURLRedirectErrorCode, e)
+}
+
+func (e URLRedirectErrorCode) GoString() string {
+	return enumGoString(AllURLRedirectErrorCode, enumConstants["URLRedirectErrorCode"], e, "model.URLRedirectErrorCode")
+}
+
+func (e URLRedirectErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *URLRedirectErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "URLRedirectErrorCode")
+}
+
+func (e *URLRedirectErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "URLRedirectErrorCode")
+}
+
+// LookupURLRedirectImportErrorCode returns the value of AllURLRedirectImportErrorCode named name.
+func LookupURLRedirectImportErrorCode(name string) (URLRedirectImportErrorCode, bool) {
+	return lookupEnum(AllURLRedirectImportErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllURLRedirectImportErrorCode, the values declared by the schema the models were
+// generated from.
+func (e URLRedirectImportErrorCode) IsKnown() bool {
+	return slices.Contains(AllURLRedirectImportErrorCode, e)
+}
+
+func (e URLRedirectImportErrorCode) GoString() string {
+	return enumGoString(AllURLRedirectImportErrorCode, enumConstants["URLRedirectImportErrorCode"], e, "model.URLRedirectImportErrorCode")
+}
+
+func (e URLRedirectImportErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *URLRedirectImportErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "URLRedirectImportErrorCode")
+}
+
+func (e *URLRedirectImportErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "URLRedirectImportErrorCode")
+}
+
+// LookupURLRedirectSortKeys returns the value of AllURLRedirectSortKeys named name.
+func LookupURLRedirectSortKeys(name string) (URLRedirectSortKeys, bool) {
+	return lookupEnum(AllURLRedirectSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllURLRedirectSortKeys, the values declared by the schema the models were
+// generated from.
+func (e URLRedirectSortKeys) IsKnown() bool {
+	return slices.Contains(AllURLRedirectSortKeys, e)
+}
+
+func (e URLRedirectSortKeys) GoString() string {
+	return enumGoString(AllURLRedirectSortKeys, enumConstants["URLRedirectSortKeys"], e, "model.URLRedirectSortKeys")
+}
+
+func (e URLRedirectSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *URLRedirectSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "URLRedirectSortKeys")
+}
+
+func (e *URLRedirectSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "URLRedirectSortKeys")
+}
+
+// LookupUnitPriceMeasurementMeasuredType returns the value of AllUnitPriceMeasurementMeasuredType named name.
+func LookupUnitPriceMeasurementMeasuredType(name string) (UnitPriceMeasurementMeasuredType, bool) {
+	return lookupEnum(AllUnitPriceMeasurementMeasuredType, name)
+}
+
+// IsKnown reports whether e is one of AllUnitPriceMeasurementMeasuredType, the values declared by the schema the models were
+// generated from.
+func (e UnitPriceMeasurementMeasuredType) IsKnown() bool {
+	return slices.Contains(AllUnitPriceMeasurementMeasuredType, e)
+}
+
+func (e UnitPriceMeasurementMeasuredType) GoString() string {
+	return enumGoString(AllUnitPriceMeasurementMeasuredType, enumConstants["UnitPriceMeasurementMeasuredType"], e, "model.UnitPriceMeasurementMeasuredType")
+}
+
+func (e UnitPriceMeasurementMeasuredType) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *UnitPriceMeasurementMeasuredType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "UnitPriceMeasurementMeasuredType")
+}
+
+func (e *UnitPriceMeasurementMeasuredType) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "UnitPriceMeasurementMeasuredType")
+}
+
+// LookupUnitPriceMeasurementMeasuredUnit returns the value of AllUnitPriceMeasurementMeasuredUnit named name.
+func LookupUnitPriceMeasurementMeasuredUnit(name string) (UnitPriceMeasurementMeasuredUnit, bool) {
+	return lookupEnum(AllUnitPriceMeasurementMeasuredUnit, name)
+}
+
+// IsKnown reports whether e is one of AllUnitPriceMeasurementMeasuredUnit, the values declared by the schema the models were
+// generated from.
+func (e UnitPriceMeasurementMeasuredUnit) IsKnown() bool {
+	return slices.Contains(AllUnitPriceMeasurementMeasuredUnit, e)
+}
+
+func (e UnitPriceMeasurementMeasuredUnit) GoString() string {
+	return enumGoString(AllUnitPriceMeasurementMeasuredUnit, enumConstants["UnitPriceMeasurementMeasuredUnit"], e, "model.UnitPriceMeasurementMeasuredUnit")
+}
+
+func (e UnitPriceMeasurementMeasuredUnit) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *UnitPriceMeasurementMeasuredUnit) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "UnitPriceMeasurementMeasuredUnit")
+}
+
+func (e *UnitPriceMeasurementMeasuredUnit) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "UnitPriceMeasurementMeasuredUnit")
+}
+
+// LookupUnitSystem returns the value of AllUnitSystem named name.
+func LookupUnitSystem(name string) (UnitSystem, bool) {
+	return lookupEnum(AllUnitSystem, name)
+}
+
+// IsKnown reports whether e is one of AllUnitSystem, the values declared by the schema the models were
+// generated from.
+func (e UnitSystem) IsKnown() bool {
+	return slices.Contains(AllUnitSystem, e)
+}
+
+func (e UnitSystem) GoString() string {
+	return enumGoString(AllUnitSystem, enumConstants["UnitSystem"], e, "model.UnitSystem")
+}
+
+func (e UnitSystem) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *UnitSystem) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "UnitSystem")
+}
+
+func (e *UnitSystem) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "UnitSystem")
+}
+
+// LookupValidationSortKeys returns the value of AllValidationSortKeys named name.
+func LookupValidationSortKeys(name string) (ValidationSortKeys, bool) {
+	return lookupEnum(AllValidationSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllValidationSortKeys, the values declared by the schema the models were
+// generated from.
+func (e ValidationSortKeys) IsKnown() bool {
+	return slices.Contains(AllValidationSortKeys, e)
+}
+
+func (e ValidationSortKeys) GoString() string {
+	return enumGoString(AllValidationSortKeys, enumConstants["ValidationSortKeys"], e, "model.ValidationSortKeys")
+}
+
+func (e ValidationSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ValidationSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ValidationSortKeys")
+}
+
+func (e *ValidationSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ValidationSortKeys")
+}
+
+// LookupValidationUserErrorCode returns the value of AllValidationUserErrorCode named name.
+func LookupValidationUserErrorCode(name string) (ValidationUserErrorCode, bool) {
+	return lookupEnum(AllValidationUserErrorCode, name)
+}
+
+// IsKnown reports whether e is one of AllValidationUserErrorCode, the values declared by the schema the models were
+// generated from.
+func (e ValidationUserErrorCode) IsKnown() bool {
+	return slices.Contains(AllValidationUserErrorCode, e)
+}
+
+func (e ValidationUserErrorCode) GoString() string {
+	return enumGoString(AllValidationUserErrorCode, enumConstants["ValidationUserErrorCode"], e, "model.ValidationUserErrorCode")
+}
+
+func (e ValidationUserErrorCode) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *ValidationUserErrorCode) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "ValidationUserErrorCode")
+}
+
+func (e *ValidationUserErrorCode) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "ValidationUserErrorCode")
+}
+
+// LookupWebhookSubscriptionFormat returns the value of AllWebhookSubscriptionFormat named name.
+func LookupWebhookSubscriptionFormat(name string) (WebhookSubscriptionFormat, bool) {
+	return lookupEnum(AllWebhookSubscriptionFormat, name)
+}
+
+// IsKnown reports whether e is one of AllWebhookSubscriptionFormat, the values declared by the schema the models were
+// generated from.
+func (e WebhookSubscriptionFormat) IsKnown() bool {
+	return slices.Contains(AllWebhookSubscriptionFormat, e)
+}
+
+func (e WebhookSubscriptionFormat) GoString() string {
+	return enumGoString(AllWebhookSubscriptionFormat, enumConstants["WebhookSubscriptionFormat"], e, "model.WebhookSubscriptionFormat")
+}
+
+func (e WebhookSubscriptionFormat) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *WebhookSubscriptionFormat) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "WebhookSubscriptionFormat")
+}
+
+func (e *WebhookSubscriptionFormat) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "WebhookSubscriptionFormat")
+}
+
+// LookupWebhookSubscriptionSortKeys returns the value of AllWebhookSubscriptionSortKeys named name.
+func LookupWebhookSubscriptionSortKeys(name string) (WebhookSubscriptionSortKeys, bool) {
+	return lookupEnum(AllWebhookSubscriptionSortKeys, name)
+}
+
+// IsKnown reports whether e is one of AllWebhookSubscriptionSortKeys, the values declared by the schema the models were
+// generated from.
+func (e WebhookSubscriptionSortKeys) IsKnown() bool {
+	return slices.Contains(AllWebhookSubscriptionSortKeys, e)
+}
+
+func (e WebhookSubscriptionSortKeys) GoString() string {
+	return enumGoString(AllWebhookSubscriptionSortKeys, enumConstants["WebhookSubscriptionSortKeys"], e, "model.WebhookSubscriptionSortKeys")
+}
+
+func (e WebhookSubscriptionSortKeys) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *WebhookSubscriptionSortKeys) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "WebhookSubscriptionSortKeys")
+}
+
+func (e *WebhookSubscriptionSortKeys) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "WebhookSubscriptionSortKeys")
+}
+
+// LookupWebhookSubscriptionTopic returns the value of AllWebhookSubscriptionTopic named name.
+func LookupWebhookSubscriptionTopic(name string) (WebhookSubscriptionTopic, bool) {
+	return lookupEnum(AllWebhookSubscriptionTopic, name)
+}
+
+// IsKnown reports whether e is one of AllWebhookSubscriptionTopic, the values declared by the schema the models were
+// generated from.
+func (e WebhookSubscriptionTopic) IsKnown() bool {
+	return slices.Contains(AllWebhookSubscriptionTopic, e)
+}
+
+func (e WebhookSubscriptionTopic) GoString() string {
+	return enumGoString(AllWebhookSubscriptionTopic, enumConstants["WebhookSubscriptionTopic"], e, "model.WebhookSubscriptionTopic")
+}
+
+func (e WebhookSubscriptionTopic) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *WebhookSubscriptionTopic) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "WebhookSubscriptionTopic")
+}
+
+func (e *WebhookSubscriptionTopic) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "WebhookSubscriptionTopic")
+}
+
+// LookupWeightUnit returns the value of AllWeightUnit named name.
+func LookupWeightUnit(name string) (WeightUnit, bool) {
+	return lookupEnum(AllWeightUnit, name)
+}
+
+// IsKnown reports whether e is one of AllWeightUnit, the values declared by the schema the models were
+// generated from.
+func (e WeightUnit) IsKnown() bool {
+	return slices.Contains(AllWeightUnit, e)
+}
+
+func (e WeightUnit) GoString() string {
+	return enumGoString(AllWeightUnit, enumConstants["WeightUnit"], e, "model.WeightUnit")
+}
+
+func (e WeightUnit) MarshalText() ([]byte, error) {
+	return []byte(e), nil
+}
+
+func (e *WeightUnit) UnmarshalJSON(b []byte) error {
+	return unmarshalEnumJSON(e, b, "WeightUnit")
+}
+
+func (e *WeightUnit) UnmarshalText(text []byte) error {
+	return unmarshalEnumText(e, text, "WeightUnit")
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"errors"
+	"strings"
+)
+
 func (e FileError) Error() string {
 	if e.Details != nil {
 		return *e.Details
@@ -13,3 +18,57 @@ func (e MediaError) Error() string {
 	}
 	return e.Message
 }
+
+// userErrorString returns the message of a user error, prefixed by the path of the field and followed by the code
+// when present, e.g. `input.title: Title can't be blank (BLANK)`.
+func userErrorString(field []string, message string, code string) string {
+	s := message
+	if len(field) > 0 {
+		s = strings.Join(field, ".") + ": " + s
+	}
+	if code != "" {
+		s += " (" + code + ")"
+	}
+	return s
+}
+
+func codeString[T ~string](code *T) string {
+	if code == nil {
+		return ""
+	}
+	return string(*code)
+}
+
+// JoinUserErrors returns the user errors of a mutation payload joined into a single error, or nil if there are none:
+//
+//	if err := model.JoinUserErrors(payload.UserErrors); err != nil {
+//		if errors.Is(err, model.ProductSetUserErrorCodeInvalidVariant) {
+//			...
+//		}
+//	}
+func JoinUserErrors[E error](userErrors []E) error {
+	if len(userErrors) == 0 {
+		return nil
+	}
+	errs := make([]error, len(userErrors))
+	for i, e := range userErrors {
+		errs[i] = e
+	}
+	return errors.Join(errs...)
+}
+
+// OneOfError is returned by the Validate and MarshalJSON methods of one-of inputs that do not have exactly one field
+// set.
+type OneOfError struct {
+	// Input is the name of the input type, e.g. AppPlanInput.
+	Input string
+	// Set are the names of the fields set, none or several.
+	Set []string
+}
+
+func (e *OneOfError) Error() string {
+	if len(e.Set) == 0 {
+		return e.Input + ": exactly one field must be set, got none"
+	}
+	return e.Input + ": exactly one field must be set, got " + strings.Join(e.Set, ", ")
+}
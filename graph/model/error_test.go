@@ -0,0 +1,45 @@
+package model_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("UserErrors", func() {
+	code := model.ProductSetUserErrorCodeGenericError
+
+	It("formats user errors", func() {
+		Expect(model.UserError{Message: "Access denied"}.Error()).To(Equal("Access denied"))
+		Expect(model.ProductSetUserError{
+			Field: []string{"input", "title"}, Message: "Title can't be blank", Code: &code,
+		}.Error()).To(Equal("input.title: Title can't be blank (GENERIC_ERROR)"))
+	})
+
+	It("joins the user errors of a payload", func() {
+		payload := model.ProductSetPayload{UserErrors: []model.ProductSetUserError{
+			{Field: []string{"input", "title"}, Message: "Title can't be blank", Code: &code},
+			{Message: "Handle is taken"},
+		}}
+		err := model.JoinUserErrors(payload.UserErrors)
+		Expect(err).To(MatchError("input.title: Title can't be blank (GENERIC_ERROR)\nHandle is taken"))
+		Expect(errors.Is(err, model.ProductSetUserErrorCodeGenericError)).To(BeTrue())
+		Expect(errors.Is(err, model.ProductSetUserErrorCodeInvalidProduct)).To(BeFalse())
+
+		var userErr model.ProductSetUserError
+		Expect(errors.As(err, &userErr)).To(BeTrue())
+		Expect(userErr.Field).To(Equal([]string{"input", "title"}))
+
+		Expect(model.JoinUserErrors([]model.ProductSetUserError{})).To(Succeed())
+	})
+
+	It("describes the fields set on @oneOf inputs", func() {
+		Expect((&model.OneOfError{Input: "AppPlanInput"}).Error()).
+			To(Equal("AppPlanInput: exactly one field must be set, got none"))
+		Expect((&model.OneOfError{Input: "AppPlanInput", Set: []string{"a", "b"}}).Error()).
+			To(Equal("AppPlanInput: exactly one field must be set, got a, b"))
+	})
+})
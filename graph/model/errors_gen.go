@@ -0,0 +1,2261 @@
+// Code generated by github.com/gempages/go-shopify-graphql-model, DO NOT EDIT.
+
+package model
+
+func (e AbandonmentEmailStateUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e AbandonmentEmailStateUpdateUserError) Is(target error) bool {
+	code, ok := target.(AbandonmentEmailStateUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e AbandonmentUpdateActivitiesDeliveryStatusesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e AbandonmentUpdateActivitiesDeliveryStatusesUserError) Is(target error) bool {
+	code, ok := target.(AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e AppRevokeAccessScopesAppRevokeScopeError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e AppRevokeAccessScopesAppRevokeScopeError) Is(target error) bool {
+	code, ok := target.(AppRevokeAccessScopesAppRevokeScopeErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e AppSubscriptionTrialExtendUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e AppSubscriptionTrialExtendUserError) Is(target error) bool {
+	code, ok := target.(AppSubscriptionTrialExtendUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ArticleCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ArticleCreateUserError) Is(target error) bool {
+	code, ok := target.(ArticleCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ArticleDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ArticleDeleteUserError) Is(target error) bool {
+	code, ok := target.(ArticleDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ArticleUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ArticleUpdateUserError) Is(target error) bool {
+	code, ok := target.(ArticleUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BillingAttemptUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BillingAttemptUserError) Is(target error) bool {
+	code, ok := target.(BillingAttemptUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BlogCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BlogCreateUserError) Is(target error) bool {
+	code, ok := target.(BlogCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BlogDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BlogDeleteUserError) Is(target error) bool {
+	code, ok := target.(BlogDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BlogUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BlogUpdateUserError) Is(target error) bool {
+	code, ok := target.(BlogUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BulkMutationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BulkMutationUserError) Is(target error) bool {
+	code, ok := target.(BulkMutationErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BulkProductResourceFeedbackCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BulkProductResourceFeedbackCreateUserError) Is(target error) bool {
+	code, ok := target.(BulkProductResourceFeedbackCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e BusinessCustomerUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e BusinessCustomerUserError) Is(target error) bool {
+	code, ok := target.(BusinessCustomerErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CarrierServiceCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CarrierServiceCreateUserError) Is(target error) bool {
+	code, ok := target.(CarrierServiceCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CarrierServiceDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CarrierServiceDeleteUserError) Is(target error) bool {
+	code, ok := target.(CarrierServiceDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CarrierServiceUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CarrierServiceUpdateUserError) Is(target error) bool {
+	code, ok := target.(CarrierServiceUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CartTransformCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CartTransformCreateUserError) Is(target error) bool {
+	code, ok := target.(CartTransformCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CartTransformDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CartTransformDeleteUserError) Is(target error) bool {
+	code, ok := target.(CartTransformDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CatalogUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CatalogUserError) Is(target error) bool {
+	code, ok := target.(CatalogUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CheckoutBrandingUpsertUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CheckoutBrandingUpsertUserError) Is(target error) bool {
+	code, ok := target.(CheckoutBrandingUpsertUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CollectionAddProductsV2UserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CollectionAddProductsV2UserError) Is(target error) bool {
+	code, ok := target.(CollectionAddProductsV2UserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CombinedListingUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CombinedListingUpdateUserError) Is(target error) bool {
+	code, ok := target.(CombinedListingUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CommentApproveUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CommentApproveUserError) Is(target error) bool {
+	code, ok := target.(CommentApproveUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CommentDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CommentDeleteUserError) Is(target error) bool {
+	code, ok := target.(CommentDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CommentNotSpamUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CommentNotSpamUserError) Is(target error) bool {
+	code, ok := target.(CommentNotSpamUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CommentSpamUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CommentSpamUserError) Is(target error) bool {
+	code, ok := target.(CommentSpamUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerCancelDataErasureUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerCancelDataErasureUserError) Is(target error) bool {
+	code, ok := target.(CustomerCancelDataErasureErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerEmailMarketingConsentUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerEmailMarketingConsentUpdateUserError) Is(target error) bool {
+	code, ok := target.(CustomerEmailMarketingConsentUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerMergeUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerMergeUserError) Is(target error) bool {
+	code, ok := target.(CustomerMergeErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerPaymentMethodCreateFromDuplicationDataUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerPaymentMethodCreateFromDuplicationDataUserError) Is(target error) bool {
+	code, ok := target.(CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerPaymentMethodGetDuplicationDataUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerPaymentMethodGetDuplicationDataUserError) Is(target error) bool {
+	code, ok := target.(CustomerPaymentMethodGetDuplicationDataUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerPaymentMethodGetUpdateURLUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerPaymentMethodGetUpdateURLUserError) Is(target error) bool {
+	code, ok := target.(CustomerPaymentMethodGetUpdateURLUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerPaymentMethodRemoteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerPaymentMethodRemoteUserError) Is(target error) bool {
+	code, ok := target.(CustomerPaymentMethodRemoteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerPaymentMethodUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerPaymentMethodUserError) Is(target error) bool {
+	code, ok := target.(CustomerPaymentMethodUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerRequestDataErasureUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerRequestDataErasureUserError) Is(target error) bool {
+	code, ok := target.(CustomerRequestDataErasureErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerSegmentMembersQueryUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerSegmentMembersQueryUserError) Is(target error) bool {
+	code, ok := target.(CustomerSegmentMembersQueryUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerSendAccountInviteEmailUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerSendAccountInviteEmailUserError) Is(target error) bool {
+	code, ok := target.(CustomerSendAccountInviteEmailUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e CustomerSmsMarketingConsentError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e CustomerSmsMarketingConsentError) Is(target error) bool {
+	code, ok := target.(CustomerSmsMarketingConsentErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DataSaleOptOutUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DataSaleOptOutUserError) Is(target error) bool {
+	code, ok := target.(DataSaleOptOutUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DelegateAccessTokenCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DelegateAccessTokenCreateUserError) Is(target error) bool {
+	code, ok := target.(DelegateAccessTokenCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DelegateAccessTokenDestroyUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DelegateAccessTokenDestroyUserError) Is(target error) bool {
+	code, ok := target.(DelegateAccessTokenDestroyUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DeliveryCustomizationError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DeliveryCustomizationError) Is(target error) bool {
+	code, ok := target.(DeliveryCustomizationErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DeliveryLocationLocalPickupSettingsError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DeliveryLocationLocalPickupSettingsError) Is(target error) bool {
+	code, ok := target.(DeliveryLocationLocalPickupSettingsErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DeliveryPromiseProviderUpsertUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DeliveryPromiseProviderUpsertUserError) Is(target error) bool {
+	code, ok := target.(DeliveryPromiseProviderUpsertUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DiscountUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DiscountUserError) Is(target error) bool {
+	code, ok := target.(DiscountErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e DisputeEvidenceUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e DisputeEvidenceUpdateUserError) Is(target error) bool {
+	code, ok := target.(DisputeEvidenceUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ErrorsServerPixelUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ErrorsServerPixelUserError) Is(target error) bool {
+	code, ok := target.(ErrorsServerPixelUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ErrorsWebPixelUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ErrorsWebPixelUserError) Is(target error) bool {
+	code, ok := target.(ErrorsWebPixelUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FilesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FilesUserError) Is(target error) bool {
+	code, ok := target.(FilesErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentConstraintRuleCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentConstraintRuleCreateUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentConstraintRuleCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentConstraintRuleDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentConstraintRuleDeleteUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentConstraintRuleDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentConstraintRuleUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentConstraintRuleUpdateUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentConstraintRuleUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrderHoldUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrderHoldUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrderHoldUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrderLineItemsPreparedForPickupUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrderLineItemsPreparedForPickupUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrderLineItemsPreparedForPickupUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrderMergeUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrderMergeUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrderMergeUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrderReleaseHoldUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrderReleaseHoldUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrderReleaseHoldUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrderRescheduleUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrderRescheduleUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrderRescheduleUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrderSplitUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrderSplitUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrderSplitUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e FulfillmentOrdersSetFulfillmentDeadlineUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e FulfillmentOrdersSetFulfillmentDeadlineUserError) Is(target error) bool {
+	code, ok := target.(FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e GiftCardDeactivateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e GiftCardDeactivateUserError) Is(target error) bool {
+	code, ok := target.(GiftCardDeactivateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e GiftCardSendNotificationToCustomerUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e GiftCardSendNotificationToCustomerUserError) Is(target error) bool {
+	code, ok := target.(GiftCardSendNotificationToCustomerUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e GiftCardSendNotificationToRecipientUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e GiftCardSendNotificationToRecipientUserError) Is(target error) bool {
+	code, ok := target.(GiftCardSendNotificationToRecipientUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e GiftCardTransactionUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e GiftCardTransactionUserError) Is(target error) bool {
+	code, ok := target.(GiftCardTransactionUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e GiftCardUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e GiftCardUserError) Is(target error) bool {
+	code, ok := target.(GiftCardErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e InventoryAdjustQuantitiesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e InventoryAdjustQuantitiesUserError) Is(target error) bool {
+	code, ok := target.(InventoryAdjustQuantitiesUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e InventoryBulkToggleActivationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e InventoryBulkToggleActivationUserError) Is(target error) bool {
+	code, ok := target.(InventoryBulkToggleActivationUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e InventoryMoveQuantitiesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e InventoryMoveQuantitiesUserError) Is(target error) bool {
+	code, ok := target.(InventoryMoveQuantitiesUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e InventorySetOnHandQuantitiesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e InventorySetOnHandQuantitiesUserError) Is(target error) bool {
+	code, ok := target.(InventorySetOnHandQuantitiesUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e InventorySetQuantitiesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e InventorySetQuantitiesUserError) Is(target error) bool {
+	code, ok := target.(InventorySetQuantitiesUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e InventorySetScheduledChangesUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e InventorySetScheduledChangesUserError) Is(target error) bool {
+	code, ok := target.(InventorySetScheduledChangesUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e LocationActivateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e LocationActivateUserError) Is(target error) bool {
+	code, ok := target.(LocationActivateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e LocationAddUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e LocationAddUserError) Is(target error) bool {
+	code, ok := target.(LocationAddUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e LocationDeactivateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e LocationDeactivateUserError) Is(target error) bool {
+	code, ok := target.(LocationDeactivateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e LocationDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e LocationDeleteUserError) Is(target error) bool {
+	code, ok := target.(LocationDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e LocationEditUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e LocationEditUserError) Is(target error) bool {
+	code, ok := target.(LocationEditUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MarketCurrencySettingsUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MarketCurrencySettingsUserError) Is(target error) bool {
+	code, ok := target.(MarketCurrencySettingsUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MarketUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MarketUserError) Is(target error) bool {
+	code, ok := target.(MarketUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MarketingActivityUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MarketingActivityUserError) Is(target error) bool {
+	code, ok := target.(MarketingActivityUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MediaUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MediaUserError) Is(target error) bool {
+	code, ok := target.(MediaUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MenuCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MenuCreateUserError) Is(target error) bool {
+	code, ok := target.(MenuCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MenuDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MenuDeleteUserError) Is(target error) bool {
+	code, ok := target.(MenuDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MenuUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MenuUpdateUserError) Is(target error) bool {
+	code, ok := target.(MenuUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetafieldDefinitionCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetafieldDefinitionCreateUserError) Is(target error) bool {
+	code, ok := target.(MetafieldDefinitionCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetafieldDefinitionDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetafieldDefinitionDeleteUserError) Is(target error) bool {
+	code, ok := target.(MetafieldDefinitionDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetafieldDefinitionPinUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetafieldDefinitionPinUserError) Is(target error) bool {
+	code, ok := target.(MetafieldDefinitionPinUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetafieldDefinitionUnpinUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetafieldDefinitionUnpinUserError) Is(target error) bool {
+	code, ok := target.(MetafieldDefinitionUnpinUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetafieldDefinitionUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetafieldDefinitionUpdateUserError) Is(target error) bool {
+	code, ok := target.(MetafieldDefinitionUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetafieldsSetUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetafieldsSetUserError) Is(target error) bool {
+	code, ok := target.(MetafieldsSetUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MetaobjectUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MetaobjectUserError) Is(target error) bool {
+	code, ok := target.(MetaobjectUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e MobilePlatformApplicationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e MobilePlatformApplicationUserError) Is(target error) bool {
+	code, ok := target.(MobilePlatformApplicationUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OnlineStoreThemeFilesUserErrors) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OnlineStoreThemeFilesUserErrors) Is(target error) bool {
+	code, ok := target.(OnlineStoreThemeFilesUserErrorsCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderCancelUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderCancelUserError) Is(target error) bool {
+	code, ok := target.(OrderCancelUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderCreateMandatePaymentUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderCreateMandatePaymentUserError) Is(target error) bool {
+	code, ok := target.(OrderCreateMandatePaymentUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderCreateUserError) Is(target error) bool {
+	code, ok := target.(OrderCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderDeleteUserError) Is(target error) bool {
+	code, ok := target.(OrderDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderEditAddShippingLineUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderEditAddShippingLineUserError) Is(target error) bool {
+	code, ok := target.(OrderEditAddShippingLineUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderEditRemoveDiscountUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderEditRemoveDiscountUserError) Is(target error) bool {
+	code, ok := target.(OrderEditRemoveDiscountUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderEditRemoveShippingLineUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderEditRemoveShippingLineUserError) Is(target error) bool {
+	code, ok := target.(OrderEditRemoveShippingLineUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderEditUpdateDiscountUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderEditUpdateDiscountUserError) Is(target error) bool {
+	code, ok := target.(OrderEditUpdateDiscountUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderEditUpdateShippingLineUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderEditUpdateShippingLineUserError) Is(target error) bool {
+	code, ok := target.(OrderEditUpdateShippingLineUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderInvoiceSendUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderInvoiceSendUserError) Is(target error) bool {
+	code, ok := target.(OrderInvoiceSendUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e OrderRiskAssessmentCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e OrderRiskAssessmentCreateUserError) Is(target error) bool {
+	code, ok := target.(OrderRiskAssessmentCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PageCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PageCreateUserError) Is(target error) bool {
+	code, ok := target.(PageCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PageDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PageDeleteUserError) Is(target error) bool {
+	code, ok := target.(PageDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PageUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PageUpdateUserError) Is(target error) bool {
+	code, ok := target.(PageUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PaymentCustomizationError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PaymentCustomizationError) Is(target error) bool {
+	code, ok := target.(PaymentCustomizationErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PaymentReminderSendUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PaymentReminderSendUserError) Is(target error) bool {
+	code, ok := target.(PaymentReminderSendUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PaymentTermsCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PaymentTermsCreateUserError) Is(target error) bool {
+	code, ok := target.(PaymentTermsCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PaymentTermsDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PaymentTermsDeleteUserError) Is(target error) bool {
+	code, ok := target.(PaymentTermsDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PaymentTermsUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PaymentTermsUpdateUserError) Is(target error) bool {
+	code, ok := target.(PaymentTermsUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PriceListFixedPricesByProductBulkUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PriceListFixedPricesByProductBulkUpdateUserError) Is(target error) bool {
+	code, ok := target.(PriceListFixedPricesByProductBulkUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PriceListPriceUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PriceListPriceUserError) Is(target error) bool {
+	code, ok := target.(PriceListPriceUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PriceListUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PriceListUserError) Is(target error) bool {
+	code, ok := target.(PriceListUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductBundleMutationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductBundleMutationUserError) Is(target error) bool {
+	code, ok := target.(ProductBundleMutationUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductChangeStatusUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductChangeStatusUserError) Is(target error) bool {
+	code, ok := target.(ProductChangeStatusUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductFeedCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductFeedCreateUserError) Is(target error) bool {
+	code, ok := target.(ProductFeedCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductFeedDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductFeedDeleteUserError) Is(target error) bool {
+	code, ok := target.(ProductFeedDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductFullSyncUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductFullSyncUserError) Is(target error) bool {
+	code, ok := target.(ProductFullSyncUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductOptionUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductOptionUpdateUserError) Is(target error) bool {
+	code, ok := target.(ProductOptionUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductOptionsCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductOptionsCreateUserError) Is(target error) bool {
+	code, ok := target.(ProductOptionsCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductOptionsDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductOptionsDeleteUserError) Is(target error) bool {
+	code, ok := target.(ProductOptionsDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductOptionsReorderUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductOptionsReorderUserError) Is(target error) bool {
+	code, ok := target.(ProductOptionsReorderUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductSetUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductSetUserError) Is(target error) bool {
+	code, ok := target.(ProductSetUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductVariantRelationshipBulkUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductVariantRelationshipBulkUpdateUserError) Is(target error) bool {
+	code, ok := target.(ProductVariantRelationshipBulkUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductVariantsBulkCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductVariantsBulkCreateUserError) Is(target error) bool {
+	code, ok := target.(ProductVariantsBulkCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductVariantsBulkDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductVariantsBulkDeleteUserError) Is(target error) bool {
+	code, ok := target.(ProductVariantsBulkDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductVariantsBulkReorderUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductVariantsBulkReorderUserError) Is(target error) bool {
+	code, ok := target.(ProductVariantsBulkReorderUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ProductVariantsBulkUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ProductVariantsBulkUpdateUserError) Is(target error) bool {
+	code, ok := target.(ProductVariantsBulkUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PubSubWebhookSubscriptionCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PubSubWebhookSubscriptionCreateUserError) Is(target error) bool {
+	code, ok := target.(PubSubWebhookSubscriptionCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PubSubWebhookSubscriptionUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PubSubWebhookSubscriptionUpdateUserError) Is(target error) bool {
+	code, ok := target.(PubSubWebhookSubscriptionUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e PublicationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e PublicationUserError) Is(target error) bool {
+	code, ok := target.(PublicationUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e QuantityPricingByVariantUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e QuantityPricingByVariantUserError) Is(target error) bool {
+	code, ok := target.(QuantityPricingByVariantUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e QuantityRuleUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e QuantityRuleUserError) Is(target error) bool {
+	code, ok := target.(QuantityRuleUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ReturnUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ReturnUserError) Is(target error) bool {
+	code, ok := target.(ReturnErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SellingPlanGroupUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SellingPlanGroupUserError) Is(target error) bool {
+	code, ok := target.(SellingPlanGroupUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ShopPolicyUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ShopPolicyUserError) Is(target error) bool {
+	code, ok := target.(ShopPolicyErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ShopResourceFeedbackCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ShopResourceFeedbackCreateUserError) Is(target error) bool {
+	code, ok := target.(ShopResourceFeedbackCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e StandardMetafieldDefinitionEnableUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e StandardMetafieldDefinitionEnableUserError) Is(target error) bool {
+	code, ok := target.(StandardMetafieldDefinitionEnableUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e StoreCreditAccountCreditUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e StoreCreditAccountCreditUserError) Is(target error) bool {
+	code, ok := target.(StoreCreditAccountCreditUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e StoreCreditAccountDebitUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e StoreCreditAccountDebitUserError) Is(target error) bool {
+	code, ok := target.(StoreCreditAccountDebitUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionBillingCycleBulkUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionBillingCycleBulkUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionBillingCycleBulkUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionBillingCycleSkipUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionBillingCycleSkipUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionBillingCycleSkipUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionBillingCycleUnskipUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionBillingCycleUnskipUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionBillingCycleUnskipUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionBillingCycleUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionBillingCycleUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionBillingCycleErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionContractStatusUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionContractStatusUpdateUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionContractStatusUpdateErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionContractUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionContractUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionContractErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e SubscriptionDraftUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e SubscriptionDraftUserError) Is(target error) bool {
+	code, ok := target.(SubscriptionDraftErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e TaxAppConfigureUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e TaxAppConfigureUserError) Is(target error) bool {
+	code, ok := target.(TaxAppConfigureUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ThemeCreateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ThemeCreateUserError) Is(target error) bool {
+	code, ok := target.(ThemeCreateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ThemeDeleteUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ThemeDeleteUserError) Is(target error) bool {
+	code, ok := target.(ThemeDeleteUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ThemePublishUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ThemePublishUserError) Is(target error) bool {
+	code, ok := target.(ThemePublishUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e ThemeUpdateUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ThemeUpdateUserError) Is(target error) bool {
+	code, ok := target.(ThemeUpdateUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e TransactionVoidUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e TransactionVoidUserError) Is(target error) bool {
+	code, ok := target.(TransactionVoidUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e TranslationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e TranslationUserError) Is(target error) bool {
+	code, ok := target.(TranslationErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e URLRedirectBulkDeleteByIdsUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e URLRedirectBulkDeleteByIdsUserError) Is(target error) bool {
+	code, ok := target.(URLRedirectBulkDeleteByIdsUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e URLRedirectBulkDeleteBySavedSearchUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e URLRedirectBulkDeleteBySavedSearchUserError) Is(target error) bool {
+	code, ok := target.(URLRedirectBulkDeleteBySavedSearchUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e URLRedirectBulkDeleteBySearchUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e URLRedirectBulkDeleteBySearchUserError) Is(target error) bool {
+	code, ok := target.(URLRedirectBulkDeleteBySearchUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e URLRedirectImportUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e URLRedirectImportUserError) Is(target error) bool {
+	code, ok := target.(URLRedirectImportErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e URLRedirectUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e URLRedirectUserError) Is(target error) bool {
+	code, ok := target.(URLRedirectErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e UserError) Error() string {
+	return userErrorString(e.Field, e.Message, "")
+}
+
+func (e ValidationUserError) Error() string {
+	return userErrorString(e.Field, e.Message, codeString(e.Code))
+}
+
+// Is reports whether target is the code of e.
+func (e ValidationUserError) Is(target error) bool {
+	code, ok := target.(ValidationUserErrorCode)
+	return ok && e.Code != nil && *e.Code == code
+}
+
+func (e AbandonmentEmailStateUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e AbandonmentUpdateActivitiesDeliveryStatusesUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e AppRevokeAccessScopesAppRevokeScopeErrorCode) Error() string {
+	return string(e)
+}
+
+func (e AppSubscriptionTrialExtendUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ArticleCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ArticleDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ArticleUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BillingAttemptUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BlogCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BlogDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BlogUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BulkMutationErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BulkProductResourceFeedbackCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e BusinessCustomerErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CarrierServiceCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CarrierServiceDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CarrierServiceUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CartTransformCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CartTransformDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CatalogUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CheckoutBrandingUpsertUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CollectionAddProductsV2UserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CombinedListingUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CommentApproveUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CommentDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CommentNotSpamUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CommentSpamUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerCancelDataErasureErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerEmailMarketingConsentUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerMergeErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerPaymentMethodCreateFromDuplicationDataUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerPaymentMethodGetDuplicationDataUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerPaymentMethodGetUpdateURLUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerPaymentMethodRemoteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerPaymentMethodUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerRequestDataErasureErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerSegmentMembersQueryUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerSendAccountInviteEmailUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e CustomerSmsMarketingConsentErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DataSaleOptOutUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DelegateAccessTokenCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DelegateAccessTokenDestroyUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DeliveryCustomizationErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DeliveryLocationLocalPickupSettingsErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DeliveryPromiseProviderUpsertUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DiscountErrorCode) Error() string {
+	return string(e)
+}
+
+func (e DisputeEvidenceUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ErrorsServerPixelUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ErrorsWebPixelUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FilesErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentConstraintRuleCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentConstraintRuleDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentConstraintRuleUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrderHoldUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrderLineItemsPreparedForPickupUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrderMergeUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrderReleaseHoldUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrderRescheduleUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrderSplitUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e FulfillmentOrdersSetFulfillmentDeadlineUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e GiftCardDeactivateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e GiftCardErrorCode) Error() string {
+	return string(e)
+}
+
+func (e GiftCardSendNotificationToCustomerUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e GiftCardSendNotificationToRecipientUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e GiftCardTransactionUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e InventoryAdjustQuantitiesUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e InventoryBulkToggleActivationUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e InventoryMoveQuantitiesUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e InventorySetOnHandQuantitiesUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e InventorySetQuantitiesUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e InventorySetScheduledChangesUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e LocationActivateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e LocationAddUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e LocationDeactivateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e LocationDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e LocationEditUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MarketCurrencySettingsUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MarketUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MarketingActivityUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MediaUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MenuCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MenuDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MenuUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetafieldDefinitionCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetafieldDefinitionDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetafieldDefinitionPinUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetafieldDefinitionUnpinUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetafieldDefinitionUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetafieldsSetUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MetaobjectUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e MobilePlatformApplicationUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OnlineStoreThemeFilesUserErrorsCode) Error() string {
+	return string(e)
+}
+
+func (e OrderCancelUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderCreateMandatePaymentUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderEditAddShippingLineUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderEditRemoveDiscountUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderEditRemoveShippingLineUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderEditUpdateDiscountUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderEditUpdateShippingLineUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderInvoiceSendUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e OrderRiskAssessmentCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PageCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PageDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PageUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PaymentCustomizationErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PaymentReminderSendUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PaymentTermsCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PaymentTermsDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PaymentTermsUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PriceListFixedPricesByProductBulkUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PriceListPriceUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PriceListUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductBundleMutationUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductChangeStatusUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductFeedCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductFeedDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductFullSyncUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductOptionUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductOptionsCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductOptionsDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductOptionsReorderUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductSetUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductVariantRelationshipBulkUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductVariantsBulkCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductVariantsBulkDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductVariantsBulkReorderUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ProductVariantsBulkUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PubSubWebhookSubscriptionCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PubSubWebhookSubscriptionUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e PublicationUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e QuantityPricingByVariantUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e QuantityRuleUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ReturnErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SellingPlanGroupUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ShopPolicyErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ShopResourceFeedbackCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e StandardMetafieldDefinitionEnableUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e StoreCreditAccountCreditUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e StoreCreditAccountDebitUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionBillingCycleBulkUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionBillingCycleErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionBillingCycleSkipUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionBillingCycleUnskipUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionContractErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionContractStatusUpdateErrorCode) Error() string {
+	return string(e)
+}
+
+func (e SubscriptionDraftErrorCode) Error() string {
+	return string(e)
+}
+
+func (e TaxAppConfigureUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ThemeCreateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ThemeDeleteUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ThemePublishUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ThemeUpdateUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e TransactionVoidUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e TranslationErrorCode) Error() string {
+	return string(e)
+}
+
+func (e URLRedirectBulkDeleteByIdsUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e URLRedirectBulkDeleteBySavedSearchUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e URLRedirectBulkDeleteBySearchUserErrorCode) Error() string {
+	return string(e)
+}
+
+func (e URLRedirectErrorCode) Error() string {
+	return string(e)
+}
+
+func (e URLRedirectImportErrorCode) Error() string {
+	return string(e)
+}
+
+func (e ValidationUserErrorCode) Error() string {
+	return string(e)
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"sort"
+	"strconv"
+)
+
+// FieldSet is the set of JSON paths present in decoded data, e.g. `seo.title` or `variants.nodes.0.price`.
+// A field that was selected but is null is present, a field that was not selected is not, which cannot be
+// told apart from the zero values of the decoded struct.
+type FieldSet map[string]struct{}
+
+// Has reports whether the JSON path was present in the decoded data.
+func (s FieldSet) Has(path string) bool {
+	_, ok := s[path]
+	return ok
+}
+
+// Paths returns the present JSON paths in lexical order.
+func (s FieldSet) Paths() []string {
+	paths := make([]string, 0, len(s))
+	for p := range s {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// add records the paths of data and its children under prefix.
+func (s FieldSet) add(prefix string, data any) {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, child := range v {
+			path := joinPath(prefix, key)
+			s[path] = struct{}{}
+			s.add(path, child)
+		}
+	case []any:
+		for i, child := range v {
+			path := joinPath(prefix, strconv.Itoa(i))
+			s[path] = struct{}{}
+			s.add(path, child)
+		}
+	}
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
@@ -0,0 +1,53 @@
+package model_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("FieldSet", func() {
+	It("records the paths present in the decoded data", func() {
+		var fields model.FieldSet
+		result, err := model.Decode(map[string]any{
+			"id":    "gid://shopify/Product/1",
+			"title": "",
+			"seo": map[string]any{
+				"title":       nil,
+				"description": "summer",
+			},
+			"tags": []any{"a"},
+		}, &model.Product{}, model.WithFieldSet(&fields))
+		Expect(err).NotTo(HaveOccurred())
+
+		product := result.(*model.Product)
+		Expect(product.Seo.Title).To(BeNil())
+		Expect(fields.Has("seo.title")).To(BeTrue())
+		Expect(fields.Has("seo.description")).To(BeTrue())
+		Expect(fields.Has("title")).To(BeTrue())
+		Expect(fields.Has("handle")).To(BeFalse())
+		Expect(fields.Paths()).To(Equal([]string{"id", "seo", "seo.description", "seo.title", "tags", "tags.0", "title"}))
+	})
+
+	It("records the paths when unmarshalling JSON", func() {
+		var fields model.FieldSet
+		connection := new(model.MediaConnection)
+		err := model.Unmarshal([]byte(mediaConnectionJSON), connection, model.WithFieldSet(&fields))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(connection.Nodes).To(HaveLen(2))
+		Expect(fields.Has("nodes.0.__typename")).To(BeTrue())
+		Expect(fields.Has("nodes.1.id")).To(BeTrue())
+		Expect(fields.Has("edges")).To(BeFalse())
+	})
+
+	It("does not record anything by default", func() {
+		result, err := model.Decode(map[string]any{"title": "a"}, &model.Product{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.(*model.Product).Title).To(Equal("a"))
+	})
+
+	It("returns invalid JSON errors", func() {
+		Expect(model.Unmarshal([]byte(`{`), &model.Product{})).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,238 @@
+// Code generated by github.com/gempages/go-shopify-graphql-model, DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+)
+
+// Validate checks that exactly one field of AppPlanInput is set.
+func (i AppPlanInput) Validate() error {
+	var set []string
+	if i.AppUsagePricingDetails != nil {
+		set = append(set, "appUsagePricingDetails")
+	}
+	if i.AppRecurringPricingDetails != nil {
+		set = append(set, "appRecurringPricingDetails")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "AppPlanInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i AppPlanInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain AppPlanInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of AppSubscriptionDiscountValueInput is set.
+func (i AppSubscriptionDiscountValueInput) Validate() error {
+	var set []string
+	if i.Percentage != nil {
+		set = append(set, "percentage")
+	}
+	if i.Amount != nil {
+		set = append(set, "amount")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "AppSubscriptionDiscountValueInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i AppSubscriptionDiscountValueInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain AppSubscriptionDiscountValueInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of CustomerPaymentMethodRemoteInput is set.
+func (i CustomerPaymentMethodRemoteInput) Validate() error {
+	var set []string
+	if i.StripePaymentMethod != nil {
+		set = append(set, "stripePaymentMethod")
+	}
+	if i.AuthorizeNetCustomerPaymentProfile != nil {
+		set = append(set, "authorizeNetCustomerPaymentProfile")
+	}
+	if i.BraintreePaymentMethod != nil {
+		set = append(set, "braintreePaymentMethod")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "CustomerPaymentMethodRemoteInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i CustomerPaymentMethodRemoteInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain CustomerPaymentMethodRemoteInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of MetafieldAccessGrantOperationInput is set.
+func (i MetafieldAccessGrantOperationInput) Validate() error {
+	var set []string
+	if i.Create != nil {
+		set = append(set, "create")
+	}
+	if i.Update != nil {
+		set = append(set, "update")
+	}
+	if i.Delete != nil {
+		set = append(set, "delete")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "MetafieldAccessGrantOperationInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i MetafieldAccessGrantOperationInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain MetafieldAccessGrantOperationInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of MetaobjectBulkDeleteWhereCondition is set.
+func (i MetaobjectBulkDeleteWhereCondition) Validate() error {
+	var set []string
+	if i.Type != nil {
+		set = append(set, "type")
+	}
+	if len(i.Ids) > 0 {
+		set = append(set, "ids")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "MetaobjectBulkDeleteWhereCondition", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i MetaobjectBulkDeleteWhereCondition) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain MetaobjectBulkDeleteWhereCondition
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of MetaobjectFieldDefinitionOperationInput is set.
+func (i MetaobjectFieldDefinitionOperationInput) Validate() error {
+	var set []string
+	if i.Create != nil {
+		set = append(set, "create")
+	}
+	if i.Update != nil {
+		set = append(set, "update")
+	}
+	if i.Delete != nil {
+		set = append(set, "delete")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "MetaobjectFieldDefinitionOperationInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i MetaobjectFieldDefinitionOperationInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain MetaobjectFieldDefinitionOperationInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of OrderCreateDiscountCodeInput is set.
+func (i OrderCreateDiscountCodeInput) Validate() error {
+	var set []string
+	if i.ItemPercentageDiscountCode != nil {
+		set = append(set, "itemPercentageDiscountCode")
+	}
+	if i.ItemFixedDiscountCode != nil {
+		set = append(set, "itemFixedDiscountCode")
+	}
+	if i.FreeShippingDiscountCode != nil {
+		set = append(set, "freeShippingDiscountCode")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "OrderCreateDiscountCodeInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i OrderCreateDiscountCodeInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain OrderCreateDiscountCodeInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of PurchasingEntityInput is set.
+func (i PurchasingEntityInput) Validate() error {
+	var set []string
+	if i.CustomerID != nil {
+		set = append(set, "customerId")
+	}
+	if i.PurchasingCompany != nil {
+		set = append(set, "purchasingCompany")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "PurchasingEntityInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i PurchasingEntityInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain PurchasingEntityInput
+	return json.Marshal(plain(i))
+}
+
+// Validate checks that exactly one field of SubscriptionDeliveryMethodInput is set.
+func (i SubscriptionDeliveryMethodInput) Validate() error {
+	var set []string
+	if i.Shipping != nil {
+		set = append(set, "shipping")
+	}
+	if i.LocalDelivery != nil {
+		set = append(set, "localDelivery")
+	}
+	if i.Pickup != nil {
+		set = append(set, "pickup")
+	}
+	if len(set) != 1 {
+		return &OneOfError{Input: "SubscriptionDeliveryMethodInput", Set: set}
+	}
+	return nil
+}
+
+// MarshalJSON encodes i, failing when Validate does.
+func (i SubscriptionDeliveryMethodInput) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	type plain SubscriptionDeliveryMethodInput
+	return json.Marshal(plain(i))
+}
@@ -0,0 +1,47 @@
+package model_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+var _ = Describe("one-of inputs", func() {
+	price := model.MoneyInput{Amount: decimal.NewFromInt(10), CurrencyCode: model.CurrencyCodeUsd}
+	recurring := &model.AppRecurringPricingInput{Price: &price}
+	usage := &model.AppUsagePricingInput{CappedAmount: &price, Terms: "$1 per order"}
+
+	It("rejects inputs without a field set", func() {
+		var input model.AppPlanInput
+		Expect(input.Validate()).To(MatchError("AppPlanInput: exactly one field must be set, got none"))
+		_, err := json.Marshal(input)
+		var oneOf *model.OneOfError
+		Expect(errors.As(err, &oneOf)).To(BeTrue())
+		Expect(oneOf.Set).To(BeEmpty())
+	})
+
+	It("encodes inputs with one field set", func() {
+		input := model.AppPlanInput{AppRecurringPricingDetails: recurring}
+		Expect(input.Validate()).To(Succeed())
+		data, err := json.Marshal(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"appRecurringPricingDetails": {"price": {"amount": "10", "currencyCode": "USD"}}}`))
+
+		data, err = json.Marshal(&model.AppSubscriptionLineItemInput{Plan: &input})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"plan":{"appRecurringPricingDetails"`))
+	})
+
+	It("rejects inputs with two fields set", func() {
+		input := model.AppPlanInput{AppRecurringPricingDetails: recurring, AppUsagePricingDetails: usage}
+		Expect(input.Validate()).To(MatchError(
+			"AppPlanInput: exactly one field must be set, got appUsagePricingDetails, appRecurringPricingDetails"))
+		_, err := json.Marshal([]model.AppPlanInput{input})
+		Expect(err).To(MatchError(ContainSubstring("exactly one field must be set")))
+	})
+})
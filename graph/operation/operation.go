@@ -0,0 +1,120 @@
+// Package operation builds GraphQL documents from Go code so that the selected fields stay in sync with the
+// structs of the model package they are decoded into.
+package operation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// Kind is the type of operation.
+type Kind string
+
+const (
+	KindQuery    Kind = "query"
+	KindMutation Kind = "mutation"
+)
+
+// Operation is a GraphQL query or mutation together with its variables.
+type Operation struct {
+	kind       Kind
+	name       string
+	variables  []variable
+	selections []Selection
+}
+
+type variable struct {
+	name    string
+	gqlType string
+	value   any
+}
+
+// NewQuery returns a query named name selecting the given fields of QueryRoot.
+func NewQuery(name string, selections ...Selection) *Operation {
+	return &Operation{kind: KindQuery, name: name, selections: selections}
+}
+
+// NewMutation returns a mutation named name selecting the given fields of Mutation.
+func NewMutation(name string, selections ...Selection) *Operation {
+	return &Operation{kind: KindMutation, name: name, selections: selections}
+}
+
+// Variable declares the variable name of GraphQL type gqlType, e.g. `Int!`, with the given value.
+// A nil value declares the variable without sending it, so that its default applies.
+func (o *Operation) Variable(name, gqlType string, value any) *Operation {
+	o.variables = append(o.variables, variable{name: name, gqlType: gqlType, value: value})
+	return o
+}
+
+// Select appends root fields to the operation.
+func (o *Operation) Select(selections ...Selection) *Operation {
+	o.selections = append(o.selections, selections...)
+	return o
+}
+
+// Query renders the GraphQL document of the operation.
+func (o *Operation) Query() string {
+	var b strings.Builder
+	b.WriteString(string(o.kind))
+	if o.name != "" {
+		b.WriteString(" ")
+		b.WriteString(o.name)
+	}
+	if len(o.variables) > 0 {
+		b.WriteString("(")
+		for i, v := range o.variables {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "$%s: %s", v.name, v.gqlType)
+		}
+		b.WriteString(")")
+	}
+	renderSelectionSet(&b, o.selections, 0)
+	b.WriteString("\n")
+	return b.String()
+}
+
+// Variables returns the values of the declared variables, keyed by variable name.
+func (o *Operation) Variables() map[string]any {
+	vars := make(map[string]any, len(o.variables))
+	for _, v := range o.variables {
+		if v.value != nil {
+			vars[v.name] = v.value
+		}
+	}
+	return vars
+}
+
+// Build renders the document and returns it with its variables.
+func (o *Operation) Build() (string, map[string]any) {
+	return o.Query(), o.Variables()
+}
+
+// Validate checks the document and its variables against schema, which is loaded from schema.graphql with
+// schema.Load.
+func (o *Operation) Validate(schema *ast.Schema) error {
+	doc, errs := gqlparser.LoadQuery(schema, o.Query())
+	if len(errs) > 0 {
+		return fmt.Errorf("validate %s %s: %w", o.kind, o.name, errs)
+	}
+
+	// Variables are validated in their JSON form, which is what is sent to Shopify.
+	b, err := json.Marshal(o.Variables())
+	if err != nil {
+		return fmt.Errorf("marshal variables: %w", err)
+	}
+	var vars map[string]any
+	if err = json.Unmarshal(b, &vars); err != nil {
+		return fmt.Errorf("unmarshal variables: %w", err)
+	}
+	if _, err = validator.VariableValues(schema, doc.Operations[0], vars); err != nil {
+		return fmt.Errorf("validate variables of %s %s: %w", o.kind, o.name, err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package operation_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/operation"
+	"github.com/gempages/go-shopify-graphql-model/graph/schema"
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+var (
+	queryRootProducts = operation.FieldDescriptor{Parent: "QueryRoot", Name: "products", Type: "ProductConnection!"}
+	connectionNodes   = operation.FieldDescriptor{Parent: "ProductConnection", Name: "nodes", Type: "[Product!]!"}
+	productID         = operation.FieldDescriptor{Parent: "Product", Name: "id", Type: "ID!"}
+	productTitle      = operation.FieldDescriptor{Parent: "Product", Name: "title", Type: "String!"}
+	productMedia      = operation.FieldDescriptor{Parent: "Product", Name: "media", Type: "[Media!]!", Abstract: true}
+	mediaAlt          = operation.FieldDescriptor{Parent: "Media", Name: "alt", Type: "String"}
+	imageURL          = operation.FieldDescriptor{Parent: "MediaImage", Name: "url", Type: "String!"}
+	mutationSet       = operation.FieldDescriptor{Parent: "Mutation", Name: "productSet", Type: "ProductSetPayload"}
+	payloadProduct    = operation.FieldDescriptor{Parent: "ProductSetPayload", Name: "product", Type: "Product"}
+)
+
+var _ = Describe("Operation", func() {
+	var productsQuery *operation.Operation
+
+	BeforeEach(func() {
+		productsQuery = operation.NewQuery("GetProducts",
+			operation.Select(queryRootProducts,
+				operation.Select(connectionNodes,
+					operation.Select(productID),
+					operation.Select(productTitle).Alias("name"),
+					operation.Select(productMedia,
+						operation.Select(mediaAlt),
+						operation.On(types.MediaImage, operation.Select(imageURL)),
+					),
+				),
+			).Arg("first", operation.Var("first")).Arg("sortKey", model.ProductSortKeysTitle),
+		).Variable("first", "Int!", 10)
+	})
+
+	It("renders the document and inserts __typename on abstract fields", func() {
+		query, vars := productsQuery.Build()
+		Expect(query).To(Equal(`query GetProducts($first: Int!) {
+  products(first: $first, sortKey: TITLE) {
+    nodes {
+      id
+      name: title
+      media {
+        __typename
+        alt
+        ... on MediaImage {
+          url
+        }
+      }
+    }
+  }
+}
+`))
+		Expect(vars).To(Equal(map[string]any{"first": 10}))
+	})
+
+	It("does not insert __typename twice", func() {
+		query := operation.NewQuery("",
+			operation.Select(productMedia, operation.Select(operation.FieldDescriptor{Name: "__typename"})),
+		).Query()
+		Expect(query).To(Equal("query {\n  media {\n    __typename\n  }\n}\n"))
+	})
+
+	It("renders Go values as literals", func() {
+		title := "Summer \"sale\""
+		query := operation.NewMutation("SetProduct",
+			operation.Select(mutationSet, operation.Select(payloadProduct, operation.Select(productID))).
+				Arg("input", model.ProductSetInput{Title: &title, Tags: []string{"a", "b"}}).
+				Arg("price", decimal.RequireFromString("1.50")).
+				Arg("synchronous", true),
+		).Query()
+		Expect(query).To(ContainSubstring(
+			`productSet(input: {tags: ["a", "b"], title: "Summer \"sale\""}, price: "1.5", synchronous: true)`))
+	})
+
+	It("renders nil pointers as null", func() {
+		query := operation.NewQuery("Products",
+			operation.Select(queryRootProducts, operation.Select(connectionNodes, operation.Select(productID))).
+				Arg("sortKey", (*model.ProductSortKeys)(nil)).
+				Arg("query", (*string)(nil)),
+		).Query()
+		Expect(query).To(ContainSubstring("products(sortKey: null, query: null)"))
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid operation", func() {
+			s, err := schema.LoadString("schema.graphql", operationSchema)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(productsQuery.Validate(s)).To(Succeed())
+		})
+
+		It("rejects unknown fields", func() {
+			s, err := schema.LoadString("schema.graphql", operationSchema)
+			Expect(err).NotTo(HaveOccurred())
+			productsQuery.Select(operation.Select(operation.FieldDescriptor{Parent: "QueryRoot", Name: "orders"}))
+			Expect(productsQuery.Validate(s)).To(MatchError(ContainSubstring(`Cannot query field "orders"`)))
+		})
+
+		It("rejects invalid variables", func() {
+			s, err := schema.LoadString("schema.graphql", operationSchema)
+			Expect(err).NotTo(HaveOccurred())
+			var first any
+			Expect(json.Unmarshal([]byte(`"ten"`), &first)).To(Succeed())
+			query := operation.NewQuery("GetProducts",
+				operation.Select(queryRootProducts, operation.Select(connectionNodes, operation.Select(productID))).
+					Arg("first", operation.Var("first")),
+			).Variable("first", "Int!", first)
+			Expect(query.Validate(s)).To(MatchError(ContainSubstring("first")))
+		})
+	})
+})
+
+const operationSchema = `
+type QueryRoot {
+  products(first: Int, sortKey: ProductSortKeys): ProductConnection!
+}
+
+type Mutation {
+  productSet(input: ProductSetInput!, synchronous: Boolean): ProductSetPayload
+}
+
+enum ProductSortKeys {
+  ID
+  TITLE
+}
+
+type ProductConnection {
+  nodes: [Product!]!
+}
+
+type Product {
+  id: ID!
+  title: String!
+  media: [Media!]!
+}
+
+interface Media {
+  alt: String
+}
+
+type MediaImage implements Media {
+  alt: String
+  url: String!
+}
+
+input ProductSetInput {
+  title: String
+  tags: [String!]
+}
+
+type ProductSetPayload {
+  product: Product
+}
+`
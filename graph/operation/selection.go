@@ -0,0 +1,137 @@
+package operation
+
+import (
+	"strings"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// FieldDescriptor describes a field of a GraphQL object or interface type.
+// Descriptors for every type of the schema are generated into fields_gen.go, e.g. ProductFields.Title.
+type FieldDescriptor struct {
+	// Parent is the name of the type declaring the field.
+	Parent string
+	// Name is the name of the field.
+	Name string
+	// Type is the GraphQL type of the field, e.g. `[Media!]!`.
+	Type string
+	// Abstract is true when the named type of the field is an interface or a union.
+	Abstract bool
+}
+
+// Selection is a field or an inline fragment in a selection set.
+type Selection interface {
+	render(b *strings.Builder, depth int)
+}
+
+// Field is a field selection built from a FieldDescriptor.
+type Field struct {
+	desc       FieldDescriptor
+	alias      string
+	args       []argument
+	selections []Selection
+}
+
+type argument struct {
+	name  string
+	value any
+}
+
+// Select returns a selection of the field described by desc with the given sub-selections.
+func Select(desc FieldDescriptor, selections ...Selection) *Field {
+	return &Field{desc: desc, selections: selections}
+}
+
+// Alias sets the response key of the field.
+func (f *Field) Alias(alias string) *Field {
+	f.alias = alias
+	return f
+}
+
+// Arg adds an argument to the field. The value is either a VarRef or a Go value rendered as a GraphQL literal.
+func (f *Field) Arg(name string, value any) *Field {
+	f.args = append(f.args, argument{name: name, value: value})
+	return f
+}
+
+// Select appends sub-selections to the field.
+func (f *Field) Select(selections ...Selection) *Field {
+	f.selections = append(f.selections, selections...)
+	return f
+}
+
+func (f *Field) render(b *strings.Builder, depth int) {
+	writeIndent(b, depth)
+	if f.alias != "" {
+		b.WriteString(f.alias)
+		b.WriteString(": ")
+	}
+	b.WriteString(f.desc.Name)
+	if len(f.args) > 0 {
+		b.WriteString("(")
+		for i, arg := range f.args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(arg.name)
+			b.WriteString(": ")
+			writeValue(b, arg.value)
+		}
+		b.WriteString(")")
+	}
+
+	selections := f.selections
+	// model.Decode needs __typename to pick the concrete type of interfaces and unions.
+	if f.desc.Abstract && len(selections) > 0 && !selectsTypename(selections) {
+		selections = append([]Selection{typename}, selections...)
+	}
+	renderSelectionSet(b, selections, depth)
+	b.WriteString("\n")
+}
+
+// InlineFragment is a `... on Type` selection.
+type InlineFragment struct {
+	typeCondition string
+	selections    []Selection
+}
+
+// On returns an inline fragment selecting fields of the concrete type typeName.
+func On(typeName types.GqlTypeName, selections ...Selection) *InlineFragment {
+	return &InlineFragment{typeCondition: string(typeName), selections: selections}
+}
+
+func (f *InlineFragment) render(b *strings.Builder, depth int) {
+	writeIndent(b, depth)
+	b.WriteString("... on ")
+	b.WriteString(f.typeCondition)
+	renderSelectionSet(b, f.selections, depth)
+	b.WriteString("\n")
+}
+
+// typename selects the __typename meta field.
+var typename = Select(FieldDescriptor{Name: types.GqlTypeNameKey, Type: "String!"})
+
+func selectsTypename(selections []Selection) bool {
+	for _, s := range selections {
+		if f, ok := s.(*Field); ok && f.desc.Name == types.GqlTypeNameKey && f.alias == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func renderSelectionSet(b *strings.Builder, selections []Selection, depth int) {
+	if len(selections) == 0 {
+		return
+	}
+	b.WriteString(" {\n")
+	for _, s := range selections {
+		s.render(b, depth+1)
+	}
+	writeIndent(b, depth)
+	b.WriteString("}")
+}
+
+func writeIndent(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+}
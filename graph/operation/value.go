@@ -0,0 +1,135 @@
+package operation
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// VarRef references a variable of the operation in a field argument.
+type VarRef string
+
+// Var returns a reference to the variable name, to be used as a field argument.
+func Var(name string) VarRef {
+	return VarRef(name)
+}
+
+// writeValue writes v as a GraphQL input value literal.
+func writeValue(b *strings.Builder, v any) {
+	// Nil pointers are checked first, as a nil *T implements the interfaces of T, e.g. types.Enum.
+	if isNil(v) {
+		b.WriteString("null")
+		return
+	}
+	switch v := v.(type) {
+	case VarRef:
+		b.WriteString("$")
+		b.WriteString(string(v))
+		return
+	case types.Enum:
+		b.WriteString(v.String())
+		return
+	case time.Time:
+		writeString(b, v.Format(time.RFC3339))
+		return
+	case decimal.Decimal:
+		writeString(b, v.String())
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		writeValue(b, rv.Elem().Interface())
+	case reflect.String:
+		writeString(b, rv.String())
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		fmt.Fprint(b, rv.Interface())
+	case reflect.Slice, reflect.Array:
+		b.WriteString("[")
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeValue(b, rv.Index(i).Interface())
+		}
+		b.WriteString("]")
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		values := make(map[string]any, rv.Len())
+		for _, k := range rv.MapKeys() {
+			key := fmt.Sprint(k.Interface())
+			keys = append(keys, key)
+			values[key] = rv.MapIndex(k).Interface()
+		}
+		sort.Strings(keys)
+		writeObject(b, keys, values)
+	case reflect.Struct:
+		writeStruct(b, rv)
+	default:
+		writeString(b, fmt.Sprint(v))
+	}
+}
+
+// isNil reports whether v is nil or a nil pointer.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// writeStruct writes an input struct as an object literal using its json tags, so that generated input types
+// are rendered the same way as when they are passed as variables.
+func writeStruct(b *strings.Builder, rv reflect.Value) {
+	var keys []string
+	values := map[string]any{}
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fv := rv.Field(i)
+		if strings.Contains(opts, "omitempty") && fv.IsZero() {
+			continue
+		}
+		keys = append(keys, name)
+		values[name] = fv.Interface()
+	}
+	writeObject(b, keys, values)
+}
+
+func writeObject(b *strings.Builder, keys []string, values map[string]any) {
+	b.WriteString("{")
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(key)
+		b.WriteString(": ")
+		writeValue(b, values[key])
+	}
+	b.WriteString("}")
+}
+
+func writeString(b *strings.Builder, s string) {
+	// JSON string escaping is a subset of GraphQL string escaping.
+	quoted, _ := json.Marshal(s)
+	b.Write(quoted)
+}
@@ -0,0 +1,42 @@
+// Package schema loads the Shopify Admin API schema fetched by fetchSchema.js.
+package schema
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// QueryRoot is the name of the Shopify query root type.
+	QueryRoot = "QueryRoot"
+	// Mutation is the name of the Shopify mutation root type.
+	Mutation = "Mutation"
+)
+
+// Load reads and parses the schema file at filename, e.g. schema.graphql.
+func Load(filename string) (*ast.Schema, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read schema: %w", err)
+	}
+	return LoadString(filename, string(b))
+}
+
+// LoadString parses the schema input. The `schema` declaration is removed from schema.graphql to generate
+// the models, so the Shopify root types are assigned when the input does not declare them.
+func LoadString(name, input string) (*ast.Schema, error) {
+	s, err := gqlparser.LoadSchema(&ast.Source{Name: name, Input: input})
+	if err != nil {
+		return nil, fmt.Errorf("load schema %s: %w", name, err)
+	}
+	if s.Query == nil {
+		s.Query = s.Types[QueryRoot]
+	}
+	if s.Mutation == nil {
+		s.Mutation = s.Types[Mutation]
+	}
+	return s, nil
+}
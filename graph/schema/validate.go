@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+	// Blank import is used to load up the validator rules.
+	_ "github.com/vektah/gqlparser/v2/validator/rules"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// QueryFiles expands the directories in paths into the *.graphql and *.gql documents they contain.
+func QueryFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if p == path && !d.IsDir() {
+				files = append(files, p)
+				return nil
+			}
+			if ext := filepath.Ext(p); !d.IsDir() && (ext == ".graphql" || ext == ".gql") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walk %s: %w", path, err)
+		}
+	}
+	return files, nil
+}
+
+// ValidateQueryFile reads the operation document at filename and validates it with ValidateQuery.
+func ValidateQueryFile(s *ast.Schema, filename string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("read query: %w", err)
+	}
+	return ValidateQuery(s, filename, string(b))
+}
+
+// ValidateQuery parses the operation document input and validates it against s with LoadQuery.
+// The returned error is a gqlerror.List.
+func ValidateQuery(s *ast.Schema, name, input string) error {
+	_, err := LoadQuery(s, &ast.Source{Name: name, Input: input})
+	return err
+}
+
+// LoadQuery parses the operation documents of sources as a single document and validates it against s.
+// Besides the GraphQL validation rules, every selection of an interface or a union must include __typename,
+// otherwise model.Decode cannot conclude the concrete type of the result.
+// The returned error is a gqlerror.List.
+func LoadQuery(s *ast.Schema, sources ...*ast.Source) (*ast.QueryDocument, error) {
+	doc := &ast.QueryDocument{}
+	for _, src := range sources {
+		d, err := parser.ParseQuery(src)
+		if err != nil {
+			var gqlErr *gqlerror.Error
+			if errors.As(err, &gqlErr) {
+				return nil, gqlerror.List{gqlErr}
+			}
+			return nil, gqlerror.List{gqlerror.Wrap(err)}
+		}
+		doc.Operations = append(doc.Operations, d.Operations...)
+		doc.Fragments = append(doc.Fragments, d.Fragments...)
+	}
+
+	if errs := validator.Validate(s, doc); len(errs) > 0 {
+		return nil, errs
+	}
+
+	var errs gqlerror.List
+	for _, op := range doc.Operations {
+		errs = append(errs, checkTypename(s, op.SelectionSet)...)
+	}
+	for _, fragment := range doc.Fragments {
+		errs = append(errs, checkTypename(s, fragment.SelectionSet)...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return doc, nil
+}
+
+// checkTypename returns an error for every field of an abstract type in set whose selection set does not
+// include __typename.
+func checkTypename(s *ast.Schema, set ast.SelectionSet) gqlerror.List {
+	var errs gqlerror.List
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if len(sel.SelectionSet) == 0 {
+				continue
+			}
+			def := sel.Definition
+			if def != nil && isAbstract(s, def.Type) && !selectsTypename(sel.SelectionSet, def.Type.Name()) {
+				errs = append(errs, gqlerror.ErrorPosf(sel.Position,
+					"must query %s on field %q of abstract type %s", types.GqlTypeNameKey, sel.Alias, def.Type.Name()))
+			}
+			errs = append(errs, checkTypename(s, sel.SelectionSet)...)
+		case *ast.InlineFragment:
+			errs = append(errs, checkTypename(s, sel.SelectionSet)...)
+		}
+		// Fragment definitions are checked on their own.
+	}
+	return errs
+}
+
+// selectsTypename reports whether __typename is part of every response object of type typeName selected by set,
+// either directly or through fragments on typeName.
+func selectsTypename(set ast.SelectionSet, typeName string) bool {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if sel.Name == types.GqlTypeNameKey && sel.Alias == types.GqlTypeNameKey && len(sel.Directives) == 0 {
+				return true
+			}
+		case *ast.InlineFragment:
+			if (sel.TypeCondition == "" || sel.TypeCondition == typeName) && selectsTypename(sel.SelectionSet, typeName) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if sel.Definition != nil && sel.Definition.TypeCondition == typeName &&
+				selectsTypename(sel.Definition.SelectionSet, typeName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isAbstract(s *ast.Schema, t *ast.Type) bool {
+	def := s.Types[t.Name()]
+	return def != nil && def.IsAbstractType()
+}
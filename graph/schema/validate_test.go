@@ -0,0 +1,119 @@
+package schema_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/schema"
+)
+
+var _ = Describe("ValidateQuery", func() {
+	var s *ast.Schema
+
+	BeforeEach(func() {
+		var err error
+		s, err = schema.LoadString("schema.graphql", testSchema)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("assigns the Shopify root types", func() {
+		Expect(s.Query.Name).To(Equal(schema.QueryRoot))
+		Expect(s.Mutation.Name).To(Equal(schema.Mutation))
+	})
+
+	It("accepts a valid document", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `
+			query GetProduct($id: ID!) {
+			  product(id: $id) {
+			    id
+			    media { __typename alt ... on MediaImage { url } }
+			    metafield { owner { ...Owner } }
+			  }
+			}
+
+			fragment Owner on MetafieldOwner {
+			  __typename
+			  ... on Product { id }
+			}`)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns GraphQL validation errors", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `query { product(id: 1) { sku } }`)
+		Expect(err).To(MatchError(ContainSubstring(`Cannot query field "sku" on type "Product"`)))
+		Expect(err).To(MatchError(ContainSubstring("product.graphql:1")))
+	})
+
+	It("returns syntax errors", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `query {`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("requires __typename on interface selections", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `query { product(id: 1) { media { alt } } }`)
+		Expect(err).To(MatchError(ContainSubstring(`must query __typename on field "media" of abstract type Media`)))
+	})
+
+	It("requires __typename on union selections", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `
+			query { product(id: 1) { metafield { owner { ... on Product { id } } } } }`)
+		Expect(err).To(MatchError(ContainSubstring(`field "owner" of abstract type MetafieldOwner`)))
+	})
+
+	It("does not accept __typename from a fragment on a concrete type", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `
+			query { product(id: 1) { media { ... on MediaImage { __typename url } } } }`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not accept an aliased __typename", func() {
+		err := schema.ValidateQuery(s, "product.graphql", `query { product(id: 1) { media { type: __typename } } }`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("validates documents on disk", func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "product.graphql")
+		Expect(os.WriteFile(filename, []byte(`query { product(id: 1) { id } }`), 0o600)).To(Succeed())
+		Expect(schema.ValidateQueryFile(s, filename)).To(Succeed())
+		Expect(schema.ValidateQueryFile(s, filename+".missing")).NotTo(Succeed())
+	})
+})
+
+const testSchema = `
+type QueryRoot {
+  product(id: ID!): Product
+}
+
+type Mutation {
+  productDelete(id: ID!): ID
+}
+
+type Product {
+  id: ID!
+  media: [Media!]!
+  metafield: Metafield
+}
+
+interface Media {
+  alt: String
+}
+
+type MediaImage implements Media {
+  alt: String
+  url: String!
+}
+
+type Collection {
+  id: ID!
+}
+
+union MetafieldOwner = Product | Collection
+
+type Metafield {
+  owner: MetafieldOwner!
+}
+`
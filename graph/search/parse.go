@@ -0,0 +1,275 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Parse parses a query in the search syntax into its nodes. AND takes precedence over OR, and adjacent terms are
+// joined by AND, e.g. `a OR b c` is parsed as `a OR (b AND c)`.
+func Parse(query string) (Node, error) {
+	p := &parser{s: query}
+	p.skipSpaces()
+	if p.eof() {
+		return nil, fmt.Errorf("empty search query")
+	}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, p.errorf("unexpected %q", p.s[p.pos:p.pos+1])
+	}
+	return n, nil
+}
+
+// Fields returns the names of the fields matched by the terms of n, in lexical order.
+func Fields(n Node) []string {
+	seen := map[string]bool{}
+	var walk func(Node)
+	walk = func(n Node) {
+		switch n := n.(type) {
+		case Term:
+			if n.Field != "" {
+				seen[n.Field] = true
+			}
+		case And:
+			for _, c := range n {
+				walk(c)
+			}
+		case Or:
+			for _, c := range n {
+				walk(c)
+			}
+		case Not:
+			walk(n.Node)
+		}
+	}
+	walk(n)
+
+	fields := make([]string, 0, len(seen))
+	for f := range seen {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("parse search query at offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *parser) skipSpaces() {
+	for !p.eof() && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+// peekKeyword reports whether the keyword k is next.
+func (p *parser) peekKeyword(k string) bool {
+	if !strings.HasPrefix(p.s[p.pos:], k) {
+		return false
+	}
+	end := p.pos + len(k)
+	return end == len(p.s) || strings.ContainsRune(" \t\n()", rune(p.s[end]))
+}
+
+// keyword consumes the keyword k when it is next.
+func (p *parser) keyword(k string) bool {
+	if !p.peekKeyword(k) {
+		return false
+	}
+	p.pos += len(k)
+	p.skipSpaces()
+	return true
+}
+
+func (p *parser) parseOr() (Node, error) {
+	var nodes Or
+	for {
+		n, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		if !p.keyword("OR") {
+			break
+		}
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	var nodes And
+	for {
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		if p.eof() || p.s[p.pos] == ')' || p.peekKeyword("OR") {
+			break
+		}
+		p.keyword("AND")
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	if p.keyword("NOT") {
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Node: n}, nil
+	}
+	if !p.eof() && p.s[p.pos] == '-' {
+		p.pos++
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Node: n}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	if p.eof() {
+		return nil, p.errorf("unexpected end of query")
+	}
+	if p.s[p.pos] == '(' {
+		p.pos++
+		p.skipSpaces()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.eof() || p.s[p.pos] != ')' {
+			return nil, p.errorf("missing )")
+		}
+		p.pos++
+		p.skipSpaces()
+		return n, nil
+	}
+
+	t, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	return t, nil
+}
+
+func (p *parser) parseTerm() (Term, error) {
+	var t Term
+	start := p.pos
+	word := p.bare()
+	if !p.eof() && p.s[p.pos] == ':' && word != "" {
+		t.Field = word
+		p.pos++
+		for _, c := range []Comparator{LessOrEqual, GreaterOrEqual, Less, Greater} {
+			if strings.HasPrefix(p.s[p.pos:], string(c)) {
+				t.Comparator = c
+				p.pos += len(c)
+				break
+			}
+		}
+		if t.Comparator == Equal && p.star() {
+			t.Exists = true
+			return t, nil
+		}
+		value, err := p.value()
+		if err != nil {
+			return Term{}, err
+		}
+		t.Value = value
+	} else {
+		p.pos = start
+		value, err := p.value()
+		if err != nil {
+			return Term{}, err
+		}
+		t.Value = value
+	}
+	t.Prefix = p.star()
+	return t, nil
+}
+
+// star consumes a * ending a term.
+func (p *parser) star() bool {
+	if p.eof() || p.s[p.pos] != '*' {
+		return false
+	}
+	end := p.pos + 1
+	if end < len(p.s) && !strings.ContainsRune(" \t\n()", rune(p.s[end])) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+// bare consumes an unquoted value.
+func (p *parser) bare() string {
+	start := p.pos
+	for !p.eof() {
+		r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+		if !isBareRune(r) {
+			break
+		}
+		p.pos += size
+	}
+	return p.s[start:p.pos]
+}
+
+// value consumes a quoted or unquoted value.
+func (p *parser) value() (string, error) {
+	if p.eof() {
+		return "", p.errorf("missing value")
+	}
+	q := p.s[p.pos]
+	if q != '\'' && q != '"' {
+		v := p.bare()
+		if v == "" {
+			return "", p.errorf("unexpected %q", p.s[p.pos:p.pos+1])
+		}
+		return v, nil
+	}
+
+	start := p.pos
+	p.pos++
+	var b strings.Builder
+	for !p.eof() {
+		c := p.s[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.s):
+			b.WriteByte(p.s[p.pos+1])
+			p.pos += 2
+		case c == q:
+			p.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	p.pos = start
+	return "", p.errorf("unterminated quoted value")
+}
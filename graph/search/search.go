@@ -0,0 +1,268 @@
+// Package search builds and parses the search syntax accepted by the `query` argument of connection fields, e.g.
+// `QueryRoot.products(query: "status:active AND tag:'summer'")`.
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/types"
+)
+
+// Node is a node of a search query.
+type Node interface {
+	// String returns the node in the search syntax.
+	String() string
+	node()
+}
+
+// Comparator compares the value of a field in a Term.
+type Comparator string
+
+const (
+	Equal          Comparator = ""
+	Less           Comparator = "<"
+	LessOrEqual    Comparator = "<="
+	Greater        Comparator = ">"
+	GreaterOrEqual Comparator = ">="
+)
+
+// Term matches the value of a field, e.g. `created_at:>2024-01-01`, or free text when Field is empty.
+type Term struct {
+	Field      string
+	Comparator Comparator
+	// Value is the unquoted value.
+	Value string
+	// Prefix makes the term match the values starting with Value, e.g. `title:summer*`.
+	Prefix bool
+	// Exists makes the term match the objects having a value for the field, e.g. `barcode:*`. Value is ignored.
+	Exists bool
+}
+
+// And matches the objects matched by all of its nodes.
+type And []Node
+
+// Or matches the objects matched by any of its nodes.
+type Or []Node
+
+// Not matches the objects not matched by Node.
+type Not struct {
+	Node Node
+}
+
+func (Term) node() {}
+func (And) node()  {}
+func (Or) node()   {}
+func (Not) node()  {}
+
+func (t Term) String() string {
+	var b strings.Builder
+	if t.Field != "" {
+		b.WriteString(t.Field)
+		b.WriteString(":")
+	}
+	if t.Exists {
+		b.WriteString("*")
+		return b.String()
+	}
+	b.WriteString(string(t.Comparator))
+	b.WriteString(quote(t.Value))
+	if t.Prefix {
+		b.WriteString("*")
+	}
+	return b.String()
+}
+
+func (a And) String() string {
+	return join(a, " AND ", func(n Node) bool {
+		_, ok := n.(Or)
+		return ok
+	})
+}
+
+func (o Or) String() string {
+	return join(o, " OR ", func(n Node) bool {
+		_, ok := n.(And)
+		return ok
+	})
+}
+
+func (n Not) String() string {
+	switch n.Node.(type) {
+	case And, Or:
+		return "NOT (" + n.Node.String() + ")"
+	}
+	return "NOT " + n.Node.String()
+}
+
+// join joins the nodes with sep, grouping the nodes for which group returns true.
+func join(nodes []Node, sep string, group func(Node) bool) string {
+	parts := make([]string, len(nodes))
+	for i, n := range nodes {
+		parts[i] = n.String()
+		if group(n) && len(nodes) > 1 {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// quote returns value as is when it can be written without quotes, or between single quotes otherwise.
+func quote(value string) string {
+	if !needsQuotes(value) {
+		return value
+	}
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range value {
+		if r == '\'' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+func needsQuotes(value string) bool {
+	if value == "" || isKeyword(value) || value[0] == '-' {
+		return true
+	}
+	for _, r := range value {
+		if !isBareRune(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBareRune reports whether r can be written in an unquoted value.
+func isBareRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r > 0x7f:
+		return true
+	}
+	return strings.ContainsRune("_-.@/+", r)
+}
+
+func isKeyword(s string) bool {
+	return s == "AND" || s == "OR" || s == "NOT"
+}
+
+// Field is a search field whose values are of type T, e.g. `Field[time.Time]("created_at")`.
+// Values are formatted by FormatValue.
+type Field[T any] string
+
+// Eq matches the objects whose field equals v.
+func (f Field[T]) Eq(v T) Node {
+	return f.term(Equal, v)
+}
+
+// Lt matches the objects whose field is less than v.
+func (f Field[T]) Lt(v T) Node {
+	return f.term(Less, v)
+}
+
+// Lte matches the objects whose field is less than or equal to v.
+func (f Field[T]) Lte(v T) Node {
+	return f.term(LessOrEqual, v)
+}
+
+// Gt matches the objects whose field is greater than v.
+func (f Field[T]) Gt(v T) Node {
+	return f.term(Greater, v)
+}
+
+// Gte matches the objects whose field is greater than or equal to v.
+func (f Field[T]) Gte(v T) Node {
+	return f.term(GreaterOrEqual, v)
+}
+
+// Between matches the objects whose field is between from and to, both included.
+func (f Field[T]) Between(from, to T) Node {
+	return And{f.Gte(from), f.Lte(to)}
+}
+
+// In matches the objects whose field equals any of values.
+func (f Field[T]) In(values ...T) Node {
+	nodes := make(Or, len(values))
+	for i, v := range values {
+		nodes[i] = f.Eq(v)
+	}
+	return nodes
+}
+
+// Prefix matches the objects whose field starts with v.
+func (f Field[T]) Prefix(v T) Node {
+	t := f.term(Equal, v)
+	t.Prefix = true
+	return t
+}
+
+// Exists matches the objects having a value for the field.
+func (f Field[T]) Exists() Node {
+	return Term{Field: string(f), Exists: true}
+}
+
+func (f Field[T]) term(c Comparator, v T) Term {
+	return Term{Field: string(f), Comparator: c, Value: FormatValue(v)}
+}
+
+// Text matches the objects containing text in any of their default fields.
+func Text(text string) Node {
+	return Term{Value: text}
+}
+
+// AllOf matches the objects matched by all of nodes.
+func AllOf(nodes ...Node) Node {
+	return And(nodes)
+}
+
+// AnyOf matches the objects matched by any of nodes.
+func AnyOf(nodes ...Node) Node {
+	return Or(nodes)
+}
+
+// Negate matches the objects not matched by n.
+func Negate(n Node) Node {
+	return Not{Node: n}
+}
+
+// FormatValue returns the unquoted search value of v. Times are formatted as RFC 3339, decimals in their exact
+// representation and enums by their value.
+func FormatValue(v any) string {
+	// Nil pointers are checked first, as a nil *T implements the interfaces of T, e.g. types.Enum.
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return ""
+	}
+	switch v := v.(type) {
+	case string:
+		return v
+	case types.Enum:
+		return v.String()
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case decimal.Decimal:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		return FormatValue(rv.Elem().Interface())
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,128 @@
+package search_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/search"
+)
+
+var (
+	status    = search.Field[model.ProductStatus]("status")
+	tag       = search.Field[string]("tag")
+	createdAt = search.Field[time.Time]("created_at")
+	price     = search.Field[decimal.Decimal]("price")
+	title     = search.Field[string]("title")
+	barcode   = search.Field[string]("barcode")
+)
+
+var _ = Describe("Field", func() {
+	It("builds terms", func() {
+		q := search.AllOf(
+			status.Eq(model.ProductStatusActive),
+			tag.Eq("summer"),
+			createdAt.Gt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		)
+		Expect(q.String()).To(Equal("status:ACTIVE AND tag:summer AND created_at:>'2024-01-01T00:00:00Z'"))
+	})
+
+	It("builds ranges", func() {
+		q := price.Between(decimal.RequireFromString("10.50"), decimal.RequireFromString("20"))
+		Expect(q.String()).To(Equal("price:>=10.5 AND price:<=20"))
+		Expect(price.Lt(decimal.NewFromInt(5)).String()).To(Equal("price:<5"))
+	})
+
+	It("builds exists, prefix and negation", func() {
+		Expect(barcode.Exists().String()).To(Equal("barcode:*"))
+		Expect(title.Prefix("sum").String()).To(Equal("title:sum*"))
+		Expect(search.Negate(tag.Eq("sale")).String()).To(Equal("NOT tag:sale"))
+		Expect(search.Negate(tag.In("a", "b")).String()).To(Equal("NOT (tag:a OR tag:b)"))
+	})
+
+	It("groups nested nodes", func() {
+		q := search.AllOf(tag.In("a", "b"), search.AnyOf(status.Eq(model.ProductStatusDraft), search.AllOf(
+			title.Eq("x"), title.Eq("y"))))
+		Expect(q.String()).To(Equal("(tag:a OR tag:b) AND (status:DRAFT OR (title:x AND title:y))"))
+	})
+
+	It("quotes and escapes values", func() {
+		Expect(tag.Eq("summer sale").String()).To(Equal("tag:'summer sale'"))
+		Expect(tag.Eq("men's").String()).To(Equal(`tag:'men\'s'`))
+		Expect(tag.Eq(`a\b`).String()).To(Equal(`tag:'a\\b'`))
+		Expect(tag.Eq("OR").String()).To(Equal("tag:'OR'"))
+		Expect(tag.Eq("").String()).To(Equal("tag:''"))
+		Expect(tag.Eq("-1").String()).To(Equal("tag:'-1'"))
+		Expect(tag.Eq("a:b").String()).To(Equal("tag:'a:b'"))
+		Expect(search.Text("red shirt").String()).To(Equal("'red shirt'"))
+	})
+
+	It("formats nil pointers as empty values", func() {
+		Expect(search.FormatValue((*model.ProductStatus)(nil))).To(BeEmpty())
+		active := model.ProductStatusActive
+		Expect(search.FormatValue(&active)).To(Equal("ACTIVE"))
+	})
+})
+
+var _ = Describe("Parse", func() {
+	It("parses terms", func() {
+		n, err := search.Parse("status:active AND tag:'summer' AND created_at:>2024-01-01")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(search.And{
+			search.Term{Field: "status", Value: "active"},
+			search.Term{Field: "tag", Value: "summer"},
+			search.Term{Field: "created_at", Comparator: search.Greater, Value: "2024-01-01"},
+		}))
+	})
+
+	It("joins adjacent terms with AND, which takes precedence over OR", func() {
+		n, err := search.Parse("a OR b c")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(search.Or{
+			search.Term{Value: "a"},
+			search.And{search.Term{Value: "b"}, search.Term{Value: "c"}},
+		}))
+	})
+
+	It("parses groups, negation, exists and prefixes", func() {
+		n, err := search.Parse(`-tag:sale AND NOT (barcode:* OR title:sum*) AND vendor:"Acme \"Co\""`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(search.And{
+			search.Not{Node: search.Term{Field: "tag", Value: "sale"}},
+			search.Not{Node: search.Or{
+				search.Term{Field: "barcode", Exists: true},
+				search.Term{Field: "title", Value: "sum", Prefix: true},
+			}},
+			search.Term{Field: "vendor", Value: `Acme "Co"`},
+		}))
+		Expect(search.Fields(n)).To(Equal([]string{"barcode", "tag", "title", "vendor"}))
+	})
+
+	It("parses the queries built by the fields", func() {
+		q := search.AllOf(
+			tag.In("summer sale", "men's"),
+			search.Negate(createdAt.Lte(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))),
+			price.Gte(decimal.RequireFromString("9.99")),
+			barcode.Exists(),
+		)
+		n, err := search.Parse(q.String())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(q))
+	})
+
+	DescribeTable("rejects invalid queries",
+		func(query, message string) {
+			_, err := search.Parse(query)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("empty", " ", "empty search query"),
+		Entry("unterminated quote", "tag:'summer", "at offset 4: unterminated quoted value"),
+		Entry("missing parenthesis", "(a OR b", "missing )"),
+		Entry("unexpected parenthesis", "a)", `unexpected ")"`),
+		Entry("missing value", "tag:", "missing value"),
+		Entry("dangling operator", "a AND", "unexpected end of query"),
+	)
+})
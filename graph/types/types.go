@@ -26,3 +26,10 @@ const (
 	WebhookEventBridgeEndpoint        GqlTypeName = "WebhookEventBridgeEndpoint"
 	WebhookPubSubEndpoint             GqlTypeName = "WebhookPubSubEndpoint"
 )
+
+// Enum is implemented by the enums generated in the model package. It lets the packages that render values, such
+// as the operation and search builders, recognize enums without importing the models.
+type Enum interface {
+	IsValid() bool
+	String() string
+}
@@ -0,0 +1,217 @@
+// Package upload builds the requests uploading files to the targets returned by the stagedUploadsCreate mutation.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// FileField is the name of the form field holding the file of POST uploads.
+const FileField = "file"
+
+// NewInput returns the input of stagedUploadsCreate to upload body as filename. The MIME type is guessed from the
+// extension of filename, and the size is computed when body is a file, a seeker or has a Len method.
+func NewInput(
+	resource model.StagedUploadTargetGenerateUploadResource, filename string, body io.Reader,
+) (model.StagedUploadInput, error) {
+	mimeType := mime.TypeByExtension(filepath.Ext(filename))
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	input := model.StagedUploadInput{Resource: resource, Filename: filename, MimeType: mimeType}
+
+	size, err := Size(body)
+	if err != nil {
+		return input, err
+	}
+	if size >= 0 {
+		fileSize := strconv.FormatInt(size, 10)
+		input.FileSize = &fileSize
+	}
+	return input, nil
+}
+
+// Size returns the number of bytes left to read from r, or -1 when it cannot be known without reading r.
+func Size(r io.Reader) (int64, error) {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), nil
+	case *os.File:
+		info, err := r.Stat()
+		if err != nil {
+			return 0, fmt.Errorf("stat %s: %w", r.Name(), err)
+		}
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, fmt.Errorf("seek %s: %w", r.Name(), err)
+		}
+		return info.Size() - offset, nil
+	case io.Seeker:
+		offset, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, fmt.Errorf("seek: %w", err)
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, fmt.Errorf("seek: %w", err)
+		}
+		if _, err = r.Seek(offset, io.SeekStart); err != nil {
+			return 0, fmt.Errorf("seek: %w", err)
+		}
+		return end - offset, nil
+	}
+	return -1, nil
+}
+
+// Upload is a file to upload to a staged upload target.
+type Upload struct {
+	// Input is the input passed to stagedUploadsCreate.
+	Input model.StagedUploadInput
+	// Target is the target returned for Input.
+	Target model.StagedMediaUploadTarget
+}
+
+// NewRequest returns the request uploading body to the target. POST uploads send the parameters of the target and
+// the file as a multipart form, PUT uploads send the file as the request body, with the parameters as headers.
+func (u Upload) NewRequest(ctx context.Context, body io.Reader) (*http.Request, error) {
+	if u.Target.URL == nil {
+		return nil, fmt.Errorf("staged upload target has no url")
+	}
+	size, err := Size(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Input.HTTPMethod != nil && *u.Input.HTTPMethod == model.StagedUploadHTTPMethodTypePut {
+		return u.newPutRequest(ctx, body, size)
+	}
+	return u.newPostRequest(ctx, body, size)
+}
+
+func (u Upload) newPutRequest(ctx context.Context, body io.Reader, size int64) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, *u.Target.URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("new staged upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", u.Input.MimeType)
+	for _, p := range u.Target.Parameters {
+		req.Header.Set(putHeader(p.Name), p.Value)
+	}
+	if size >= 0 {
+		req.ContentLength = size
+	}
+	return req, nil
+}
+
+// putHeader returns the header of the parameter name of a PUT target.
+func putHeader(name string) string {
+	switch name {
+	case "content_type":
+		return "Content-Type"
+	case "acl":
+		return "X-Goog-Acl"
+	}
+	return name
+}
+
+func (u Upload) newPostRequest(ctx context.Context, body io.Reader, size int64) (*http.Request, error) {
+	// The form is streamed as the parameters and the header of the file part, the file and the closing boundary,
+	// so that its length is known when the size of body is.
+	var head, tail bytes.Buffer
+	w := multipart.NewWriter(&head)
+	for _, p := range u.Target.Parameters {
+		if err := w.WriteField(p.Name, p.Value); err != nil {
+			return nil, fmt.Errorf("write staged upload parameter %s: %w", p.Name, err)
+		}
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, FileField,
+		escapeQuotes(u.Input.Filename)))
+	header.Set("Content-Type", u.Input.MimeType)
+	if _, err := w.CreatePart(header); err != nil {
+		return nil, fmt.Errorf("write staged upload file header: %w", err)
+	}
+	fmt.Fprintf(&tail, "\r\n--%s--\r\n", w.Boundary())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *u.Target.URL,
+		io.MultiReader(&head, body, &tail))
+	if err != nil {
+		return nil, fmt.Errorf("new staged upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	if size >= 0 {
+		req.ContentLength = int64(head.Len()) + size + int64(tail.Len())
+	}
+	return req, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+// ResourceURL returns the URL of the uploaded file, to be passed as the originalSource of the inputs creating files
+// or media.
+func (u Upload) ResourceURL() (string, error) {
+	if u.Target.ResourceURL == nil {
+		return "", fmt.Errorf("staged upload target has no resourceUrl")
+	}
+	return *u.Target.ResourceURL, nil
+}
+
+// FileCreateInput returns the input of fileCreate creating a file from the upload.
+func (u Upload) FileCreateInput() (model.FileCreateInput, error) {
+	source, err := u.ResourceURL()
+	if err != nil {
+		return model.FileCreateInput{}, err
+	}
+	filename := u.Input.Filename
+	input := model.FileCreateInput{OriginalSource: source, Filename: &filename}
+	if contentType, ok := fileContentTypes[u.Input.Resource]; ok {
+		input.ContentType = &contentType
+	}
+	return input, nil
+}
+
+// CreateMediaInput returns the input of productCreateMedia creating a media from the upload.
+func (u Upload) CreateMediaInput() (model.CreateMediaInput, error) {
+	source, err := u.ResourceURL()
+	if err != nil {
+		return model.CreateMediaInput{}, err
+	}
+	contentType, ok := mediaContentTypes[u.Input.Resource]
+	if !ok {
+		return model.CreateMediaInput{}, fmt.Errorf("%s uploads cannot be used as media", u.Input.Resource)
+	}
+	return model.CreateMediaInput{OriginalSource: source, MediaContentType: contentType}, nil
+}
+
+var fileContentTypes = map[model.StagedUploadTargetGenerateUploadResource]model.FileContentType{
+	model.StagedUploadTargetGenerateUploadResourceImage:           model.FileContentTypeImage,
+	model.StagedUploadTargetGenerateUploadResourceProductImage:    model.FileContentTypeImage,
+	model.StagedUploadTargetGenerateUploadResourceCollectionImage: model.FileContentTypeImage,
+	model.StagedUploadTargetGenerateUploadResourceShopImage:       model.FileContentTypeImage,
+	model.StagedUploadTargetGenerateUploadResourceVideo:           model.FileContentTypeVideo,
+	model.StagedUploadTargetGenerateUploadResourceModel3d:         model.FileContentTypeModel3d,
+	model.StagedUploadTargetGenerateUploadResourceFile:            model.FileContentTypeFile,
+}
+
+var mediaContentTypes = map[model.StagedUploadTargetGenerateUploadResource]model.MediaContentType{
+	model.StagedUploadTargetGenerateUploadResourceImage:        model.MediaContentTypeImage,
+	model.StagedUploadTargetGenerateUploadResourceProductImage: model.MediaContentTypeImage,
+	model.StagedUploadTargetGenerateUploadResourceVideo:        model.MediaContentTypeVideo,
+	model.StagedUploadTargetGenerateUploadResourceModel3d:      model.MediaContentTypeModel3d,
+}
@@ -0,0 +1,165 @@
+package upload_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/upload"
+)
+
+var _ = Describe("NewInput", func() {
+	It("computes the MIME type and size", func() {
+		input, err := upload.NewInput(model.StagedUploadTargetGenerateUploadResourceImage, "shirt.png",
+			strings.NewReader("png data"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input.MimeType).To(Equal("image/png"))
+		Expect(*input.FileSize).To(Equal("8"))
+		Expect(input.Filename).To(Equal("shirt.png"))
+	})
+
+	It("computes the size of files", func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "data")
+		Expect(os.WriteFile(filename, []byte("0123456789"), 0o644)).To(Succeed())
+		f, err := os.Open(filename)
+		Expect(err).NotTo(HaveOccurred())
+		defer f.Close()
+		_, err = f.Seek(4, io.SeekStart)
+		Expect(err).NotTo(HaveOccurred())
+
+		input, err := upload.NewInput(model.StagedUploadTargetGenerateUploadResourceFile, "data", f)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input.MimeType).To(Equal("application/octet-stream"))
+		Expect(*input.FileSize).To(Equal("6"))
+	})
+
+	It("leaves the size of streams unset", func() {
+		input, err := upload.NewInput(model.StagedUploadTargetGenerateUploadResourceFile, "a.txt",
+			io.MultiReader(strings.NewReader("a")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input.FileSize).To(BeNil())
+	})
+})
+
+var _ = Describe("Upload", func() {
+	var (
+		server   *httptest.Server
+		received *http.Request
+		form     map[string]string
+		content  string
+	)
+
+	BeforeEach(func() {
+		form = map[string]string{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			if r.Method == http.MethodPost {
+				Expect(r.ParseMultipartForm(1 << 20)).To(Succeed())
+				for name, values := range r.MultipartForm.Value {
+					form[name] = values[0]
+				}
+				file, header, err := r.FormFile(upload.FileField)
+				Expect(err).NotTo(HaveOccurred())
+				form["filename"] = header.Filename
+				form["file content type"] = header.Header.Get("Content-Type")
+				b, _ := io.ReadAll(file)
+				content = string(b)
+			} else {
+				b, _ := io.ReadAll(r.Body)
+				content = string(b)
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	target := func(parameters ...model.StagedUploadParameter) model.StagedMediaUploadTarget {
+		resourceURL := server.URL + "/tmp/shirt.png"
+		return model.StagedMediaUploadTarget{URL: &server.URL, ResourceURL: &resourceURL, Parameters: parameters}
+	}
+
+	It("posts a multipart form", func() {
+		input, err := upload.NewInput(model.StagedUploadTargetGenerateUploadResourceImage, "shirt.png",
+			strings.NewReader("png data"))
+		Expect(err).NotTo(HaveOccurred())
+		u := upload.Upload{Input: input, Target: target(
+			model.StagedUploadParameter{Name: "key", Value: "tmp/shirt.png"},
+			model.StagedUploadParameter{Name: "policy", Value: "abc"},
+		)}
+
+		req, err := u.NewRequest(context.Background(), strings.NewReader("png data"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(req.ContentLength).To(BeNumerically(">", 8))
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+
+		Expect(received.ContentLength).To(Equal(req.ContentLength))
+		Expect(form).To(Equal(map[string]string{
+			"key":               "tmp/shirt.png",
+			"policy":            "abc",
+			"filename":          "shirt.png",
+			"file content type": "image/png",
+		}))
+		Expect(content).To(Equal("png data"))
+	})
+
+	It("puts the file", func() {
+		put := model.StagedUploadHTTPMethodTypePut
+		u := upload.Upload{
+			Input: model.StagedUploadInput{
+				Resource: model.StagedUploadTargetGenerateUploadResourceFile, Filename: "a.csv", MimeType: "text/csv",
+				HTTPMethod: &put,
+			},
+			Target: target(
+				model.StagedUploadParameter{Name: "content_type", Value: "text/csv"},
+				model.StagedUploadParameter{Name: "acl", Value: "private"},
+			),
+		}
+
+		req, err := u.NewRequest(context.Background(), strings.NewReader("a,b"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(received.Method).To(Equal(http.MethodPut))
+		Expect(received.Header.Get("Content-Type")).To(Equal("text/csv"))
+		Expect(received.Header.Get("X-Goog-Acl")).To(Equal("private"))
+		Expect(received.ContentLength).To(Equal(int64(3)))
+		Expect(content).To(Equal("a,b"))
+	})
+
+	It("returns the inputs creating files and media", func() {
+		u := upload.Upload{
+			Input:  model.StagedUploadInput{Resource: model.StagedUploadTargetGenerateUploadResourceImage, Filename: "shirt.png"},
+			Target: target(),
+		}
+		file, err := u.FileCreateInput()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.OriginalSource).To(Equal(server.URL + "/tmp/shirt.png"))
+		Expect(*file.ContentType).To(Equal(model.FileContentTypeImage))
+
+		media, err := u.CreateMediaInput()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(media.MediaContentType).To(Equal(model.MediaContentTypeImage))
+
+		u.Input.Resource = model.StagedUploadTargetGenerateUploadResourceBulkMutationVariables
+		_, err = u.CreateMediaInput()
+		Expect(err).To(MatchError("BULK_MUTATION_VARIABLES uploads cannot be used as media"))
+	})
+
+	It("requires the target URLs", func() {
+		_, err := upload.Upload{}.NewRequest(context.Background(), strings.NewReader(""))
+		Expect(err).To(MatchError("staged upload target has no url"))
+		_, err = upload.Upload{}.ResourceURL()
+		Expect(err).To(MatchError("staged upload target has no resourceUrl"))
+	})
+})
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/search"
+)
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+// Filter builds and validates the filters of the subscriptions to a topic, see WebhookSubscriptionInput.Filter.
+// The filters match the fields of the payload of the topic by their JSON names, joined by dots for nested objects,
+// e.g. variants.price for PRODUCTS_UPDATE.
+type Filter struct {
+	topic model.WebhookSubscriptionTopic
+	// fields maps the paths of the filterable fields to their types.
+	fields map[string]reflect.Type
+	// allowed holds the paths of the fields missing from the payload type that are accepted, see AllowFields.
+	allowed map[string]bool
+}
+
+// FilterOption configures a Filter.
+type FilterOption func(*Filter)
+
+// AllowFields accepts terms on the fields at paths, which the payload types do not hold. Their values are not
+// checked. Terms on other fields missing from the payload type are rejected, so that typos are caught.
+func AllowFields(paths ...string) FilterOption {
+	return func(f *Filter) {
+		for _, path := range paths {
+			f.allowed[path] = true
+		}
+	}
+}
+
+// NewFilter returns the filter of topic. It fails if the topic has no payload type, see NewPayload.
+func NewFilter(topic model.WebhookSubscriptionTopic, opts ...FilterOption) (*Filter, error) {
+	payload, ok := NewPayload(topic)
+	if !ok {
+		return nil, fmt.Errorf("no payload type for topic %s", topic)
+	}
+	f := &Filter{topic: topic, fields: map[string]reflect.Type{}, allowed: map[string]bool{}}
+	f.addFields("", reflect.TypeOf(payload).Elem())
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f, nil
+}
+
+// addFields adds the fields of the struct t, prefixing their paths with prefix.
+func (f *Filter) addFields(prefix string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		path := prefix + name
+
+		ft := field.Type
+		for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct && ft != timeType && ft != decimalType {
+			f.addFields(path+".", ft)
+			continue
+		}
+		f.fields[path] = ft
+	}
+}
+
+// Fields returns the paths of the fields the filters can match, in lexical order.
+func (f *Filter) Fields() []string {
+	fields := make([]string, 0, len(f.fields))
+	for path := range f.fields {
+		fields = append(fields, path)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Validate checks that the terms of n have a field of the payload type or an allowed field, and that the values of
+// the fields of the payload type are of their types.
+func (f *Filter) Validate(n search.Node) error {
+	switch n := n.(type) {
+	case search.Term:
+		return f.validateTerm(n)
+	case search.And:
+		return f.validateAll(n)
+	case search.Or:
+		return f.validateAll(n)
+	case search.Not:
+		return f.Validate(n.Node)
+	case nil:
+		return fmt.Errorf("empty filter")
+	}
+	return fmt.Errorf("unsupported filter node %T", n)
+}
+
+func (f *Filter) validateAll(nodes []search.Node) error {
+	for _, n := range nodes {
+		if err := f.Validate(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Filter) validateTerm(t search.Term) error {
+	if t.Field == "" {
+		return fmt.Errorf("filter term %q has no field", t.Value)
+	}
+	typ, ok := f.fields[t.Field]
+	if !ok {
+		if f.allowed[t.Field] {
+			return nil
+		}
+		return fmt.Errorf("unknown field %q in %s filter", t.Field, f.topic)
+	}
+	if t.Exists || t.Prefix {
+		return nil
+	}
+	if err := checkValue(typ, t.Value); err != nil {
+		return fmt.Errorf("field %q: %w", t.Field, err)
+	}
+	return nil
+}
+
+// checkValue checks that value is a valid search value for a field of type t.
+func checkValue(t reflect.Type, value string) error {
+	var err error
+	switch {
+	case t == timeType:
+		_, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			_, err = time.Parse(time.DateOnly, value)
+		}
+	case t == decimalType:
+		_, err = decimal.NewFromString(value)
+	default:
+		switch t.Kind() {
+		case reflect.Bool:
+			_, err = strconv.ParseBool(value)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			_, err = strconv.ParseInt(value, 10, 64)
+		case reflect.Float32, reflect.Float64:
+			_, err = strconv.ParseFloat(value, 64)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q", t, value)
+	}
+	return nil
+}
+
+// Build validates the terms joined by AND and returns the filter string.
+func (f *Filter) Build(nodes ...search.Node) (string, error) {
+	var n search.Node
+	switch len(nodes) {
+	case 0:
+	case 1:
+		n = nodes[0]
+	default:
+		n = search.And(nodes)
+	}
+	if err := f.Validate(n); err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+// ValidateFilter parses the filter of a subscription to topic and validates it, see Filter.Validate.
+func ValidateFilter(topic model.WebhookSubscriptionTopic, filter string, opts ...FilterOption) error {
+	f, err := NewFilter(topic, opts...)
+	if err != nil {
+		return err
+	}
+	n, err := search.Parse(filter)
+	if err != nil {
+		return fmt.Errorf("parse filter: %w", err)
+	}
+	return f.Validate(n)
+}
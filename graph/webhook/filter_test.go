@@ -0,0 +1,80 @@
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/search"
+	"github.com/gempages/go-shopify-graphql-model/graph/webhook"
+)
+
+var _ = Describe("Filter", func() {
+	var f *webhook.Filter
+
+	BeforeEach(func() {
+		var err error
+		f, err = webhook.NewFilter(model.WebhookSubscriptionTopicProductsUpdate)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists the fields of the payload", func() {
+		Expect(f.Fields()).To(ContainElements("vendor", "status", "variants.price", "variants.sku"))
+		Expect(f.Fields()).NotTo(ContainElement("variants"))
+	})
+
+	It("builds filters", func() {
+		filter, err := f.Build(
+			search.Field[string]("vendor").Eq("Acme & Co"),
+			search.Field[decimal.Decimal]("variants.price").Gte(decimal.RequireFromString("10.50")),
+			search.Negate(search.Field[string]("status").Eq("draft")),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filter).To(Equal(`vendor:'Acme & Co' AND variants.price:>=10.5 AND NOT status:draft`))
+	})
+
+	It("rejects fields missing from the payload type", func() {
+		_, err := f.Build(search.Field[string]("variants.inventory_item_id").Eq("1"))
+		Expect(err).To(MatchError(`unknown field "variants.inventory_item_id" in PRODUCTS_UPDATE filter`))
+	})
+
+	It("accepts allowed fields without checking their values", func() {
+		f, err := webhook.NewFilter(model.WebhookSubscriptionTopicProductsUpdate,
+			webhook.AllowFields("variants.inventory_item_id"))
+		Expect(err).NotTo(HaveOccurred())
+		filter, err := f.Build(search.Field[string]("variants.inventory_item_id").Eq("any"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filter).To(Equal("variants.inventory_item_id:any"))
+		_, err = f.Build(search.Field[string]("variants.inventory_id").Eq("1"))
+		Expect(err).To(MatchError(ContainSubstring(`unknown field "variants.inventory_id"`)))
+	})
+
+	It("rejects invalid values and terms without field", func() {
+		_, err := f.Build(search.Field[string]("variants.price").Gt("cheap"))
+		Expect(err).To(MatchError(ContainSubstring(`invalid decimal.Decimal value "cheap"`)))
+		_, err = f.Build(search.Text("shirt"))
+		Expect(err).To(MatchError(ContainSubstring("no field")))
+		_, err = f.Build()
+		Expect(err).To(MatchError("empty filter"))
+	})
+
+	It("accepts prefix and existence terms", func() {
+		_, err := f.Build(search.Field[string]("handle").Prefix("shirt"), search.Field[string]("published_at").Exists())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("validates filter strings", func() {
+		Expect(webhook.ValidateFilter(model.WebhookSubscriptionTopicOrdersCreate,
+			"financial_status:paid AND line_items.quantity:>1")).To(Succeed())
+		Expect(webhook.ValidateFilter(model.WebhookSubscriptionTopicOrdersCreate,
+			"line_items.quantity:>many")).To(MatchError(ContainSubstring("invalid int value")))
+		Expect(webhook.ValidateFilter(model.WebhookSubscriptionTopicOrdersCreate, "totl_price:>10")).To(
+			MatchError(ContainSubstring(`unknown field "totl_price"`)))
+		Expect(webhook.ValidateFilter(model.WebhookSubscriptionTopicOrdersCreate, "totl_price:>10",
+			webhook.AllowFields("totl_price"))).To(Succeed())
+		Expect(webhook.ValidateFilter(model.WebhookSubscriptionTopicOrdersCreate, "total_price:(")).To(HaveOccurred())
+		Expect(webhook.ValidateFilter(model.WebhookSubscriptionTopicCollectionsCreate, "id:1")).To(
+			MatchError(ContainSubstring("no payload type")))
+	})
+})
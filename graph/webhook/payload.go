@@ -0,0 +1,354 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// payloadTypes holds the constructors of the payloads of the topics with a typed payload.
+var payloadTypes = map[model.WebhookSubscriptionTopic]func() any{
+	model.WebhookSubscriptionTopicProductsCreate:         func() any { return new(ProductPayload) },
+	model.WebhookSubscriptionTopicProductsUpdate:         func() any { return new(ProductPayload) },
+	model.WebhookSubscriptionTopicProductsDelete:         func() any { return new(DeletePayload) },
+	model.WebhookSubscriptionTopicOrdersCreate:           func() any { return new(OrderPayload) },
+	model.WebhookSubscriptionTopicOrdersUpdated:          func() any { return new(OrderPayload) },
+	model.WebhookSubscriptionTopicOrdersPaid:             func() any { return new(OrderPayload) },
+	model.WebhookSubscriptionTopicOrdersCancelled:        func() any { return new(OrderPayload) },
+	model.WebhookSubscriptionTopicOrdersFulfilled:        func() any { return new(OrderPayload) },
+	model.WebhookSubscriptionTopicOrdersDelete:           func() any { return new(DeletePayload) },
+	model.WebhookSubscriptionTopicCustomersCreate:        func() any { return new(CustomerPayload) },
+	model.WebhookSubscriptionTopicCustomersUpdate:        func() any { return new(CustomerPayload) },
+	model.WebhookSubscriptionTopicCustomersDelete:        func() any { return new(DeletePayload) },
+	model.WebhookSubscriptionTopicAppSubscriptionsUpdate: func() any { return new(AppSubscriptionPayload) },
+	model.WebhookSubscriptionTopicAppUninstalled:         func() any { return new(ShopPayload) },
+	model.WebhookSubscriptionTopicShopUpdate:             func() any { return new(ShopPayload) },
+}
+
+// NewPayload returns a pointer to a new payload of topic, e.g. *ProductPayload for PRODUCTS_UPDATE, or false when
+// the topic has no typed payload.
+func NewPayload(topic model.WebhookSubscriptionTopic) (any, bool) {
+	newPayload, ok := payloadTypes[topic]
+	if !ok {
+		return nil, false
+	}
+	return newPayload(), true
+}
+
+// Payload decodes the body of the delivery into the payload of its topic, see NewPayload.
+func (d *Delivery) Payload() (any, error) {
+	payload, ok := NewPayload(d.Topic)
+	if !ok {
+		return nil, fmt.Errorf("no payload type for topic %s", d.Topic)
+	}
+	if err := json.Unmarshal(d.Body, payload); err != nil {
+		return nil, fmt.Errorf("decode %s payload: %w", d.Topic, err)
+	}
+	return payload, nil
+}
+
+// GID returns the global ID of the object of type typeName with the numeric REST id, e.g.
+// gid://shopify/Product/1.
+func GID(typeName string, id int64) string {
+	return fmt.Sprintf("gid://shopify/%s/%d", typeName, id)
+}
+
+// gid returns the GraphQL ID sent in admin_graphql_api_id, or builds it from the REST id.
+func gid(apiID string, typeName string, id int64) string {
+	if apiID != "" {
+		return apiID
+	}
+	return GID(typeName, id)
+}
+
+// splitTags splits the comma separated tags of REST payloads.
+func splitTags(tags string) []string {
+	var result []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// DeletePayload is the payload of the delete topics, e.g. products/delete.
+type DeletePayload struct {
+	ID int64 `json:"id"`
+}
+
+// ProductPayload is the payload of the products/create and products/update topics.
+type ProductPayload struct {
+	ID                int64            `json:"id"`
+	AdminGraphqlAPIID string           `json:"admin_graphql_api_id"`
+	Title             string           `json:"title"`
+	BodyHTML          *string          `json:"body_html"`
+	Vendor            string           `json:"vendor"`
+	ProductType       string           `json:"product_type"`
+	Handle            string           `json:"handle"`
+	Status            string           `json:"status"`
+	Tags              string           `json:"tags"`
+	TemplateSuffix    *string          `json:"template_suffix"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+	PublishedAt       *time.Time       `json:"published_at"`
+	Variants          []VariantPayload `json:"variants"`
+}
+
+// VariantPayload is a variant of a ProductPayload.
+type VariantPayload struct {
+	ID                int64            `json:"id"`
+	AdminGraphqlAPIID string           `json:"admin_graphql_api_id"`
+	Title             string           `json:"title"`
+	Price             decimal.Decimal  `json:"price"`
+	CompareAtPrice    *decimal.Decimal `json:"compare_at_price"`
+	Sku               *string          `json:"sku"`
+	Barcode           *string          `json:"barcode"`
+	Position          int              `json:"position"`
+	InventoryQuantity *int             `json:"inventory_quantity"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+// ToModel converts the payload into a model.Product, with its variants in Variants.Nodes.
+func (p *ProductPayload) ToModel() *model.Product {
+	product := &model.Product{
+		ID:             gid(p.AdminGraphqlAPIID, "Product", p.ID),
+		Title:          p.Title,
+		Vendor:         p.Vendor,
+		ProductType:    p.ProductType,
+		Handle:         p.Handle,
+		Status:         model.ProductStatus(strings.ToUpper(p.Status)),
+		Tags:           splitTags(p.Tags),
+		TemplateSuffix: p.TemplateSuffix,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		PublishedAt:    p.PublishedAt,
+	}
+	if p.BodyHTML != nil {
+		product.DescriptionHTML = *p.BodyHTML
+	}
+	if p.Variants != nil {
+		product.Variants = &model.ProductVariantConnection{}
+		for _, v := range p.Variants {
+			product.Variants.Nodes = append(product.Variants.Nodes, *v.ToModel())
+		}
+	}
+	return product
+}
+
+// ToModel converts the payload into a model.ProductVariant.
+func (p *VariantPayload) ToModel() *model.ProductVariant {
+	return &model.ProductVariant{
+		ID:                gid(p.AdminGraphqlAPIID, "ProductVariant", p.ID),
+		Title:             p.Title,
+		Price:             p.Price,
+		CompareAtPrice:    p.CompareAtPrice,
+		Sku:               p.Sku,
+		Barcode:           p.Barcode,
+		Position:          p.Position,
+		InventoryQuantity: p.InventoryQuantity,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+// OrderPayload is the payload of the orders topics, e.g. orders/create.
+type OrderPayload struct {
+	ID                int64             `json:"id"`
+	AdminGraphqlAPIID string            `json:"admin_graphql_api_id"`
+	Name              string            `json:"name"`
+	Email             *string           `json:"email"`
+	Note              *string           `json:"note"`
+	Tags              string            `json:"tags"`
+	Currency          string            `json:"currency"`
+	TotalPrice        decimal.Decimal   `json:"total_price"`
+	SubtotalPrice     decimal.Decimal   `json:"subtotal_price"`
+	TotalTax          decimal.Decimal   `json:"total_tax"`
+	FinancialStatus   *string           `json:"financial_status"`
+	FulfillmentStatus *string           `json:"fulfillment_status"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+	CancelledAt       *time.Time        `json:"cancelled_at"`
+	LineItems         []LineItemPayload `json:"line_items"`
+}
+
+// LineItemPayload is a line item of an OrderPayload.
+type LineItemPayload struct {
+	ID                int64           `json:"id"`
+	AdminGraphqlAPIID string          `json:"admin_graphql_api_id"`
+	Title             string          `json:"title"`
+	Quantity          int             `json:"quantity"`
+	Price             decimal.Decimal `json:"price"`
+	Sku               *string         `json:"sku"`
+	ProductID         *int64          `json:"product_id"`
+	VariantID         *int64          `json:"variant_id"`
+}
+
+// fulfillmentStatuses maps the fulfillment statuses of REST orders to the display statuses of the models.
+var fulfillmentStatuses = map[string]model.OrderDisplayFulfillmentStatus{
+	"":          model.OrderDisplayFulfillmentStatusUnfulfilled,
+	"fulfilled": model.OrderDisplayFulfillmentStatusFulfilled,
+	"partial":   model.OrderDisplayFulfillmentStatusPartiallyFulfilled,
+	"restocked": model.OrderDisplayFulfillmentStatusRestocked,
+}
+
+// ToModel converts the payload into a model.Order, with its line items in LineItems.Nodes. The amounts are in the
+// currency of the shop.
+func (p *OrderPayload) ToModel() *model.Order {
+	currency := model.CurrencyCode(p.Currency)
+	money := func(amount decimal.Decimal) *model.MoneyBag {
+		return &model.MoneyBag{ShopMoney: &model.MoneyV2{Amount: amount, CurrencyCode: currency}}
+	}
+
+	order := &model.Order{
+		ID:               gid(p.AdminGraphqlAPIID, "Order", p.ID),
+		Name:             p.Name,
+		Email:            p.Email,
+		Note:             p.Note,
+		Tags:             splitTags(p.Tags),
+		CurrencyCode:     currency,
+		TotalPriceSet:    money(p.TotalPrice),
+		SubtotalPriceSet: money(p.SubtotalPrice),
+		TotalTaxSet:      money(p.TotalTax),
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+		CancelledAt:      p.CancelledAt,
+	}
+	if p.FinancialStatus != nil {
+		status := model.OrderDisplayFinancialStatus(strings.ToUpper(*p.FinancialStatus))
+		order.DisplayFinancialStatus = &status
+	}
+	var fulfillment string
+	if p.FulfillmentStatus != nil {
+		fulfillment = *p.FulfillmentStatus
+	}
+	if status, ok := fulfillmentStatuses[fulfillment]; ok {
+		order.DisplayFulfillmentStatus = status
+	} else {
+		order.DisplayFulfillmentStatus = model.OrderDisplayFulfillmentStatus(strings.ToUpper(fulfillment))
+	}
+
+	if p.LineItems != nil {
+		order.LineItems = &model.LineItemConnection{}
+		for _, item := range p.LineItems {
+			lineItem := model.LineItem{
+				ID:                   gid(item.AdminGraphqlAPIID, "LineItem", item.ID),
+				Title:                item.Title,
+				Quantity:             item.Quantity,
+				Sku:                  item.Sku,
+				OriginalUnitPriceSet: money(item.Price),
+			}
+			if item.ProductID != nil {
+				lineItem.Product = &model.Product{ID: GID("Product", *item.ProductID)}
+			}
+			if item.VariantID != nil {
+				lineItem.Variant = &model.ProductVariant{ID: GID("ProductVariant", *item.VariantID)}
+			}
+			order.LineItems.Nodes = append(order.LineItems.Nodes, lineItem)
+		}
+	}
+	return order
+}
+
+// CustomerPayload is the payload of the customers/create and customers/update topics.
+type CustomerPayload struct {
+	ID                int64     `json:"id"`
+	AdminGraphqlAPIID string    `json:"admin_graphql_api_id"`
+	Email             *string   `json:"email"`
+	FirstName         *string   `json:"first_name"`
+	LastName          *string   `json:"last_name"`
+	Phone             *string   `json:"phone"`
+	Note              *string   `json:"note"`
+	State             string    `json:"state"`
+	Tags              string    `json:"tags"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// ToModel converts the payload into a model.Customer.
+func (p *CustomerPayload) ToModel() *model.Customer {
+	return &model.Customer{
+		ID:        gid(p.AdminGraphqlAPIID, "Customer", p.ID),
+		Email:     p.Email,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+		Phone:     p.Phone,
+		Note:      p.Note,
+		State:     model.CustomerState(strings.ToUpper(p.State)),
+		Tags:      splitTags(p.Tags),
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// AppSubscriptionPayload is the payload of the app_subscriptions/update topic.
+type AppSubscriptionPayload struct {
+	AppSubscription struct {
+		AdminGraphqlAPIID     string           `json:"admin_graphql_api_id"`
+		AdminGraphqlAPIShopID string           `json:"admin_graphql_api_shop_id"`
+		Name                  string           `json:"name"`
+		Status                string           `json:"status"`
+		CreatedAt             time.Time        `json:"created_at"`
+		UpdatedAt             time.Time        `json:"updated_at"`
+		Currency              string           `json:"currency"`
+		CappedAmount          *decimal.Decimal `json:"capped_amount"`
+	} `json:"app_subscription"`
+}
+
+// ToModel converts the payload into a model.AppSubscription. The capped amount, sent for subscriptions with usage
+// pricing, becomes a line item with AppUsagePricing in the currency of the payload. The payload has no ID for the
+// line item.
+func (p *AppSubscriptionPayload) ToModel() *model.AppSubscription {
+	subscription := &model.AppSubscription{
+		ID:        p.AppSubscription.AdminGraphqlAPIID,
+		Name:      p.AppSubscription.Name,
+		Status:    model.AppSubscriptionStatus(strings.ToUpper(p.AppSubscription.Status)),
+		CreatedAt: p.AppSubscription.CreatedAt,
+	}
+	if p.AppSubscription.CappedAmount != nil {
+		subscription.LineItems = []model.AppSubscriptionLineItem{{
+			Plan: &model.AppPlanV2{PricingDetails: &model.AppUsagePricing{
+				CappedAmount: &model.MoneyV2{
+					Amount:       *p.AppSubscription.CappedAmount,
+					CurrencyCode: model.CurrencyCode(p.AppSubscription.Currency),
+				},
+				Interval: model.AppPricingIntervalEvery30Days,
+			}},
+		}}
+	}
+	return subscription
+}
+
+// ShopPayload is the payload of the app/uninstalled and shop/update topics.
+type ShopPayload struct {
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	CustomerEmail   string    `json:"customer_email"`
+	MyshopifyDomain string    `json:"myshopify_domain"`
+	Currency        string    `json:"currency"`
+	IanaTimezone    string    `json:"iana_timezone"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ToModel converts the payload into a model.Shop.
+func (p *ShopPayload) ToModel() *model.Shop {
+	return &model.Shop{
+		ID:              GID("Shop", p.ID),
+		Name:            p.Name,
+		Email:           p.Email,
+		ContactEmail:    p.CustomerEmail,
+		MyshopifyDomain: p.MyshopifyDomain,
+		CurrencyCode:    model.CurrencyCode(p.Currency),
+		IanaTimezone:    p.IanaTimezone,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
@@ -0,0 +1,221 @@
+package webhook_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/webhook"
+)
+
+// fixture returns the payload decoded from the fixture of topic in testdata, named after the topic, e.g.
+// orders_create.json for ORDERS_CREATE.
+func fixture(topic model.WebhookSubscriptionTopic) any {
+	body, err := os.ReadFile(filepath.Join("testdata", strings.ToLower(string(topic))+".json"))
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	payload, err := (&webhook.Delivery{Topic: topic, Body: body}).Payload()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return payload
+}
+
+var _ = Describe("Payload", func() {
+	created := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	It("decodes product payloads", func() {
+		payload := fixture(model.WebhookSubscriptionTopicProductsUpdate)
+		Expect(payload).To(BeAssignableToTypeOf(&webhook.ProductPayload{}))
+
+		product := payload.(*webhook.ProductPayload).ToModel()
+		Expect(product.ID).To(Equal("gid://shopify/Product/788032119674292922"))
+		Expect(product.Title).To(Equal("Example T-Shirt"))
+		Expect(product.DescriptionHTML).To(Equal("<p>A shirt.</p>"))
+		Expect(product.Status).To(Equal(model.ProductStatusActive))
+		Expect(product.Tags).To(Equal([]string{"example", "mens", "t-shirt"}))
+		Expect(product.TemplateSuffix).To(BeNil())
+		Expect(product.CreatedAt.Equal(created)).To(BeTrue())
+		Expect(product.PublishedAt).NotTo(BeNil())
+
+		Expect(product.Variants.Nodes).To(HaveLen(1))
+		variant := product.Variants.Nodes[0]
+		Expect(variant.ID).To(Equal("gid://shopify/ProductVariant/642667041472713922"))
+		Expect(variant.Price.Equal(decimal.RequireFromString("19.99"))).To(BeTrue())
+		Expect(variant.CompareAtPrice.Equal(decimal.RequireFromString("24.99"))).To(BeTrue())
+		Expect(*variant.Sku).To(Equal("SHIRT-S"))
+		Expect(variant.Barcode).To(BeNil())
+		Expect(*variant.InventoryQuantity).To(Equal(75))
+	})
+
+	It("has a fixture for every topic with a payload type", func() {
+		topics := 0
+		for _, topic := range model.AllWebhookSubscriptionTopic {
+			if _, ok := webhook.NewPayload(topic); ok {
+				Expect(fixture(topic)).NotTo(BeNil(), "topic %s", topic)
+				topics++
+			}
+		}
+		Expect(topics).To(Equal(15))
+	})
+
+	It("decodes delete payloads", func() {
+		Expect(fixture(model.WebhookSubscriptionTopicProductsDelete)).To(
+			Equal(&webhook.DeletePayload{ID: 788032119674292922}))
+		Expect(fixture(model.WebhookSubscriptionTopicOrdersDelete)).To(
+			Equal(&webhook.DeletePayload{ID: 820982911946154508}))
+		Expect(fixture(model.WebhookSubscriptionTopicCustomersDelete)).To(
+			Equal(&webhook.DeletePayload{ID: 706405506930370084}))
+	})
+
+	It("decodes created product payloads", func() {
+		product := fixture(model.WebhookSubscriptionTopicProductsCreate).(*webhook.ProductPayload).ToModel()
+		Expect(product.ID).To(Equal("gid://shopify/Product/788032119674292923"))
+		Expect(product.Status).To(Equal(model.ProductStatusDraft))
+		Expect(product.DescriptionHTML).To(BeEmpty())
+		Expect(product.Tags).To(BeEmpty())
+		Expect(product.PublishedAt).To(BeNil())
+		Expect(product.Variants.Nodes).To(HaveLen(2))
+		Expect(product.Variants.Nodes[0].ID).To(Equal("gid://shopify/ProductVariant/642667041472713923"))
+		Expect(product.Variants.Nodes[0].Sku).To(BeNil())
+		Expect(*product.Variants.Nodes[0].Barcode).To(Equal("1234567890123"))
+		Expect(product.Variants.Nodes[1].Price.Equal(decimal.RequireFromString("52.5"))).To(BeTrue())
+	})
+
+	DescribeTable("decodes the order payloads of the order topics",
+		func(topic model.WebhookSubscriptionTopic, financial model.OrderDisplayFinancialStatus,
+			fulfillment model.OrderDisplayFulfillmentStatus, tags []string, cancelled bool,
+		) {
+			payload := fixture(topic)
+			Expect(payload).To(BeAssignableToTypeOf(&webhook.OrderPayload{}))
+
+			order := payload.(*webhook.OrderPayload).ToModel()
+			Expect(order.ID).To(Equal("gid://shopify/Order/820982911946154508"))
+			Expect(*order.DisplayFinancialStatus).To(Equal(financial))
+			Expect(order.DisplayFulfillmentStatus).To(Equal(fulfillment))
+			Expect(order.Tags).To(Equal(tags))
+			Expect(order.CancelledAt != nil).To(Equal(cancelled))
+			Expect(order.LineItems.Nodes).To(HaveLen(2))
+			Expect(order.LineItems.Nodes[0].Variant.ID).To(Equal("gid://shopify/ProductVariant/642667041472713922"))
+			Expect(order.LineItems.Nodes[1].Product).To(BeNil())
+			Expect(order.LineItems.Nodes[1].Quantity).To(Equal(2))
+		},
+		Entry("ORDERS_UPDATED", model.WebhookSubscriptionTopicOrdersUpdated, model.OrderDisplayFinancialStatusPending,
+			model.OrderDisplayFulfillmentStatusUnfulfilled, nil, false),
+		Entry("ORDERS_PAID", model.WebhookSubscriptionTopicOrdersPaid, model.OrderDisplayFinancialStatusPaid,
+			model.OrderDisplayFulfillmentStatusUnfulfilled, []string{"paid"}, false),
+		Entry("ORDERS_CANCELLED", model.WebhookSubscriptionTopicOrdersCancelled,
+			model.OrderDisplayFinancialStatusRefunded, model.OrderDisplayFulfillmentStatusUnfulfilled,
+			[]string{"cancelled"}, true),
+		Entry("ORDERS_FULFILLED", model.WebhookSubscriptionTopicOrdersFulfilled, model.OrderDisplayFinancialStatusPaid,
+			model.OrderDisplayFulfillmentStatusFulfilled, []string{"shipped"}, false),
+	)
+
+	It("decodes updated customer payloads", func() {
+		customer := fixture(model.WebhookSubscriptionTopicCustomersUpdate).(*webhook.CustomerPayload).ToModel()
+		Expect(customer.ID).To(Equal("gid://shopify/Customer/706405506930370084"))
+		Expect(*customer.Phone).To(Equal("+16135551111"))
+		Expect(customer.Note).To(BeNil())
+		Expect(customer.State).To(Equal(model.CustomerStateEnabled))
+		Expect(customer.Tags).To(Equal([]string{"VIP", "newsletter"}))
+	})
+
+	It("decodes updated shop payloads", func() {
+		shop := fixture(model.WebhookSubscriptionTopicShopUpdate).(*webhook.ShopPayload).ToModel()
+		Expect(shop.ID).To(Equal("gid://shopify/Shop/548380009"))
+		Expect(shop.ContactEmail).To(BeEmpty())
+		Expect(shop.CurrencyCode).To(Equal(model.CurrencyCodeCad))
+		Expect(shop.IanaTimezone).To(Equal("America/Toronto"))
+	})
+
+	It("decodes order payloads", func() {
+		payload := fixture(model.WebhookSubscriptionTopicOrdersCreate)
+		Expect(payload).To(BeAssignableToTypeOf(&webhook.OrderPayload{}))
+
+		order := payload.(*webhook.OrderPayload).ToModel()
+		Expect(order.ID).To(Equal("gid://shopify/Order/820982911946154508"))
+		Expect(order.Name).To(Equal("#9999"))
+		Expect(*order.Email).To(Equal("jon@example.com"))
+		Expect(order.Note).To(BeNil())
+		Expect(order.Tags).To(Equal([]string{"tag1", "tag2"}))
+		Expect(order.CurrencyCode).To(Equal(model.CurrencyCodeUsd))
+		Expect(order.TotalPriceSet.ShopMoney.Amount.Equal(decimal.RequireFromString("403"))).To(BeTrue())
+		Expect(order.TotalPriceSet.ShopMoney.CurrencyCode).To(Equal(model.CurrencyCodeUsd))
+		Expect(order.SubtotalPriceSet.ShopMoney.Amount.Equal(decimal.RequireFromString("393"))).To(BeTrue())
+		Expect(*order.DisplayFinancialStatus).To(Equal(model.OrderDisplayFinancialStatusVoided))
+		Expect(order.DisplayFulfillmentStatus).To(Equal(model.OrderDisplayFulfillmentStatusPartiallyFulfilled))
+		Expect(order.CancelledAt).NotTo(BeNil())
+
+		Expect(order.LineItems.Nodes).To(HaveLen(1))
+		item := order.LineItems.Nodes[0]
+		Expect(item.ID).To(Equal("gid://shopify/LineItem/487817672276298554"))
+		Expect(item.Quantity).To(Equal(1))
+		Expect(item.OriginalUnitPriceSet.ShopMoney.Amount.Equal(decimal.RequireFromString("89.99"))).To(BeTrue())
+		Expect(item.Product.ID).To(Equal("gid://shopify/Product/788032119674292922"))
+		Expect(item.Variant).To(BeNil())
+	})
+
+	It("maps missing fulfillment statuses to unfulfilled", func() {
+		order := (&webhook.OrderPayload{ID: 1}).ToModel()
+		Expect(order.DisplayFulfillmentStatus).To(Equal(model.OrderDisplayFulfillmentStatusUnfulfilled))
+		Expect(order.DisplayFinancialStatus).To(BeNil())
+		Expect(order.LineItems).To(BeNil())
+	})
+
+	It("decodes customer payloads", func() {
+		payload := fixture(model.WebhookSubscriptionTopicCustomersCreate)
+		Expect(payload).To(BeAssignableToTypeOf(&webhook.CustomerPayload{}))
+
+		customer := payload.(*webhook.CustomerPayload).ToModel()
+		Expect(customer.ID).To(Equal("gid://shopify/Customer/706405506930370084"))
+		Expect(*customer.FirstName).To(Equal("Bob"))
+		Expect(customer.Phone).To(BeNil())
+		Expect(customer.State).To(Equal(model.CustomerStateDisabled))
+		Expect(customer.Tags).To(BeEmpty())
+		Expect(customer.CreatedAt.Equal(created)).To(BeTrue())
+	})
+
+	It("decodes app subscription payloads", func() {
+		payload := fixture(model.WebhookSubscriptionTopicAppSubscriptionsUpdate)
+		Expect(payload).To(BeAssignableToTypeOf(&webhook.AppSubscriptionPayload{}))
+
+		subscription := payload.(*webhook.AppSubscriptionPayload).ToModel()
+		Expect(subscription.ID).To(Equal("gid://shopify/AppSubscription/1029266947"))
+		Expect(subscription.Name).To(Equal("Premium"))
+		Expect(subscription.Status).To(Equal(model.AppSubscriptionStatusActive))
+		Expect(subscription.LineItems).To(HaveLen(1))
+		usage, ok := subscription.LineItems[0].Plan.PricingDetails.(*model.AppUsagePricing)
+		Expect(ok).To(BeTrue())
+		Expect(usage.CappedAmount.Amount.String()).To(Equal("20"))
+		Expect(usage.CappedAmount.CurrencyCode).To(Equal(model.CurrencyCodeUsd))
+
+		payload = &webhook.AppSubscriptionPayload{}
+		Expect(payload.(*webhook.AppSubscriptionPayload).ToModel().LineItems).To(BeEmpty())
+	})
+
+	It("decodes shop payloads", func() {
+		payload := fixture(model.WebhookSubscriptionTopicAppUninstalled)
+		Expect(payload).To(BeAssignableToTypeOf(&webhook.ShopPayload{}))
+
+		shop := payload.(*webhook.ShopPayload).ToModel()
+		Expect(shop.ID).To(Equal("gid://shopify/Shop/548380009"))
+		Expect(shop.MyshopifyDomain).To(Equal("example.myshopify.com"))
+		Expect(shop.ContactEmail).To(Equal("customers@example.com"))
+		Expect(shop.CurrencyCode).To(Equal(model.CurrencyCodeUsd))
+	})
+
+	It("fails on topics without payload types", func() {
+		_, ok := webhook.NewPayload(model.WebhookSubscriptionTopicCollectionsCreate)
+		Expect(ok).To(BeFalse())
+		_, err := (&webhook.Delivery{Topic: model.WebhookSubscriptionTopicCollectionsCreate}).Payload()
+		Expect(err).To(MatchError(ContainSubstring("COLLECTIONS_CREATE")))
+	})
+
+	It("fails on malformed bodies", func() {
+		_, err := (&webhook.Delivery{Topic: model.WebhookSubscriptionTopicOrdersCreate, Body: []byte("{")}).Payload()
+		Expect(err).To(HaveOccurred())
+	})
+})
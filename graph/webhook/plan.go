@@ -0,0 +1,272 @@
+package webhook
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// Subscription is a desired webhook subscription.
+type Subscription struct {
+	Topic model.WebhookSubscriptionTopic
+	// Endpoint is a model.WebhookHTTPEndpoint, model.WebhookEventBridgeEndpoint or model.WebhookPubSubEndpoint, or
+	// a pointer to one of them.
+	Endpoint model.WebhookSubscriptionEndpoint
+	// Format defaults to JSON.
+	Format              model.WebhookSubscriptionFormat
+	IncludeFields       []string
+	Filter              string
+	MetafieldNamespaces []string
+}
+
+// ActionKind is the kind of an Action.
+type ActionKind int
+
+const (
+	ActionCreate ActionKind = iota
+	ActionUpdate
+	ActionDelete
+)
+
+// String returns the name of the kind.
+func (k ActionKind) String() string {
+	switch k {
+	case ActionCreate:
+		return "create"
+	case ActionUpdate:
+		return "update"
+	case ActionDelete:
+		return "delete"
+	}
+	return fmt.Sprintf("ActionKind(%d)", int(k))
+}
+
+// Action is a mutation bringing the subscriptions of the shop closer to the desired ones. Exactly one of HTTP,
+// EventBridge and PubSub is set on create and update actions, according to the type of the endpoint.
+type Action struct {
+	Kind  ActionKind
+	Topic model.WebhookSubscriptionTopic
+	// ID is the ID of the subscription to update or delete.
+	ID          string
+	HTTP        *model.WebhookSubscriptionInput
+	EventBridge *model.EventBridgeWebhookSubscriptionInput
+	PubSub      *model.PubSubWebhookSubscriptionInput
+}
+
+// Mutation returns the name of the mutation performing the action, e.g. webhookSubscriptionCreate.
+func (a *Action) Mutation() string {
+	var prefix string
+	switch {
+	case a.Kind == ActionDelete:
+		return "webhookSubscriptionDelete"
+	case a.EventBridge != nil:
+		prefix = "eventBridgeWebhookSubscription"
+	case a.PubSub != nil:
+		prefix = "pubSubWebhookSubscription"
+	default:
+		prefix = "webhookSubscription"
+	}
+	if a.Kind == ActionCreate {
+		return prefix + "Create"
+	}
+	return prefix + "Update"
+}
+
+// endpointKind is the type of a subscription endpoint.
+type endpointKind int
+
+const (
+	endpointHTTP endpointKind = iota
+	endpointEventBridge
+	endpointPubSub
+)
+
+// endpoint identifies the endpoint of a subscription.
+type endpoint struct {
+	kind endpointKind
+	// address is the callback URL, ARN or Pub/Sub project.
+	address     string
+	pubSubTopic string
+}
+
+// parseEndpoint returns the endpoint identifying ep.
+func parseEndpoint(ep model.WebhookSubscriptionEndpoint) (endpoint, error) {
+	switch ep := ep.(type) {
+	case model.WebhookHTTPEndpoint:
+		return endpoint{kind: endpointHTTP, address: ep.CallbackURL}, nil
+	case *model.WebhookHTTPEndpoint:
+		return endpoint{kind: endpointHTTP, address: ep.CallbackURL}, nil
+	case model.WebhookEventBridgeEndpoint:
+		return endpoint{kind: endpointEventBridge, address: ep.Arn}, nil
+	case *model.WebhookEventBridgeEndpoint:
+		return endpoint{kind: endpointEventBridge, address: ep.Arn}, nil
+	case model.WebhookPubSubEndpoint:
+		return endpoint{kind: endpointPubSub, address: ep.PubSubProject, pubSubTopic: ep.PubSubTopic}, nil
+	case *model.WebhookPubSubEndpoint:
+		return endpoint{kind: endpointPubSub, address: ep.PubSubProject, pubSubTopic: ep.PubSubTopic}, nil
+	}
+	return endpoint{}, fmt.Errorf("unsupported endpoint %T", ep)
+}
+
+// Plan returns the actions turning the current subscriptions of the shop into the desired ones. Current
+// subscriptions with the topic and endpoint of a desired one are updated if they differ from it. The remaining
+// desired subscriptions replace the remaining current ones with their topic and endpoint type through updates, and
+// are created when there are none left. The remaining current subscriptions are deleted. Since the inputs cannot
+// clear include fields or metafield namespaces, subscriptions dropping them are recreated.
+//
+// The actions are ordered as creates, updates, recreates and deletes, each in the order of their subscriptions. A
+// recreate is the delete of the current subscription followed by the create of the desired one, since Shopify
+// rejects a second subscription with the same topic and endpoint. Plan fails
+// when the endpoint of a subscription is not supported or two desired subscriptions share a topic and endpoint.
+func Plan(desired []Subscription, current []model.WebhookSubscription) ([]Action, error) {
+	type key struct {
+		topic    model.WebhookSubscriptionTopic
+		endpoint endpoint
+	}
+
+	wanted := make([]key, len(desired))
+	seen := map[key]bool{}
+	for i, s := range desired {
+		ep, err := parseEndpoint(s.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("desired subscription %d: %w", i, err)
+		}
+		wanted[i] = key{s.Topic, ep}
+		if seen[wanted[i]] {
+			return nil, fmt.Errorf("duplicate desired subscription to %s at %s", s.Topic, ep.address)
+		}
+		seen[wanted[i]] = true
+	}
+
+	existing := make([]key, len(current))
+	for i, s := range current {
+		ep, err := parseEndpoint(s.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("subscription %s: %w", s.ID, err)
+		}
+		existing[i] = key{s.Topic, ep}
+	}
+
+	// matches[i] is the index of the current subscription kept for the desired subscription i, or -1.
+	matches := make([]int, len(desired))
+	used := make([]bool, len(current))
+	for i := range desired {
+		matches[i] = slices.IndexFunc(existing, func(k key) bool { return k == wanted[i] })
+		if matches[i] >= 0 {
+			used[matches[i]] = true
+		}
+	}
+	for i := range desired {
+		if matches[i] >= 0 {
+			continue
+		}
+		for j, k := range existing {
+			if !used[j] && k.topic == wanted[i].topic && k.endpoint.kind == wanted[i].endpoint.kind {
+				matches[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	var creates, updates, recreates, deletes []Action
+	for i := range desired {
+		s := &desired[i]
+		j := matches[i]
+		if j < 0 {
+			creates = append(creates, newAction(ActionCreate, s, ""))
+			continue
+		}
+		switch {
+		case s.clears(&current[j]):
+			recreates = append(recreates,
+				Action{Kind: ActionDelete, Topic: current[j].Topic, ID: current[j].ID},
+				newAction(ActionCreate, s, ""))
+		case existing[j] != wanted[i] || !s.matches(&current[j]):
+			updates = append(updates, newAction(ActionUpdate, s, current[j].ID))
+		}
+	}
+	for j, s := range current {
+		if !used[j] {
+			deletes = append(deletes, Action{Kind: ActionDelete, Topic: s.Topic, ID: s.ID})
+		}
+	}
+	return slices.Concat(creates, updates, recreates, deletes), nil
+}
+
+// format returns the format of the subscription, defaulting to JSON.
+func (s *Subscription) format() model.WebhookSubscriptionFormat {
+	if s.Format == "" {
+		return model.WebhookSubscriptionFormatJSON
+	}
+	return s.Format
+}
+
+// matches reports whether the options of the current subscription c are the desired ones. Field and namespace lists
+// are compared regardless of their order.
+func (s *Subscription) matches(c *model.WebhookSubscription) bool {
+	var filter string
+	if c.Filter != nil {
+		filter = *c.Filter
+	}
+	return s.format() == c.Format && s.Filter == filter &&
+		sameSet(s.IncludeFields, c.IncludeFields) && sameSet(s.MetafieldNamespaces, c.MetafieldNamespaces)
+}
+
+// clears reports whether s has no include fields or metafield namespaces where the current subscription c has some.
+// The inputs omit empty lists, so such subscriptions are replaced rather than updated.
+func (s *Subscription) clears(c *model.WebhookSubscription) bool {
+	return len(s.IncludeFields) == 0 && len(c.IncludeFields) > 0 ||
+		len(s.MetafieldNamespaces) == 0 && len(c.MetafieldNamespaces) > 0
+}
+
+// sameSet reports whether a and b hold the same strings.
+func sameSet(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// newAction returns the action of kind applying s to the subscription id.
+func newAction(kind ActionKind, s *Subscription, id string) Action {
+	a := Action{Kind: kind, Topic: s.Topic, ID: id}
+	format := s.format()
+	var filter *string
+	if s.Filter != "" || kind == ActionUpdate {
+		// An empty filter clears the filter of the updated subscription.
+		filter = &s.Filter
+	}
+	includeFields, namespaces := s.IncludeFields, s.MetafieldNamespaces
+
+	ep, _ := parseEndpoint(s.Endpoint)
+	switch ep.kind {
+	case endpointHTTP:
+		a.HTTP = &model.WebhookSubscriptionInput{
+			CallbackURL:         &ep.address,
+			Format:              &format,
+			IncludeFields:       includeFields,
+			Filter:              filter,
+			MetafieldNamespaces: namespaces,
+		}
+	case endpointEventBridge:
+		a.EventBridge = &model.EventBridgeWebhookSubscriptionInput{
+			Arn:                 &ep.address,
+			Format:              &format,
+			IncludeFields:       includeFields,
+			Filter:              filter,
+			MetafieldNamespaces: namespaces,
+		}
+	case endpointPubSub:
+		a.PubSub = &model.PubSubWebhookSubscriptionInput{
+			PubSubProject:       ep.address,
+			PubSubTopic:         ep.pubSubTopic,
+			Format:              &format,
+			IncludeFields:       includeFields,
+			Filter:              filter,
+			MetafieldNamespaces: namespaces,
+		}
+	}
+	return a
+}
@@ -0,0 +1,120 @@
+package webhook_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/webhook"
+)
+
+var _ = Describe("Plan", func() {
+	const url = "https://example.com/webhooks"
+	endpoint := &model.WebhookHTTPEndpoint{CallbackURL: url}
+	format := model.WebhookSubscriptionFormatJSON
+	ptr := func(s string) *string { return &s }
+
+	current := func(
+		id string, topic model.WebhookSubscriptionTopic, ep model.WebhookSubscriptionEndpoint,
+	) model.WebhookSubscription {
+		return model.WebhookSubscription{ID: id, Topic: topic, Endpoint: ep, Format: format}
+	}
+
+	It("plans nothing when the subscriptions are up to date", func() {
+		sub := current("gid://shopify/WebhookSubscription/1", model.WebhookSubscriptionTopicOrdersCreate, endpoint)
+		sub.IncludeFields = []string{"id", "email"}
+		actions, err := webhook.Plan([]webhook.Subscription{{
+			Topic:         model.WebhookSubscriptionTopicOrdersCreate,
+			Endpoint:      model.WebhookHTTPEndpoint{CallbackURL: url},
+			IncludeFields: []string{"email", "id"},
+		}}, []model.WebhookSubscription{sub})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(BeEmpty())
+	})
+
+	It("creates, updates and deletes subscriptions", func() {
+		actions, err := webhook.Plan([]webhook.Subscription{
+			{Topic: model.WebhookSubscriptionTopicOrdersCreate, Endpoint: endpoint, Filter: "total_price:>100"},
+			{
+				Topic:    model.WebhookSubscriptionTopicProductsUpdate,
+				Endpoint: &model.WebhookEventBridgeEndpoint{Arn: "arn:aws:events:us-east-1::event-source/x"},
+			},
+		}, []model.WebhookSubscription{
+			current("gid://shopify/WebhookSubscription/1", model.WebhookSubscriptionTopicOrdersCreate, endpoint),
+			current("gid://shopify/WebhookSubscription/2", model.WebhookSubscriptionTopicAppUninstalled, endpoint),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(Equal([]webhook.Action{
+			{
+				Kind:  webhook.ActionCreate,
+				Topic: model.WebhookSubscriptionTopicProductsUpdate,
+				EventBridge: &model.EventBridgeWebhookSubscriptionInput{
+					Arn:    ptr("arn:aws:events:us-east-1::event-source/x"),
+					Format: &format,
+				},
+			},
+			{
+				Kind:  webhook.ActionUpdate,
+				Topic: model.WebhookSubscriptionTopicOrdersCreate,
+				ID:    "gid://shopify/WebhookSubscription/1",
+				HTTP: &model.WebhookSubscriptionInput{
+					CallbackURL: ptr(url),
+					Format:      &format,
+					Filter:      ptr("total_price:>100"),
+				},
+			},
+			{
+				Kind:  webhook.ActionDelete,
+				Topic: model.WebhookSubscriptionTopicAppUninstalled,
+				ID:    "gid://shopify/WebhookSubscription/2",
+			},
+		}))
+		Expect(actions[0].Mutation()).To(Equal("eventBridgeWebhookSubscriptionCreate"))
+		Expect(actions[1].Mutation()).To(Equal("webhookSubscriptionUpdate"))
+		Expect(actions[2].Mutation()).To(Equal("webhookSubscriptionDelete"))
+	})
+
+	It("moves subscriptions to new endpoints of the same type", func() {
+		actions, err := webhook.Plan([]webhook.Subscription{{
+			Topic:    model.WebhookSubscriptionTopicShopUpdate,
+			Endpoint: model.WebhookPubSubEndpoint{PubSubProject: "project", PubSubTopic: "new"},
+		}}, []model.WebhookSubscription{
+			current("gid://shopify/WebhookSubscription/1", model.WebhookSubscriptionTopicShopUpdate, endpoint),
+			current("gid://shopify/WebhookSubscription/2", model.WebhookSubscriptionTopicShopUpdate,
+				&model.WebhookPubSubEndpoint{PubSubProject: "project", PubSubTopic: "old"}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(HaveLen(2))
+		Expect(actions[0].Kind).To(Equal(webhook.ActionUpdate))
+		Expect(actions[0].ID).To(Equal("gid://shopify/WebhookSubscription/2"))
+		Expect(actions[0].Mutation()).To(Equal("pubSubWebhookSubscriptionUpdate"))
+		Expect(actions[0].PubSub.PubSubTopic).To(Equal("new"))
+		Expect(actions[1].Kind).To(Equal(webhook.ActionDelete))
+		Expect(actions[1].ID).To(Equal("gid://shopify/WebhookSubscription/1"))
+	})
+
+	It("recreates subscriptions dropping their include fields", func() {
+		sub := current("gid://shopify/WebhookSubscription/1", model.WebhookSubscriptionTopicOrdersCreate, endpoint)
+		sub.IncludeFields = []string{"id"}
+		actions, err := webhook.Plan([]webhook.Subscription{
+			{Topic: model.WebhookSubscriptionTopicOrdersCreate, Endpoint: endpoint},
+		}, []model.WebhookSubscription{sub})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actions).To(HaveLen(2))
+		Expect(actions[0].Kind).To(Equal(webhook.ActionDelete))
+		Expect(actions[0].ID).To(Equal("gid://shopify/WebhookSubscription/1"))
+		Expect(actions[1].Kind).To(Equal(webhook.ActionCreate))
+		Expect(actions[1].Topic).To(Equal(model.WebhookSubscriptionTopicOrdersCreate))
+	})
+
+	It("fails on duplicate and unsupported subscriptions", func() {
+		_, err := webhook.Plan([]webhook.Subscription{
+			{Topic: model.WebhookSubscriptionTopicOrdersCreate, Endpoint: endpoint},
+			{Topic: model.WebhookSubscriptionTopicOrdersCreate, Endpoint: model.WebhookHTTPEndpoint{CallbackURL: url}},
+		}, nil)
+		Expect(err).To(MatchError(ContainSubstring("duplicate")))
+
+		_, err = webhook.Plan([]webhook.Subscription{{Topic: model.WebhookSubscriptionTopicOrdersCreate}}, nil)
+		Expect(err).To(MatchError(ContainSubstring("unsupported endpoint")))
+	})
+})
@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store records the handled deliveries by their X-Shopify-Webhook-Id. The Handler claims a delivery with
+// MarkIfAbsent before handling it, so that concurrent duplicates of a delivery are handled once, and releases the
+// claim with Forget when the handler fails, so that Shopify's retry is handled.
+type Store interface {
+	// MarkIfAbsent records the delivery id and reports whether it was absent. It must be atomic: of concurrent
+	// calls with the same id, only one returns true.
+	MarkIfAbsent(ctx context.Context, id string) (bool, error)
+	// Forget removes the delivery id.
+	Forget(ctx context.Context, id string) error
+}
+
+// MemoryStore is a Store keeping the handled deliveries in memory for a duration.
+type MemoryStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	handled map[string]time.Time
+	swept   time.Time
+}
+
+// NewMemoryStore returns a MemoryStore forgetting the deliveries ttl after they were handled. Shopify retries a
+// failed delivery for up to 48 hours.
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{ttl: ttl, now: time.Now, handled: map[string]time.Time{}}
+}
+
+// MarkIfAbsent records the delivery id unless it was recorded less than the ttl ago. The expired deliveries are
+// removed at most once per ttl.
+func (s *MemoryStore) MarkIfAbsent(_ context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if now.Sub(s.swept) >= s.ttl {
+		for handledID, at := range s.handled {
+			if now.Sub(at) >= s.ttl {
+				delete(s.handled, handledID)
+			}
+		}
+		s.swept = now
+	}
+	if at, ok := s.handled[id]; ok && now.Sub(at) < s.ttl {
+		return false, nil
+	}
+	s.handled[id] = now
+	return true, nil
+}
+
+// Forget removes the delivery id, so that the next delivery with the same id is handled. The Handler calls it when
+// the handler of a delivery it claimed fails.
+func (s *MemoryStore) Forget(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.handled, id)
+	return nil
+}
+
+// Len returns the number of recorded deliveries, including the expired ones not removed yet.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.handled)
+}
@@ -0,0 +1,200 @@
+// Package webhook receives the webhooks delivered by Shopify over HTTP: it verifies their signature, deduplicates
+// their deliveries and dispatches them to the handlers of their topic.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// The headers of webhook deliveries.
+const (
+	HeaderHmac        = "X-Shopify-Hmac-Sha256"
+	HeaderTopic       = "X-Shopify-Topic"
+	HeaderWebhookID   = "X-Shopify-Webhook-Id"
+	HeaderShopDomain  = "X-Shopify-Shop-Domain"
+	HeaderAPIVersion  = "X-Shopify-API-Version"
+	HeaderEventID     = "X-Shopify-Event-Id"
+	HeaderTriggeredAt = "X-Shopify-Triggered-At"
+)
+
+// MaxBodySize is the default maximum size of the body of a delivery.
+const MaxBodySize = 10 << 20
+
+// ErrInvalidSignature is returned by Verify when the signature of a delivery does not match its body.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Delivery is a webhook delivery.
+type Delivery struct {
+	Topic model.WebhookSubscriptionTopic
+	// ID is the ID of the delivery, which is the same for the retries of a delivery.
+	ID          string
+	ShopDomain  string
+	APIVersion  string
+	EventID     string
+	TriggeredAt string
+	Body        []byte
+}
+
+// HandlerFunc handles a delivery. An error makes the Handler respond with a server error, so that Shopify retries
+// the delivery.
+type HandlerFunc func(ctx context.Context, d *Delivery) error
+
+// ParseTopic returns the topic of the X-Shopify-Topic header value header, e.g. ORDERS_CREATE for orders/create.
+// Topics unknown to the models are kept, see model.WebhookSubscriptionTopic.IsKnown.
+func ParseTopic(header string) model.WebhookSubscriptionTopic {
+	return model.WebhookSubscriptionTopic(strings.ToUpper(strings.ReplaceAll(header, "/", "_")))
+}
+
+// Verify reports whether signature, the value of the X-Shopify-Hmac-Sha256 header, is the signature of body with
+// any of secrets. Several secrets can be given while the secret of the app is rotated.
+func Verify(body []byte, signature string, secrets ...string) error {
+	expected, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return ErrInvalidSignature
+	}
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if hmac.Equal(mac.Sum(nil), expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// Sign returns the signature of body with secret, as sent in the X-Shopify-Hmac-Sha256 header.
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Handler is an http.Handler receiving webhook deliveries. It responds with:
+//   - 401 when the signature is invalid,
+//   - 400 when the topic is missing,
+//   - 200 without dispatching when the delivery was already claimed in the store or no handler is registered for
+//     its topic,
+//   - 500 when the handler of the topic fails, and 200 otherwise.
+type Handler struct {
+	secrets  []string
+	store    Store
+	maxBody  int64
+	handlers map[model.WebhookSubscriptionTopic]HandlerFunc
+}
+
+// NewHandler returns a Handler verifying the deliveries with secrets, the client secrets of the app.
+func NewHandler(secrets ...string) *Handler {
+	return &Handler{
+		secrets:  secrets,
+		maxBody:  MaxBodySize,
+		handlers: map[model.WebhookSubscriptionTopic]HandlerFunc{},
+	}
+}
+
+// SetStore sets the store of the handled deliveries, used to skip the deliveries already handled.
+func (h *Handler) SetStore(store Store) {
+	h.store = store
+}
+
+// SetMaxBodySize sets the maximum size of the body of a delivery.
+func (h *Handler) SetMaxBodySize(size int64) {
+	h.maxBody = size
+}
+
+// Handle registers fn as the handler of topic.
+func (h *Handler) Handle(topic model.WebhookSubscriptionTopic, fn HandlerFunc) {
+	h.handlers[topic] = fn
+}
+
+// On registers fn as the handler of topic, with the body of the deliveries decoded into T.
+func On[T any](
+	h *Handler, topic model.WebhookSubscriptionTopic, fn func(ctx context.Context, d *Delivery, payload T) error,
+) {
+	h.Handle(topic, func(ctx context.Context, d *Delivery) error {
+		var payload T
+		if err := json.Unmarshal(d.Body, &payload); err != nil {
+			return fmt.Errorf("decode %s payload: %w", d.Topic, err)
+		}
+		return fn(ctx, d, payload)
+	})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.maxBody+1))
+	if err != nil {
+		http.Error(w, "read body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > h.maxBody {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err = Verify(body, r.Header.Get(HeaderHmac), h.secrets...); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	d := &Delivery{
+		Topic:       ParseTopic(r.Header.Get(HeaderTopic)),
+		ID:          r.Header.Get(HeaderWebhookID),
+		ShopDomain:  r.Header.Get(HeaderShopDomain),
+		APIVersion:  r.Header.Get(HeaderAPIVersion),
+		EventID:     r.Header.Get(HeaderEventID),
+		TriggeredAt: r.Header.Get(HeaderTriggeredAt),
+		Body:        body,
+	}
+	if d.Topic == "" {
+		http.Error(w, "missing topic", http.StatusBadRequest)
+		return
+	}
+
+	handler := h.handlers[d.Topic]
+	if handler == nil {
+		logrus.Debugf("no handler for webhook topic %s", d.Topic)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	ctx := r.Context()
+	if h.store != nil && d.ID != "" {
+		claimed, err := h.store.MarkIfAbsent(ctx, d.ID)
+		if err != nil {
+			http.Error(w, "check delivery", http.StatusInternalServerError)
+			return
+		}
+		if !claimed {
+			logrus.Debugf("skip duplicate webhook delivery %s", d.ID)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	if err = handler(ctx, d); err != nil {
+		logrus.Errorf("handle webhook %s delivery %s: %v", d.Topic, d.ID, err)
+		if h.store != nil && d.ID != "" {
+			if err = h.store.Forget(ctx, d.ID); err != nil {
+				logrus.Errorf("forget webhook delivery %s: %v", d.ID, err)
+			}
+		}
+		http.Error(w, "handle delivery", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
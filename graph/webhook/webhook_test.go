@@ -0,0 +1,156 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql-model/graph/webhook"
+)
+
+const secret = "shhh"
+
+var _ = Describe("ParseTopic", func() {
+	It("parses the topic header", func() {
+		Expect(webhook.ParseTopic("orders/create")).To(Equal(model.WebhookSubscriptionTopicOrdersCreate))
+		Expect(webhook.ParseTopic("app_subscriptions/update")).To(
+			Equal(model.WebhookSubscriptionTopicAppSubscriptionsUpdate))
+		Expect(webhook.ParseTopic("things/happen").IsKnown()).To(BeFalse())
+	})
+})
+
+var _ = Describe("Verify", func() {
+	It("verifies the signature", func() {
+		body := []byte(`{"id":1}`)
+		Expect(webhook.Verify(body, webhook.Sign(body, secret), secret)).To(Succeed())
+		Expect(webhook.Verify(body, webhook.Sign(body, secret), "old", secret)).To(Succeed())
+		Expect(webhook.Verify(body, webhook.Sign(body, "other"), secret)).To(MatchError(webhook.ErrInvalidSignature))
+		Expect(webhook.Verify(body, "not base64", secret)).To(MatchError(webhook.ErrInvalidSignature))
+		Expect(webhook.Verify(body, "", secret)).To(MatchError(webhook.ErrInvalidSignature))
+	})
+})
+
+var _ = Describe("Handler", func() {
+	type order struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+
+	var (
+		handler  *webhook.Handler
+		server   *httptest.Server
+		received []order
+		failing  bool
+	)
+
+	BeforeEach(func() {
+		received = nil
+		failing = false
+		handler = webhook.NewHandler(secret)
+		handler.SetStore(webhook.NewMemoryStore(time.Hour))
+		webhook.On(handler, model.WebhookSubscriptionTopicOrdersCreate,
+			func(_ context.Context, d *webhook.Delivery, o order) error {
+				Expect(d.ShopDomain).To(Equal("shop.myshopify.com"))
+				if failing {
+					return errors.New("database is down")
+				}
+				received = append(received, o)
+				return nil
+			})
+		server = httptest.NewServer(handler)
+		DeferCleanup(server.Close)
+	})
+
+	deliver := func(topic, id, body, signature string) int {
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set(webhook.HeaderTopic, topic)
+		req.Header.Set(webhook.HeaderWebhookID, id)
+		req.Header.Set(webhook.HeaderShopDomain, "shop.myshopify.com")
+		req.Header.Set(webhook.HeaderHmac, signature)
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	body := `{"id":1,"name":"#1001"}`
+
+	It("dispatches the deliveries to the handler of their topic", func() {
+		Expect(deliver("orders/create", "a", body, webhook.Sign([]byte(body), secret))).To(Equal(http.StatusOK))
+		Expect(received).To(Equal([]order{{ID: 1, Name: "#1001"}}))
+	})
+
+	It("rejects invalid signatures", func() {
+		Expect(deliver("orders/create", "a", body, webhook.Sign([]byte("{}"), secret))).To(
+			Equal(http.StatusUnauthorized))
+		Expect(received).To(BeEmpty())
+	})
+
+	It("skips duplicate deliveries", func() {
+		signature := webhook.Sign([]byte(body), secret)
+		Expect(deliver("orders/create", "a", body, signature)).To(Equal(http.StatusOK))
+		Expect(deliver("orders/create", "a", body, signature)).To(Equal(http.StatusOK))
+		Expect(deliver("orders/create", "b", body, signature)).To(Equal(http.StatusOK))
+		Expect(received).To(HaveLen(2))
+	})
+
+	It("lets Shopify retry failed deliveries", func() {
+		signature := webhook.Sign([]byte(body), secret)
+		failing = true
+		Expect(deliver("orders/create", "a", body, signature)).To(Equal(http.StatusInternalServerError))
+		failing = false
+		Expect(deliver("orders/create", "a", body, signature)).To(Equal(http.StatusOK))
+		Expect(received).To(HaveLen(1))
+	})
+
+	It("acknowledges topics without handler", func() {
+		Expect(deliver("products/update", "a", body, webhook.Sign([]byte(body), secret))).To(Equal(http.StatusOK))
+		Expect(received).To(BeEmpty())
+	})
+
+	It("requires the topic", func() {
+		Expect(deliver("", "a", body, webhook.Sign([]byte(body), secret))).To(Equal(http.StatusBadRequest))
+	})
+
+	It("fails on payloads not matching the type", func() {
+		invalid := `{"id":"x"}`
+		Expect(deliver("orders/create", "a", invalid, webhook.Sign([]byte(invalid), secret))).To(
+			Equal(http.StatusInternalServerError))
+	})
+
+	It("limits the body size", func() {
+		handler.SetMaxBodySize(4)
+		Expect(deliver("orders/create", "a", body, webhook.Sign([]byte(body), secret))).To(
+			Equal(http.StatusRequestEntityTooLarge))
+	})
+})
+
+var _ = Describe("MemoryStore", func() {
+	ctx := context.Background()
+
+	It("claims a delivery once", func() {
+		store := webhook.NewMemoryStore(time.Hour)
+		Expect(store.MarkIfAbsent(ctx, "a")).To(BeTrue())
+		Expect(store.MarkIfAbsent(ctx, "a")).To(BeFalse())
+		Expect(store.Forget(ctx, "a")).To(Succeed())
+		Expect(store.MarkIfAbsent(ctx, "a")).To(BeTrue())
+	})
+
+	It("forgets the deliveries after the ttl", func() {
+		store := webhook.NewMemoryStore(10 * time.Millisecond)
+		Expect(store.MarkIfAbsent(ctx, "a")).To(BeTrue())
+		Expect(store.MarkIfAbsent(ctx, "a")).To(BeFalse())
+		time.Sleep(20 * time.Millisecond)
+		Expect(store.MarkIfAbsent(ctx, "b")).To(BeTrue())
+		Expect(store.Len()).To(Equal(1))
+		Expect(store.MarkIfAbsent(ctx, "a")).To(BeTrue())
+	})
+})
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"go/types"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/plugin/modelgen"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/gempages/go-shopify-graphql-model/codegen"
 )
 
 func main() {
@@ -31,6 +35,52 @@ func main() {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(3)
 	}
+
+	// Generating the additional code from the loaded schema.
+	generators := []struct {
+		filename string
+		pkg      string
+		generate func(schema *ast.Schema, pkg string) ([]byte, error)
+	}{
+		{filename: filepath.Join(cfg.Model.Dir(), "args_gen.go"), pkg: cfg.Model.Package, generate: codegen.GenerateArgs},
+		{filename: "graph/operation/fields_gen.go", pkg: "operation", generate: codegen.GenerateFields},
+	}
+	for _, g := range generators {
+		src, err := g.generate(cfg.Schema, g.pkg)
+		writeGenerated(g.filename, src, err)
+	}
+
+	// Generating the additional code from the generated models.
+	models, err := codegen.ParseModels(cfg.Model.Filename, codegen.OneOfDirectives(cfg.Schema)...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(4)
+	}
+	modelGenerators := []struct {
+		filename string
+		generate func(models *codegen.Models) ([]byte, error)
+	}{
+		{filename: filepath.Join(cfg.Model.Dir(), "enums_gen.go"), generate: codegen.GenerateEnums},
+		{filename: filepath.Join(cfg.Model.Dir(), "connections_gen.go"), generate: codegen.GenerateConnections},
+		{filename: filepath.Join(cfg.Model.Dir(), "errors_gen.go"), generate: codegen.GenerateErrors},
+		{filename: filepath.Join(cfg.Model.Dir(), "oneof_gen.go"), generate: codegen.GenerateOneOf},
+	}
+	for _, g := range modelGenerators {
+		src, err := g.generate(models)
+		writeGenerated(g.filename, src, err)
+	}
+}
+
+// writeGenerated writes the generated src to filename, exiting on generation or write errors.
+func writeGenerated(filename string, src []byte, err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(4)
+	}
+	if err = os.WriteFile(filename, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to write", filename, err.Error())
+		os.Exit(4)
+	}
 }
 
 func isPointer(v interface{}) bool {